@@ -14,6 +14,7 @@ import (
 	"github.com/MadBase/MadNet/application/objs"
 	"github.com/MadBase/MadNet/application/objs/uint256"
 	"github.com/MadBase/MadNet/application/pendingtx"
+	"github.com/MadBase/MadNet/application/supply"
 	"github.com/MadBase/MadNet/application/utxohandler"
 	"github.com/MadBase/MadNet/application/wrapper"
 	trie "github.com/MadBase/MadNet/badgerTrie"
@@ -52,6 +53,7 @@ func (a *Application) Init(conDB *consensusdb.Database, memDB *badger.DB, dph *d
 		mTxHdlr: minedtx.NewMinedTxHandler(),
 		dHdlr:   dph,
 		uHdlr:   uHdlr,
+		sLdgr:   supply.NewLedger(),
 		cdb:     conDB,
 		storage: storage,
 	}
@@ -195,6 +197,13 @@ func (a *Application) ApplyState(txn *badger.Txn, chainID uint32, height uint32,
 	return a.txHandler.ApplyState(txn, chainID, height, tx)
 }
 
+// RollbackState undoes ApplyState for the block at height, which must be
+// the most recent height applied. The txHashes are the transactions of the
+// block. The state root of the height before is returned.
+func (a *Application) RollbackState(txn *badger.Txn, height uint32, txHashes [][]byte) ([]byte, error) {
+	return a.txHandler.RollbackState(txn, height, txHashes)
+}
+
 // PendingTxAdd adds a transaction to the txPool and cleans up any stale
 // tx as a result.
 func (a *Application) PendingTxAdd(txn *badger.Txn, chainID uint32, height uint32, txs []interfaces.Transaction) error {
@@ -205,6 +214,19 @@ func (a *Application) PendingTxAdd(txn *badger.Txn, chainID uint32, height uint3
 	return a.txHandler.PendingTxAdd(txn, chainID, height, tx)
 }
 
+// PendingTxDrop removes transactions from the txPool. The whole txPool is
+// dropped if no hashes are given.
+func (a *Application) PendingTxDrop(txHashes [][]byte) error {
+	return a.txHandler.PendingTxDrop(txHashes)
+}
+
+// ValidateTx runs the checks performed before a transaction is accepted into
+// the txPool and reports every failure found. The transaction is not added
+// to the txPool.
+func (a *Application) ValidateTx(txn *badger.Txn, chainID uint32, height uint32, tx *objs.Tx) (*TxValidation, error) {
+	return a.txHandler.ValidateTx(txn, chainID, height, tx)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 //Data Getters/Setters/RPC methods//////////////////////////////////////////////
@@ -297,7 +319,9 @@ func (a *Application) UTXOGetData(txn *badger.Txn, curveSpec constants.CurveSpec
 	return a.txHandler.UTXOGetData(txn, owner, dataIdx)
 }
 
-func (a *Application) GetValueForOwner(txn *badger.Txn, curveSpec constants.CurveSpec, account []byte, minValue *uint256.Uint256, ptBytes []byte) ([][]byte, *uint256.Uint256, *objs.PaginationToken, error) {
+// GetValueForOwner returns a list of UTXOIDs owned by an account which sum to
+// at least minValue; UTXOs which are timelocked at height are excluded
+func (a *Application) GetValueForOwner(txn *badger.Txn, curveSpec constants.CurveSpec, account []byte, height uint32, minValue *uint256.Uint256, ptBytes []byte) ([][]byte, *uint256.Uint256, *objs.PaginationToken, error) {
 	owner := &objs.Owner{}
 	err := owner.New(account, curveSpec)
 	if err != nil {
@@ -315,7 +339,7 @@ func (a *Application) GetValueForOwner(txn *badger.Txn, curveSpec constants.Curv
 		}
 	}
 
-	return a.txHandler.GetValueForOwner(txn, owner, minValue, pt)
+	return a.txHandler.GetValueForOwner(txn, owner, minValue, height, pt)
 }
 
 // UTXOGet returns a list of UTXO objects
@@ -335,11 +359,99 @@ func (a *Application) PaginateDataByOwner(txn *badger.Txn, curveSpec constants.C
 	return a.txHandler.PaginateDataByOwner(txn, owner, height, numItems, startIndex)
 }
 
+// GetWithdrawalProof returns the Withdrawal with utxoID and a proof of its
+// inclusion in the UTXO trie with root stateRoot
+func (a *Application) GetWithdrawalProof(txn *badger.Txn, utxoID []byte, stateRoot []byte) (*objs.TXOut, *consensusdb.MerkleProof, error) {
+	return a.txHandler.GetWithdrawalProof(txn, utxoID, stateRoot)
+}
+
+// GetWithdrawalsForAccount returns a list of UTXOIDs of the withdrawals to an
+// Ethereum account and the key to continue the list from, if any remain
+func (a *Application) GetWithdrawalsForAccount(txn *badger.Txn, account []byte, maxCount int, startKey []byte) ([][]byte, []byte, error) {
+	return a.txHandler.GetWithdrawalsForAccount(txn, account, maxCount, startKey)
+}
+
+// GetFees returns the fees currently set in dynamics
+func (a *Application) GetFees() (minTxFee, valueStoreFee, dataStoreEpochFee, atomicSwapFee *uint256.Uint256, err error) {
+	storage := a.txHandler.storage
+	if minTxFee, err = storage.GetMinTxFee(); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if valueStoreFee, err = storage.GetValueStoreFee(); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if dataStoreEpochFee, err = storage.GetDataStoreEpochFee(); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if atomicSwapFee, err = storage.GetAtomicSwapFee(); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return minTxFee, valueStoreFee, dataStoreEpochFee, atomicSwapFee, nil
+}
+
+// GetFeatures returns the registered and the scheduled features
+func (a *Application) GetFeatures() []*dynamics.FeatureInfo {
+	return a.txHandler.storage.GetFeatures()
+}
+
 // GetHeightForTx returns the height at which a tx was mined
 func (a *Application) GetHeightForTx(txn *badger.Txn, txHash []byte) (uint32, error) {
 	return a.txHandler.GetHeightForTx(txn, txHash)
 }
 
+// GetSpendingTx returns the hash of the mined tx which consumed utxoID and the
+// height at which it was mined. Spends are indexed as blocks are applied, so
+// after a fast sync only the UTXOs consumed above the snapshot height are
+// known; for the others an error naming the first indexed height is
+// returned.
+func (a *Application) GetSpendingTx(txn *badger.Txn, utxoID []byte) ([]byte, uint32, error) {
+	return a.txHandler.GetSpendingTx(txn, utxoID)
+}
+
+// GetSupply returns the supply ledger entry of height
+func (a *Application) GetSupply(txn *badger.Txn, height uint32) (*supply.Supply, error) {
+	return a.txHandler.GetSupply(txn, height)
+}
+
+// CheckSupply verifies the supply ledger against the state trie at the
+// latest snapshot height. The state trie is walked in a read-only
+// transaction, so the chain is not held up while it is; the Result is
+// written by StoreSupplyCheck. Mismatches are logged; errors are logged as
+// well and a nil Result is returned so that the caller keeps running.
+func (a *Application) CheckSupply() *supply.Result {
+	var r *supply.Result
+	err := a.txHandler.db.View(func(txn *badger.Txn) error {
+		os, err := a.txHandler.cdb.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		r, err = a.txHandler.CheckSupply(txn, os.SyncToBH.BClaims.Height)
+		return err
+	})
+	if err != nil {
+		a.logger.Warnf("Could not check the supply ledger: %v", err)
+		return nil
+	}
+	return r
+}
+
+// StoreSupplyCheck writes the Result of CheckSupply unless the chain has
+// changed in a way that makes it stale. It must be called while the chain
+// can not move. Errors are logged so that the caller keeps running.
+func (a *Application) StoreSupplyCheck(r *supply.Result) error {
+	err := a.txHandler.db.Update(func(txn *badger.Txn) error {
+		os, err := a.txHandler.cdb.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		return a.txHandler.StoreSupplyCheck(txn, os.SyncToBH.BClaims.Height, r)
+	})
+	if err != nil {
+		a.logger.Warnf("Could not store the supply check: %v", err)
+	}
+	return nil
+}
+
 // Cleanup does nothing at this time
 func (a *Application) Cleanup() error {
 	return nil
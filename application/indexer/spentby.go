@@ -0,0 +1,132 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+/*
+
+== BADGER KEYS ==
+
+lookup:
+key: <prefix>|<utxoID>
+  value: <txHash>|<height>
+
+start:
+key: <prefix>|start
+  value: <height>
+
+*/
+
+// spentByStartKey is the suffix of the key of the first indexed height; it
+// is shorter than a utxoID so it does not collide with the lookup keys
+var spentByStartKey = []byte("start")
+
+func NewSpentByIndex(p prefixFunc) *SpentByIndex {
+	return &SpentByIndex{p}
+}
+
+// SpentByIndex creates an index that allows the mined transaction which
+// consumed a UTXO to be looked up
+type SpentByIndex struct {
+	prefix prefixFunc
+}
+
+type SpentByIndexKey struct {
+	key []byte
+}
+
+// MarshalBinary returns the byte slice for the key object
+func (sbik *SpentByIndexKey) MarshalBinary() []byte {
+	return utils.CopySlice(sbik.key)
+}
+
+// UnmarshalBinary takes in a byte slice to set the key object
+func (sbik *SpentByIndexKey) UnmarshalBinary(data []byte) {
+	sbik.key = utils.CopySlice(data)
+}
+
+// Add records that utxoID was consumed by txHash at height
+func (sbi *SpentByIndex) Add(txn *badger.Txn, utxoID []byte, txHash []byte, height uint32) error {
+	if len(txHash) != constants.HashLen {
+		return errorz.ErrInvalid{}.New("invalid txHash length")
+	}
+	sbiKey := sbi.makeKey(utxoID)
+	key := sbiKey.MarshalBinary()
+	value := make([]byte, 0, constants.HashLen+4)
+	value = append(value, utils.CopySlice(txHash)...)
+	value = append(value, utils.MarshalUint32(height)...)
+	return utils.SetValue(txn, key, value)
+}
+
+// Drop removes an item from the index
+func (sbi *SpentByIndex) Drop(txn *badger.Txn, utxoID []byte) error {
+	sbiKey := sbi.makeKey(utxoID)
+	key := sbiKey.MarshalBinary()
+	return utils.DeleteValue(txn, key)
+}
+
+// SetStart records that the transactions mined below height are not
+// indexed, as after a fast sync which did not download them
+func (sbi *SpentByIndex) SetStart(txn *badger.Txn, height uint32) error {
+	sbiKey := sbi.makeKey(spentByStartKey)
+	key := sbiKey.MarshalBinary()
+	return utils.SetValue(txn, key, utils.MarshalUint32(height))
+}
+
+// GetStart returns the first indexed height; this is 1 unless SetStart has
+// been called
+func (sbi *SpentByIndex) GetStart(txn *badger.Txn) (uint32, error) {
+	sbiKey := sbi.makeKey(spentByStartKey)
+	key := sbiKey.MarshalBinary()
+	value, err := utils.GetValue(txn, key)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return 1, nil
+		}
+		return 0, err
+	}
+	return utils.UnmarshalUint32(value)
+}
+
+// Get returns the hash of the transaction which consumed utxoID and the
+// height at which it was mined. If utxoID is not found and heights were
+// not indexed, an error saying so is returned instead of ErrKeyNotFound.
+func (sbi *SpentByIndex) Get(txn *badger.Txn, utxoID []byte) ([]byte, uint32, error) {
+	sbiKey := sbi.makeKey(utxoID)
+	key := sbiKey.MarshalBinary()
+	value, err := utils.GetValue(txn, key)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			return nil, 0, err
+		}
+		start, serr := sbi.GetStart(txn)
+		if serr != nil {
+			return nil, 0, serr
+		}
+		if start > 1 {
+			return nil, 0, errorz.ErrInvalid{}.New(fmt.Sprintf("SpentByIndex.Get: not indexed below height %d", start))
+		}
+		return nil, 0, err
+	}
+	if len(value) != constants.HashLen+4 {
+		return nil, 0, errorz.ErrInvalid{}.New("SpentByIndex.Get: invalid byte length of value; should be 36")
+	}
+	// No error is checked because the slice has length 4
+	height, _ := utils.UnmarshalUint32(value[constants.HashLen:])
+	return utils.CopySlice(value[:constants.HashLen]), height, nil
+}
+
+func (sbi *SpentByIndex) makeKey(utxoID []byte) *SpentByIndexKey {
+	key := []byte{}
+	key = append(key, sbi.prefix()...)
+	key = append(key, utils.CopySlice(utxoID)...)
+	sbiKey := &SpentByIndexKey{}
+	sbiKey.UnmarshalBinary(key)
+	return sbiKey
+}
@@ -0,0 +1,91 @@
+package indexer
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/dgraph-io/badger/v2"
+)
+
+func makeSpentByIndex() *SpentByIndex {
+	prefix := func() []byte {
+		return []byte("za")
+	}
+	return NewSpentByIndex(prefix)
+}
+
+func TestSpentByIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	opts := badger.DefaultOptions(dir)
+	db, err := badger.Open(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	index := makeSpentByIndex()
+	txHash := crypto.Hasher([]byte("txHash"))
+	utxoID1 := crypto.Hasher([]byte("utxoID1"))
+	utxoID2 := crypto.Hasher([]byte("utxoID2"))
+	err = db.Update(func(txn *badger.Txn) error {
+		if err := index.Add(txn, utxoID1, txHash, 7); err != nil {
+			t.Fatal(err)
+		}
+		if err := index.Add(txn, utxoID2, txHash[:31], 7); err == nil {
+			t.Fatal("Should have raised error")
+		}
+		spentBy, height, err := index.Get(txn, utxoID1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(spentBy, txHash) || height != 7 {
+			t.Fatalf("Wrong spending tx: %x %v", spentBy, height)
+		}
+		if _, _, err := index.Get(txn, utxoID2); err != badger.ErrKeyNotFound {
+			t.Fatalf("Should have raised ErrKeyNotFound: %v", err)
+		}
+		if err := index.Drop(txn, utxoID1); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := index.Get(txn, utxoID1); err != badger.ErrKeyNotFound {
+			t.Fatalf("Should have raised ErrKeyNotFound: %v", err)
+		}
+
+		start, err := index.GetStart(txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if start != 1 {
+			t.Fatalf("Wrong start: %v", start)
+		}
+		if err := index.SetStart(txn, 9); err != nil {
+			t.Fatal(err)
+		}
+		if err := index.Add(txn, utxoID1, txHash, 12); err != nil {
+			t.Fatal(err)
+		}
+		if _, height, err := index.Get(txn, utxoID1); err != nil || height != 12 {
+			t.Fatalf("Wrong spending tx: %v %v", height, err)
+		}
+		_, _, err = index.Get(txn, utxoID2)
+		if err == nil || !strings.Contains(err.Error(), "not indexed below height 9") {
+			t.Fatalf("Should have raised not indexed error: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,83 @@
+package indexer
+
+import (
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+/*
+
+== BADGER KEYS ==
+
+lookup:
+key: <prefix>|<utxoID>
+  value: <lockEpoch>
+
+*/
+
+func NewTimelockIndex(p prefixFunc) *TimelockIndex {
+	return &TimelockIndex{p}
+}
+
+// TimelockIndex creates an index that allows timelocked objects to be
+// excluded from value lookups until their lock epoch
+type TimelockIndex struct {
+	prefix prefixFunc
+}
+
+type TimelockIndexKey struct {
+	key []byte
+}
+
+// MarshalBinary returns the byte slice for the key object
+func (tik *TimelockIndexKey) MarshalBinary() []byte {
+	return utils.CopySlice(tik.key)
+}
+
+// UnmarshalBinary takes in a byte slice to set the key object
+func (tik *TimelockIndexKey) UnmarshalBinary(data []byte) {
+	tik.key = utils.CopySlice(data)
+}
+
+// Add adds an item to the index
+func (ti *TimelockIndex) Add(txn *badger.Txn, utxoID []byte, lockEpoch uint32) error {
+	tiKey := ti.makeKey(utxoID)
+	key := tiKey.MarshalBinary()
+	return utils.SetValue(txn, key, utils.MarshalUint32(lockEpoch))
+}
+
+// Drop removes an item from the index; it is not an error to drop an
+// item which was never added
+func (ti *TimelockIndex) Drop(txn *badger.Txn, utxoID []byte) error {
+	tiKey := ti.makeKey(utxoID)
+	key := tiKey.MarshalBinary()
+	return utils.DeleteValue(txn, key)
+}
+
+// IsLocked returns true if utxoID is in the index and cannot be consumed
+// during epoch
+func (ti *TimelockIndex) IsLocked(txn *badger.Txn, utxoID []byte, epoch uint32) (bool, error) {
+	tiKey := ti.makeKey(utxoID)
+	key := tiKey.MarshalBinary()
+	v, err := utils.GetValue(txn, key)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	lockEpoch, err := utils.UnmarshalUint32(v)
+	if err != nil {
+		return false, err
+	}
+	return epoch < lockEpoch, nil
+}
+
+func (ti *TimelockIndex) makeKey(utxoID []byte) *TimelockIndexKey {
+	key := []byte{}
+	key = append(key, ti.prefix()...)
+	key = append(key, utils.CopySlice(utxoID)...)
+	tiKey := &TimelockIndexKey{}
+	tiKey.UnmarshalBinary(key)
+	return tiKey
+}
@@ -0,0 +1,82 @@
+package indexer
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/dgraph-io/badger/v2"
+)
+
+func makeTimelockIndex() *TimelockIndex {
+	prefix := func() []byte {
+		return []byte("za")
+	}
+	return NewTimelockIndex(prefix)
+}
+
+func TestTimelockIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	opts := badger.DefaultOptions(dir)
+	db, err := badger.Open(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	index := makeTimelockIndex()
+	utxoID := crypto.Hasher([]byte("utxoID"))
+	other := crypto.Hasher([]byte("other"))
+	err = db.Update(func(txn *badger.Txn) error {
+		if err := index.Add(txn, utxoID, 5); err != nil {
+			t.Fatal(err)
+		}
+		locked, err := index.IsLocked(txn, utxoID, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !locked {
+			t.Fatal("Should be locked")
+		}
+		locked, err = index.IsLocked(txn, utxoID, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if locked {
+			t.Fatal("Should not be locked")
+		}
+		locked, err = index.IsLocked(txn, other, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if locked {
+			t.Fatal("Should not be locked")
+		}
+		if err := index.Drop(txn, utxoID); err != nil {
+			t.Fatal(err)
+		}
+		if err := index.Drop(txn, other); err != nil {
+			t.Fatal(err)
+		}
+		locked, err = index.IsLocked(txn, utxoID, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if locked {
+			t.Fatal("Should not be locked after drop")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,149 @@
+package indexer
+
+import (
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+/*
+
+== BADGER KEYS ==
+
+lookup:
+key: <prefix>|<account>|<utxoID>
+  value: <utxoID>
+
+reverse lookup:
+key: <refPrefix>|<utxoID>
+  value: <account>
+
+*/
+
+func NewWithdrawalIndex(p, pp prefixFunc) *WithdrawalIndex {
+	return &WithdrawalIndex{p, pp}
+}
+
+// WithdrawalIndex creates an index that allows the withdrawals to an
+// Ethereum account to be looked up
+type WithdrawalIndex struct {
+	prefix    prefixFunc
+	refPrefix prefixFunc
+}
+
+type WithdrawalIndexKey struct {
+	key []byte
+}
+
+// MarshalBinary returns the byte slice for the key object
+func (wik *WithdrawalIndexKey) MarshalBinary() []byte {
+	return utils.CopySlice(wik.key)
+}
+
+// UnmarshalBinary takes in a byte slice to set the key object
+func (wik *WithdrawalIndexKey) UnmarshalBinary(data []byte) {
+	wik.key = utils.CopySlice(data)
+}
+
+type WithdrawalIndexRefKey struct {
+	refkey []byte
+}
+
+// MarshalBinary returns the byte slice for the key object
+func (wirk *WithdrawalIndexRefKey) MarshalBinary() []byte {
+	return utils.CopySlice(wirk.refkey)
+}
+
+// UnmarshalBinary takes in a byte slice to set the key object
+func (wirk *WithdrawalIndexRefKey) UnmarshalBinary(data []byte) {
+	wirk.refkey = utils.CopySlice(data)
+}
+
+// Add adds an item to the index
+func (wi *WithdrawalIndex) Add(txn *badger.Txn, utxoID []byte, account []byte) error {
+	if len(account) != constants.OwnerLen {
+		return errorz.ErrInvalid{}.New("invalid account length")
+	}
+	wiKey := wi.makeKey(account, utxoID)
+	key := wiKey.MarshalBinary()
+	wiRefKey := wi.makeRefKey(utxoID)
+	refKey := wiRefKey.MarshalBinary()
+	err := utils.SetValue(txn, refKey, utils.CopySlice(account))
+	if err != nil {
+		return err
+	}
+	return utils.SetValue(txn, key, utils.CopySlice(utxoID))
+}
+
+// Drop removes an item from the index
+func (wi *WithdrawalIndex) Drop(txn *badger.Txn, utxoID []byte) error {
+	wiRefKey := wi.makeRefKey(utxoID)
+	refKey := wiRefKey.MarshalBinary()
+	account, err := utils.GetValue(txn, refKey)
+	if err != nil {
+		return err
+	}
+	wiKey := wi.makeKey(account, utxoID)
+	key := wiKey.MarshalBinary()
+	err = utils.DeleteValue(txn, refKey)
+	if err != nil {
+		return err
+	}
+	return utils.DeleteValue(txn, key)
+}
+
+// GetUTXOIDs returns up to maxCount utxoIDs of the withdrawals to account.
+// If more remain, the key to pass as lastKey to continue the iteration is
+// returned as well.
+func (wi *WithdrawalIndex) GetUTXOIDs(txn *badger.Txn, account []byte, maxCount int, lastKey []byte) ([][]byte, []byte, error) {
+	prefix := wi.prefix()
+	prefix = append(prefix, account...)
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+
+	result := [][]byte{}
+	if lastKey != nil {
+		iter.Seek(lastKey)
+		if !iter.ValidForPrefix(prefix) {
+			return result, nil, nil
+		}
+		iter.Next()
+	} else {
+		iter.Seek(prefix)
+	}
+
+	for ; iter.ValidForPrefix(prefix); iter.Next() {
+		itm := iter.Item()
+		utxoID, err := itm.ValueCopy(nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		result = append(result, utxoID)
+		if len(result) >= maxCount {
+			return result, itm.KeyCopy(nil), nil
+		}
+	}
+	return result, nil, nil
+}
+
+func (wi *WithdrawalIndex) makeKey(account []byte, utxoID []byte) *WithdrawalIndexKey {
+	key := []byte{}
+	key = append(key, wi.prefix()...)
+	key = append(key, utils.CopySlice(account)...)
+	key = append(key, utils.CopySlice(utxoID)...)
+	wiKey := &WithdrawalIndexKey{}
+	wiKey.UnmarshalBinary(key)
+	return wiKey
+}
+
+func (wi *WithdrawalIndex) makeRefKey(utxoID []byte) *WithdrawalIndexRefKey {
+	refKey := []byte{}
+	refKey = append(refKey, wi.refPrefix()...)
+	refKey = append(refKey, utils.CopySlice(utxoID)...)
+	wiRefKey := &WithdrawalIndexRefKey{}
+	wiRefKey.UnmarshalBinary(refKey)
+	return wiRefKey
+}
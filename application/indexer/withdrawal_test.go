@@ -0,0 +1,101 @@
+package indexer
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/dgraph-io/badger/v2"
+)
+
+func makeWithdrawalIndex() *WithdrawalIndex {
+	prefix := func() []byte {
+		return []byte("za")
+	}
+	refPrefix := func() []byte {
+		return []byte("zb")
+	}
+	return NewWithdrawalIndex(prefix, refPrefix)
+}
+
+func TestWithdrawalIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	opts := badger.DefaultOptions(dir)
+	db, err := badger.Open(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	index := makeWithdrawalIndex()
+	acct := crypto.Hasher([]byte("account"))[:20]
+	other := crypto.Hasher([]byte("other"))[:20]
+	utxoID1 := crypto.Hasher([]byte("utxoID1"))
+	utxoID2 := crypto.Hasher([]byte("utxoID2"))
+	utxoID3 := crypto.Hasher([]byte("utxoID3"))
+	err = db.Update(func(txn *badger.Txn) error {
+		if err := index.Add(txn, utxoID1, acct); err != nil {
+			t.Fatal(err)
+		}
+		if err := index.Add(txn, utxoID2, acct); err != nil {
+			t.Fatal(err)
+		}
+		if err := index.Add(txn, utxoID3, other); err != nil {
+			t.Fatal(err)
+		}
+		if err := index.Add(txn, utxoID3, acct[:19]); err == nil {
+			t.Fatal("Should have raised error")
+		}
+		utxoIDs, lastKey, err := index.GetUTXOIDs(txn, acct, 1, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(utxoIDs) != 1 || lastKey == nil {
+			t.Fatalf("Should have returned one utxoID and a key: %v %x", len(utxoIDs), lastKey)
+		}
+		more, lastKey, err := index.GetUTXOIDs(txn, acct, 10, lastKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(more) != 1 || lastKey != nil {
+			t.Fatalf("Should have returned the remaining utxoID: %v %x", len(more), lastKey)
+		}
+		utxoIDs = append(utxoIDs, more...)
+		for _, utxoID := range utxoIDs {
+			if !bytes.Equal(utxoID, utxoID1) && !bytes.Equal(utxoID, utxoID2) {
+				t.Fatalf("Unexpected utxoID: %x", utxoID)
+			}
+		}
+		if err := index.Drop(txn, utxoID1); err != nil {
+			t.Fatal(err)
+		}
+		utxoIDs, _, err = index.GetUTXOIDs(txn, acct, 10, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(utxoIDs) != 1 || !bytes.Equal(utxoIDs[0], utxoID2) {
+			t.Fatal("Should have returned utxoID2 only")
+		}
+		utxoIDs, _, err = index.GetUTXOIDs(txn, other, 10, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(utxoIDs) != 1 || !bytes.Equal(utxoIDs[0], utxoID3) {
+			t.Fatal("Should have returned utxoID3 only")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
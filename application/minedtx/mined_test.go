@@ -482,6 +482,106 @@ func TestMinedGetHeightForTx(t *testing.T) {
 	}
 }
 
+func TestMinedGetSpendingTx(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	opts := badger.DefaultOptions(dir)
+	db, err := badger.Open(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	////////////////////////////////////////
+	hndlr := NewMinedTxHandler()
+
+	ownerSigner := testingOwner()
+	_, tx := makeTxInitial(ownerSigner)
+	tx2 := makeTxConsuming(ownerSigner, tx.Vout)
+	height := uint32(1)
+
+	err = db.Update(func(txn *badger.Txn) error {
+		txHash, err := tx.TxHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx2Hash, err := tx2.TxHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = hndlr.Add(txn, height, []*objs.Tx{tx})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = hndlr.Add(txn, height+1, []*objs.Tx{tx2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		consumed, err := tx.ConsumedUTXOID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		generated, err := tx.GeneratedUTXOID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, utxoID := range consumed {
+			spentBy, retHeight, err := hndlr.GetSpendingTx(txn, utxoID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(spentBy, txHash) || retHeight != height {
+				t.Fatal("spending tx does not agree")
+			}
+		}
+		for _, utxoID := range generated {
+			spentBy, retHeight, err := hndlr.GetSpendingTx(txn, utxoID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(spentBy, tx2Hash) || retHeight != height+1 {
+				t.Fatal("spending tx does not agree")
+			}
+		}
+		err = hndlr.Delete(txn, [][]byte{tx2Hash})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, utxoID := range generated {
+			_, _, err := hndlr.GetSpendingTx(txn, utxoID)
+			if err != badger.ErrKeyNotFound {
+				t.Fatal("Should have raised ErrKeyNotFound")
+			}
+		}
+
+		// after a fast sync the txs below the snapshot are not indexed
+		if err := hndlr.SetSpendingTxStart(txn, height+5); err != nil {
+			t.Fatal(err)
+		}
+		for _, utxoID := range consumed {
+			if _, _, err := hndlr.GetSpendingTx(txn, utxoID); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, utxoID := range generated {
+			_, _, err := hndlr.GetSpendingTx(txn, utxoID)
+			if err == nil || err == badger.ErrKeyNotFound {
+				t.Fatalf("Should have raised not indexed error: %v", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestMinedGetOneInternal(t *testing.T) {
 	dir, err := ioutil.TempDir("", "badger-test")
 	if err != nil {
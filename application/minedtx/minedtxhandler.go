@@ -14,12 +14,14 @@ import (
 func NewMinedTxHandler() *MinedTxHandler {
 	return &MinedTxHandler{
 		heightIdxIndex: indexer.NewHeightIdxIndex(dbprefix.PrefixMinedTxIndexKey, dbprefix.PrefixMinedTxIndexRefKey),
+		spentByIndex:   indexer.NewSpentByIndex(dbprefix.PrefixMinedTxSpentByKey),
 	}
 }
 
 // MinedTxHandler manages the storage of mined trasactions with indexing
 type MinedTxHandler struct {
 	heightIdxIndex *indexer.HeightIdxIndex
+	spentByIndex   *indexer.SpentByIndex
 }
 
 // Add adds txs at height to MinedTxHandler
@@ -42,7 +44,20 @@ func (mt *MinedTxHandler) Add(txn *badger.Txn, height uint32, txs []*objs.Tx) er
 func (mt *MinedTxHandler) Delete(txn *badger.Txn, txHashes [][]byte) error {
 	for j := 0; j < len(txHashes); j++ {
 		txHash := utils.CopySlice(txHashes[j])
-		err := mt.heightIdxIndex.Delete(txn, utils.CopySlice(txHash))
+		tx, err := mt.getOneInternal(txn, utils.CopySlice(txHash))
+		if err != nil {
+			return err
+		}
+		consumedUTXOIDs, err := tx.ConsumedUTXOID()
+		if err != nil {
+			return err
+		}
+		for _, utxoID := range consumedUTXOIDs {
+			if err := mt.spentByIndex.Drop(txn, utxoID); err != nil {
+				return err
+			}
+		}
+		err = mt.heightIdxIndex.Delete(txn, utils.CopySlice(txHash))
 		if err != nil {
 			return err
 		}
@@ -82,6 +97,18 @@ func (mt *MinedTxHandler) GetHeightForTx(txn *badger.Txn, txHash []byte) (uint32
 	return height, nil
 }
 
+// GetSpendingTx returns the hash of the mined tx which consumed utxoID and the
+// height at which it was mined
+func (mt *MinedTxHandler) GetSpendingTx(txn *badger.Txn, utxoID []byte) ([]byte, uint32, error) {
+	return mt.spentByIndex.Get(txn, utxoID)
+}
+
+// SetSpendingTxStart records that the txs mined below height, which a fast
+// sync does not download, are not indexed by GetSpendingTx
+func (mt *MinedTxHandler) SetSpendingTxStart(txn *badger.Txn, height uint32) error {
+	return mt.spentByIndex.SetStart(txn, height)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 /////////PRIVATE METHODS////////////////////////////////////////////////////////
@@ -101,6 +128,15 @@ func (mt *MinedTxHandler) addOneInternal(txn *badger.Txn, tx *objs.Tx, txHash []
 	if err != nil {
 		return err
 	}
+	consumedUTXOIDs, err := tx.ConsumedUTXOID()
+	if err != nil {
+		return err
+	}
+	for _, utxoID := range consumedUTXOIDs {
+		if err := mt.spentByIndex.Add(txn, utxoID, txHash, height); err != nil {
+			return err
+		}
+	}
 	return db.SetTx(txn, key, tx)
 }
 
@@ -84,6 +84,10 @@ func (msg *mockStorageGetter) LoadStorage(txn *badger.Txn, epoch uint32) error {
 	return nil
 }
 
+func (msg *mockStorageGetter) LoadMinTxFee(epoch uint32, usage dynamics.EpochUsageFunc) error {
+	return nil
+}
+
 func (msg *mockStorageGetter) GetDataStoreEpochFee() *big.Int {
 	return msg.dataStoreEpochFee
 }
@@ -129,8 +133,8 @@ func (msg *mockStorageGetter) GetAtomicSwapValidStopEpoch() uint32 {
 	return 0
 }
 
-func (msg *mockStorageGetter) GetMinTxFee() *big.Int {
-	return msg.minTxFee
+func (msg *mockStorageGetter) GetMinTxFee() (*big.Int, error) {
+	return msg.minTxFee, nil
 }
 
 func (msg *mockStorageGetter) SetMinTxFee(value *big.Int) {
@@ -143,3 +147,11 @@ func (msg *mockStorageGetter) SetMinTxFee(value *big.Int) {
 func (msg *mockStorageGetter) GetTxValidVersion() uint32 {
 	return 0
 }
+
+func (msg *mockStorageGetter) IsActive(feature dynamics.Feature, epoch uint32) bool {
+	return false
+}
+
+func (msg *mockStorageGetter) GetFeatures() []*dynamics.FeatureInfo {
+	return nil
+}
@@ -16,8 +16,9 @@ var (
 	DefaultVSPreImage   = VSPreImage{Struct: capnp.MustUnmarshalRootPtr(x_b99093b7d2518300[312:416]).Struct()}
 	DefaultASPreImage   = ASPreImage{Struct: capnp.MustUnmarshalRootPtr(x_b99093b7d2518300[416:528]).Struct()}
 	DefaultTFPreImage   = TFPreImage{Struct: capnp.MustUnmarshalRootPtr(x_b99093b7d2518300[528:584]).Struct()}
-	DefaultTXInPreImage = TXInPreImage{Struct: capnp.MustUnmarshalRootPtr(x_b99093b7d2518300[584:624]).Struct()}
-	DefaultTXInLinker   = TXInLinker{Struct: capnp.MustUnmarshalRootPtr(x_b99093b7d2518300[624:688]).Struct()}
+	DefaultWDPreImage   = WDPreImage{Struct: capnp.MustUnmarshalRootPtr(x_b99093b7d2518300[584:688]).Struct()}
+	DefaultTXInPreImage = TXInPreImage{Struct: capnp.MustUnmarshalRootPtr(x_b99093b7d2518300[688:728]).Struct()}
+	DefaultTXInLinker   = TXInLinker{Struct: capnp.MustUnmarshalRootPtr(x_b99093b7d2518300[728:792]).Struct()}
 )
 
 func init() {
@@ -27,6 +28,7 @@ func init() {
 	DefaultVSPreImage.Segment().Message().ReadLimiter().Reset((1 << 64) - 1)
 	DefaultASPreImage.Segment().Message().ReadLimiter().Reset((1 << 64) - 1)
 	DefaultTFPreImage.Segment().Message().ReadLimiter().Reset((1 << 64) - 1)
+	DefaultWDPreImage.Segment().Message().ReadLimiter().Reset((1 << 64) - 1)
 	DefaultTXInPreImage.Segment().Message().ReadLimiter().Reset((1 << 64) - 1)
 	DefaultTXInLinker.Segment().Message().ReadLimiter().Reset((1 << 64) - 1)
 }
@@ -312,7 +314,7 @@ func (s DSLinker) DSPreImage() DSPreImage {
 		s.NewDSPreImage()
 	}
 	p, _ := s.Struct.Ptr(0)
-	ss, _ := p.StructDefault(x_b99093b7d2518300[688:832])
+	ss, _ := p.StructDefault(x_b99093b7d2518300[792:936])
 	return DSPreImage{Struct: ss}
 }
 
@@ -379,7 +381,7 @@ func (p DSLinker_Promise) Struct() (DSLinker, error) {
 }
 
 func (p DSLinker_Promise) DSPreImage() DSPreImage_Promise {
-	return DSPreImage_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[832:976])}
+	return DSPreImage_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[936:1080])}
 }
 
 type DataStore struct{ capnp.Struct }
@@ -412,7 +414,7 @@ func (s DataStore) DSLinker() DSLinker {
 		s.NewDSLinker()
 	}
 	p, _ := s.Struct.Ptr(0)
-	ss, _ := p.StructDefault(x_b99093b7d2518300[976:1144])
+	ss, _ := p.StructDefault(x_b99093b7d2518300[1080:1248])
 	return DSLinker{Struct: ss}
 }
 
@@ -479,7 +481,7 @@ func (p DataStore_Promise) Struct() (DataStore, error) {
 }
 
 func (p DataStore_Promise) DSLinker() DSLinker_Promise {
-	return DSLinker_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[1144:1312])}
+	return DSLinker_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[1248:1416])}
 }
 
 type VSPreImage struct{ capnp.Struct }
@@ -723,7 +725,7 @@ func (s ValueStore) VSPreImage() VSPreImage {
 		s.NewVSPreImage()
 	}
 	p, _ := s.Struct.Ptr(0)
-	ss, _ := p.StructDefault(x_b99093b7d2518300[1312:1416])
+	ss, _ := p.StructDefault(x_b99093b7d2518300[1416:1520])
 	return VSPreImage{Struct: ss}
 }
 
@@ -790,7 +792,7 @@ func (p ValueStore_Promise) Struct() (ValueStore, error) {
 }
 
 func (p ValueStore_Promise) VSPreImage() VSPreImage_Promise {
-	return VSPreImage_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[1416:1520])}
+	return VSPreImage_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[1520:1624])}
 }
 
 type ASPreImage struct{ capnp.Struct }
@@ -1050,7 +1052,7 @@ func (s AtomicSwap) ASPreImage() ASPreImage {
 		s.NewASPreImage()
 	}
 	p, _ := s.Struct.Ptr(0)
-	ss, _ := p.StructDefault(x_b99093b7d2518300[1520:1632])
+	ss, _ := p.StructDefault(x_b99093b7d2518300[1624:1736])
 	return ASPreImage{Struct: ss}
 }
 
@@ -1117,7 +1119,7 @@ func (p AtomicSwap_Promise) Struct() (AtomicSwap, error) {
 }
 
 func (p AtomicSwap_Promise) ASPreImage() ASPreImage_Promise {
-	return ASPreImage_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[1632:1744])}
+	return ASPreImage_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[1736:1848])}
 }
 
 type TFPreImage struct{ capnp.Struct }
@@ -1281,7 +1283,7 @@ func (s TxFee) TFPreImage() TFPreImage {
 		s.NewTFPreImage()
 	}
 	p, _ := s.Struct.Ptr(0)
-	ss, _ := p.StructDefault(x_b99093b7d2518300[1744:1800])
+	ss, _ := p.StructDefault(x_b99093b7d2518300[1848:1904])
 	return TFPreImage{Struct: ss}
 }
 
@@ -1348,7 +1350,318 @@ func (p TxFee_Promise) Struct() (TxFee, error) {
 }
 
 func (p TxFee_Promise) TFPreImage() TFPreImage_Promise {
-	return TFPreImage_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[1800:1856])}
+	return TFPreImage_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[1904:1960])}
+}
+
+type WDPreImage struct{ capnp.Struct }
+
+// WDPreImage_TypeID is the unique identifier for the type WDPreImage.
+const WDPreImage_TypeID = 0x9729e7527e215f8d
+
+func NewWDPreImage(s *capnp.Segment) (WDPreImage, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 72, PointerCount: 1})
+	return WDPreImage{st}, err
+}
+
+func NewRootWDPreImage(s *capnp.Segment) (WDPreImage, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 72, PointerCount: 1})
+	return WDPreImage{st}, err
+}
+
+func ReadRootWDPreImage(msg *capnp.Message) (WDPreImage, error) {
+	root, err := msg.RootPtr()
+	return WDPreImage{root.Struct()}, err
+}
+
+func (s WDPreImage) String() string {
+	str, _ := text.Marshal(0x9729e7527e215f8d, s.Struct)
+	return str
+}
+
+func (s WDPreImage) ChainID() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s WDPreImage) SetChainID(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s WDPreImage) TXOutIdx() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s WDPreImage) SetTXOutIdx(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+func (s WDPreImage) Account() []byte {
+	p, _ := s.Struct.Ptr(0)
+	return []byte(p.DataDefault([]byte{0x0}))
+}
+
+func (s WDPreImage) HasAccount() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s WDPreImage) SetAccount(v []byte) error {
+	if v == nil {
+		v = []byte{}
+	}
+	return s.Struct.SetData(0, v)
+}
+func (s WDPreImage) Value() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s WDPreImage) SetValue(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+func (s WDPreImage) Value1() uint32 {
+	return s.Struct.Uint32(12)
+}
+
+func (s WDPreImage) SetValue1(v uint32) {
+	s.Struct.SetUint32(12, v)
+}
+
+func (s WDPreImage) Value2() uint32 {
+	return s.Struct.Uint32(16)
+}
+
+func (s WDPreImage) SetValue2(v uint32) {
+	s.Struct.SetUint32(16, v)
+}
+
+func (s WDPreImage) Value3() uint32 {
+	return s.Struct.Uint32(20)
+}
+
+func (s WDPreImage) SetValue3(v uint32) {
+	s.Struct.SetUint32(20, v)
+}
+
+func (s WDPreImage) Value4() uint32 {
+	return s.Struct.Uint32(24)
+}
+
+func (s WDPreImage) SetValue4(v uint32) {
+	s.Struct.SetUint32(24, v)
+}
+
+func (s WDPreImage) Value5() uint32 {
+	return s.Struct.Uint32(28)
+}
+
+func (s WDPreImage) SetValue5(v uint32) {
+	s.Struct.SetUint32(28, v)
+}
+
+func (s WDPreImage) Value6() uint32 {
+	return s.Struct.Uint32(32)
+}
+
+func (s WDPreImage) SetValue6(v uint32) {
+	s.Struct.SetUint32(32, v)
+}
+
+func (s WDPreImage) Value7() uint32 {
+	return s.Struct.Uint32(36)
+}
+
+func (s WDPreImage) SetValue7(v uint32) {
+	s.Struct.SetUint32(36, v)
+}
+
+func (s WDPreImage) Fee0() uint32 {
+	return s.Struct.Uint32(40)
+}
+
+func (s WDPreImage) SetFee0(v uint32) {
+	s.Struct.SetUint32(40, v)
+}
+
+func (s WDPreImage) Fee1() uint32 {
+	return s.Struct.Uint32(44)
+}
+
+func (s WDPreImage) SetFee1(v uint32) {
+	s.Struct.SetUint32(44, v)
+}
+
+func (s WDPreImage) Fee2() uint32 {
+	return s.Struct.Uint32(48)
+}
+
+func (s WDPreImage) SetFee2(v uint32) {
+	s.Struct.SetUint32(48, v)
+}
+
+func (s WDPreImage) Fee3() uint32 {
+	return s.Struct.Uint32(52)
+}
+
+func (s WDPreImage) SetFee3(v uint32) {
+	s.Struct.SetUint32(52, v)
+}
+
+func (s WDPreImage) Fee4() uint32 {
+	return s.Struct.Uint32(56)
+}
+
+func (s WDPreImage) SetFee4(v uint32) {
+	s.Struct.SetUint32(56, v)
+}
+
+func (s WDPreImage) Fee5() uint32 {
+	return s.Struct.Uint32(60)
+}
+
+func (s WDPreImage) SetFee5(v uint32) {
+	s.Struct.SetUint32(60, v)
+}
+
+func (s WDPreImage) Fee6() uint32 {
+	return s.Struct.Uint32(64)
+}
+
+func (s WDPreImage) SetFee6(v uint32) {
+	s.Struct.SetUint32(64, v)
+}
+
+func (s WDPreImage) Fee7() uint32 {
+	return s.Struct.Uint32(68)
+}
+
+func (s WDPreImage) SetFee7(v uint32) {
+	s.Struct.SetUint32(68, v)
+}
+
+// WDPreImage_List is a list of WDPreImage.
+type WDPreImage_List struct{ capnp.List }
+
+// NewWDPreImage creates a new list of WDPreImage.
+func NewWDPreImage_List(s *capnp.Segment, sz int32) (WDPreImage_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 72, PointerCount: 1}, sz)
+	return WDPreImage_List{l}, err
+}
+
+func (s WDPreImage_List) At(i int) WDPreImage { return WDPreImage{s.List.Struct(i)} }
+
+func (s WDPreImage_List) Set(i int, v WDPreImage) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s WDPreImage_List) String() string {
+	str, _ := text.MarshalList(0x9729e7527e215f8d, s.List)
+	return str
+}
+
+// WDPreImage_Promise is a wrapper for a WDPreImage promised by a client call.
+type WDPreImage_Promise struct{ *capnp.Pipeline }
+
+func (p WDPreImage_Promise) Struct() (WDPreImage, error) {
+	s, err := p.Pipeline.Struct()
+	return WDPreImage{s}, err
+}
+
+type Withdrawal struct{ capnp.Struct }
+
+// Withdrawal_TypeID is the unique identifier for the type Withdrawal.
+const Withdrawal_TypeID = 0xdbac05e471657fa2
+
+func NewWithdrawal(s *capnp.Segment) (Withdrawal, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Withdrawal{st}, err
+}
+
+func NewRootWithdrawal(s *capnp.Segment) (Withdrawal, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Withdrawal{st}, err
+}
+
+func ReadRootWithdrawal(msg *capnp.Message) (Withdrawal, error) {
+	root, err := msg.RootPtr()
+	return Withdrawal{root.Struct()}, err
+}
+
+func (s Withdrawal) String() string {
+	str, _ := text.Marshal(0xdbac05e471657fa2, s.Struct)
+	return str
+}
+
+func (s Withdrawal) WDPreImage() WDPreImage {
+	if !s.HasWDPreImage() {
+		s.NewWDPreImage()
+	}
+	p, _ := s.Struct.Ptr(0)
+	ss, _ := p.StructDefault(x_b99093b7d2518300[1960:2064])
+	return WDPreImage{Struct: ss}
+}
+
+func (s Withdrawal) HasWDPreImage() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s Withdrawal) SetWDPreImage(v WDPreImage) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewWDPreImage sets the wDPreImage field to a newly
+// allocated WDPreImage struct, preferring placement in s's segment.
+func (s Withdrawal) NewWDPreImage() (WDPreImage, error) {
+	ss, err := NewWDPreImage(s.Struct.Segment())
+	if err != nil {
+		return WDPreImage{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+func (s Withdrawal) TxHash() []byte {
+	p, _ := s.Struct.Ptr(1)
+	return []byte(p.DataDefault([]byte{0x0}))
+}
+
+func (s Withdrawal) HasTxHash() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
+
+func (s Withdrawal) SetTxHash(v []byte) error {
+	if v == nil {
+		v = []byte{}
+	}
+	return s.Struct.SetData(1, v)
+}
+
+// Withdrawal_List is a list of Withdrawal.
+type Withdrawal_List struct{ capnp.List }
+
+// NewWithdrawal creates a new list of Withdrawal.
+func NewWithdrawal_List(s *capnp.Segment, sz int32) (Withdrawal_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return Withdrawal_List{l}, err
+}
+
+func (s Withdrawal_List) At(i int) Withdrawal { return Withdrawal{s.List.Struct(i)} }
+
+func (s Withdrawal_List) Set(i int, v Withdrawal) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Withdrawal_List) String() string {
+	str, _ := text.MarshalList(0xdbac05e471657fa2, s.List)
+	return str
+}
+
+// Withdrawal_Promise is a wrapper for a Withdrawal promised by a client call.
+type Withdrawal_Promise struct{ *capnp.Pipeline }
+
+func (p Withdrawal_Promise) Struct() (Withdrawal, error) {
+	s, err := p.Pipeline.Struct()
+	return Withdrawal{s}, err
+}
+
+func (p Withdrawal_Promise) WDPreImage() WDPreImage_Promise {
+	return WDPreImage_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[2064:2168])}
 }
 
 type TXInPreImage struct{ capnp.Struct }
@@ -1465,7 +1778,7 @@ func (s TXInLinker) TXInPreImage() TXInPreImage {
 		s.NewTXInPreImage()
 	}
 	p, _ := s.Struct.Ptr(0)
-	ss, _ := p.StructDefault(x_b99093b7d2518300[1856:1896])
+	ss, _ := p.StructDefault(x_b99093b7d2518300[2168:2208])
 	return TXInPreImage{Struct: ss}
 }
 
@@ -1532,7 +1845,7 @@ func (p TXInLinker_Promise) Struct() (TXInLinker, error) {
 }
 
 func (p TXInLinker_Promise) TXInPreImage() TXInPreImage_Promise {
-	return TXInPreImage_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[1896:1936])}
+	return TXInPreImage_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[2208:2248])}
 }
 
 type TXIn struct{ capnp.Struct }
@@ -1565,7 +1878,7 @@ func (s TXIn) TXInLinker() TXInLinker {
 		s.NewTXInLinker()
 	}
 	p, _ := s.Struct.Ptr(0)
-	ss, _ := p.StructDefault(x_b99093b7d2518300[1936:2000])
+	ss, _ := p.StructDefault(x_b99093b7d2518300[2248:2312])
 	return TXInLinker{Struct: ss}
 }
 
@@ -1632,7 +1945,7 @@ func (p TXIn_Promise) Struct() (TXIn, error) {
 }
 
 func (p TXIn_Promise) TXInLinker() TXInLinker_Promise {
-	return TXInLinker_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[2000:2064])}
+	return TXInLinker_Promise{Pipeline: p.Pipeline.GetPipelineDefault(0, x_b99093b7d2518300[2312:2376])}
 }
 
 type TXOut struct{ capnp.Struct }
@@ -1643,10 +1956,11 @@ const (
 	TXOut_Which_valueStore TXOut_Which = 1
 	TXOut_Which_atomicSwap TXOut_Which = 2
 	TXOut_Which_txFee      TXOut_Which = 3
+	TXOut_Which_withdrawal TXOut_Which = 4
 )
 
 func (w TXOut_Which) String() string {
-	const s = "dataStorevalueStoreatomicSwaptxFee"
+	const s = "dataStorevalueStoreatomicSwaptxFeewithdrawal"
 	switch w {
 	case TXOut_Which_dataStore:
 		return s[0:9]
@@ -1656,6 +1970,8 @@ func (w TXOut_Which) String() string {
 		return s[19:29]
 	case TXOut_Which_txFee:
 		return s[29:34]
+	case TXOut_Which_withdrawal:
+		return s[34:44]
 
 	}
 	return "TXOut_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
@@ -1827,6 +2143,41 @@ func (s TXOut) NewTxFee() (TxFee, error) {
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
+func (s TXOut) Withdrawal() (Withdrawal, error) {
+	if s.Struct.Uint16(0) != 4 {
+		panic("Which() != withdrawal")
+	}
+	p, err := s.Struct.Ptr(0)
+	if err != nil {
+		return Withdrawal{}, err
+	}
+	return Withdrawal{Struct: p.Struct()}, err
+}
+
+func (s TXOut) HasWithdrawal() bool {
+	if s.Struct.Uint16(0) != 4 {
+		return false
+	}
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s TXOut) SetWithdrawal(v Withdrawal) error {
+	s.Struct.SetUint16(0, 4)
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewWithdrawal sets the withdrawal field to a newly
+// allocated Withdrawal struct, preferring placement in s's segment.
+func (s TXOut) NewWithdrawal() (Withdrawal, error) {
+	s.Struct.SetUint16(0, 4)
+	ss, err := NewWithdrawal(s.Struct.Segment())
+	if err != nil {
+		return Withdrawal{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
 
 // TXOut_List is a list of TXOut.
 type TXOut_List struct{ capnp.List }
@@ -1870,6 +2221,10 @@ func (p TXOut_Promise) TxFee() TxFee_Promise {
 	return TxFee_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
 }
 
+func (p TXOut_Promise) Withdrawal() Withdrawal_Promise {
+	return Withdrawal_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
 type Tx struct{ capnp.Struct }
 
 // Tx_TypeID is the unique identifier for the type Tx.
@@ -1900,7 +2255,7 @@ func (s Tx) Vin() (TXIn_List, error) {
 	if err != nil {
 		return TXIn_List{}, err
 	}
-	l, err := p.ListDefault(x_b99093b7d2518300[2064:2088])
+	l, err := p.ListDefault(x_b99093b7d2518300[2376:2400])
 	return TXIn_List{List: l}, err
 }
 
@@ -1929,7 +2284,7 @@ func (s Tx) Vout() (TXOut_List, error) {
 	if err != nil {
 		return TXOut_List{}, err
 	}
-	l, err := p.ListDefault(x_b99093b7d2518300[2088:2112])
+	l, err := p.ListDefault(x_b99093b7d2518300[2400:2424])
 	return TXOut_List{List: l}, err
 }
 
@@ -1979,152 +2334,160 @@ func (p Tx_Promise) Struct() (Tx, error) {
 	return Tx{s}, err
 }
 
-const schema_b99093b7d2518300 = "x\xda\xdc\x98kl\x14\xd7\x15\xc7\xef\x99\xbb\xbb\xb3\xbb" +
-	"x\xbd\xb3\xbe\x83Ey\x08\xb0Le\xa8i\xb1\xbd6" +
-	"\x06\xe1\xdaNl\xc4F\xa6x2\x1bj*\x90\xd8\xb2" +
-	"\x13\xbc\xc1^/\xebYX*P\xa0\x04\x09$\xa0\xa0" +
-	"\x90\x86H$\x10\xe5\xa1\xa6i\x9b\xa6\x0f5\x0f\"\x15" +
-	"\x946 \x81\x14*P\xa0*mZ5\x89\xe2~H" +
-	"\x1f\x1f\xfa\xa2Lu\xce\xec\xee\xcc>\x00W\xaa\x14)" +
-	"\x92?\xec\xfd\xf9\xcc\x9ds\xe6\xde\xfb?\xff\x99e\x1f" +
-	"\xcb}R\x9b\xb7WfL\x8b{}\xd6W^\xf9\xbb" +
-	"\xb6n\xfd\x91o2\xad\x01\xbc\xb7\xf7k\xbf\xfa\xd9\xe3" +
-	"\xc7^\xf7\xc8\x8c\x89\x03|J\x1c\xe72c\x1dG\xf8" +
-	"r\x89\x81\xf5\xfc[?=\xfd\xd7\xaew\x0e\xb1H\x03" +
-	"\x14#\xbd\x12\x86^\xf6\x9e\x17\xd7\xbc\xf8\xeb\x8aw'" +
-	"\x03+\xdf\xf0\xdb\xe7\x16/\xcf\x1ce\x91\x06O1\x92" +
-	"AG\xcc\xd7\x04b\x83OfL\x8f\xfb8\xe8\x9b}" +
-	"\x120f]\xf8p\xdbf\xed\xd4\xc9\xe3\xd5\xf3\xee\xf3" +
-	"\xfdZ\x1c\xc1xq\xc8\x87\xf3\xb6\xce\xeb\xde~!z" +
-	"\xf8\x89\xea\xc8\xa5\xf2\x94X!\xe3\xafN\x19#\xbf\xfc" +
-	"\xc8\xbaVx\xcez\xb2:\xf2\x05\xf9\xbb\xe2\xfb\x14\xf9" +
-	"\x12E\x1e^\xf5\xe1\xd3\xd1\xc7\x1bOV\xe4\xba\xd4\xdf" +
-	"\x04\xa2\xc7\x8f\xb9v\xfb9\xe8\x03~\xcau\xc1\xb6\xee" +
-	"\xbf\xbc\xf6\xbb\xfbOV\xcf\x9b\xf3\xffA\xec\xc3x\xb1" +
-	"\xc7\x8f\xf3\xde\xff\xce\xad\x1f}\xab\xbd\xedLud[" +
-	"`J\xf4\x04\xf0\xd7\x8a\x00F.I\xde~\xb9\xef\xab" +
-	"\xfb\xcfTdp1\xd0\x00\xe2\x06\xc6\xe9W\x03\x1c\xf4" +
-	"\xf7\x03\x94\xc1\x89\xc1\x9e\xf7\xf6\xff9\xfabE\xf4\xbc" +
-	"`\x13\x88\xa5A\x8cn\x09r\xd0\xa3A\x8a>\xf4d" +
-	"\xc7\xb6\x97\xbf~\xf6E\\\xde`)\x0d\xc0\x9bk\xc1" +
-	")\xb1\x09/\xe8\xd8\x10<\xeda`}\xbc\xb8\xeb\x99" +
-	"_\x1e\xdd\xf5\x83\x8a\x99;\xc3\xed \x06\xc38s_" +
-	"\x98\x83>\x14\xa6\x99O\xfdx`\xe3\xcd\x8f\xbc\xafV" +
-	"\xd7\x17\x0b\xbf!4\x8c\x17k\xc3X\xdf\xe97>\xf8" +
-	"\xf6'\x8b\xa471\x07(\xcf\xe1r\xf8_\xe2\x06\x85" +
-	"^\x0b\xbf\xc2\xc0\xd2\xdfz\xb6\xeb\xbcq\xeeb\xf5\xa4" +
-	"\xbb\x94)q@\xa1M\xa1\xe0\xa4\x9f\x7f\xf3\xc8\x17\x16" +
-	"\xae\xfa\xd3\xd5\xf2\xc2p\xbb\x8a\x99\x91)\xb10\x82\x85" +
-	"\xcd\x8b\xbc\x87\x85\xdd\x8a|\xf2\xcf\xa7\x07z\xaeU\x14" +
-	"\xb6\xa1\xb1\x09D\xaa\x11\x0bK6r\xd03\x8dT\xd8" +
-	"\x9c\xa3+\xbc\x7f\xe3\xe7\xff\x813\x07\xca\xd3\xcd5N" +
-	"\x89}xA\xc7\x9e\xc6/\xe1\xcc\xcd\xd9\x9e\x17.-" +
-	"\x1a\xf8wYi\x83 {\x18\x137g\x9f\x17\x1f\xcc" +
-	"\xc6\xcb~?\xfb#\x06\xd6\xc2kg\x8f\xad\xbd\xf0\x8c" +
-	"U\x91\xc5\xb99M \xae\xcc\xc1,.\xcd\xe1\xa0_" +
-	"\x9fCY$2\x99\xb1\xd4\x96\x84)\xa5&\xd2_\xdc" +
-	"\x92\xc8\xa43+\xe3\xab\x87\xb3F,<\x9e\xd8j\x0c" +
-	"\x03h-\xdc\xc3\x98\x07\x00D\x00\xeecL\xf7\x00\x07" +
-	"]\x01\x09\"\x00* \x0f\xc1\x03\x8c\xe9u\xc8g!" +
-	"\x97$\x15$\x001\x13\x960\xa6+\xc8\xe7\"\xe7\\" +
-	"\x05\x0e >G\x1c\x7f\xeb\x0b\x90{<*x\x00\xc4" +
-	"<\xe2\xb3\x907#\xf7zU\xf0\x02\x88\x85\xc4\xe7\"" +
-	"oA\xee\xf3\xa9\xe0\x03\x10\x8b\x88/@\xde\x8a\\\x96" +
-	"U\x90\x01\xc4b\xe2\xcd\xc8\x97!\xf7\xfbU\xf0\x03\x88" +
-	"\xa5\xc4[\x90G\x91\x07\x02*\x04\x00D\x1b\xf1V\xe4" +
-	"\xdd \xc1\xa3[F\x13\xa9tl\x00\xfcL\x02?\x03" +
-	"\xcb\x1cY\x973c\xc9<c\xac\xc8\xc2\x0f\x1b\xc62" +
-	"\xf7\xa0\xcd=hw\x0f:\xdc\x83\xa8{\xd0\xe9\x1et" +
-	"\xb9\x07\xcbK\xf7\xae\xb5>\xf9\xd5\x86\xc1pi\xfc\xc5" +
-	"\xa5\x89,\xfe\x1acZ\x0b\x07-\xea\xacKdp%" +
-	"cZ\x1f\x07mH\x02\xcb\xa4U\x1dO0\xbe\xd5\x00" +
-	"\xc5\xd1c\x06\xa00\xa6x\x99\xa7\xd7\xcc\xafIL\x8e" +
-	"B\x88I\x10b,\x02A\xd7\xfe\xe0\xce\xfd\x93\xc6\xc3" +
-	"\x89\xdc\x98\xd9\xaf\xd3\x84\xb2\xbdM@q4\xc0\x9er" +
-	"8\x08,P>\x89\xab\x88\x01}(\x95\xde\xc6\x8d\xec" +
-	"4\xea\xd8\x8eu\x8cq\xd0\xf2\x12XI\xbd\xac\x8e\xd2" +
-	"\xf9,\xde\x94\xb3@$\x10\xb4\xff\x98t\xd7\x9a\xdc\xcf" +
-	"t$\x96\x1eJ\x85\xd3\xdb\xaa\x12z\xa4FB=\x98" +
-	"P7\x07m\x00\x1f\xecH,\x8d)1:1\xa08" +
-	":TH\x09\xa0p\xe3\xbb&\x03\xc5d\xc2\xb8\xc2\x15" +
-	"I41\xa65s\xd0\xfa\\I\xf4/aL[\xc5" +
-	"A\x1b\x91@\xde\x91JC=\x83a\x8e\x0bQ\x92L" +
-	"\x06P\x8f\xf7\x91\xfb\xa4\xf0\x8e\x89\x9c\xe9\x84\x94\x04\xa5" +
-	"\x142\x0cp\x97\xc5\x1e\xa8\\\xec{<\xf7\x9aK\x9e" +
-	"0\x13\xba)Od\x8d\x8a\xea\x1e\xa8\xf1\x88\xf7<\xc8" +
-	"\x98\xb6\x9b\x83v\x90\xd6\x1c7\x8b\x91\xc5\x03\xa88}" +
-	"\xdc\xbeu\x9f\xa4y\x82<\xd2\x1ft'\xc0\xad\xc9\xd4" +
-	"\xd6t\xc2\xcce\x19\x18\xf7\\\xfd\xf5\x89\xb1\x9c\xa1\x87" +
-	"\xcd\xea\xd4jm\xc7M\xb8\xfa#\x1c\xb4\xa4\x04\xd6\x8e" +
-	"\xf2\xedX\x12\xf5\xc2c\x09\x00\xf3\xdf{\xedk=o" +
-	"\xaa\x18\xb2\xf6\xd3\x9e^\xc9w\x9en}\xe5\xf2\xdd1" +
-	"\xcfZ\x8f\xc7>\xe9\xa5\x86\x10/5\x84\x1e\x09\x1bB" +
-	"\xb7\x84\xbeEB\xc1.4\x84~\xa9\x9d1}\x15\xf2" +
-	"5\xc8\xa1\xd0\x10\x06%l\x14\x03\xc8\x87%\x09\x10c" +
-	"?XK\xe1k\x10\xc7\xa5B\x9f\xc0~\xf0\x10\x85\xc7" +
-	"\x91o\x96\x0a}\x02\xfb\xc1&\xa9\x891}\x04y\x12" +
-	"\xb9\xcfk\xf7\x83\x84\xb4\x921}#\xf2Q\xe4\xb2\xcf" +
-	"\xee\x07\x06\xf1\xcd\xc8\xc7\x90\xfbe\xbb\x1f\xa4\x88'\x91" +
-	"g\x90\x07\xfcv?\x18'>\x8a\xdcD\x1e\x0c\xa8\x10" +
-	"\x04\x10\xdb\x89\x8f!\xcf#\x9f\x11Ta\x06\x80\xc8\x11" +
-	"\xcf \xdf\x8d\xbcn\x86\x0au\x00b\x17q\x13\xf9^" +
-	"\xe4\xa1:\x15B\x00b\x8f\x84\xfd&\x8f\xfc1\xe4\xf5" +
-	"!\x15\xea\x01\xc4>\xe2\xbb\x91\x1fD\x1e\xaeW!\x0c" +
-	" \x0e\x10\xdf\x8b\xfc0r%\xac\x82\x02 \x0e\x11\x7f" +
-	"\x0c\xf91\xe4\x11E\xc5]*\x8e\x10?\x88\xfc\x04\xf2" +
-	"\x86\x88\x0a\x0d\x00\xe28\xf1\xc3\xc8O\"\x17\x0d*\x08" +
-	"\x00\xf1\x04\xf1c\xc8O!W\x85\x8a\x0b)\x9e\"~" +
-	"\x02\xf9\x19\xa9\xba/\xce\xdf\x81\xc7\xe6n]r\xfe\xc4" +
-	"\xce\xb4\x91-\xdf\xf3\xa9\xc9\xc9\x9c\x91\xec7]a\xb2" +
-	"\x91\xcf\x14\x7f\xf7\xd2\xa4m\xe5\xc3\xf6\xf2aG\xf90" +
-	"Z>\xec,\x1fv\x95\x0f\x97\x7fj\x1d\xbc\xfaT\xc6" +
-	"\xed\xce1?6^:\x97w\xec\x1d\xd5}\xa2\xd7\xee" +
-	"Z\xd3\x10\xac\x18j\xe9\x1a\x0eZ\xbc\xd0\xaeP[\x18" +
-	"7\xb2\xa08\xaf:\x8e\xb2\x00DBA\xfb\xb6\xf0?" +
-	"\xc9\xa8]O\xafQ\xacG\xab+\xe55x\x9fcE" +
-	"\x9c\xbc\xb2N^\x05\x89\x88h\xdf`L\x1b\xe6\xa0m" +
-	"\xaca\xc4\xb6L\xa4's\xe3F\x92\xcd\x8f\xe7c\xc9" +
-	"|5\xef\x8dO\xaf\xdd\xf7\x9b\x13\xe3\xa9-a}g" +
-	"\"3\x8d\xe7\x97@\xc1\xdf\xc8A\x1b\x95\xc0J\x94\x0b" +
-	"\xfe\x1dM\xcft\x9d\xc7@\xb9\xb8\x8e\x94\xc4u\x03\x89" +
-	"kI\xfd\x8af{\x13\x89\xa5#~\x12\xd8\xdaj\x90" +
-	"X:b\xe6+\x88\xeb8M\xe3\x88\x19\x07[\\\xb7" +
-	"\x13w\xc4\xcc\xc3mq\xddE\xf38\xe2\xe4\x95lq" +
-	"\xddG\xf7u\xc4I\xf6\xd8\xe2z\x88\xe2\x1d\xb1\xf1{" +
-	"mq=N\xdc\x11\x95\x80\xcf\x16\xd7\xa7\x88\x9fD\xfe" +
-	"<\x89\xabl\x8b\xeb\xb3\xc4\xcf \xff\x1e\x89\xab\xdf\x16" +
-	"\xd7\x97\x88\x7f\x07\xf9OH\\\x03\xb6\xb8\xbeJ\xfc\x87" +
-	"\xc8\xcf\x92\xb8\x06mq}\x9d\xf8k\xc8\xdf&q\x9d" +
-	"a\x8b\xeb9\x12\xb3\xb3\xc8/\x90\xb8\xd6\xd9\xe2\xfa\x0b" +
-	"\xe2?G~\x89\xc45d\x8b\xebE\xe2o#\x7f\x97" +
-	"\xc4\xb5\xde\x16\xd7\xcb\xc4/ \xbfJ\xe2\x1a\xb6\xc5\xf5" +
-	"\x0a\xf1K\xc8\xaf\x93\xb8*\xb6\xb8^#\xfe.\xf2\xdf" +
-	"\x90\xb8Flq\xbdA\xfc*\xf2\xf7\x91\xcflPa" +
-	"&\x80\xb8I\xfc:\xf2?\xd6\x12\xddT:i\xe4\xef" +
-	")\xa9\x8f&\x8d\xcc\xc4d\xca,\x8d\xb3\x89\x9d\xe8\xbf" +
-	"\xca/\x9c\xa6d\x17\xe6js\x85\x15Y{\x0d\xd6Q" +
-	"\x83Ek\xb0\xce\x1a\xac\xab\x06[\xfei\xbew\xd5P" +
-	"\xed\xd5\x15^\xaa\xc6\xabTM\x93Y~\xd0\x87J\x07" +
-	"\xdd\x8b'\xf1A\\\xf0\xba\xe2\x01\xa5\xb7m:p\x1e" +
-	"\xe4\x8a\xdbD\x85h\x83\xd7!\x9f\xe52Q3)\\" +
-	"A<\xb7x\x9e\xe9\xa5\x9aL\xc8,\xe4\xcdt\x9e\x0b" +
-	"&j!\xf1\xb9\xc8[\xdc&j\x11\xf1\x05\xc8[\xdd" +
-	"&j1\xf1f\xe4\xcb\xdc&j)\xf1\x16\xe4Q\xb7" +
-	"\x89j#\xde\x8a\xbc\xdbm\xa2:\x89/C\xbe\xcam" +
-	"\xa2V\xd0\xbe\x8f\"\xefs\x9b\xa8\x1e\xe2\x8e\xc7,\x9a" +
-	"\xa8~\xe2\x8e\xc7,\x9a\xa8A\xe2}\xc8\x87\xdc&*" +
-	"F\xbc\xe4=K&j-q\xc7|\x16M\x94F|" +
-	"\x08\xf9\x88\xdbD=D|\x18\xf9\xc6\xff\x97)\xfa\xec" +
-	"\x9a\x9e2w\xb0.g\xd2g\x0b\x85{\xea,\xcb\x83" +
-	"\xd6&\x81\xd6d3\x07mL\x82\x10\xdc\xb6T2<" +
-	")\xec\xc2\xa3\x1c4S\x82\x90\xf4\x1fK\x05\x89\xb1\xc8" +
-	"v\xa4\x19\x0e\xdan\x09B\xfc\x96\xa5\x02g,\xb2\xab" +
-	"\x9d1\xcd\xe4\xa0\xed\xc5\x17Ez\xc5\x9c \xb7\xa28" +
-	"\x1fQ\xe9`\x82E\xcfB7'\x18\xcf\xe2\xbfK_" +
-	"N\x0b\xffN\x90)\xd0w2\x9e\xc8\x80\xe2|#\xb4" +
-	"\xff=\xdf\xcc\xaf6\xf0\xb2\xd2\xe7\xe9\xe2ew\xf5w" +
-	"C\xa9\xb4l\x7fT\xb8\xb7\xd9\xfao\x00\x00\x00\xff\xff" +
-	"Z\xbe\x9cC"
+const schema_b99093b7d2518300 = "x\xda\xec\x99{l\x14\xc7\x19\xc0\xe7\x9b\xb9\xa7\xb9\xa7" +
+	"g\x8d\x12\xd9\x91\x1f2\x95M\x9d\xd6>?0\x08\x17" +
+	"\xdb9Sl\x99\xe2e\x0d8\x11\xa8l}\x1b|\xc1" +
+	">\x9f\xcfg|T\xa4$\x85H \x015\x0a\x14\xbb" +
+	"\x82\x00\x0d\x89\xda\x125\xa5m\xd4\x90\x80\x94 \xd2\x80" +
+	"J$Z\x81B\x1f\xb4UE\x12\xd5U\x95>T\xb5" +
+	"i(W}\xb3\xf7\xd8\xbd;\x83S\xa9\x8a\x1aU\xf2" +
+	"\x1f;?\x7f;\xfb\xcd\xec~\xbf\x9d\xd9\xab\xdf\xe3l" +
+	"\xa7\x0d\xd6\xcf;\x08\x917[m\xc9/\xbc\xf8wy" +
+	"\xcd\xfa\xfd_%r1X\xef\xec\x92\x7f\xf6\xa3\xa7\xa7" +
+	"\xceZ\xec\x84p\xa7e\x96\x97\xe0Q\xa3\xdf\xb2\x84\x12" +
+	"H\x9e:\xff\xd2\xf1\xbf\xb4\xbc\xb9\x97\xf8\x8b!\x1di" +
+	"\xa5\x18*\xdb.\xf0\x87mx\xb4\xce6I \x99(" +
+	"\xfe\xf5\xb3\xb5K\xa2\x07\x88\xbf\xd8\x92\x8e$\xd0\xf8\x96" +
+	"\xad\x0a\xf8M\x8cSn\xd8\x18(\xb7l\x14\x08I^" +
+	"zw\xebf\xf9\xe8\xf4\xc1\xfc~\xad\xf6_p\xbf\x1d" +
+	"\x8f\xdcv\xec\xb7\xee\x81\xd6\xb1KM\xfb\x0e\xe7G\x9e" +
+	"\xb1\xcf\xf2\xf3\"\xf2\xac\x88\xdc\xff\xc5\xca\xaf\xac}\xaf" +
+	"\xf6\x08\x0e\xcb\x99\x09\x05\x0c\xa8u\xcc\xf2f\x07\x8e\xab" +
+	"\xc1\xf1Y\x0b\x81\xe4\xe7\x1e[S\x07\xcf&\x8f\xe4\xf7" +
+	"z\xd0\xf5\x1d>\xe3\xc2\xa3\xc3.\xecu\xdf\xf2w\x8f" +
+	"5=\xbdp:g\\\xf7\xbb\xab\x80\xd7\xbaq\\\xd5" +
+	"n\x06J\xbd[\x8c\xabbk\xeb\x9f_\xfe\xcdC\xd3" +
+	"\xf9\xfd\xaa\xee\xdf\xf1\x11\x8c\xe7a7\xf6\xfb\xd0\x9b\xb7" +
+	"\xbf\xff\xb5@\xc3\x89\xfc\xc8\x07<\xb3\xbc\xd6\x83G\x8b" +
+	"<\x18\xb98t\xe7t\xfb\x86]'r2x\xc9S" +
+	"\x0c\xfc\x0d\x8cS^\xf30P\xaexD\x06\x87\xba\xda" +
+	"\xde\xde\xf5\xa7\xa6\xe7s\xa2\x9d\xde*\xe0\xf7{1Z" +
+	"\xf22P*\xbc\"z\xef\x91\xc6\xad\xa7\xbft\xeey" +
+	"\x9c\xb3\"\xf3\x9c\xb5ygy7\x9e\xd0\xd8\xe5=\x8e" +
+	"s\xf6N\xd9\xd1=?\xf9\xc6#\xa7sz\xae\x94\xaa" +
+	"\x807H\xd8s\x9d\xc4@i\x95D\xcf\xbf\xafmy" +
+	"\xe6\xc7\x07\xb6\x7f7'z\x9d\x14\x00\xae\x89\xe8\xcd\x18" +
+	"=\xacG\x1f\xfdAp\xe3\xcd\xf7\xacg\xf2g#," +
+	"\xbd\xc2\xc70\x9e\x8fH8\x1b\xc7_y\xe7\xeb\xef/" +
+	"\xa2\xafb\xc6`\xce\xf8\x8f\xd2?\xf9\x07\"\xf4o\xd2" +
+	"\x8b\x04\x92\xca\xf9\x93-\x17\xb4\xd7/\xe7w:S2" +
+	"\xcb\x9f+\xc1\xa3\x93%\xd8\xe9\xa7^\xdd\xff\xe9\xca\xe5" +
+	"\x7f\xb8f\x9e\x06\x86\x01\xcd\x0bgy\xc7B\x9c\x86\xb6" +
+	"\x85o\xe34\xdc\xf6\xbf\xff\xc1\xb1`\xdb\xf5\x9c\x81m" +
+	"/\xad\x02\xbe\xb7\x14\x07\xb6\xbb\x94\x812U*\x06\xf6" +
+	"\xcd\x9d\xda\xd8-\xeb\x0b\xbf\xcc\xcf\xe1p\xe9,?\x89" +
+	"\xf1\xfcX)\xe6Pz`\xa9\xf5\xaf\xec\xc2?\x0a<" +
+	"\xbe\x0f\x96\xcd\xf2\xa5e\x98Cs\x99x|\xabcm" +
+	"\xcf]Y\x14\xfc\xd04\x09]`\xb7b\xbf\x15\x17\xf8" +
+	"\xb1\x0a\x0c\x9e\xa9(\x07\x02\xc9\xca\xeb\xe7\xa6V_z" +
+	"&\x99\x93\xf0\xd9\xca*\xe0\x97+1\xe1\x8b\x95\x0c\x94" +
+	"\xab\x95\"a5\x1a\x1d\x0e\x0f\xaaq\x1a\x1e\x8d|f" +
+	"P\x8dF\xa2\xcb\xfaW\xf6\xc5\xb4n\xef\x88\xbaE\xeb" +
+	"\x03\x90k\x98\x85\x10\x0b\x00p't\x12\xa2X\x80\x81" +
+	"\xe2\x03\x0a~\x00\x09\x90\xbb\xa1\x87\x10\xc5\x85\xfc>\xe4" +
+	"\x94J@\x01x\x09,&D\xf1!/C\xce\x98\x04" +
+	"\x0c\x80\xdf/8\x1e+\x15\xc8-\x16\x09,\x00\xfc\x01" +
+	"\xc1\xefC^\x8d\xdcj\x95\xc0\x0a\xc0+\x05/C^" +
+	"\x83\xdcf\x93\xc0\x06\xc0\x17\x09^\x81\xbc\x0e\xb9\xdd." +
+	"\x81\x1d\x80\xd7\x0a^\x8d\xbc\x1e\xb9\xc3!\x81\x03\x80?" +
+	"(x\x0d\xf2&\xe4N\xa7\x04N\x00\xde x\x1d\xf2" +
+	"V\xa0\xb0spH\x0dG\xba\x83\xe0 \x14\x1c\x04\x92" +
+	"\xf1\x815\x13\xf1\xeeP\x82\x10\x92f\xdeG5\xad\xde" +
+	"\xd8h06\x02\xc6F\xa3\xb1\xd1dl4\x1b\x1b-" +
+	"\xc6\xc6\x92\xcc\xb5\x0b\xdd\x9f\xc4JM#xk\x1c\xe9" +
+	"[\xe3\xaf}\x84\x10\xb9\x86\x81\xdc\x94\xbd/\xfe\xaee" +
+	"\x84\xc8\xed\x0c\xe4^\x0a\xc9\xb8\xb8\xab#*a[4" +
+	"\xf0e_\x0a\x04\xc0G\x88\xcfJ,+\xe2\x89U\xea" +
+	"\xf8\x10\xb8\x09\x057!~(2<\x1f,{\xfd\x90" +
+	"\xf6\xa8:1\x1c\xefPD\x87v\xfd1\x01_V." +
+	"z\x97}E@\x9c\xe6N\x0c\x83\x08*\xbd\xe1\xc8V" +
+	"\xa6\xc5\xe61\x8e1\x1c\xc70\x039A!\x19RL" +
+	"\xe3\xc8\x94r\xfa\xa2\x8c8\xfd\xce\"\xfd\x8f\xd0\xbb\x8e" +
+	"\xc98\xa7\x03\xdd\x91\xde\xb07\xb25/\xa1\xc7\x0a$" +
+	"\xd4\x86\x09\xb52\x90\x838\xb1\x03\xdd\x11L\x89\x88\x8a" +
+	"\x01_VY\xa9\x94\x00R\x17\x9eo2\x1b\x82\xa6\x02" +
+	"\xec\xcd\x14\xa0\x95v\x12\xb2\x962P\\\x14\xeb)U" +
+	"\x7fN\x1a\xc0\xbaD\xeeC\x0e\xa9\xfasSQ\x97\xc8" +
+	"\xef\xa3\x14\x80\xea\xe5W\x82\xbd(>\xc4e\x18na" +
+	"\xa9\xf2\xa3\xcb\xb0\xfc\x90W#\xb7ZR\xe5'x\x19" +
+	"\xf2\x1a\xe46k\xaa\xfc\x04\xaf@^\x87\xdcnK\x95" +
+	"\x9f\xe0\xd5\xc8\xeb\x91;\xec\xa9\xf2\x13\xbc\x06y\x13r" +
+	"\xa7#U~\x82\xd7!oE^\xe4\x94\xa0\x08\x807" +
+	"\x0b^\x8f|9\xf2\x05E\x12,\x00\xe0K)\x96k" +
+	"\x13\xf2v\xe4\xae\x05\x12\xb8\x00x\x9b\xe0\xad\xc8\x83\xc8" +
+	"\xdd.\x09\xdc\x00\xbcC\xf0\xe5\xc8W!\xf7\xb8%\xf0" +
+	"\x00\xf0.\xc1\xdb\x91\xf7\"\xf7z$\xf0\x02\xf0n\xc1" +
+	"\x83\xc8\xfb\x90\xfb\xbc\x12\xf8\x00\xf8j\xc1W!\xefG" +
+	"\xee\xf7I\xe0\x07\xe0\xb2\xe0\xbd\xc8\x07\x90\x17\xfb%(" +
+	"\x06\xe0\xeb\x04\xefC\xbe\x91\xe6k\xa5|\x9b:<\xa1" +
+	"\xddM2;\xd5\xc1\xc1\xd1\x89H\xdc\xf4\xc0\xac\x10\xa7" +
+	"et\xa37\x03\xe6f\xa3\xb9\xd9dn6\x9b\x9b-" +
+	"\xe6\xe6\x92\x8fMq\x90\xae\x00/:.\xa7\x0c\xab\x08" +
+	"\x91\xab\x19\xc8\xed\x862\xecXL\x88\xbc\x9c\x81<@" +
+	"\xc1\xbe-\x1c\x01\x0f\x81>\x86*\xca\xac/\x08\x80\x87" +
+	"\x10?\xd8\xdb\xa9w\xdb\xe8D<\x1b\x92y\xa7fB" +
+	"\xfa\x00\xee\xa2\xbb`\xae\xee\xeea\x9e\x82\xd2S\xe3\xaa" +
+	"\x12\xb7\x8f\xc6\xb4\x9c\xd1\xf5\x14\x90\xcc\xe3k\x09\x91w" +
+	"0\x90\xf7\x08\xeb\xa1.\xb5\x18!\x04|\xd9\xe5\xb4~" +
+	"\xe9v*[\x8a\x98\xbf\xa3\xc8\x98\x00K\x8e\x87\xb7D" +
+	"\xd4\xf8D\x8c\x80vO\xe5\xac\xc7[\xafx\xe3\xf9\xa9" +
+	"\x15\x12\xf2&\xf4\xdf\x00\x039D!\xb9\xcd,\xe4\xcc" +
+	"\xba&5-N \x8e{\xdb\xaf\xd0|\x8b\x11CL" +
+	"\x9f\xed\xf9\x0dy\xee\xee\xd6\xe7\xde\xbe9\xf3,4=" +
+	"\x1d\x8a\xc9\xc8\xfd\x19#\xb7\xd1N\x93s\xac)%w" +
+	"\xd0\x80\xc99i%w\xd1\x1e\xa3[\xd2J^M\x03" +
+	"&\xb5\xb0\x94\x92\xd7\x89\xf0~\xe4\x9bij\xa5\x84J" +
+	"\xdeD\xab\x08Q\x06\x90\x87\x8cJV\x8527\"\x1f" +
+	"2*Y\x13|3\xf2a\xa3\x92\xc3\x82\x87\x90G\x8d" +
+	"J\x1e\x11|\x08y\xdc\xa8\xe41\xc1\x87\x91'\x8cJ" +
+	"\x9e\x10<\x8a|\x87Q\xc9\xdb\x05\x8f#\x7f\xc2\xa8\xe4" +
+	"\xc7\x85\x1a\x13\xc8w\x1b\x95\xfc\xa4\xe0;\x90\xef1*" +
+	"\xf9)\xc1\x9f@\xbe\xcf\xa8\xe4\xbd\x82\xefF>eT" +
+	"\xf2~\xc1\xf7 ?dT\xf2A\xc1\xf7!\x9fF\xce" +
+	"\x8b%\xe0\x00\xfc\xb0\xe0S\xc8\x8f\"\x97\xb8\x847\x92" +
+	"\xcf\x08~\x08\xf9\x89\xffH\xe1\xe5\xa3\x93\x11-f~" +
+	"\xe6\xc3\xe3\xe3\x13Z\xa8#n\x08\xb3k\x89\xa8Y\xc3" +
+	"\x9fD\xc1\xe7W\xe5\x86`NUf6\xf5sVe" +
+	"~'\xfd\xfa\x02\xac\xbc{$\xd3\xcd\x9cK\xb0\xfc\x97" +
+	"\xcd\x0a}\xf17\x0f\xebu\xa3\x90W1\x90\xfbS\xab" +
+	">\x14\x14aZ\x0c|\xd9\xcf\x16Y=\x01\xf8\xddE" +
+	"\xfae\xe1#\xb9X\x1f\xcf\x0a-=\x1e\xd9\x95\xc9\xab" +
+	"\xab3\xbb\xa2\xcf\xe6\x15\xcb\xe6\x95\xf2\x8c_\xfe2!" +
+	"r\x1f\x03yc\x81\xfd\xcc\xe0hd|bD\x0b\x91" +
+	"\xf2\xfeDw(\x91\xcfW\xf4\xcfo\xa1\xda\x11\x1f\x1d" +
+	"\x09\x0fz\x95I5:\x8f\xf9S\xf1\xad\xb1\x91\x81<" +
+	"D!\xa9\x9a\xdf\x1as\xee\x1d\xe6\xbbf\x0e\x9a\x0d=" +
+	"\x901\xf4\xc3\xb4\xd3\xa8\xd0\xf4\x9eu\x13\x0d\x98\x0cJ" +
+	"A\x17\xb4F{LF\xb4\xa5\x0c=B;MFd" +
+	"\xa0\x1bz\x8cv\x9a\x8c\x88\x8bi\xab0_\x8f\xc9p" +
+	"V\xaa\x1b\xfaI\x1a0\x19\xcen\xd1\x0d\xbd\x97\xf6\x98" +
+	"\x8c\xe5\xb0\xea\x86>H{Lfr\xdatC\xcf\x08" +
+	">\x8d\xfc\x940\xb4]7\xf4I\xc1O \x7fA\x18" +
+	"\xda\xa1\x1b\xfa\xdb\x82\x7f\x0b\xf9\x0f\x85\xa1\x9d\xba\xa1\xcf" +
+	"\x08\xfe=\xe4\xe7\x84\xa1\x8btC\x9f\x15\xfce\xe4\x17" +
+	"\x85\xa1\x17\xe8\x86~]\x18\xf1\x1c\xf2K\xc2\xd0.\xdd" +
+	"\xd0o\x08\xfe\x1a\xf2+\xc2\xd0n\xdd\xd0\x97\x05\xbf\x88" +
+	"\xfc*r\xbfG7\xf4[\x82_B~M\x18\xda\xab" +
+	"\x1b\xfa\xa7\x82_A~\x039\xf7\xe9\x86\xbe.\xf8U" +
+	"\xe4\xbfB.\xf9uC\xff\\\xf0k\xc8\x7f\x8b\xbc\xa4" +
+	"X\x82\x12\x00~S\xf0\x1b\xc8o\x152w8\x12\xd2" +
+	"\x12\xf7\xf4\xf2\xce\x90\x16\x1d\x1d\x0f\xc73\xed\x98:\x89" +
+	"\x8b8\xf3\x89\xf3\xf4~\xaa\xaf\x06CX\x9a\x05\x0a\xb0" +
+	"\xc6\x02\xac\xa9\x00k.\xc0Z\x0a\xb0%\x1f\xe7\xe7\x8b" +
+	"\x02\xd6^\x99\xa3\xfe\x02_$\x0an\x8e\xc3\xf1\xa1P" +
+	"\xcc\xabN\xaa\xc3\x1fu\xa5:\x1949g\xcew\xcd" +
+	"|\x9d\xb3^\xf9/\xef\xd3\x03\xff\xdf\xa7\xff/\xec\xd3" +
+	"\x0b\x14\xfb'w\x11gZ\xa8\xac\x99\x88\x8b\x0f\x91\x12" +
+	"\xb3\xb8\x92I\x0b\x10b\xda\xb6\xba\xe1NR\x02\xa4O" +
+	"aq\xeef OQp\xd3\x7f%%\xa0\x84\xf8\xf7" +
+	"#\xdd\xc7@\x9e\xa6\xe0f\xb7\x93\x120B\xfc\x87\x03" +
+	"\x84\xc8S\x0c\xe4\xa3\x14\xdc\x96\x0fSt\x06c\xa7\x19" +
+	"\xc8\xa7p;,6\xd2\xa3b9\xe5\xcb\xfe\x0a#\x0a" +
+	"\x19\x92b\x86\x94\xf8(a1\xfcw\xe6\xa7\x97\xd4\xbf" +
+	"U\xb1jQ&\x09S\xa3\xe0\xcb\xfel\xa0\xff\xbb<" +
+	"\x8e\x9fW\xc1\x97\xfd-,u\xda\xa4\x10\x8f\x8a\xa7\x0d" +
+	"\x83/\xfb\xa5?\xdd\xeb]\xd7\xa7\xbd\xe1\x88]\xff\xb6" +
+	"x\xef\xc5\xe2\xbf\x07\x00\x04Z`>"
 
 func init() {
 	schemas.Register(schema_b99093b7d2518300,
@@ -2133,6 +2496,7 @@ func init() {
 		0x8e703729a3de1278,
 		0x91989c51606be6c8,
 		0x958c34c871381d2c,
+		0x9729e7527e215f8d,
 		0x97ffa3012c4f6a3e,
 		0x981693349de63c8c,
 		0x9843dfb8f1386b20,
@@ -2140,12 +2504,14 @@ func init() {
 		0xa0835740abfe642a,
 		0xa634f083d73d4594,
 		0xa6bc62ab6b339789,
+		0xab5a9acb889c1ce5,
 		0xae798ec69e3629e9,
 		0xb105e7dd5c44b39c,
 		0xbb0225ef96e5ba9f,
 		0xc9c165c236a1bd53,
 		0xd4eb3c212b8dbb26,
 		0xd53d449df9ef11fc,
+		0xdbac05e471657fa2,
 		0xf8c203f305398e1b,
 		0xfb4425cca53d7224,
 		0xff9ec84d90bcd521)
@@ -2225,6 +2591,19 @@ var x_b99093b7d2518300 = []byte{
 	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 12, 0, 0, 0,
+	0, 0, 0, 0, 9, 0, 1, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	1, 0, 0, 0, 10, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 4, 0, 0, 0,
 	0, 0, 0, 0, 1, 0, 1, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
@@ -2384,6 +2763,32 @@ var x_b99093b7d2518300 = []byte{
 	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 12, 0, 0, 0,
+	0, 0, 0, 0, 9, 0, 1, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	1, 0, 0, 0, 10, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 12, 0, 0, 0,
+	0, 0, 0, 0, 9, 0, 1, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	1, 0, 0, 0, 10, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 4, 0, 0, 0,
 	0, 0, 0, 0, 1, 0, 1, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
@@ -16,6 +16,11 @@ const (
 	// DataStoreSVA is the constant which specifies the
 	// Signature Verification Algorithm used for DataStore objects
 	DataStoreSVA
+
+	// TimelockSVA is the constant which specifies the
+	// Signature Verification Algorithm used for ValueStore objects
+	// which may not be consumed before a lock epoch
+	TimelockSVA
 )
 
 // SignerRole is the defined type utilized for designation for signers
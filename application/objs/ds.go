@@ -1,11 +1,14 @@
 package objs
 
 import (
+	"bytes"
+
 	mdefs "github.com/MadBase/MadNet/application/objs/capn"
 	"github.com/MadBase/MadNet/application/objs/datastore"
 	"github.com/MadBase/MadNet/application/objs/uint256"
 	"github.com/MadBase/MadNet/application/wrapper"
 	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/dynamics"
 	"github.com/MadBase/MadNet/errorz"
 	"github.com/MadBase/MadNet/utils"
 	capnp "zombiezen.com/go/capnproto2"
@@ -321,6 +324,113 @@ func (b *DataStore) ValidateFee(storage *wrapper.Storage) error {
 	return nil
 }
 
+// FeatureRenewal activates DataStore renewals. Until governance schedules
+// it, a DataStore which renews a consumed DataStore is charged in full.
+var FeatureRenewal = dynamics.RegisterFeature("renewal", "DataStore renewals which are only charged for the added epochs")
+
+// IsRenewalOf returns true if the datastore extends the lease of the consumed
+// datastore. A renewal must have the same owner, index and raw data as the
+// consumed datastore, the consumed datastore must not have expired, and the
+// renewal must expire in a later epoch.
+// The DataStore format has no way to refer to the raw data of another
+// DataStore, so a renewal embeds the raw data again; only its fee is reduced.
+func (b *DataStore) IsRenewalOf(currentHeight uint32, consumed *DataStore) (bool, error) {
+	if b == nil || consumed == nil {
+		return false, errorz.ErrInvalid{}.New("not initialized")
+	}
+	expired, err := consumed.IsExpired(currentHeight)
+	if err != nil {
+		return false, err
+	}
+	if expired {
+		return false, nil
+	}
+	idx, err := b.Index()
+	if err != nil {
+		return false, err
+	}
+	oldIdx, err := consumed.Index()
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(idx, oldIdx) {
+		return false, nil
+	}
+	owner, err := b.Owner()
+	if err != nil {
+		return false, err
+	}
+	ownerBytes, err := owner.MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	oldOwner, err := consumed.Owner()
+	if err != nil {
+		return false, err
+	}
+	oldOwnerBytes, err := oldOwner.MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(ownerBytes, oldOwnerBytes) {
+		return false, nil
+	}
+	rawData, err := b.RawData()
+	if err != nil {
+		return false, err
+	}
+	oldRawData, err := consumed.RawData()
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(rawData, oldRawData) {
+		return false, nil
+	}
+	eoe, err := b.EpochOfExpiration()
+	if err != nil {
+		return false, err
+	}
+	oldEoe, err := consumed.EpochOfExpiration()
+	if err != nil {
+		return false, err
+	}
+	return eoe > oldEoe, nil
+}
+
+// ValidateRenewalFee validates the fee of a datastore which renews the
+// consumed datastore. Only the epochs added beyond the epoch of expiration
+// of the consumed datastore are charged.
+func (b *DataStore) ValidateRenewalFee(storage *wrapper.Storage, consumed *DataStore) error {
+	fee, err := b.Fee()
+	if err != nil {
+		return err
+	}
+	eoe, err := b.EpochOfExpiration()
+	if err != nil {
+		return err
+	}
+	oldEoe, err := consumed.EpochOfExpiration()
+	if err != nil {
+		return err
+	}
+	if eoe <= oldEoe {
+		return errorz.ErrInvalid{}.New("invalid renewal; epoch of expiration not extended")
+	}
+	perEpochFee, err := storage.GetDataStoreEpochFee()
+	if err != nil {
+		return err
+	}
+	addedEpochs, _ := new(uint256.Uint256).FromUint64(uint64(eoe - oldEoe))
+	feeTrue, err := new(uint256.Uint256).Mul(perEpochFee, addedEpochs)
+	if err != nil {
+		return err
+	}
+	if fee.Cmp(feeTrue) != 0 {
+		return errorz.ErrInvalid{}.New("invalid renewal fee")
+	}
+	return nil
+}
+
 // ValidatePreSignature validates the signature of the datastore at the time of
 // creation
 func (b *DataStore) ValidatePreSignature() error {
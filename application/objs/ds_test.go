@@ -1180,3 +1180,158 @@ func TestDSValidateFee(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestDSIsRenewalOf(t *testing.T) {
+	ownerSigner := makeSecpSigner(crypto.Hasher([]byte("a")))
+	rawData := crypto.Hasher([]byte("rawdata"))
+	index := crypto.Hasher([]byte("Index"))
+	zero := new(uint256.Uint256).SetZero()
+	currentHeight := 2*constants.EpochLength + 1
+
+	// Consumed datastore expires in epoch 7
+	consumed, err := makeDSWithValueFee(t, ownerSigner, 1, rawData, index, 1, 5, zero).DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Renewal expires in epoch 14
+	renewal, err := makeDSWithValueFee(t, ownerSigner, 2, rawData, index, 3, 10, zero).DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := renewal.IsRenewalOf(currentHeight, consumed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("Should be a renewal")
+	}
+
+	// Consumed datastore has expired
+	ok, err = renewal.IsRenewalOf(7*constants.EpochLength, consumed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("Should not be a renewal (1)")
+	}
+
+	// Different raw data
+	other, err := makeDSWithValueFee(t, ownerSigner, 2, crypto.Hasher([]byte("other")), index, 3, 10, zero).DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err = other.IsRenewalOf(currentHeight, consumed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("Should not be a renewal (2)")
+	}
+
+	// Different index
+	other, err = makeDSWithValueFee(t, ownerSigner, 2, rawData, crypto.Hasher([]byte("other")), 3, 10, zero).DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err = other.IsRenewalOf(currentHeight, consumed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("Should not be a renewal (3)")
+	}
+
+	// Different owner
+	otherSigner := makeSecpSigner(crypto.Hasher([]byte("b")))
+	other, err = makeDSWithValueFee(t, otherSigner, 2, rawData, index, 3, 10, zero).DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err = other.IsRenewalOf(currentHeight, consumed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("Should not be a renewal (4)")
+	}
+
+	// Lifetime is not extended
+	other, err = makeDSWithValueFee(t, ownerSigner, 2, rawData, index, 3, 3, zero).DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err = other.IsRenewalOf(currentHeight, consumed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("Should not be a renewal (5)")
+	}
+
+	ds := &DataStore{}
+	_, err = ds.IsRenewalOf(currentHeight, consumed)
+	if err == nil {
+		t.Fatal("Should have raised an error")
+	}
+}
+
+func TestDSValidateRenewalFee(t *testing.T) {
+	ownerSigner := makeSecpSigner(crypto.Hasher([]byte("a")))
+	rawData := crypto.Hasher([]byte("rawdata"))
+	index := crypto.Hasher([]byte("Index"))
+	zero := new(uint256.Uint256).SetZero()
+
+	msg := makeMockStorageGetter()
+	perEpochFee32 := uint32(3)
+	msg.SetDataStoreEpochFee(big.NewInt(int64(perEpochFee32)))
+	storage := makeStorage(msg)
+
+	// Consumed datastore expires in epoch 7
+	consumed, err := makeDSWithValueFee(t, ownerSigner, 1, rawData, index, 1, 5, zero).DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Renewal expires in epoch 14; 7 epochs are added
+	fee, err := new(uint256.Uint256).FromUint64(uint64(perEpochFee32 * 7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	renewal, err := makeDSWithValueFee(t, ownerSigner, 2, rawData, index, 3, 10, fee).DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = renewal.ValidateRenewalFee(storage, consumed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Full fee is not valid for a renewal
+	err = renewal.ValidateFee(storage)
+	if err == nil {
+		t.Fatal("Should have raised an error (1)")
+	}
+
+	// Full fee charged on a renewal
+	fee, err = new(uint256.Uint256).FromUint64(uint64(perEpochFee32 * (10 + 2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	renewal, err = makeDSWithValueFee(t, ownerSigner, 2, rawData, index, 3, 10, fee).DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = renewal.ValidateRenewalFee(storage, consumed)
+	if err == nil {
+		t.Fatal("Should have raised an error (2)")
+	}
+
+	// Lifetime is not extended
+	renewal, err = makeDSWithValueFee(t, ownerSigner, 2, rawData, index, 3, 3, zero).DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = renewal.ValidateRenewalFee(storage, consumed)
+	if err == nil {
+		t.Fatal("Should have raised an error (3)")
+	}
+}
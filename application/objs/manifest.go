@@ -0,0 +1,238 @@
+package objs
+
+import (
+	"bytes"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+)
+
+// manifestMagic prefixes the raw data of every manifest DataStore so that
+// manifests may be told apart from ordinary data.
+var manifestMagic = []byte("MNFST")
+
+// ManifestVersion is the version of the manifest encoding
+const ManifestVersion uint8 = 1
+
+// manifestHeaderLen is the length of the fixed portion of a manifest:
+// magic | version | object size | object hash | chunk count
+var manifestHeaderLen = len(manifestMagic) + 1 + 8 + constants.HashLen + 4
+
+// manifestChunkLen is the length of a single chunk entry:
+// index | hash | size
+const manifestChunkLen = constants.HashLen + constants.HashLen + 4
+
+// ManifestChunk describes a single chunk of a large object
+type ManifestChunk struct {
+	Index []byte
+	Hash  []byte
+	Size  uint32
+}
+
+// Manifest describes a large object which has been split across several
+// DataStores. The manifest is itself stored as the raw data of a DataStore
+// and lists the index and hash of every chunk DataStore in order.
+type Manifest struct {
+	Size   uint64
+	Hash   []byte
+	Chunks []*ManifestChunk
+}
+
+// ChunkIndex returns the index of the i-th chunk DataStore of the object
+// whose manifest is stored at manifestIndex.
+func ChunkIndex(manifestIndex []byte, i uint32) []byte {
+	return crypto.Hasher(manifestIndex, utils.MarshalUint32(i))
+}
+
+// MaxChunkSize returns the largest raw data a chunk or manifest DataStore
+// may hold: it must fit in a single DataStore and in a block of maxBytes,
+// the MaxBytes value currently set in dynamics.
+func MaxChunkSize(maxBytes uint32) uint32 {
+	if maxBytes < constants.MaxDataStoreSize {
+		return maxBytes
+	}
+	return constants.MaxDataStoreSize
+}
+
+// SplitObject splits data into chunks of at most chunkSize bytes and returns
+// the manifest describing them along with the chunks in order. The chunk
+// indexes are derived from manifestIndex. maxBytes is the MaxBytes value
+// currently set in dynamics and bounds the size of every chunk and of the
+// manifest itself.
+func SplitObject(manifestIndex []byte, data []byte, chunkSize uint32, maxBytes uint32) (*Manifest, [][]byte, error) {
+	if len(manifestIndex) != constants.HashLen {
+		return nil, nil, errorz.ErrInvalid{}.New("invalid manifest index; must have len(Index) == constants.HashLen")
+	}
+	if len(data) == 0 {
+		return nil, nil, errorz.ErrInvalid{}.New("invalid object; size is zero")
+	}
+	maxChunkSize := MaxChunkSize(maxBytes)
+	if chunkSize == 0 || chunkSize > maxChunkSize {
+		return nil, nil, errorz.ErrInvalid{}.New("invalid chunk size")
+	}
+	m := &Manifest{
+		Size: uint64(len(data)),
+		Hash: crypto.Hasher(data),
+	}
+	chunks := [][]byte{}
+	for start := 0; start < len(data); start += int(chunkSize) {
+		end := start + int(chunkSize)
+		if end > len(data) {
+			end = len(data)
+		}
+		chunk := utils.CopySlice(data[start:end])
+		m.Chunks = append(m.Chunks, &ManifestChunk{
+			Index: ChunkIndex(manifestIndex, uint32(len(chunks))),
+			Hash:  crypto.Hasher(chunk),
+			Size:  uint32(len(chunk)),
+		})
+		chunks = append(chunks, chunk)
+	}
+	if uint64(manifestHeaderLen+len(m.Chunks)*manifestChunkLen) > uint64(maxChunkSize) {
+		return nil, nil, errorz.ErrInvalid{}.New("invalid object; manifest is too large")
+	}
+	return m, chunks, nil
+}
+
+// IsManifest returns true if rawData carries the manifest prefix
+func IsManifest(rawData []byte) bool {
+	return bytes.HasPrefix(rawData, manifestMagic)
+}
+
+// MarshalBinary takes the Manifest object and returns the canonical
+// byte slice
+func (m *Manifest) MarshalBinary() ([]byte, error) {
+	if m == nil || len(m.Hash) != constants.HashLen || len(m.Chunks) == 0 {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	out := make([]byte, 0, manifestHeaderLen+len(m.Chunks)*manifestChunkLen)
+	out = append(out, manifestMagic...)
+	out = append(out, ManifestVersion)
+	out = append(out, utils.MarshalUint64(m.Size)...)
+	out = append(out, m.Hash...)
+	out = append(out, utils.MarshalUint32(uint32(len(m.Chunks)))...)
+	for _, c := range m.Chunks {
+		if c == nil || len(c.Index) != constants.HashLen || len(c.Hash) != constants.HashLen {
+			return nil, errorz.ErrInvalid{}.New("invalid manifest chunk")
+		}
+		out = append(out, c.Index...)
+		out = append(out, c.Hash...)
+		out = append(out, utils.MarshalUint32(c.Size)...)
+	}
+	return out, nil
+}
+
+// UnmarshalBinary takes a byte slice and returns the corresponding
+// Manifest object
+func (m *Manifest) UnmarshalBinary(data []byte) error {
+	if m == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	if !IsManifest(data) || len(data) < manifestHeaderLen {
+		return errorz.ErrInvalid{}.New("invalid manifest; bad header")
+	}
+	data = data[len(manifestMagic):]
+	if data[0] != ManifestVersion {
+		return errorz.ErrInvalid{}.New("invalid manifest; unknown version")
+	}
+	data = data[1:]
+	size, err := utils.UnmarshalUint64(data[:8])
+	if err != nil {
+		return err
+	}
+	data = data[8:]
+	hsh := utils.CopySlice(data[:constants.HashLen])
+	data = data[constants.HashLen:]
+	numChunks, err := utils.UnmarshalUint32(data[:4])
+	if err != nil {
+		return err
+	}
+	data = data[4:]
+	if numChunks == 0 || uint64(len(data)) != uint64(numChunks)*manifestChunkLen {
+		return errorz.ErrInvalid{}.New("invalid manifest; bad chunk list")
+	}
+	chunks := make([]*ManifestChunk, 0, numChunks)
+	total := uint64(0)
+	for i := uint32(0); i < numChunks; i++ {
+		c := &ManifestChunk{
+			Index: utils.CopySlice(data[:constants.HashLen]),
+			Hash:  utils.CopySlice(data[constants.HashLen : 2*constants.HashLen]),
+		}
+		c.Size, err = utils.UnmarshalUint32(data[2*constants.HashLen : manifestChunkLen])
+		if err != nil {
+			return err
+		}
+		total += uint64(c.Size)
+		chunks = append(chunks, c)
+		data = data[manifestChunkLen:]
+	}
+	if total != size {
+		return errorz.ErrInvalid{}.New("invalid manifest; chunk sizes do not sum to object size")
+	}
+	m.Size = size
+	m.Hash = hsh
+	m.Chunks = chunks
+	return nil
+}
+
+// Reassemble joins chunks in order, verifying each chunk and the whole object
+// against the manifest.
+func (m *Manifest) Reassemble(chunks [][]byte) ([]byte, error) {
+	if m == nil || len(m.Chunks) == 0 {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	if len(chunks) != len(m.Chunks) {
+		return nil, errorz.ErrInvalid{}.New("invalid object; wrong number of chunks")
+	}
+	out := make([]byte, 0, m.Size)
+	for i, chunk := range chunks {
+		if err := m.ValidateChunk(uint32(i), chunk); err != nil {
+			return nil, err
+		}
+		out = append(out, chunk...)
+	}
+	if uint64(len(out)) != m.Size || !bytes.Equal(crypto.Hasher(out), m.Hash) {
+		return nil, errorz.ErrInvalid{}.New("invalid object; hash mismatch")
+	}
+	return out, nil
+}
+
+// ValidateChunk verifies that chunk matches the i-th entry of the manifest
+func (m *Manifest) ValidateChunk(i uint32, chunk []byte) error {
+	if m == nil || int(i) >= len(m.Chunks) {
+		return errorz.ErrInvalid{}.New("invalid chunk number")
+	}
+	c := m.Chunks[i]
+	if uint32(len(chunk)) != c.Size || !bytes.Equal(crypto.Hasher(chunk), c.Hash) {
+		return errorz.ErrInvalid{}.New("invalid chunk; hash mismatch")
+	}
+	return nil
+}
+
+// ReadObject parses the manifest in manifestData and uses getData to fetch the
+// raw data of each chunk DataStore it lists. The chunks are verified against
+// the manifest and returned reassembled. Objects larger than maxSize are
+// rejected before any chunk is fetched; a maxSize of zero means no limit.
+func ReadObject(manifestData []byte, maxSize uint64, getData func(index []byte) ([]byte, error)) ([]byte, error) {
+	m := &Manifest{}
+	if err := m.UnmarshalBinary(manifestData); err != nil {
+		return nil, err
+	}
+	if maxSize > 0 && m.Size > maxSize {
+		return nil, errorz.ErrInvalid{}.New("invalid object; object is too large")
+	}
+	chunks := make([][]byte, 0, len(m.Chunks))
+	for i, c := range m.Chunks {
+		chunk, err := getData(utils.CopySlice(c.Index))
+		if err != nil {
+			return nil, err
+		}
+		if err := m.ValidateChunk(uint32(i), chunk); err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, chunk)
+	}
+	return m.Reassemble(chunks)
+}
@@ -0,0 +1,159 @@
+package objs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MadBase/MadNet/crypto"
+)
+
+// testMaxBytes is the MaxBytes value set in dynamics in these tests
+const testMaxBytes uint32 = 3000000
+
+func TestManifestSplitReassemble(t *testing.T) {
+	manifestIndex := crypto.Hasher([]byte("object"))
+	data := make([]byte, 1000)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	m, chunks, err := SplitObject(manifestIndex, data, 300, testMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chunks) != 4 || len(m.Chunks) != 4 {
+		t.Fatalf("wrong number of chunks: %v", len(chunks))
+	}
+	if len(chunks[3]) != 100 {
+		t.Fatalf("wrong size of last chunk: %v", len(chunks[3]))
+	}
+	for i, c := range m.Chunks {
+		if !bytes.Equal(c.Index, ChunkIndex(manifestIndex, uint32(i))) {
+			t.Fatalf("wrong chunk index at %v", i)
+		}
+	}
+
+	mBytes, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsManifest(mBytes) {
+		t.Fatal("Should be a manifest")
+	}
+	m2 := &Manifest{}
+	err = m2.UnmarshalBinary(mBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := m2.Reassemble(chunks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Fatal("reassembled object does not match")
+	}
+
+	// Corrupted chunk
+	chunks[1][0]++
+	_, err = m2.Reassemble(chunks)
+	if err == nil {
+		t.Fatal("Should have raised an error (1)")
+	}
+	chunks[1][0]--
+
+	// Missing chunk
+	_, err = m2.Reassemble(chunks[:3])
+	if err == nil {
+		t.Fatal("Should have raised an error (2)")
+	}
+}
+
+func TestManifestBad(t *testing.T) {
+	manifestIndex := crypto.Hasher([]byte("object"))
+	_, _, err := SplitObject(manifestIndex[:10], []byte("data"), 1, testMaxBytes)
+	if err == nil {
+		t.Fatal("Should have raised an error (1)")
+	}
+	_, _, err = SplitObject(manifestIndex, nil, 1, testMaxBytes)
+	if err == nil {
+		t.Fatal("Should have raised an error (2)")
+	}
+	_, _, err = SplitObject(manifestIndex, []byte("data"), 0, testMaxBytes)
+	if err == nil {
+		t.Fatal("Should have raised an error (3)")
+	}
+	// Chunks must fit in a block
+	_, _, err = SplitObject(manifestIndex, []byte("data"), 3, 2)
+	if err == nil {
+		t.Fatal("Should have raised an error (3a)")
+	}
+	// Manifest must fit in a block
+	_, _, err = SplitObject(manifestIndex, make([]byte, 4096), 1, 4096)
+	if err == nil {
+		t.Fatal("Should have raised an error (3b)")
+	}
+
+	m := &Manifest{}
+	err = m.UnmarshalBinary([]byte("data"))
+	if err == nil {
+		t.Fatal("Should have raised an error (4)")
+	}
+	if IsManifest([]byte("data")) {
+		t.Fatal("Should not be a manifest")
+	}
+
+	m, _, err = SplitObject(manifestIndex, []byte("data"), 1, testMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mBytes, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Truncated chunk list
+	err = m.UnmarshalBinary(mBytes[:len(mBytes)-1])
+	if err == nil {
+		t.Fatal("Should have raised an error (5)")
+	}
+	// Unknown version
+	mBytes[len(manifestMagic)]++
+	err = m.UnmarshalBinary(mBytes)
+	if err == nil {
+		t.Fatal("Should have raised an error (6)")
+	}
+}
+
+func TestManifestReadObject(t *testing.T) {
+	manifestIndex := crypto.Hasher([]byte("object"))
+	data := crypto.Hasher([]byte("a"), []byte("b"), []byte("c"))
+	m, chunks, err := SplitObject(manifestIndex, data, 5, testMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mBytes, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := make(map[string][]byte)
+	for i, c := range m.Chunks {
+		store[string(c.Index)] = chunks[i]
+	}
+	getData := func(index []byte) ([]byte, error) {
+		return store[string(index)], nil
+	}
+	out, err := ReadObject(mBytes, 0, getData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Fatal("object does not match")
+	}
+	_, err = ReadObject(mBytes, uint64(len(data)-1), getData)
+	if err == nil {
+		t.Fatal("Should have raised an error (too large)")
+	}
+	delete(store, string(m.Chunks[2].Index))
+	_, err = ReadObject(mBytes, 0, getData)
+	if err == nil {
+		t.Fatal("Should have raised an error")
+	}
+}
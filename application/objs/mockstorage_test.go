@@ -38,6 +38,7 @@ type mockStorageGetter struct {
 	atomicSwapFee     *big.Int
 	minTxFee          *big.Int
 	maxTxVectorLength int
+	features          map[dynamics.Feature]uint32
 }
 
 func (msg *mockStorageGetter) GetMaxBytes() uint32 {
@@ -84,6 +85,10 @@ func (msg *mockStorageGetter) LoadStorage(txn *badger.Txn, epoch uint32) error {
 	return nil
 }
 
+func (msg *mockStorageGetter) LoadMinTxFee(epoch uint32, usage dynamics.EpochUsageFunc) error {
+	return nil
+}
+
 func (msg *mockStorageGetter) GetDataStoreEpochFee() *big.Int {
 	return msg.dataStoreEpochFee
 }
@@ -129,8 +134,8 @@ func (msg *mockStorageGetter) GetAtomicSwapValidStopEpoch() uint32 {
 	return 0
 }
 
-func (msg *mockStorageGetter) GetMinTxFee() *big.Int {
-	return msg.minTxFee
+func (msg *mockStorageGetter) GetMinTxFee() (*big.Int, error) {
+	return msg.minTxFee, nil
 }
 
 func (msg *mockStorageGetter) SetMinTxFee(value *big.Int) {
@@ -143,3 +148,19 @@ func (msg *mockStorageGetter) SetMinTxFee(value *big.Int) {
 func (msg *mockStorageGetter) GetTxValidVersion() uint32 {
 	return 0
 }
+
+func (msg *mockStorageGetter) IsActive(feature dynamics.Feature, epoch uint32) bool {
+	activation, ok := msg.features[feature]
+	return ok && epoch >= activation
+}
+
+func (msg *mockStorageGetter) SetActivation(feature dynamics.Feature, epoch uint32) {
+	if msg.features == nil {
+		msg.features = make(map[dynamics.Feature]uint32)
+	}
+	msg.features[feature] = epoch
+}
+
+func (msg *mockStorageGetter) GetFeatures() []*dynamics.FeatureInfo {
+	return nil
+}
@@ -373,6 +373,15 @@ func (b *Tx) ValidateSignature(currentHeight uint32, refUTXOs Vout) error {
 	return refUTXOs.ValidateSignature(currentHeight, b.Vin)
 }
 
+// ValidateUnlocked validates that none of the consumed objects are
+// timelocked at currentHeight
+func (b *Tx) ValidateUnlocked(currentHeight uint32, refUTXOs Vout) error {
+	if b == nil || len(b.Vin) == 0 {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	return refUTXOs.ValidateUnlocked(currentHeight)
+}
+
 // ValidatePreSignature validates the presignatures of the objects
 func (b *Tx) ValidatePreSignature() error {
 	if b == nil || len(b.Vout) == 0 {
@@ -382,7 +391,8 @@ func (b *Tx) ValidatePreSignature() error {
 }
 
 // ValidateFees validates the fees of the object.
-// currentHeight and refUTXOs are needed to verify if we have a cleanup tx.
+// currentHeight and refUTXOs are needed to verify if we have a cleanup tx
+// and to determine which DataStores renew a consumed DataStore.
 func (b *Tx) ValidateFees(currentHeight uint32, refUTXOs Vout, storage *wrapper.Storage) error {
 	if b == nil || len(b.Vout) == 0 || len(b.Vin) == 0 {
 		return errorz.ErrInvalid{}.New("not initialized")
@@ -391,7 +401,11 @@ func (b *Tx) ValidateFees(currentHeight uint32, refUTXOs Vout, storage *wrapper.
 		// Tx is a valid Cleanup Tx, so we do not worry about fees
 		return nil
 	}
-	if err := b.Vout.ValidateFees(storage); err != nil {
+	renewals, err := b.Vout.Renewals(currentHeight, refUTXOs, storage)
+	if err != nil {
+		return err
+	}
+	if err := b.Vout.ValidateFees(storage, renewals); err != nil {
 		return err
 	}
 	if err := b.Vout.ValidateTxFee(storage); err != nil {
@@ -547,6 +561,12 @@ func (b *Tx) Validate(set map[string]bool, currentHeight uint32, consumedUTXOs V
 	if err := b.Vout.ValidateTxOutIdx(); err != nil {
 		return nil, err
 	}
+	if err := b.Vout.ValidateWithdrawals(currentHeight, storage); err != nil {
+		return nil, err
+	}
+	if err := b.Vout.ValidateTimelocks(currentHeight, storage); err != nil {
+		return nil, err
+	}
 	set, err := b.ValidateDataStoreIndexes(set)
 	if err != nil {
 		return nil, err
@@ -559,6 +579,10 @@ func (b *Tx) Validate(set map[string]bool, currentHeight uint32, consumedUTXOs V
 	if err != nil {
 		return nil, err
 	}
+	err = b.ValidateUnlocked(currentHeight, consumedUTXOs)
+	if err != nil {
+		return nil, err
+	}
 	err = b.ValidateTxHash()
 	if err != nil {
 		return nil, err
@@ -603,6 +627,10 @@ func (b *Tx) PostValidatePending(currentHeight uint32, consumedUTXOs Vout) error
 	if err != nil {
 		return err
 	}
+	err = b.ValidateUnlocked(currentHeight, consumedUTXOs)
+	if err != nil {
+		return err
+	}
 	err = b.ValidateSignature(currentHeight, consumedUTXOs)
 	if err != nil {
 		return err
@@ -1732,3 +1732,51 @@ func TestTxValidateEqualVinVoutBad3(t *testing.T) {
 		t.Fatal("Should have raised error")
 	}
 }
+
+func TestTxValidateFeesRenewal(t *testing.T) {
+	ownerSigner := &crypto.Secp256k1Signer{}
+	if err := ownerSigner.SetPrivk(crypto.Hasher([]byte("a"))); err != nil {
+		t.Fatal(err)
+	}
+	rawData := crypto.Hasher([]byte("rawdata"))
+	index := crypto.Hasher([]byte("Index"))
+	zero := new(uint256.Uint256).SetZero()
+	currentHeight := 2*constants.EpochLength + 1
+
+	msg := makeMockStorageGetter()
+	perEpochFee32 := uint32(3)
+	msg.SetDataStoreEpochFee(big.NewInt(int64(perEpochFee32)))
+	storage := makeStorage(msg)
+
+	// Consumed datastore expires in epoch 7; renewal expires in epoch 14
+	consumed := makeDSWithValueFee(t, ownerSigner, 1, rawData, index, 1, 5, zero)
+	txin, err := consumed.MakeTxIn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee, err := new(uint256.Uint256).FromUint64(uint64(perEpochFee32 * 7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	renewal := makeDSWithValueFee(t, ownerSigner, 2, rawData, index, 3, 10, fee)
+
+	tx := &Tx{}
+	tx.Vin = []*TXIn{txin}
+	tx.Vout = []*TXOut{renewal}
+	// the full fee is required before renewals are active
+	err = tx.ValidateFees(currentHeight, Vout{consumed}, storage)
+	if err == nil {
+		t.Fatal("Should have raised error")
+	}
+	msg.SetActivation(FeatureRenewal, 1)
+	err = tx.ValidateFees(currentHeight, Vout{consumed}, storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Without the consumed datastore the full fee is required
+	err = tx.ValidateFees(currentHeight, nil, storage)
+	if err == nil {
+		t.Fatal("Should have raised error")
+	}
+}
@@ -266,6 +266,28 @@ func (txv TxVec) PreValidatePending(chainID uint32) error {
 	return nil
 }
 
+// ValidateWithdrawals returns an error if a tx in TxVec generates a
+// Withdrawal before FeatureWithdrawal is active
+func (txv TxVec) ValidateWithdrawals(currentHeight uint32, storage *wrapper.Storage) error {
+	for i := 0; i < len(txv); i++ {
+		if err := txv[i].Vout.ValidateWithdrawals(currentHeight, storage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateTimelocks returns an error if a tx in TxVec generates a
+// timelocked ValueStore before FeatureTimelock is active
+func (txv TxVec) ValidateTimelocks(currentHeight uint32, storage *wrapper.Storage) error {
+	for i := 0; i < len(txv); i++ {
+		if err := txv[i].Vout.ValidateTimelocks(currentHeight, storage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // PostValidatePending ...
 func (txv TxVec) PostValidatePending(currentHeight uint32, consumedUTXOs Vout) error {
 	for i := 0; i < len(txv); i++ {
@@ -88,6 +88,17 @@ func extractHash(owner []byte) ([]byte, []byte, error) {
 	return owner[0:constants.HashLen], utils.CopySlice(owner[constants.HashLen:]), nil
 }
 
+func extractLockEpoch(owner []byte) (uint32, []byte, error) {
+	if len(owner) < 4 {
+		return 0, nil, errorz.ErrInvalid{}.New("extractLockEpoch: Invalid LockEpoch")
+	}
+	lockEpoch, err := utils.UnmarshalUint32(owner[0:4])
+	if err != nil {
+		return 0, nil, err
+	}
+	return lockEpoch, utils.CopySlice(owner[4:]), nil
+}
+
 func extractZero(owner []byte) error {
 	if len(owner) != 0 {
 		return errorz.ErrInvalid{}.New("bytes remaining at end")
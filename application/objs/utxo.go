@@ -17,11 +17,13 @@ type TXOut struct {
 	valueStore *ValueStore
 	atomicSwap *AtomicSwap
 	txFee      *TxFee
+	withdrawal *Withdrawal
 	// not part of serialized object below this line
 	hasDataStore  bool
 	hasValueStore bool
 	hasAtomicSwap bool
 	hasTxFee      bool
+	hasWithdrawal bool
 }
 
 // CreateValueStore makes a new ValueStore
@@ -50,10 +52,12 @@ func (b *TXOut) NewDataStore(v *DataStore) error {
 	b.hasValueStore = false
 	b.hasAtomicSwap = false
 	b.hasTxFee = false
+	b.hasWithdrawal = false
 	b.dataStore = v
 	b.atomicSwap = nil
 	b.valueStore = nil
 	b.txFee = nil
+	b.withdrawal = nil
 	return nil
 }
 
@@ -63,10 +67,12 @@ func (b *TXOut) NewValueStore(v *ValueStore) error {
 	b.hasValueStore = true
 	b.hasAtomicSwap = false
 	b.hasTxFee = false
+	b.hasWithdrawal = false
 	b.dataStore = nil
 	b.valueStore = v
 	b.atomicSwap = nil
 	b.txFee = nil
+	b.withdrawal = nil
 	return nil
 }
 
@@ -76,10 +82,12 @@ func (b *TXOut) NewAtomicSwap(v *AtomicSwap) error {
 	b.hasValueStore = false
 	b.hasAtomicSwap = true
 	b.hasTxFee = false
+	b.hasWithdrawal = false
 	b.dataStore = nil
 	b.valueStore = nil
 	b.atomicSwap = v
 	b.txFee = nil
+	b.withdrawal = nil
 	return nil
 }
 
@@ -89,10 +97,38 @@ func (b *TXOut) NewTxFee(v *TxFee) error {
 	b.hasValueStore = false
 	b.hasAtomicSwap = false
 	b.hasTxFee = true
+	b.hasWithdrawal = false
 	b.dataStore = nil
 	b.valueStore = nil
 	b.atomicSwap = nil
 	b.txFee = v
+	b.withdrawal = nil
+	return nil
+}
+
+// CreateWithdrawal makes a new Withdrawal of value to the Ethereum address
+// acct
+func (b *TXOut) CreateWithdrawal(chainID uint32, value *uint256.Uint256, fee *uint256.Uint256, acct []byte, txHash []byte) error {
+	wd := &Withdrawal{}
+	err := wd.New(chainID, value, fee, acct, txHash)
+	if err != nil {
+		return err
+	}
+	return b.NewWithdrawal(wd)
+}
+
+// NewWithdrawal makes a TXOut object which with the specified Withdrawal
+func (b *TXOut) NewWithdrawal(v *Withdrawal) error {
+	b.hasDataStore = false
+	b.hasValueStore = false
+	b.hasAtomicSwap = false
+	b.hasTxFee = false
+	b.hasWithdrawal = true
+	b.dataStore = nil
+	b.valueStore = nil
+	b.atomicSwap = nil
+	b.txFee = nil
+	b.withdrawal = v
 	return nil
 }
 
@@ -128,6 +164,14 @@ func (b *TXOut) HasTxFee() bool {
 	return b.hasTxFee
 }
 
+// HasWithdrawal specifies if the TXOut object has a Withdrawal
+func (b *TXOut) HasWithdrawal() bool {
+	if b == nil {
+		return false
+	}
+	return b.hasWithdrawal
+}
+
 // DataStore returns the DataStore of the TXOut object if it exists
 func (b *TXOut) DataStore() (*DataStore, error) {
 	if b.HasDataStore() {
@@ -160,6 +204,14 @@ func (b *TXOut) TxFee() (*TxFee, error) {
 	return nil, errorz.ErrInvalid{}.New("object does not have a TxFee")
 }
 
+// Withdrawal returns the Withdrawal of the TXOut object if it exists
+func (b *TXOut) Withdrawal() (*Withdrawal, error) {
+	if b.HasWithdrawal() {
+		return b.withdrawal, nil
+	}
+	return nil, errorz.ErrInvalid{}.New("object does not have a Withdrawal")
+}
+
 // UnmarshalBinary takes a byte slice and returns the corresponding
 // TXOut object
 func (b *TXOut) UnmarshalBinary(data []byte) error {
@@ -204,6 +256,8 @@ func (b *TXOut) UnmarshalCapn(bc mdefs.TXOut) error {
 		b.hasAtomicSwap = false
 		b.txFee = nil
 		b.hasTxFee = false
+		b.withdrawal = nil
+		b.hasWithdrawal = false
 	case bc.HasValueStore():
 		cObj, err := bc.ValueStore()
 		if err != nil {
@@ -222,6 +276,8 @@ func (b *TXOut) UnmarshalCapn(bc mdefs.TXOut) error {
 		b.hasAtomicSwap = false
 		b.txFee = nil
 		b.hasTxFee = false
+		b.withdrawal = nil
+		b.hasWithdrawal = false
 	case bc.HasAtomicSwap():
 		cObj, err := bc.AtomicSwap()
 		if err != nil {
@@ -240,6 +296,8 @@ func (b *TXOut) UnmarshalCapn(bc mdefs.TXOut) error {
 		b.hasAtomicSwap = true
 		b.txFee = nil
 		b.hasTxFee = false
+		b.withdrawal = nil
+		b.hasWithdrawal = false
 	case bc.HasTxFee():
 		cObj, err := bc.TxFee()
 		if err != nil {
@@ -258,6 +316,28 @@ func (b *TXOut) UnmarshalCapn(bc mdefs.TXOut) error {
 		b.hasAtomicSwap = false
 		b.txFee = obj
 		b.hasTxFee = true
+		b.withdrawal = nil
+		b.hasWithdrawal = false
+	case bc.HasWithdrawal():
+		cObj, err := bc.Withdrawal()
+		if err != nil {
+			return err
+		}
+		obj := &Withdrawal{}
+		err = obj.UnmarshalCapn(cObj)
+		if err != nil {
+			return err
+		}
+		b.dataStore = nil
+		b.hasDataStore = false
+		b.valueStore = nil
+		b.hasValueStore = false
+		b.atomicSwap = nil
+		b.hasAtomicSwap = false
+		b.txFee = nil
+		b.hasTxFee = false
+		b.withdrawal = obj
+		b.hasWithdrawal = true
 	default:
 		return errorz.ErrInvalid{}.New("TXOut type not defined in UnmarshalCapn")
 	}
@@ -321,6 +401,14 @@ func (b *TXOut) MarshalCapn(seg *capnp.Segment) (mdefs.TXOut, error) {
 		if err := bc.SetTxFee(tf); err != nil {
 			return bc, err
 		}
+	case b.hasWithdrawal:
+		wd, err := b.withdrawal.MarshalCapn(seg)
+		if err != nil {
+			return bc, err
+		}
+		if err := bc.SetWithdrawal(wd); err != nil {
+			return bc, err
+		}
 	default:
 		return mdefs.TXOut{}, errorz.ErrInvalid{}.New("TXOut type not defined in MarshalCapn")
 	}
@@ -342,6 +430,9 @@ func (b *TXOut) PreHash() ([]byte, error) {
 	case b.HasTxFee():
 		obj, _ := b.TxFee()
 		return obj.PreHash()
+	case b.HasWithdrawal():
+		obj, _ := b.Withdrawal()
+		return obj.PreHash()
 	default:
 		return nil, errorz.ErrInvalid{}.New("TXOut type not defined in PreHash")
 	}
@@ -362,6 +453,9 @@ func (b *TXOut) UTXOID() ([]byte, error) {
 	case b.HasTxFee():
 		obj, _ := b.TxFee()
 		return obj.UTXOID()
+	case b.HasWithdrawal():
+		obj, _ := b.Withdrawal()
+		return obj.UTXOID()
 	default:
 		return nil, errorz.ErrInvalid{}.New("TXOut type not defined in UTXOID")
 	}
@@ -382,6 +476,9 @@ func (b *TXOut) ChainID() (uint32, error) {
 	case b.HasTxFee():
 		obj, _ := b.TxFee()
 		return obj.ChainID()
+	case b.HasWithdrawal():
+		obj, _ := b.Withdrawal()
+		return obj.ChainID()
 	default:
 		return 0, errorz.ErrInvalid{}.New("TXOut type not defined for ChainID")
 	}
@@ -402,6 +499,9 @@ func (b *TXOut) TXOutIdx() (uint32, error) {
 	case b.HasTxFee():
 		obj, _ := b.TxFee()
 		return obj.TXOutIdx()
+	case b.HasWithdrawal():
+		obj, _ := b.Withdrawal()
+		return obj.TXOutIdx()
 	default:
 		return 0, errorz.ErrInvalid{}.New("TXOut type not defined in TXOutIdx")
 	}
@@ -422,6 +522,9 @@ func (b *TXOut) SetTXOutIdx(idx uint32) error {
 	case b.HasTxFee():
 		obj, _ := b.TxFee()
 		return obj.SetTXOutIdx(idx)
+	case b.HasWithdrawal():
+		obj, _ := b.Withdrawal()
+		return obj.SetTXOutIdx(idx)
 	default:
 		return errorz.ErrInvalid{}.New("TXOut type not defined in SetTXOutIdx")
 	}
@@ -455,6 +558,12 @@ func (b *TXOut) TxHash() ([]byte, error) {
 			return nil, errorz.ErrInvalid{}.New("not initialized")
 		}
 		return utils.CopySlice(obj.TxHash), nil
+	case b.HasWithdrawal():
+		obj, _ := b.Withdrawal()
+		if obj == nil || len(obj.TxHash) != constants.HashLen {
+			return nil, errorz.ErrInvalid{}.New("not initialized")
+		}
+		return utils.CopySlice(obj.TxHash), nil
 	default:
 		return nil, errorz.ErrInvalid{}.New("TXOut type not defined in TxHash")
 	}
@@ -475,6 +584,9 @@ func (b *TXOut) SetTxHash(txHash []byte) error {
 	case b.HasTxFee():
 		obj, _ := b.TxFee()
 		return obj.SetTxHash(utils.CopySlice(txHash))
+	case b.HasWithdrawal():
+		obj, _ := b.Withdrawal()
+		return obj.SetTxHash(utils.CopySlice(txHash))
 	default:
 		return errorz.ErrInvalid{}.New("TXOut type not defined in SetTxHash")
 	}
@@ -491,11 +603,40 @@ func (b *TXOut) IsExpired(currentHeight uint32) (bool, error) {
 	case b.HasAtomicSwap():
 		obj, _ := b.AtomicSwap()
 		return obj.IsExpired(currentHeight)
+	case b.HasWithdrawal():
+		return false, nil
 	default:
 		return false, errorz.ErrInvalid{}.New("TXOut type not defined in IsExpired")
 	}
 }
 
+// IsLocked returns true if the utxo has a timelock which prevents it from
+// being consumed at currentHeight
+func (b *TXOut) IsLocked(currentHeight uint32) (bool, error) {
+	switch {
+	case b.HasDataStore():
+		return false, nil
+	case b.HasValueStore():
+		obj, _ := b.ValueStore()
+		return obj.IsLocked(currentHeight)
+	case b.HasAtomicSwap():
+		return false, nil
+	case b.HasWithdrawal():
+		return false, nil
+	default:
+		return false, errorz.ErrInvalid{}.New("TXOut type not defined in IsLocked")
+	}
+}
+
+// HasTimelock returns true if the utxo is a ValueStore with a timelock
+func (b *TXOut) HasTimelock() bool {
+	if !b.HasValueStore() {
+		return false
+	}
+	obj, _ := b.ValueStore()
+	return obj.HasTimelock()
+}
+
 // RemainingValue returns the remaining value after discount
 func (b *TXOut) RemainingValue(currentHeight uint32) (*uint256.Uint256, error) {
 	switch {
@@ -508,6 +649,8 @@ func (b *TXOut) RemainingValue(currentHeight uint32) (*uint256.Uint256, error) {
 	case b.HasAtomicSwap():
 		obj, _ := b.AtomicSwap()
 		return obj.Value()
+	case b.HasWithdrawal():
+		return nil, errorz.ErrInvalid{}.New("a withdrawal can not be consumed")
 	default:
 		return nil, errorz.ErrInvalid{}.New("TXOut type not defined in RemainingValue")
 	}
@@ -525,6 +668,8 @@ func (b *TXOut) MakeTxIn() (*TXIn, error) {
 	case b.HasAtomicSwap():
 		obj, _ := b.AtomicSwap()
 		return obj.MakeTxIn()
+	case b.HasWithdrawal():
+		return nil, errorz.ErrInvalid{}.New("a withdrawal can not be consumed")
 	default:
 		return nil, errorz.ErrInvalid{}.New("TXOut type not defined in MakeTxIn")
 	}
@@ -542,6 +687,9 @@ func (b *TXOut) Value() (*uint256.Uint256, error) {
 	case b.HasAtomicSwap():
 		obj, _ := b.AtomicSwap()
 		return obj.Value()
+	case b.HasWithdrawal():
+		obj, _ := b.Withdrawal()
+		return obj.Value()
 	default:
 		return nil, errorz.ErrInvalid{}.New("TXOut type not defined in Value")
 	}
@@ -564,6 +712,9 @@ func (b *TXOut) ValuePlusFee() (*uint256.Uint256, error) {
 		// the total value out of the Tx; this must include TxFee.
 		obj, _ := b.TxFee()
 		return obj.Fee()
+	case b.HasWithdrawal():
+		obj, _ := b.Withdrawal()
+		return obj.ValuePlusFee()
 	default:
 		return nil, errorz.ErrInvalid{}.New("TXOut type not defined in ValuePlusFee")
 	}
@@ -586,6 +737,9 @@ func (b *TXOut) ValidateFee(storage *wrapper.Storage) error {
 		// we must look at the *entire* Tx object to ensure at most one TxFee
 		// UTXO is present
 		return nil
+	case b.HasWithdrawal():
+		obj, _ := b.Withdrawal()
+		return obj.ValidateFee(storage)
 	default:
 		return errorz.ErrInvalid{}.New("TXOut type not defined in ValidateFee")
 	}
@@ -603,6 +757,8 @@ func (b *TXOut) ValidatePreSignature() error {
 		return nil
 	case b.HasTxFee():
 		return nil
+	case b.HasWithdrawal():
+		return nil
 	default:
 		return errorz.ErrInvalid{}.New("TXOut type not defined in ValidatePreSignature")
 	}
@@ -616,12 +772,14 @@ func (b *TXOut) ValidateSignature(currentHeight uint32, txIn *TXIn) error {
 		return obj.ValidateSignature(currentHeight, txIn)
 	case b.HasValueStore():
 		obj, _ := b.ValueStore()
-		return obj.ValidateSignature(txIn)
+		return obj.ValidateSignature(currentHeight, txIn)
 	case b.HasAtomicSwap():
 		obj, _ := b.AtomicSwap()
 		return obj.ValidateSignature(currentHeight, txIn)
 	case b.HasTxFee():
 		return nil
+	case b.HasWithdrawal():
+		return errorz.ErrInvalid{}.New("a withdrawal can not be consumed")
 	default:
 		return errorz.ErrInvalid{}.New("TXOut type not defined in ValidateSignature")
 	}
@@ -648,6 +806,8 @@ func (b *TXOut) MustBeMinedBeforeHeight() (uint32, error) {
 		return (iat * constants.EpochLength) - 1, nil
 	case b.HasTxFee():
 		return constants.MaxUint32, nil
+	case b.HasWithdrawal():
+		return constants.MaxUint32, nil
 	default:
 		return 0, errorz.ErrInvalid{}.New("TXOut type not defined in MustBeMinedBeforeHeight")
 	}
@@ -674,6 +834,8 @@ func (b *TXOut) CannotBeMinedBeforeHeight() (uint32, error) {
 		return (iat-1)*constants.EpochLength + 1, nil
 	case b.HasTxFee():
 		return 1, nil
+	case b.HasWithdrawal():
+		return 1, nil
 	default:
 		return 0, errorz.ErrInvalid{}.New("TXOut type not defined in MustBeMinedBeforeHeight")
 	}
@@ -707,6 +869,9 @@ func (b *TXOut) Account() ([]byte, error) {
 			return nil, err
 		}
 		return utils.CopySlice(asoPrimaryAcct), nil
+	case b.HasWithdrawal():
+		obj, _ := b.Withdrawal()
+		return obj.Account()
 	default:
 		return nil, errorz.ErrInvalid{}.New("TXOut type not defined in Account")
 	}
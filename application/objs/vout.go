@@ -4,6 +4,7 @@ import (
 	"github.com/MadBase/MadNet/application/objs/uint256"
 	"github.com/MadBase/MadNet/application/wrapper"
 	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
 )
 
 // Vout is a vector of TXOut objects
@@ -88,6 +89,13 @@ func (vout Vout) ValidateTxOutIdx() error {
 				return err
 			}
 			txOutIdx = tfTxOutIdx
+		case utxo.HasWithdrawal():
+			wd, _ := utxo.Withdrawal()
+			wdTxOutIdx, err := wd.TXOutIdx()
+			if err != nil {
+				return err
+			}
+			txOutIdx = wdTxOutIdx
 		default:
 			return errorz.ErrInvalid{}.New("bad txOutIdx: Invalid Type")
 		}
@@ -130,9 +138,21 @@ func (vout Vout) PreHash() ([][]byte, error) {
 	return phs, nil
 }
 
-// ValidateFees validates the Fee from each TXOut in Vout
-func (vout Vout) ValidateFees(storage *wrapper.Storage) error {
+// ValidateFees validates the Fee from each TXOut in Vout.
+// renewals maps the index of a DataStore in Vout to the consumed DataStore
+// whose lease it extends; these are charged only for the added epochs.
+func (vout Vout) ValidateFees(storage *wrapper.Storage, renewals map[int]*DataStore) error {
 	for i := 0; i < len(vout); i++ {
+		if consumed, ok := renewals[i]; ok {
+			ds, err := vout[i].DataStore()
+			if err != nil {
+				return err
+			}
+			if err := ds.ValidateRenewalFee(storage, consumed); err != nil {
+				return err
+			}
+			continue
+		}
 		err := vout[i].ValidateFee(storage)
 		if err != nil {
 			return err
@@ -141,10 +161,80 @@ func (vout Vout) ValidateFees(storage *wrapper.Storage) error {
 	return nil
 }
 
+// ValidateWithdrawals returns an error if vout contains a Withdrawal before
+// FeatureWithdrawal is active at the epoch of currentHeight
+func (vout Vout) ValidateWithdrawals(currentHeight uint32, storage *wrapper.Storage) error {
+	if storage.IsActive(FeatureWithdrawal, utils.Epoch(currentHeight)) {
+		return nil
+	}
+	for i := 0; i < len(vout); i++ {
+		if vout[i].HasWithdrawal() {
+			return errorz.ErrInvalid{}.New("withdrawals are not active")
+		}
+	}
+	return nil
+}
+
+// ValidateTimelocks returns an error if vout contains a timelocked
+// ValueStore before FeatureTimelock is active at the epoch of currentHeight
+func (vout Vout) ValidateTimelocks(currentHeight uint32, storage *wrapper.Storage) error {
+	if storage.IsActive(FeatureTimelock, utils.Epoch(currentHeight)) {
+		return nil
+	}
+	for i := 0; i < len(vout); i++ {
+		if vout[i].HasTimelock() {
+			return errorz.ErrInvalid{}.New("timelocks are not active")
+		}
+	}
+	return nil
+}
+
+// Renewals returns the DataStores in Vout which renew a DataStore in
+// refUTXOs; the result maps the index in Vout to the consumed DataStore.
+// Each consumed DataStore may be renewed at most once. No renewals are
+// returned before FeatureRenewal is active at the epoch of currentHeight.
+func (vout Vout) Renewals(currentHeight uint32, refUTXOs Vout, storage *wrapper.Storage) (map[int]*DataStore, error) {
+	renewals := make(map[int]*DataStore)
+	used := make(map[int]bool)
+	for i := 0; i < len(vout); i++ {
+		if !vout[i].HasDataStore() {
+			continue
+		}
+		ds, err := vout[i].DataStore()
+		if err != nil {
+			return nil, err
+		}
+		for j := 0; j < len(refUTXOs); j++ {
+			if used[j] || !refUTXOs[j].HasDataStore() {
+				continue
+			}
+			consumed, err := refUTXOs[j].DataStore()
+			if err != nil {
+				return nil, err
+			}
+			ok, err := ds.IsRenewalOf(currentHeight, consumed)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				renewals[i] = consumed
+				used[j] = true
+				break
+			}
+		}
+	}
+	if len(renewals) > 0 && !storage.IsActive(FeatureRenewal, utils.Epoch(currentHeight)) {
+		return make(map[int]*DataStore), nil
+	}
+	return renewals, nil
+}
+
 // ValidateTxFee validates the transaction fee in Vout
 //
 // There can be at most one TxFee UTXO object in Vout.
 // There can be zero TxFee UTXO objects if MinTxFee is zero.
+// MinTxFee is the minimum of the current epoch, which follows the fullness
+// of recent blocks when the dynamic minimum fee is enabled.
 func (vout Vout) ValidateTxFee(storage *wrapper.Storage) error {
 	maxNumTxFees := 1
 	minTxFee, err := storage.GetMinTxFee()
@@ -201,6 +291,21 @@ func (vout Vout) ValidateSignature(currentHeight uint32, txIn []*TXIn) error {
 	return nil
 }
 
+// ValidateUnlocked ensures no TXOut in Vout has a timelock which prevents
+// consumption at currentHeight
+func (vout Vout) ValidateUnlocked(currentHeight uint32) error {
+	for i := 0; i < len(vout); i++ {
+		locked, err := vout[i].IsLocked(currentHeight)
+		if err != nil {
+			return err
+		}
+		if locked {
+			return errorz.ErrInvalid{}.New("invalid Vout: consumed utxo is timelocked")
+		}
+	}
+	return nil
+}
+
 // MakeTxIn converts Vout to Vin
 func (vout Vout) MakeTxIn() (Vin, error) {
 	txIns := Vin{}
@@ -6,11 +6,16 @@ import (
 	"github.com/MadBase/MadNet/application/objs/valuestore"
 	"github.com/MadBase/MadNet/application/wrapper"
 	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/dynamics"
 	"github.com/MadBase/MadNet/errorz"
 	"github.com/MadBase/MadNet/utils"
 	capnp "zombiezen.com/go/capnproto2"
 )
 
+// FeatureTimelock activates timelocked ValueStore owners. Until governance
+// schedules it, a tx which generates a timelocked ValueStore is invalid.
+var FeatureTimelock = dynamics.RegisterFeature("timelock", "ValueStores which may not be consumed before a lock epoch")
+
 // ValueStore stores value in a UTXO
 type ValueStore struct {
 	VSPreImage *VSPreImage
@@ -32,6 +37,27 @@ func (b *ValueStore) New(chainID uint32, value *uint256.Uint256, fee *uint256.Ui
 	}
 	vsowner := &ValueStoreOwner{}
 	vsowner.New(acct, curveSpec)
+	return b.newFromOwner(chainID, value, fee, vsowner, txHash)
+}
+
+// NewTimelock creates a new ValueStore which may not be consumed before
+// lockEpoch
+func (b *ValueStore) NewTimelock(chainID uint32, value *uint256.Uint256, fee *uint256.Uint256, acct []byte, curveSpec constants.CurveSpec, lockEpoch uint32, txHash []byte) error {
+	if b == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	if value == nil || value.IsZero() {
+		return errorz.ErrInvalid{}.New("invalue value: nil or zero")
+	}
+	if fee == nil {
+		return errorz.ErrInvalid{}.New("invalue fee: nil")
+	}
+	vsowner := &ValueStoreOwner{}
+	vsowner.NewTimelock(acct, curveSpec, lockEpoch)
+	return b.newFromOwner(chainID, value, fee, vsowner, txHash)
+}
+
+func (b *ValueStore) newFromOwner(chainID uint32, value *uint256.Uint256, fee *uint256.Uint256, vsowner *ValueStoreOwner, txHash []byte) error {
 	if err := vsowner.Validate(); err != nil {
 		return err
 	}
@@ -262,6 +288,35 @@ func (b *ValueStore) Owner() (*ValueStoreOwner, error) {
 	return b.VSPreImage.Owner, nil
 }
 
+// IsLocked returns true if the ValueStore has a timelock which prevents
+// it from being consumed at currentHeight
+func (b *ValueStore) IsLocked(currentHeight uint32) (bool, error) {
+	owner, err := b.Owner()
+	if err != nil {
+		return false, err
+	}
+	return owner.IsLocked(currentHeight), nil
+}
+
+// HasTimelock returns true if the owner of the ValueStore has a timelock
+func (b *ValueStore) HasTimelock() bool {
+	owner, err := b.Owner()
+	if err != nil {
+		return false
+	}
+	return owner.SVA == TimelockSVA
+}
+
+// LockEpoch returns the epoch before which the ValueStore may not be
+// consumed; zero is returned if the ValueStore has no timelock
+func (b *ValueStore) LockEpoch() (uint32, error) {
+	owner, err := b.Owner()
+	if err != nil {
+		return 0, err
+	}
+	return owner.LockEpoch, nil
+}
+
 // GenericOwner returns the Owner of the ValueStore
 func (b *ValueStore) GenericOwner() (*Owner, error) {
 	vso, err := b.Owner()
@@ -321,10 +376,17 @@ func (b *ValueStore) ValidateFee(storage *wrapper.Storage) error {
 
 // ValidateSignature validates the signature of the ValueStore at the time of
 // consumption
-func (b *ValueStore) ValidateSignature(txIn *TXIn) error {
+func (b *ValueStore) ValidateSignature(currentHeight uint32, txIn *TXIn) error {
 	if b == nil {
 		return errorz.ErrInvalid{}.New("not initialized")
 	}
+	locked, err := b.IsLocked(currentHeight)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return errorz.ErrInvalid{}.New("vs: timelocked; cannot be consumed before lock epoch")
+	}
 	msg, err := txIn.TXInLinker.MarshalBinary()
 	if err != nil {
 		return err
@@ -788,12 +788,12 @@ func TestValueStoreSign(t *testing.T) {
 func TestValueStoreValidateSignature(t *testing.T) {
 	txIn := &TXIn{}
 	utxo := &TXOut{}
-	err := utxo.valueStore.ValidateSignature(txIn)
+	err := utxo.valueStore.ValidateSignature(1, txIn)
 	if err == nil {
 		t.Fatal("Should raise an error (1)")
 	}
 	vs := &ValueStore{}
-	err = vs.ValidateSignature(txIn)
+	err = vs.ValidateSignature(1, txIn)
 	if err == nil {
 		t.Fatal("Should raise an error (2)")
 	}
@@ -813,7 +813,7 @@ func TestValueStoreValidateSignature(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = vs.ValidateSignature(txIn)
+	err = vs.ValidateSignature(1, txIn)
 	if err == nil {
 		t.Fatal("Should raise an error (3)")
 	}
@@ -844,12 +844,118 @@ func TestValueStoreValidateSignature(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = vs.ValidateSignature(txIn)
+	err = vs.ValidateSignature(1, txIn)
 	if err != nil {
 		t.Fatal(err)
 	}
 }
 
+func TestValueStoreTimelock(t *testing.T) {
+	signer := &crypto.Secp256k1Signer{}
+	privk := make([]byte, 32)
+	privk[0] = 1
+	privk[31] = 1
+	if err := signer.SetPrivk(privk); err != nil {
+		t.Fatal(err)
+	}
+	pk, err := signer.Pubkey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	acct := crypto.GetAccount(pk)
+	value, err := new(uint256.Uint256).FromUint64(65537)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lockEpoch := uint32(3)
+	vs := &ValueStore{}
+	err = vs.NewTimelock(1, value, uint256.Zero(), acct, constants.CurveSecp256k1, 0, make([]byte, constants.HashLen))
+	if err == nil {
+		t.Fatal("Should raise an error (1)")
+	}
+	err = vs.NewTimelock(1, value, uint256.Zero(), acct, constants.CurveSecp256k1, lockEpoch, make([]byte, constants.HashLen))
+	if err != nil {
+		t.Fatal(err)
+	}
+	vsBytes, err := vs.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vs2 := &ValueStore{}
+	if err := vs2.UnmarshalBinary(vsBytes); err != nil {
+		t.Fatal(err)
+	}
+	vsEqual(t, vs, vs2)
+	le, err := vs2.LockEpoch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if le != lockEpoch {
+		t.Fatal("lock epoch mismatch")
+	}
+
+	txIn, err := vs.MakeTxIn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	txIn.TXInLinker.TxHash = crypto.Hasher([]byte("hsh"))
+	if err := vs.Sign(txIn, signer); err != nil {
+		t.Fatal(err)
+	}
+	lastLockedHeight := (lockEpoch - 1) * constants.EpochLength
+	locked, err := vs.IsLocked(lastLockedHeight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !locked {
+		t.Fatal("Should be locked")
+	}
+	err = vs.ValidateSignature(lastLockedHeight, txIn)
+	if err == nil {
+		t.Fatal("Should raise an error (2)")
+	}
+	locked, err = vs.IsLocked(lastLockedHeight + 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if locked {
+		t.Fatal("Should not be locked")
+	}
+	err = vs.ValidateSignature(lastLockedHeight+1, txIn)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVoutValidateTimelocks(t *testing.T) {
+	acct := crypto.Hasher([]byte("account"))[:constants.OwnerLen]
+	vs := &ValueStore{}
+	err := vs.NewTimelock(1, uint256.One(), uint256.Zero(), acct, constants.CurveSecp256k1, 3, make([]byte, constants.HashLen))
+	if err != nil {
+		t.Fatal(err)
+	}
+	utxo := &TXOut{}
+	if err := utxo.NewValueStore(vs); err != nil {
+		t.Fatal(err)
+	}
+	if !utxo.HasTimelock() {
+		t.Fatal("Should have a timelock")
+	}
+	vout := Vout{utxo}
+	msg := makeMockStorageGetter()
+	storage := makeStorage(msg)
+	if err := vout.ValidateTimelocks(1, storage); err == nil {
+		t.Fatal("Should raise error; timelocks are not active!")
+	}
+	msg.SetActivation(FeatureTimelock, 2)
+	if err := vout.ValidateTimelocks(constants.EpochLength, storage); err == nil {
+		t.Fatal("Should raise error; timelocks are not active before epoch 2!")
+	}
+	if err := vout.ValidateTimelocks(constants.EpochLength+1, storage); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestValueStoreMakeTxIn(t *testing.T) {
 	utxo := &TXOut{}
 	_, err := utxo.valueStore.MakeTxIn()
@@ -983,7 +1089,7 @@ func TestValueStoreOwnerSig(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		err = vs.ValidateSignature(txIn)
+		err = vs.ValidateSignature(1, txIn)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1000,7 +1106,7 @@ func TestValueStoreOwnerSig(t *testing.T) {
 			t.Fatal(err)
 		}
 		txIn.TXInLinker.TxHash = crypto.Hasher([]byte("hshs"))
-		err = vs.ValidateSignature(txIn)
+		err = vs.ValidateSignature(1, txIn)
 		if err == nil {
 			t.Fatal("Should raise an error")
 		}
@@ -1061,7 +1167,7 @@ func TestValueStoreOwnerSig2(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		err = vs.ValidateSignature(txIn)
+		err = vs.ValidateSignature(1, txIn)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1078,7 +1184,7 @@ func TestValueStoreOwnerSig2(t *testing.T) {
 			t.Fatal(err)
 		}
 		txIn.TXInLinker.TxHash = crypto.Hasher([]byte("hshs"))
-		err = vs.ValidateSignature(txIn)
+		err = vs.ValidateSignature(1, txIn)
 		if err == nil {
 			t.Fatal("Should raise an error")
 		}
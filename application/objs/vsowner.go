@@ -15,6 +15,9 @@ type ValueStoreOwner struct {
 	SVA       SVA
 	CurveSpec constants.CurveSpec
 	Account   []byte
+	// LockEpoch is only set when SVA is TimelockSVA; the ValueStore
+	// may not be consumed before this epoch
+	LockEpoch uint32
 }
 
 // New makes a new ValueStoreOwner
@@ -22,6 +25,16 @@ func (vso *ValueStoreOwner) New(acct []byte, curveSpec constants.CurveSpec) {
 	vso.SVA = ValueStoreSVA
 	vso.CurveSpec = curveSpec
 	vso.Account = utils.CopySlice(acct)
+	vso.LockEpoch = 0
+}
+
+// NewTimelock makes a new ValueStoreOwner which may not be consumed
+// before lockEpoch
+func (vso *ValueStoreOwner) NewTimelock(acct []byte, curveSpec constants.CurveSpec, lockEpoch uint32) {
+	vso.SVA = TimelockSVA
+	vso.CurveSpec = curveSpec
+	vso.Account = utils.CopySlice(acct)
+	vso.LockEpoch = lockEpoch
 }
 
 // NewFromOwner takes an Owner object and creates the corresponding
@@ -53,6 +66,9 @@ func (vso *ValueStoreOwner) MarshalBinary() ([]byte, error) {
 	owner = append(owner, []byte{uint8(vso.SVA)}...)
 	owner = append(owner, []byte{uint8(vso.CurveSpec)}...)
 	owner = append(owner, utils.CopySlice(vso.Account)...)
+	if vso.SVA == TimelockSVA {
+		owner = append(owner, utils.MarshalUint32(vso.LockEpoch)...)
+	}
 	return owner, nil
 }
 
@@ -70,6 +86,9 @@ func (vso *ValueStoreOwner) Validate() error {
 	if err := vso.validateAccount(); err != nil {
 		return err
 	}
+	if err := vso.validateLockEpoch(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -92,12 +111,20 @@ func (vso *ValueStoreOwner) UnmarshalBinary(o []byte) error {
 	if err != nil {
 		return err
 	}
+	lockEpoch := uint32(0)
+	if sva == TimelockSVA {
+		lockEpoch, owner, err = extractLockEpoch(owner)
+		if err != nil {
+			return err
+		}
+	}
 	if err := extractZero(owner); err != nil {
 		return err
 	}
 	vso.SVA = sva
 	vso.CurveSpec = curveSpec
 	vso.Account = account
+	vso.LockEpoch = lockEpoch
 	if err := vso.Validate(); err != nil {
 		return err
 	}
@@ -158,12 +185,38 @@ func (vso *ValueStoreOwner) validateSVA() error {
 	if vso == nil {
 		return errorz.ErrInvalid{}.New("not initialized")
 	}
-	if vso.SVA != ValueStoreSVA {
+	if vso.SVA != ValueStoreSVA && vso.SVA != TimelockSVA {
 		return errorz.ErrInvalid{}.New("signature verification algorithm invalid for ValueStoreOwner")
 	}
 	return nil
 }
 
+func (vso *ValueStoreOwner) validateLockEpoch() error {
+	if vso == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	switch vso.SVA {
+	case TimelockSVA:
+		if vso.LockEpoch == 0 {
+			return errorz.ErrInvalid{}.New("lock epoch invalid for timelocked ValueStoreOwner")
+		}
+	default:
+		if vso.LockEpoch != 0 {
+			return errorz.ErrInvalid{}.New("lock epoch set for ValueStoreOwner without timelock")
+		}
+	}
+	return nil
+}
+
+// IsLocked returns true if the owner has a timelock which prevents
+// consumption at currentHeight
+func (vso *ValueStoreOwner) IsLocked(currentHeight uint32) bool {
+	if vso == nil || vso.SVA != TimelockSVA {
+		return false
+	}
+	return utils.Epoch(currentHeight) < vso.LockEpoch
+}
+
 func (vso *ValueStoreOwner) validateAccount() error {
 	if vso == nil {
 		return errorz.ErrInvalid{}.New("not initialized")
@@ -172,6 +172,47 @@ func TestVSOwnerValidate(t *testing.T) {
 	}
 }
 
+func TestVSOwnerTimelock(t *testing.T) {
+	acct := make([]byte, constants.OwnerLen)
+	vso := &ValueStoreOwner{}
+	vso.NewTimelock(acct, constants.CurveSecp256k1, 0)
+	if err := vso.Validate(); err == nil {
+		t.Fatal("Should have raised error (0)")
+	}
+
+	vso.New(acct, constants.CurveSecp256k1)
+	vso.LockEpoch = 1
+	if err := vso.Validate(); err == nil {
+		t.Fatal("Should have raised error (1)")
+	}
+
+	vso.NewTimelock(acct, constants.CurveSecp256k1, 2)
+	data, err := vso.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 2+constants.OwnerLen+4 {
+		t.Fatal("invalid length for timelocked owner")
+	}
+	vso2 := &ValueStoreOwner{}
+	if err := vso2.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if vso2.SVA != TimelockSVA || vso2.LockEpoch != 2 {
+		t.Fatal("timelock not preserved")
+	}
+	if err := vso2.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Fatal("Should have raised error (2)")
+	}
+
+	if !vso.IsLocked(constants.EpochLength) {
+		t.Fatal("Should be locked in epoch 1")
+	}
+	if vso.IsLocked(constants.EpochLength + 1) {
+		t.Fatal("Should be unlocked in epoch 2")
+	}
+}
+
 func TestVSOwnerSign(t *testing.T) {
 	vso := &ValueStoreOwner{}
 	msg := make([]byte, 0)
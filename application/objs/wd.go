@@ -0,0 +1,269 @@
+package objs
+
+import (
+	mdefs "github.com/MadBase/MadNet/application/objs/capn"
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/application/objs/withdrawal"
+	"github.com/MadBase/MadNet/application/wrapper"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+	capnp "zombiezen.com/go/capnproto2"
+)
+
+// FeatureWithdrawal activates Withdrawals. Until governance schedules it, a
+// tx which generates a Withdrawal is invalid.
+var FeatureWithdrawal = dynamics.RegisterFeature("withdrawal", "Withdrawal outputs which burn value to be claimed on Ethereum")
+
+// Withdrawal burns value in a UTXO so that it may be claimed on Ethereum by
+// the account of the withdrawal. A Withdrawal can not be consumed; it stays
+// in the UTXOTrie as proof that the value was burned.
+type Withdrawal struct {
+	WDPreImage *WDPreImage
+	TxHash     []byte
+	//
+	utxoID []byte
+}
+
+// New creates a new Withdrawal of value to the Ethereum address acct
+func (b *Withdrawal) New(chainID uint32, value *uint256.Uint256, fee *uint256.Uint256, acct []byte, txHash []byte) error {
+	if b == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	if value == nil || value.IsZero() {
+		return errorz.ErrInvalid{}.New("invalue value: nil or zero")
+	}
+	if fee == nil {
+		return errorz.ErrInvalid{}.New("invalue fee: nil")
+	}
+	if chainID == 0 {
+		return errorz.ErrInvalid{}.New("Error in Withdrawal.New: invalid chainID")
+	}
+	if len(acct) != constants.OwnerLen {
+		return errorz.ErrInvalid{}.New("Error in Withdrawal.New: invalid account")
+	}
+	if len(txHash) != constants.HashLen {
+		return errorz.ErrInvalid{}.New("Error in Withdrawal.New: invalid txHash")
+	}
+	b.WDPreImage = &WDPreImage{
+		ChainID: chainID,
+		Value:   value.Clone(),
+		Account: utils.CopySlice(acct),
+		Fee:     fee.Clone(),
+	}
+	b.TxHash = utils.CopySlice(txHash)
+	return nil
+}
+
+// UnmarshalBinary takes a byte slice and returns the corresponding
+// Withdrawal object
+func (b *Withdrawal) UnmarshalBinary(data []byte) error {
+	if b == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	bc, err := withdrawal.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	return b.UnmarshalCapn(bc)
+}
+
+// MarshalBinary takes the Withdrawal object and returns the canonical
+// byte slice
+func (b *Withdrawal) MarshalBinary() ([]byte, error) {
+	if b == nil {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	bc, err := b.MarshalCapn(nil)
+	if err != nil {
+		return nil, err
+	}
+	return withdrawal.Marshal(bc)
+}
+
+// UnmarshalCapn unmarshals the capnproto definition of the object
+func (b *Withdrawal) UnmarshalCapn(bc mdefs.Withdrawal) error {
+	if err := withdrawal.Validate(bc); err != nil {
+		return err
+	}
+	b.WDPreImage = &WDPreImage{}
+	if err := b.WDPreImage.UnmarshalCapn(bc.WDPreImage()); err != nil {
+		return err
+	}
+	b.TxHash = utils.CopySlice(bc.TxHash())
+	return nil
+}
+
+// MarshalCapn marshals the object into its capnproto definition
+func (b *Withdrawal) MarshalCapn(seg *capnp.Segment) (mdefs.Withdrawal, error) {
+	if b == nil {
+		return mdefs.Withdrawal{}, errorz.ErrInvalid{}.New("not initialized")
+	}
+	var bc mdefs.Withdrawal
+	if seg == nil {
+		_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+		if err != nil {
+			return bc, err
+		}
+		tmp, err := mdefs.NewRootWithdrawal(seg)
+		if err != nil {
+			return bc, err
+		}
+		bc = tmp
+	} else {
+		tmp, err := mdefs.NewWithdrawal(seg)
+		if err != nil {
+			return bc, err
+		}
+		bc = tmp
+	}
+	seg = bc.Struct.Segment()
+	bt, err := b.WDPreImage.MarshalCapn(seg)
+	if err != nil {
+		return bc, err
+	}
+	if err := bc.SetWDPreImage(bt); err != nil {
+		return bc, err
+	}
+	if err := bc.SetTxHash(utils.CopySlice(b.TxHash)); err != nil {
+		return bc, err
+	}
+	return bc, nil
+}
+
+// MarshalABI returns the Ethereum ABI encoding of the object, which is
+// abi.encode(bytes32 txHash, uint32 chainID, uint32 txOutIdx,
+// address account, uint256 value, uint256 fee). A contract recovers the
+// UTXOID as keccak256(abi.encodePacked(txHash, txOutIdx)) and the PreHash
+// as the keccak256 of all but the first word.
+func (b *Withdrawal) MarshalABI() ([]byte, error) {
+	if b == nil || b.WDPreImage == nil || len(b.TxHash) != constants.HashLen {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	pi, err := b.WDPreImage.MarshalABI()
+	if err != nil {
+		return nil, err
+	}
+	out := utils.CopySlice(b.TxHash)
+	out = append(out, pi...)
+	return out, nil
+}
+
+// PreHash calculates the PreHash of the object
+func (b *Withdrawal) PreHash() ([]byte, error) {
+	if b == nil {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	return b.WDPreImage.PreHash()
+}
+
+// UTXOID calculates the UTXOID of the object
+func (b *Withdrawal) UTXOID() ([]byte, error) {
+	if b == nil || b.WDPreImage == nil || len(b.TxHash) != constants.HashLen {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	if b.utxoID != nil {
+		return utils.CopySlice(b.utxoID), nil
+	}
+	b.utxoID = MakeUTXOID(b.TxHash, b.WDPreImage.TXOutIdx)
+	return utils.CopySlice(b.utxoID), nil
+}
+
+// TXOutIdx returns the TXOutIdx of the object
+func (b *Withdrawal) TXOutIdx() (uint32, error) {
+	if b == nil || b.WDPreImage == nil {
+		return 0, errorz.ErrInvalid{}.New("not initialized")
+	}
+	return b.WDPreImage.TXOutIdx, nil
+}
+
+// SetTXOutIdx sets the TXOutIdx of the object
+func (b *Withdrawal) SetTXOutIdx(idx uint32) error {
+	if b == nil || b.WDPreImage == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	b.WDPreImage.TXOutIdx = idx
+	b.WDPreImage.preHash = nil
+	b.utxoID = nil
+	return nil
+}
+
+// SetTxHash sets the TxHash of the object
+func (b *Withdrawal) SetTxHash(txHash []byte) error {
+	if b == nil || b.WDPreImage == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	if len(txHash) != constants.HashLen {
+		return errorz.ErrInvalid{}.New("Invalid hash length")
+	}
+	b.TxHash = utils.CopySlice(txHash)
+	b.utxoID = nil
+	return nil
+}
+
+// ChainID returns the ChainID of the object
+func (b *Withdrawal) ChainID() (uint32, error) {
+	if b == nil || b.WDPreImage == nil || b.WDPreImage.ChainID == 0 {
+		return 0, errorz.ErrInvalid{}.New("not initialized")
+	}
+	return b.WDPreImage.ChainID, nil
+}
+
+// Value returns the Value of the object
+func (b *Withdrawal) Value() (*uint256.Uint256, error) {
+	if b == nil || b.WDPreImage == nil || b.WDPreImage.Value == nil || b.WDPreImage.Value.IsZero() {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	return b.WDPreImage.Value.Clone(), nil
+}
+
+// Fee returns the Fee of the object
+func (b *Withdrawal) Fee() (*uint256.Uint256, error) {
+	if b == nil || b.WDPreImage == nil || b.WDPreImage.Fee == nil {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	return b.WDPreImage.Fee.Clone(), nil
+}
+
+// ValuePlusFee returns the Value of the object with the associated fee
+func (b *Withdrawal) ValuePlusFee() (*uint256.Uint256, error) {
+	value, err := b.Value()
+	if err != nil {
+		return nil, err
+	}
+	fee, err := b.Fee()
+	if err != nil {
+		return nil, err
+	}
+	total, err := new(uint256.Uint256).Add(value, fee)
+	if err != nil {
+		return nil, err
+	}
+	return total, nil
+}
+
+// Account returns the Ethereum address the value is withdrawn to
+func (b *Withdrawal) Account() ([]byte, error) {
+	if b == nil || b.WDPreImage == nil || len(b.WDPreImage.Account) != constants.OwnerLen {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	return utils.CopySlice(b.WDPreImage.Account), nil
+}
+
+// ValidateFee validates the fee of the object at the time of creation; a
+// withdrawal pays the fee of a ValueStore
+func (b *Withdrawal) ValidateFee(storage *wrapper.Storage) error {
+	fee, err := b.Fee()
+	if err != nil {
+		return err
+	}
+	feeTrue, err := storage.GetValueStoreFee()
+	if err != nil {
+		return err
+	}
+	if fee.Cmp(feeTrue) != 0 {
+		return errorz.ErrInvalid{}.New("wd: invalid fee")
+	}
+	return nil
+}
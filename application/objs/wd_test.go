@@ -0,0 +1,161 @@
+package objs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/utils"
+)
+
+func TestWithdrawalGood(t *testing.T) {
+	wd := &Withdrawal{
+		WDPreImage: makeWDPreImage(t),
+		TxHash:     crypto.Hasher([]byte("txHash")),
+	}
+	wd2 := &Withdrawal{}
+	wdBytes, err := wd.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = wd2.UnmarshalBinary(wdBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wdpiEqual(t, wd.WDPreImage, wd2.WDPreImage)
+	if !bytes.Equal(wd.TxHash, wd2.TxHash) {
+		t.Fatal("Do not agree on TxHash!")
+	}
+}
+
+func TestWithdrawalNew(t *testing.T) {
+	acct := crypto.Hasher([]byte("account"))[:constants.OwnerLen]
+	txHash := make([]byte, constants.HashLen)
+	wd := &Withdrawal{}
+	if err := wd.New(0, uint256.One(), uint256.Zero(), acct, txHash); err == nil {
+		t.Fatal("Should raise error for invalid ChainID!")
+	}
+	if err := wd.New(1, uint256.Zero(), uint256.Zero(), acct, txHash); err == nil {
+		t.Fatal("Should raise error for zero value!")
+	}
+	if err := wd.New(1, uint256.One(), nil, acct, txHash); err == nil {
+		t.Fatal("Should raise error for nil fee!")
+	}
+	if err := wd.New(1, uint256.One(), uint256.Zero(), acct[:19], txHash); err == nil {
+		t.Fatal("Should raise error for invalid account!")
+	}
+	if err := wd.New(1, uint256.One(), uint256.One(), acct, txHash); err != nil {
+		t.Fatal(err)
+	}
+	total, err := wd.ValuePlusFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !total.Eq(uint256.Two()) {
+		t.Fatal("Invalid ValuePlusFee!")
+	}
+}
+
+func TestWithdrawalMarshalABI(t *testing.T) {
+	wd := &Withdrawal{
+		WDPreImage: makeWDPreImage(t),
+		TxHash:     crypto.Hasher([]byte("txHash")),
+	}
+	msg, err := wd.MarshalABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msg) != 6*abiWordLen {
+		t.Fatalf("Invalid ABI length: %v", len(msg))
+	}
+	utxoID, err := wd.UTXOID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the UTXOID and PreHash must be recoverable from the ABI encoding
+	packed := append(utils.CopySlice(msg[:abiWordLen]), msg[3*abiWordLen-4:3*abiWordLen]...)
+	if !bytes.Equal(utxoID, crypto.Hasher(packed)) {
+		t.Fatal("UTXOID can not be recovered from the ABI encoding!")
+	}
+	preHash, err := wd.PreHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(preHash, crypto.Hasher(msg[abiWordLen:])) {
+		t.Fatal("PreHash can not be recovered from the ABI encoding!")
+	}
+	if err := wd.SetTXOutIdx(3); err != nil {
+		t.Fatal(err)
+	}
+	utxoID2, err := wd.UTXOID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(utxoID, utxoID2) {
+		t.Fatal("UTXOID not updated with TXOutIdx!")
+	}
+}
+
+func TestUTXOWithdrawalGood(t *testing.T) {
+	acct := crypto.Hasher([]byte("account"))[:constants.OwnerLen]
+	utxo := &TXOut{}
+	err := utxo.CreateWithdrawal(1, uint256.One(), uint256.Zero(), acct, make([]byte, constants.HashLen))
+	if err != nil {
+		t.Fatal(err)
+	}
+	utxoBytes, err := utxo.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	utxo2 := &TXOut{}
+	if err := utxo2.UnmarshalBinary(utxoBytes); err != nil {
+		t.Fatal(err)
+	}
+	if !utxo2.HasWithdrawal() || utxo2.HasValueStore() {
+		t.Fatal("Should be a withdrawal!")
+	}
+	account, err := utxo2.Account()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(account, acct) {
+		t.Fatal("Do not agree on Account!")
+	}
+	if _, err := utxo2.MakeTxIn(); err == nil {
+		t.Fatal("Should raise error; a withdrawal can not be consumed!")
+	}
+	if _, err := utxo2.GenericOwner(); err == nil {
+		t.Fatal("Should raise error; a withdrawal has no owner!")
+	}
+	expired, err := utxo2.IsExpired(constants.MaxUint32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expired {
+		t.Fatal("A withdrawal should never expire!")
+	}
+}
+
+func TestVoutValidateWithdrawals(t *testing.T) {
+	acct := crypto.Hasher([]byte("account"))[:constants.OwnerLen]
+	utxo := &TXOut{}
+	err := utxo.CreateWithdrawal(1, uint256.One(), uint256.Zero(), acct, make([]byte, constants.HashLen))
+	if err != nil {
+		t.Fatal(err)
+	}
+	vout := Vout{utxo}
+	msg := makeMockStorageGetter()
+	storage := makeStorage(msg)
+	if err := vout.ValidateWithdrawals(1, storage); err == nil {
+		t.Fatal("Should raise error; withdrawals are not active!")
+	}
+	msg.SetActivation(FeatureWithdrawal, 2)
+	if err := vout.ValidateWithdrawals(constants.EpochLength, storage); err == nil {
+		t.Fatal("Should raise error; withdrawals are not active before epoch 2!")
+	}
+	if err := vout.ValidateWithdrawals(constants.EpochLength+1, storage); err != nil {
+		t.Fatal(err)
+	}
+}
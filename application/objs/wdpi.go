@@ -0,0 +1,199 @@
+package objs
+
+import (
+	"fmt"
+
+	mdefs "github.com/MadBase/MadNet/application/objs/capn"
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/application/objs/wdpreimage"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+	capnp "zombiezen.com/go/capnproto2"
+)
+
+// abiWordLen is the length of a word in the Ethereum ABI encoding
+const abiWordLen = 32
+
+// WDPreImage is a withdrawal preimage
+type WDPreImage struct {
+	ChainID  uint32
+	Value    *uint256.Uint256
+	TXOutIdx uint32
+	Account  []byte
+	Fee      *uint256.Uint256
+	//
+	preHash []byte
+}
+
+// UnmarshalBinary takes a byte slice and returns the corresponding
+// WDPreImage object
+func (b *WDPreImage) UnmarshalBinary(data []byte) error {
+	if b == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	bc, err := wdpreimage.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	return b.UnmarshalCapn(bc)
+}
+
+// MarshalBinary takes the WDPreImage object and returns the canonical
+// byte slice
+func (b *WDPreImage) MarshalBinary() ([]byte, error) {
+	if b == nil {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	bc, err := b.MarshalCapn(nil)
+	if err != nil {
+		return nil, err
+	}
+	return wdpreimage.Marshal(bc)
+}
+
+// UnmarshalCapn unmarshals the capnproto definition of the object
+func (b *WDPreImage) UnmarshalCapn(bc mdefs.WDPreImage) error {
+	if err := wdpreimage.Validate(bc); err != nil {
+		return err
+	}
+	b.ChainID = bc.ChainID()
+	u32array := [8]uint32{}
+	u32array[0] = bc.Value()
+	u32array[1] = bc.Value1()
+	u32array[2] = bc.Value2()
+	u32array[3] = bc.Value3()
+	u32array[4] = bc.Value4()
+	u32array[5] = bc.Value5()
+	u32array[6] = bc.Value6()
+	u32array[7] = bc.Value7()
+	vObj := &uint256.Uint256{}
+	err := vObj.FromUint32Array(u32array)
+	if err != nil {
+		return err
+	}
+	b.Value = vObj
+	b.TXOutIdx = bc.TXOutIdx()
+	b.Account = utils.CopySlice(bc.Account())
+	fObj := &uint256.Uint256{}
+	u32array[0] = bc.Fee0()
+	u32array[1] = bc.Fee1()
+	u32array[2] = bc.Fee2()
+	u32array[3] = bc.Fee3()
+	u32array[4] = bc.Fee4()
+	u32array[5] = bc.Fee5()
+	u32array[6] = bc.Fee6()
+	u32array[7] = bc.Fee7()
+	err = fObj.FromUint32Array(u32array)
+	if err != nil {
+		return err
+	}
+	b.Fee = fObj
+	return nil
+}
+
+// MarshalCapn marshals the object into its capnproto definition
+func (b *WDPreImage) MarshalCapn(seg *capnp.Segment) (mdefs.WDPreImage, error) {
+	if b == nil {
+		return mdefs.WDPreImage{}, errorz.ErrInvalid{}.New("not initialized")
+	}
+	var bc mdefs.WDPreImage
+	if seg == nil {
+		_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+		if err != nil {
+			return bc, err
+		}
+		tmp, err := mdefs.NewRootWDPreImage(seg)
+		if err != nil {
+			return bc, err
+		}
+		bc = tmp
+	} else {
+		tmp, err := mdefs.NewWDPreImage(seg)
+		if err != nil {
+			return bc, err
+		}
+		bc = tmp
+	}
+	if err := bc.SetAccount(utils.CopySlice(b.Account)); err != nil {
+		return bc, err
+	}
+	bc.SetChainID(b.ChainID)
+	u32array, err := b.Value.ToUint32Array()
+	if err != nil {
+		return bc, err
+	}
+	bc.SetValue(u32array[0])
+	bc.SetValue1(u32array[1])
+	bc.SetValue2(u32array[2])
+	bc.SetValue3(u32array[3])
+	bc.SetValue4(u32array[4])
+	bc.SetValue5(u32array[5])
+	bc.SetValue6(u32array[6])
+	bc.SetValue7(u32array[7])
+	u32array, err = b.Fee.ToUint32Array()
+	if err != nil {
+		return bc, err
+	}
+	bc.SetFee0(u32array[0])
+	bc.SetFee1(u32array[1])
+	bc.SetFee2(u32array[2])
+	bc.SetFee3(u32array[3])
+	bc.SetFee4(u32array[4])
+	bc.SetFee5(u32array[5])
+	bc.SetFee6(u32array[6])
+	bc.SetFee7(u32array[7])
+	bc.SetTXOutIdx(b.TXOutIdx)
+	return bc, nil
+}
+
+// MarshalABI returns the Ethereum ABI encoding of the object, which is
+// abi.encode(uint32 chainID, uint32 txOutIdx, address account,
+// uint256 value, uint256 fee)
+func (b *WDPreImage) MarshalABI() ([]byte, error) {
+	if b == nil || b.Value == nil || b.Fee == nil {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	if len(b.Account) != constants.OwnerLen {
+		return nil, errorz.ErrInvalid{}.New("invalid account length")
+	}
+	value, err := b.Value.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	fee, err := b.Fee.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	out := []byte{}
+	out = append(out, utils.ForceSliceToLength(utils.MarshalUint32(b.ChainID), abiWordLen)...)
+	out = append(out, utils.ForceSliceToLength(utils.MarshalUint32(b.TXOutIdx), abiWordLen)...)
+	out = append(out, utils.ForceSliceToLength(b.Account, abiWordLen)...)
+	out = append(out, value...)
+	out = append(out, fee...)
+	return out, nil
+}
+
+// PreHash calculates the PreHash of the object. Unlike other objects the
+// PreHash is the hash of the ABI encoding, so that a contract on Ethereum
+// can compute the value stored in the UTXOTrie.
+func (b *WDPreImage) PreHash() ([]byte, error) {
+	if b == nil {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	if b.preHash != nil {
+		return utils.CopySlice(b.preHash), nil
+	}
+	msg, err := b.MarshalABI()
+	if err != nil {
+		return nil, err
+	}
+	hsh := crypto.Hasher(msg)
+	b.preHash = hsh
+	return utils.CopySlice(b.preHash), nil
+}
+
+func (b *WDPreImage) String() string {
+	return fmt.Sprintf("&{ ChainID: %v, Value: %v, TXOutIdx: %v, Account: %x, Fee: %v, preHash: %v }", b.ChainID, b.Value.String(), b.TXOutIdx, b.Account, b.Fee.String(), b.preHash)
+}
@@ -0,0 +1,132 @@
+package objs
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func makeWDPreImage(t *testing.T) *WDPreImage {
+	value, err := new(uint256.Uint256).FromUint64(65537)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee, err := new(uint256.Uint256).FromUint64(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &WDPreImage{
+		ChainID:  2,
+		Value:    value,
+		TXOutIdx: 17,
+		Account:  crypto.Hasher([]byte("account"))[:20],
+		Fee:      fee,
+	}
+}
+
+func TestWDPreImageGood(t *testing.T) {
+	wdp := makeWDPreImage(t)
+	wdp2 := &WDPreImage{}
+	wdpBytes, err := wdp.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = wdp2.UnmarshalBinary(wdpBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wdpiEqual(t, wdp, wdp2)
+}
+
+func wdpiEqual(t *testing.T, wdpi1, wdpi2 *WDPreImage) {
+	if wdpi1.ChainID != wdpi2.ChainID {
+		t.Fatal("Do not agree on ChainID!")
+	}
+	if !wdpi1.Value.Eq(wdpi2.Value) {
+		t.Fatal("Do not agree on Value!")
+	}
+	if wdpi1.TXOutIdx != wdpi2.TXOutIdx {
+		t.Fatal("Do not agree on TXOutIdx!")
+	}
+	if !bytes.Equal(wdpi1.Account, wdpi2.Account) {
+		t.Fatal("Do not agree on Account!")
+	}
+	if !wdpi1.Fee.Eq(wdpi2.Fee) {
+		t.Fatal("Do not agree on Fee!")
+	}
+}
+
+func TestWDPreImageBad(t *testing.T) {
+	wdp := makeWDPreImage(t)
+	wdp.ChainID = 0 // Invalid ChainID
+	wdpBytes, err := wdp.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := (&WDPreImage{}).UnmarshalBinary(wdpBytes); err == nil {
+		t.Fatal("Should raise error for invalid ChainID!")
+	}
+
+	wdp = makeWDPreImage(t)
+	wdp.Account = wdp.Account[:19] // Invalid Account
+	wdpBytes, err = wdp.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := (&WDPreImage{}).UnmarshalBinary(wdpBytes); err == nil {
+		t.Fatal("Should raise error for invalid Account!")
+	}
+
+	wdp = makeWDPreImage(t)
+	wdp.Value = uint256.Zero() // Invalid Value
+	wdpBytes, err = wdp.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := (&WDPreImage{}).UnmarshalBinary(wdpBytes); err == nil {
+		t.Fatal("Should raise error for zero Value!")
+	}
+}
+
+func TestWDPreImageMarshalABI(t *testing.T) {
+	wdp := makeWDPreImage(t)
+	got, err := wdp.MarshalABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	uint32Ty, _ := abi.NewType("uint32", "", nil)
+	addressTy, _ := abi.NewType("address", "", nil)
+	uint256Ty, _ := abi.NewType("uint256", "", nil)
+	args := abi.Arguments{
+		{Type: uint32Ty},
+		{Type: uint32Ty},
+		{Type: addressTy},
+		{Type: uint256Ty},
+		{Type: uint256Ty},
+	}
+	want, err := args.Pack(
+		wdp.ChainID,
+		wdp.TXOutIdx,
+		common.BytesToAddress(wdp.Account),
+		big.NewInt(65537),
+		big.NewInt(3),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("Bad ABI encoding:\n got %x\nwant %x", got, want)
+	}
+	preHash, err := wdp.PreHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(preHash, crypto.Hasher(want)) {
+		t.Fatal("PreHash is not the hash of the ABI encoding!")
+	}
+}
@@ -0,0 +1,61 @@
+package wdpreimage
+
+import (
+	mdefs "github.com/MadBase/MadNet/application/objs/capn"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+	capnp "zombiezen.com/go/capnproto2"
+)
+
+// Marshal will marshal the WDPreImage object.
+func Marshal(v mdefs.WDPreImage) ([]byte, error) {
+	raw, err := capnp.Canonicalize(v.Struct)
+	if err != nil {
+		return nil, err
+	}
+	out := utils.CopySlice(raw)
+	return out, nil
+}
+
+// Unmarshal will unmarshal the WDPreImage object.
+func Unmarshal(data []byte) (mdefs.WDPreImage, error) {
+	var err error
+	fn := func() (mdefs.WDPreImage, error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = errorz.ErrInvalid{}.New("bad serialization")
+			}
+		}()
+		dataCopy := utils.CopySlice(data)
+		msg := &capnp.Message{Arena: capnp.SingleSegment(dataCopy)}
+		obj, tmp := mdefs.ReadRootWDPreImage(msg)
+		err = tmp
+		return obj, err
+	}
+	obj, err := fn()
+	if err != nil {
+		return mdefs.WDPreImage{}, err
+	}
+	return obj, nil
+}
+
+// Validate will validate the WDPreImage object
+func Validate(v mdefs.WDPreImage) error {
+	if v.ChainID() < 1 {
+		return errorz.ErrInvalid{}.New("wdpreimage capn obj is not valid; invalid ChainID")
+	}
+	if !v.HasAccount() {
+		return errorz.ErrInvalid{}.New("wdpreimage capn obj does not have Account")
+	}
+	if len(v.Account()) != constants.OwnerLen {
+		return errorz.ErrInvalid{}.New("wdpreimage capn obj is not valid: invalid Account; incorrect byte length")
+	}
+	if v.Value()|v.Value1()|v.Value2()|v.Value3()|v.Value4()|v.Value5()|v.Value6()|v.Value7() == 0 {
+		return errorz.ErrInvalid{}.New("wdpreimage capn obj is not valid; no value")
+	}
+	if int(v.TXOutIdx()) >= constants.MaxTxVectorLength {
+		return errorz.ErrInvalid{}.New("wdpreimage capn obj is not valid: output index is too large")
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package withdrawal
+
+import (
+	mdefs "github.com/MadBase/MadNet/application/objs/capn"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+	capnp "zombiezen.com/go/capnproto2"
+)
+
+// Marshal will marshal the Withdrawal object.
+func Marshal(v mdefs.Withdrawal) ([]byte, error) {
+	raw, err := capnp.Canonicalize(v.Struct)
+	if err != nil {
+		return nil, err
+	}
+	out := utils.CopySlice(raw)
+	return out, nil
+}
+
+// Unmarshal will unmarshal the Withdrawal object.
+func Unmarshal(data []byte) (mdefs.Withdrawal, error) {
+	var err error
+	fn := func() (mdefs.Withdrawal, error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = errorz.ErrInvalid{}.New("bad serialization")
+			}
+		}()
+		dataCopy := utils.CopySlice(data)
+		msg := &capnp.Message{Arena: capnp.SingleSegment(dataCopy)}
+		obj, tmp := mdefs.ReadRootWithdrawal(msg)
+		err = tmp
+		return obj, err
+	}
+	obj, err := fn()
+	if err != nil {
+		return mdefs.Withdrawal{}, err
+	}
+	return obj, nil
+}
+
+// Validate will validate the Withdrawal object
+func Validate(v mdefs.Withdrawal) error {
+	if !v.HasWDPreImage() {
+		return errorz.ErrInvalid{}.New("withdrawal capn obj does not have WDPreImage")
+	}
+	if !v.HasTxHash() {
+		return errorz.ErrInvalid{}.New("withdrawal capn obj does not have TxHash")
+	}
+	if len(v.TxHash()) != constants.HashLen {
+		return errorz.ErrInvalid{}.New("withdrawal capn obj is not valid: invalid TxHash; incorrect byte length")
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package pendingtx
+
+import (
+	"github.com/MadBase/MadNet/constants/dbprefix"
+	"github.com/MadBase/MadNet/metrics"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	poolSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "pendingtx",
+		Name:      "pool_size",
+		Help:      "Number of txs in the pending tx pool.",
+	})
+	poolBytes = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "pendingtx",
+		Name:      "pool_bytes",
+		Help:      "Number of bytes used by the txs in the pending tx pool.",
+	})
+)
+
+func init() {
+	metrics.MustRegister(poolSize, poolBytes)
+}
+
+// poolDelta accumulates the txs added to and deleted from the pool by a
+// database transaction. The pool metrics are only updated once the
+// transaction has been committed.
+type poolDelta struct {
+	count int
+	size  int64
+}
+
+func (pd *poolDelta) add(size int64) {
+	pd.count++
+	pd.size += size
+}
+
+func (pd *poolDelta) remove(size int64) {
+	pd.count--
+	pd.size -= size
+}
+
+func (pd *poolDelta) apply() {
+	poolSize.Add(float64(pd.count))
+	poolBytes.Add(float64(pd.size))
+}
+
+// resetPoolMetrics sets the pool metrics to the txs stored in the pool. The
+// pool is only scanned when a Handler is created or dropped; afterwards the
+// metrics are updated as txs are added and deleted.
+func (pt *Handler) resetPoolMetrics() error {
+	count, size, err := pt.poolStats()
+	if err != nil {
+		return err
+	}
+	poolSize.Set(float64(count))
+	poolBytes.Set(float64(size))
+	return nil
+}
+
+// poolStats returns the number of txs stored in the pool and their size
+func (pt *Handler) poolStats() (int, int64, error) {
+	count := 0
+	size := int64(0)
+	err := pt.db.View(func(txn *badger.Txn) error {
+		prefix := dbprefix.PrefixPendingTx()
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		opts.Prefix = prefix
+		iter := txn.NewIterator(opts)
+		defer iter.Close()
+		for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+			count++
+			size += iter.Item().ValueSize()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return count, size, nil
+}
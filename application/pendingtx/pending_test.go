@@ -13,6 +13,7 @@ import (
 	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/crypto"
 	"github.com/dgraph-io/badger/v2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
 type mockTrie struct {
@@ -339,6 +340,51 @@ func TestDeleteMined(t *testing.T) {
 	mustNotAdd(t, hndlr, tx, 1)
 }
 
+func mustMatchPoolMetrics(t *testing.T, hndlr *Handler, count int) {
+	t.Helper()
+	c, size, err := hndlr.poolStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c != count {
+		t.Fatalf("pool holds %v txs; expected %v", c, count)
+	}
+	if testutil.ToFloat64(poolSize) != float64(c) {
+		t.Fatalf("pool size metric %v; pool holds %v", testutil.ToFloat64(poolSize), c)
+	}
+	if testutil.ToFloat64(poolBytes) != float64(size) {
+		t.Fatalf("pool bytes metric %v; pool holds %v", testutil.ToFloat64(poolBytes), size)
+	}
+}
+
+func TestPoolMetrics(t *testing.T) {
+	hndlr, _, cleanup := setup(t)
+	defer cleanup()
+	mustMatchPoolMetrics(t, hndlr, 0)
+	vout, tx := makeTxInitial()
+	mustAddTx(t, hndlr, tx, 1)
+	mustMatchPoolMetrics(t, hndlr, 1)
+	mustAddTx(t, hndlr, tx, 1)
+	mustMatchPoolMetrics(t, hndlr, 1)
+	// tx2 spends the same utxos and evicts tx
+	tx2 := makeTxConsuming(vout)
+	mustAddTx(t, hndlr, tx2, 1)
+	mustMatchPoolMetrics(t, hndlr, 1)
+	mustDelTx(t, hndlr, tx2)
+	mustMatchPoolMetrics(t, hndlr, 0)
+	mustDelTx(t, hndlr, tx2)
+	mustMatchPoolMetrics(t, hndlr, 0)
+	mustAddTx(t, hndlr, tx, 1)
+	txHash, err := tx.TxHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := hndlr.DeleteMined(nil, 1, [][]byte{txHash}); err != nil {
+		t.Fatal(err)
+	}
+	mustMatchPoolMetrics(t, hndlr, 0)
+}
+
 func TestMissing(t *testing.T) {
 	hndlr, _, cleanup := setup(t)
 	defer cleanup()
@@ -28,11 +28,15 @@ type depositHandler interface {
 
 // NewPendingTxHandler creates a new Handler object
 func NewPendingTxHandler(db *badger.DB) *Handler {
-	return &Handler{
+	pt := &Handler{
 		indexer: index.NewPendingTxIndexer(),
 		db:      db,
 		logger:  logging.GetLogger(constants.LoggerApp),
 	}
+	if err := pt.resetPoolMetrics(); err != nil {
+		utils.DebugTrace(pt.logger, err)
+	}
+	return pt
 }
 
 // Handler is the object that acts as the pending tx pool
@@ -51,7 +55,8 @@ func (pt *Handler) Add(txnState *badger.Txn, txs []*objs.Tx, currentHeight uint3
 		utils.DebugTrace(pt.logger, err)
 		return err
 	}
-	return pt.db.Update(func(txn *badger.Txn) error {
+	delta := &poolDelta{}
+	err := pt.db.Update(func(txn *badger.Txn) error {
 		for i := 0; i < len(txs); i++ {
 			tx := txs[i]
 			utxoIds, err := tx.ConsumedUTXOID()
@@ -73,7 +78,7 @@ func (pt *Handler) Add(txnState *badger.Txn, txs []*objs.Tx, currentHeight uint3
 			_, err = utils.GetValue(txn, cooldownKey)
 			if err != nil {
 				if err == badger.ErrKeyNotFound {
-					err := pt.addOneInternal(txn, tx, eoe, txHash, utxoIds)
+					err := pt.addOneInternal(txn, tx, eoe, txHash, utxoIds, delta)
 					if err != nil {
 						utils.DebugTrace(pt.logger, err)
 						return err
@@ -87,21 +92,32 @@ func (pt *Handler) Add(txnState *badger.Txn, txs []*objs.Tx, currentHeight uint3
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	delta.apply()
+	return nil
 }
 
 // Delete removes a list of txHashes from the tx pool
 func (pt *Handler) Delete(txnState *badger.Txn, txHashes [][]byte) error {
 	var txHash []byte
-	return pt.db.Update(func(txn *badger.Txn) error {
+	delta := &poolDelta{}
+	err := pt.db.Update(func(txn *badger.Txn) error {
 		for i := 0; i < len(txHashes); i++ {
 			txHash = utils.CopySlice(txHashes[i])
-			if err := pt.deleteOneInternal(txn, utils.CopySlice(txHash), false); err != nil {
+			if err := pt.deleteOneInternal(txn, utils.CopySlice(txHash), false, delta); err != nil {
 				utils.DebugTrace(pt.logger, err)
 				return err
 			}
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	delta.apply()
+	return nil
 }
 
 // Get returns a list of txs based on txHashes and a list of all txHashes that
@@ -150,7 +166,8 @@ func (pt *Handler) Contains(txnState *badger.Txn, currentHeight uint32, txHashes
 // transactions
 func (pt *Handler) DeleteMined(txnState *badger.Txn, currentHeight uint32, txHashes [][]byte) error {
 	var txHash []byte
-	return pt.db.Update(func(txn *badger.Txn) error {
+	delta := &poolDelta{}
+	err := pt.db.Update(func(txn *badger.Txn) error {
 		for i := 0; i < len(txHashes); i++ {
 			txHash = utils.CopySlice(txHashes[i])
 			cooldownKey := pt.makePendingTxCooldownKey(utils.CopySlice(txHash))
@@ -162,7 +179,7 @@ func (pt *Handler) DeleteMined(txnState *badger.Txn, currentHeight uint32, txHas
 		}
 		for i := 0; i < len(txHashes); i++ {
 			txHash = utils.CopySlice(txHashes[i])
-			err := pt.deleteOneInternal(txn, utils.CopySlice(txHash), true)
+			err := pt.deleteOneInternal(txn, utils.CopySlice(txHash), true, delta)
 			if err != nil {
 				utils.DebugTrace(pt.logger, err)
 			}
@@ -181,7 +198,7 @@ func (pt *Handler) DeleteMined(txnState *badger.Txn, currentHeight uint32, txHas
 				utils.DebugTrace(pt.logger, err)
 				return err // New returned error (Hunter and Chris)
 			}
-			err = pt.deleteOneInternal(txn, utils.CopySlice(txHash), true)
+			err = pt.deleteOneInternal(txn, utils.CopySlice(txHash), true, delta)
 			if err != nil {
 				utils.DebugTrace(pt.logger, err)
 				return err // New returned error (Hunter and Chris)
@@ -189,6 +206,11 @@ func (pt *Handler) DeleteMined(txnState *badger.Txn, currentHeight uint32, txHas
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	delta.apply()
+	return nil
 }
 
 // GetTxsForProposal returns an set of txs that are mutually exclusive with
@@ -475,7 +497,7 @@ func (pt *Handler) getOneInternal(txn *badger.Txn, epoch uint32, txHash []byte)
 	return tx, nil
 }
 
-func (pt *Handler) addOneInternal(txn *badger.Txn, tx *objs.Tx, expEpoch uint32, txHash []byte, utxoIDs [][]byte) error {
+func (pt *Handler) addOneInternal(txn *badger.Txn, tx *objs.Tx, expEpoch uint32, txHash []byte, utxoIDs [][]byte, delta *poolDelta) error {
 	contains, err := pt.containsOneInternal(txn, expEpoch, txHash)
 	if err != nil {
 		utils.DebugTrace(pt.logger, err)
@@ -490,21 +512,27 @@ func (pt *Handler) addOneInternal(txn *badger.Txn, tx *objs.Tx, expEpoch uint32,
 		return err
 	}
 	for _, evictedHash := range evicted {
-		err := pt.deleteOneInternal(txn, utils.CopySlice(evictedHash), false)
+		err := pt.deleteOneInternal(txn, utils.CopySlice(evictedHash), false, delta)
 		if err != nil {
 			utils.DebugTrace(pt.logger, err)
 			return err
 		}
 	}
+	txb, err := tx.MarshalBinary()
+	if err != nil {
+		utils.DebugTrace(pt.logger, err)
+		return err
+	}
 	key := pt.makePendingTxKey(txHash)
-	if err := db.SetTx(txn, key, tx); err != nil {
+	if err := utils.SetValue(txn, key, txb); err != nil {
 		utils.DebugTrace(pt.logger, err)
 		return err
 	}
+	delta.add(int64(len(txb)))
 	return nil
 }
 
-func (pt *Handler) deleteOneInternal(txn *badger.Txn, txHash []byte, minedDelete bool) error {
+func (pt *Handler) deleteOneInternal(txn *badger.Txn, txHash []byte, minedDelete bool, delta *poolDelta) error {
 	if minedDelete {
 		txHashes, _, err := pt.indexer.DeleteMined(txn, txHash)
 		if err != nil {
@@ -514,12 +542,10 @@ func (pt *Handler) deleteOneInternal(txn *badger.Txn, txHash []byte, minedDelete
 		for j := 0; j < len(txHashes); j++ {
 			txH := utils.CopySlice(txHashes[j])
 			key := pt.makePendingTxKey(utils.CopySlice(txH))
-			err := utils.DeleteValue(txn, key)
+			err := pt.deletePendingTx(txn, key, delta)
 			if err != nil {
-				if err != badger.ErrKeyNotFound {
-					utils.DebugTrace(pt.logger, err)
-					return err
-				}
+				utils.DebugTrace(pt.logger, err)
+				return err
 			}
 		}
 	} else {
@@ -532,13 +558,29 @@ func (pt *Handler) deleteOneInternal(txn *badger.Txn, txHash []byte, minedDelete
 		}
 	}
 	key := pt.makePendingTxKey(txHash)
-	err := utils.DeleteValue(txn, key)
+	err := pt.deletePendingTx(txn, key, delta)
 	if err != nil {
-		if err != badger.ErrKeyNotFound {
-			utils.DebugTrace(pt.logger, err)
-			return err
+		utils.DebugTrace(pt.logger, err)
+		return err
+	}
+	return nil
+}
+
+// deletePendingTx deletes the tx stored at key and records it in delta if
+// it was in the pool
+func (pt *Handler) deletePendingTx(txn *badger.Txn, key []byte, delta *poolDelta) error {
+	item, err := txn.Get(key)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil
 		}
+		return err
 	}
+	size := item.ValueSize()
+	if err := utils.DeleteValue(txn, key); err != nil {
+		return err
+	}
+	delta.remove(size)
 	return nil
 }
 
@@ -569,5 +611,8 @@ func (pt *Handler) makePendingTxCooldownKey(txHash []byte) []byte {
 
 // Drop deletes all data from the pending tx pool
 func (pt *Handler) Drop() error {
-	return pt.db.DropAll()
+	if err := pt.db.DropAll(); err != nil {
+		return err
+	}
+	return pt.resetPoolMetrics()
 }
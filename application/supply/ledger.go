@@ -0,0 +1,339 @@
+package supply
+
+import (
+	"bytes"
+
+	"github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/constants/dbprefix"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/metrics"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+/*
+
+== BADGER KEYS ==
+
+supply:
+key: <prefix>|<height>
+  value: <Supply>
+
+last checked snapshot height:
+key: <prefix>
+  value: <height>
+
+*/
+
+var (
+	activeValueGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "supply",
+		Name:      "active_value",
+		Help:      "Value of the spendable UTXOs after the last height.",
+	})
+	checkedHeight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "supply",
+		Name:      "checked_height",
+		Help:      "Last snapshot height at which the supply was checked against the state trie.",
+	})
+	mismatches = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "supply",
+		Name:      "mismatches_total",
+		Help:      "Number of times the supply ledger did not match the state.",
+	})
+)
+
+func init() {
+	metrics.MustRegister(activeValueGauge, checkedHeight, mismatches)
+}
+
+// NewLedger creates a new Ledger
+func NewLedger() *Ledger {
+	return &Ledger{logger: logging.GetLogger(constants.LoggerApp)}
+}
+
+// Ledger stores the Supply of each height. A node which joined by fast sync,
+// or which ran before the ledger existed, has no Supply for the heights it
+// did not apply itself; its ledger starts once Seed is called.
+type Ledger struct {
+	logger *logrus.Logger
+}
+
+// Record computes and stores the Supply of height from the txs applied at
+// it and the UTXOs they consumed, including deposits. Nothing is recorded
+// if the ledger holds no Supply for the height before.
+func (l *Ledger) Record(txn *badger.Txn, height uint32, txs objs.TxVec, consumed objs.Vout) error {
+	prev, err := l.Get(txn, height-1)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			return err
+		}
+		if height > 1 {
+			return nil
+		}
+		prev = New(0, uint256.Zero())
+	}
+	s := New(height, prev.ActiveValue)
+	if err := s.apply(txs, consumed); err != nil {
+		return err
+	}
+	if err := l.set(txn, s); err != nil {
+		return err
+	}
+	if f, err := s.ActiveValue.ToBigInt(); err == nil {
+		fv, _ := f.Float64()
+		activeValueGauge.Set(fv)
+	}
+	return nil
+}
+
+// Rollback removes the Supply of height
+func (l *Ledger) Rollback(txn *badger.Txn, height uint32) error {
+	return utils.DeleteValue(txn, makeKey(height))
+}
+
+// Seed starts the ledger at height with the active value found in the state
+// trie at that height
+func (l *Ledger) Seed(txn *badger.Txn, height uint32, activeValue *uint256.Uint256) error {
+	return l.set(txn, New(height, activeValue))
+}
+
+// Get returns the Supply of height
+func (l *Ledger) Get(txn *badger.Txn, height uint32) (*Supply, error) {
+	v, err := utils.GetValue(txn, makeKey(height))
+	if err != nil {
+		return nil, err
+	}
+	s := &Supply{}
+	if err := s.UnmarshalBinary(v); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// State is the state trie the ledger is checked against
+type State interface {
+	// GetRootForHeight returns the root of the state trie at height
+	GetRootForHeight(txn *badger.Txn, height uint32) ([]byte, error)
+	// ActiveValue sums the active value of the UTXOs in the state trie
+	// with root
+	ActiveValue(txn *badger.Txn, root []byte) (*uint256.Uint256, error)
+}
+
+// Result is the outcome of Check, which is written by Store
+type Result struct {
+	// Height is the height of the state trie which was walked
+	Height uint32
+	// Root is the root of the state trie which was walked
+	Root []byte
+	// Seed is the Supply the ledger starts with; it is nil unless the
+	// ledger held no Supply for Height
+	Seed *Supply
+}
+
+// Check verifies the ledger up to the latest snapshot height at or before
+// height. Every height since the last check must conserve value, and the
+// active value at the snapshot height must equal the value found by walking
+// the state trie of that height. A mismatch is logged and counted; only a
+// failure to read the state is returned. If the ledger holds no Supply for
+// height the active value of the state trie at height is returned as the
+// Seed of the ledger instead. Check does not write to txn, so the walk may be
+// done in a read-only transaction without blocking the chain; the Result is
+// written by Store. A nil Result is returned if there is nothing to check.
+func (l *Ledger) Check(txn *badger.Txn, height uint32, state State) (*Result, error) {
+	_, err := l.Get(txn, height)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			return nil, err
+		}
+		r, err := walk(txn, height, state)
+		if err != nil {
+			return nil, err
+		}
+		activeValue, err := state.ActiveValue(txn, r.Root)
+		if err != nil {
+			return nil, err
+		}
+		r.Seed = New(height, activeValue)
+		return r, nil
+	}
+	snapshot := snapshotBefore(height)
+	last, err := getLastChecked(txn)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			return nil, err
+		}
+		last = 0
+	}
+	if snapshot <= last {
+		return nil, nil
+	}
+	r, err := walk(txn, snapshot, state)
+	if err != nil {
+		return nil, err
+	}
+	snapSupply, err := l.Get(txn, snapshot)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			return nil, err
+		}
+		// the ledger was seeded after the snapshot
+		return r, nil
+	}
+	prev, err := l.Get(txn, last)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			return nil, err
+		}
+		prev = nil
+	}
+	for h := last + 1; h <= snapshot; h++ {
+		cur, err := l.Get(txn, h)
+		if err != nil {
+			if err != badger.ErrKeyNotFound {
+				return nil, err
+			}
+			prev = nil
+			continue
+		}
+		if prev != nil {
+			if err := cur.Check(prev); err != nil {
+				mismatches.Inc()
+				l.logger.Errorf("Supply ledger mismatch: %v", err)
+			}
+		}
+		prev = cur
+	}
+	activeValue, err := state.ActiveValue(txn, r.Root)
+	if err != nil {
+		return nil, err
+	}
+	if !activeValue.Eq(snapSupply.ActiveValue) {
+		mismatches.Inc()
+		l.logger.Errorf("Supply ledger mismatch at height %v: the ledger holds %v but the state trie holds %v", snapshot, snapSupply.ActiveValue, activeValue)
+	}
+	return r, nil
+}
+
+// walk returns a Result pinned to the root of the state trie at height
+func walk(txn *badger.Txn, height uint32, state State) (*Result, error) {
+	root, err := state.GetRootForHeight(txn, height)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{Height: height, Root: root}, nil
+}
+
+// Store writes the Result of Check; height is the current height. The Result
+// is dropped if the state Check walked has changed since: the root of the
+// state trie at r.Height differs after a rollback, and a Seed would leave a
+// gap in the ledger once a later height has been applied. Store should be
+// called while the chain can not move.
+func (l *Ledger) Store(txn *badger.Txn, height uint32, r *Result, state State) error {
+	root, err := state.GetRootForHeight(txn, r.Height)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			return err
+		}
+		return nil
+	}
+	if !bytes.Equal(root, r.Root) {
+		return nil
+	}
+	if r.Seed != nil {
+		if height != r.Height {
+			return nil
+		}
+		if _, err := l.Get(txn, height); err != badger.ErrKeyNotFound {
+			return err
+		}
+		l.logger.Infof("Starting the supply ledger at height %v", height)
+		if err := l.Seed(txn, height, r.Seed.ActiveValue); err != nil {
+			return err
+		}
+		if err := setLastChecked(txn, snapshotBefore(height)); err != nil {
+			return err
+		}
+		return l.prune(txn, snapshotBefore(height))
+	}
+	last, err := getLastChecked(txn)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			return err
+		}
+		last = 0
+	}
+	if r.Height <= last {
+		return nil
+	}
+	checkedHeight.Set(float64(r.Height))
+	if err := setLastChecked(txn, r.Height); err != nil {
+		return err
+	}
+	return l.prune(txn, r.Height)
+}
+
+// prune deletes the supply below height; Check starts from the supply at the
+// last checked height, so nothing before it is read again
+func (l *Ledger) prune(txn *badger.Txn, height uint32) error {
+	keys := [][]byte{}
+	end := makeKey(height)
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	prefix := dbprefix.PrefixSupplyLedgerKey()
+	opts.Prefix = prefix
+	iter := txn.NewIterator(opts)
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		key := iter.Item().KeyCopy(nil)
+		if bytes.Compare(key, end) >= 0 {
+			break
+		}
+		keys = append(keys, key)
+	}
+	iter.Close()
+	for _, key := range keys {
+		if err := utils.DeleteValue(txn, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshotBefore returns the latest snapshot height at or before height
+func snapshotBefore(height uint32) uint32 {
+	return (height / constants.EpochLength) * constants.EpochLength
+}
+
+func (l *Ledger) set(txn *badger.Txn, s *Supply) error {
+	v, err := s.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return utils.SetValue(txn, makeKey(s.Height), v)
+}
+
+func getLastChecked(txn *badger.Txn) (uint32, error) {
+	v, err := utils.GetValue(txn, dbprefix.PrefixSupplyCheckedKey())
+	if err != nil {
+		return 0, err
+	}
+	return utils.UnmarshalUint32(v)
+}
+
+func setLastChecked(txn *badger.Txn, height uint32) error {
+	return utils.SetValue(txn, dbprefix.PrefixSupplyCheckedKey(), utils.MarshalUint32(height))
+}
+
+func makeKey(height uint32) []byte {
+	key := []byte{}
+	key = append(key, dbprefix.PrefixSupplyLedgerKey()...)
+	key = append(key, utils.MarshalUint32(height)...)
+	return key
+}
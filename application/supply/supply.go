@@ -0,0 +1,219 @@
+// Package supply keeps a ledger of how the value held by the UTXO set
+// changes with each height.
+//
+// Value enters the UTXO set when a deposit is consumed. It leaves as fees,
+// as the part of a DataStore deposit which is not refunded when the
+// DataStore is consumed, and as withdrawals. TxFee and Withdrawal UTXOs stay
+// in the state trie but can not be spent, so they are not counted as active
+// value. For every height
+//
+//	prev.ActiveValue + Deposits == ActiveValue + Fees + DataStoreBurned + Withdrawals
+//
+// must hold.
+package supply
+
+import (
+	"fmt"
+
+	"github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+)
+
+const supplyLen = 4 + 7*32
+
+// Supply holds the value accounting of one height
+type Supply struct {
+	Height uint32
+	// Deposits is the value of the deposits consumed at the height
+	Deposits *uint256.Uint256
+	// Fees is the value paid as fees at the height
+	Fees *uint256.Uint256
+	// DataStoreDeposits is the value locked in the DataStores created at
+	// the height
+	DataStoreDeposits *uint256.Uint256
+	// DataStoreRefunds is the value returned by the DataStores consumed at
+	// the height
+	DataStoreRefunds *uint256.Uint256
+	// DataStoreBurned is the value of the DataStores consumed at the height
+	// which was not returned
+	DataStoreBurned *uint256.Uint256
+	// Withdrawals is the value withdrawn to Ethereum at the height
+	Withdrawals *uint256.Uint256
+	// ActiveValue is the value of the spendable UTXOs after the height
+	ActiveValue *uint256.Uint256
+}
+
+// New returns the Supply of a height at which nothing changed, given the
+// active value after it
+func New(height uint32, activeValue *uint256.Uint256) *Supply {
+	return &Supply{
+		Height:            height,
+		Deposits:          uint256.Zero(),
+		Fees:              uint256.Zero(),
+		DataStoreDeposits: uint256.Zero(),
+		DataStoreRefunds:  uint256.Zero(),
+		DataStoreBurned:   uint256.Zero(),
+		Withdrawals:       uint256.Zero(),
+		ActiveValue:       activeValue.Clone(),
+	}
+}
+
+func (s *Supply) values() []*uint256.Uint256 {
+	return []*uint256.Uint256{s.Deposits, s.Fees, s.DataStoreDeposits, s.DataStoreRefunds, s.DataStoreBurned, s.Withdrawals, s.ActiveValue}
+}
+
+// MarshalBinary returns the byte slice for the Supply object
+func (s *Supply) MarshalBinary() ([]byte, error) {
+	if s == nil {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	buf := make([]byte, 0, supplyLen)
+	buf = append(buf, utils.MarshalUint32(s.Height)...)
+	for _, v := range s.values() {
+		vb, err := v.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, vb...)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary takes a byte slice and sets the Supply object
+func (s *Supply) UnmarshalBinary(data []byte) error {
+	if s == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	if len(data) != supplyLen {
+		return errorz.ErrInvalid{}.New("invalid byte length for Supply")
+	}
+	// No error is checked because the slice has length 4
+	s.Height, _ = utils.UnmarshalUint32(data[0:4])
+	s.Deposits = &uint256.Uint256{}
+	s.Fees = &uint256.Uint256{}
+	s.DataStoreDeposits = &uint256.Uint256{}
+	s.DataStoreRefunds = &uint256.Uint256{}
+	s.DataStoreBurned = &uint256.Uint256{}
+	s.Withdrawals = &uint256.Uint256{}
+	s.ActiveValue = &uint256.Uint256{}
+	for i, v := range s.values() {
+		if err := v.UnmarshalBinary(data[4+32*i : 4+32*(i+1)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Check verifies that no value was created or lost between prev, the
+// Supply of the height before, and s
+func (s *Supply) Check(prev *Supply) error {
+	in, err := new(uint256.Uint256).Add(prev.ActiveValue, s.Deposits)
+	if err != nil {
+		return err
+	}
+	out := s.ActiveValue.Clone()
+	for _, v := range []*uint256.Uint256{s.Fees, s.DataStoreBurned, s.Withdrawals} {
+		if _, err := out.Add(out, v); err != nil {
+			return err
+		}
+	}
+	if !in.Eq(out) {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("supply is not conserved at height %v: IN:%v  vs  OUT:%v", s.Height, in, out))
+	}
+	return nil
+}
+
+// ActiveValue returns the value of utxo which counts towards the active
+// value. TxFees and Withdrawals can not be spent and count as zero.
+func ActiveValue(utxo *objs.TXOut) (*uint256.Uint256, error) {
+	if utxo.HasTxFee() || utxo.HasWithdrawal() {
+		return uint256.Zero(), nil
+	}
+	return utxo.Value()
+}
+
+// apply adds the changes made by the txs of the height to s. The consumed
+// UTXOs are the ones referenced by the txs, including the deposits.
+func (s *Supply) apply(txs objs.TxVec, consumed objs.Vout) error {
+	for _, utxo := range consumed {
+		value, err := utxo.Value()
+		if err != nil {
+			return err
+		}
+		if utxo.IsDeposit() {
+			if _, err := s.Deposits.Add(s.Deposits, value); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := s.ActiveValue.Sub(s.ActiveValue, value); err != nil {
+			return err
+		}
+		if !utxo.HasDataStore() {
+			continue
+		}
+		refund, err := utxo.RemainingValue(s.Height)
+		if err != nil {
+			return err
+		}
+		if _, err := s.DataStoreRefunds.Add(s.DataStoreRefunds, refund); err != nil {
+			return err
+		}
+		burned, err := new(uint256.Uint256).Sub(value, refund)
+		if err != nil {
+			return err
+		}
+		if _, err := s.DataStoreBurned.Add(s.DataStoreBurned, burned); err != nil {
+			return err
+		}
+	}
+	generated, err := txs.GeneratedUTXOs()
+	if err != nil {
+		return err
+	}
+	for _, utxo := range generated {
+		if utxo.HasTxFee() {
+			// ValuePlusFee returns the fee of a TxFee
+			fee, err := utxo.ValuePlusFee()
+			if err != nil {
+				return err
+			}
+			if _, err := s.Fees.Add(s.Fees, fee); err != nil {
+				return err
+			}
+			continue
+		}
+		value, err := utxo.Value()
+		if err != nil {
+			return err
+		}
+		valuePlusFee, err := utxo.ValuePlusFee()
+		if err != nil {
+			return err
+		}
+		fee, err := new(uint256.Uint256).Sub(valuePlusFee, value)
+		if err != nil {
+			return err
+		}
+		if _, err := s.Fees.Add(s.Fees, fee); err != nil {
+			return err
+		}
+		switch {
+		case utxo.HasWithdrawal():
+			_, err = s.Withdrawals.Add(s.Withdrawals, value)
+		case utxo.HasDataStore():
+			if _, err = s.DataStoreDeposits.Add(s.DataStoreDeposits, value); err != nil {
+				return err
+			}
+			_, err = s.ActiveValue.Add(s.ActiveValue, value)
+		default:
+			_, err = s.ActiveValue.Add(s.ActiveValue, value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
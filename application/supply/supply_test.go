@@ -0,0 +1,396 @@
+package supply
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+const testingChainID uint32 = 42
+
+func u256(t *testing.T, v uint64) *uint256.Uint256 {
+	t.Helper()
+	u, err := new(uint256.Uint256).FromUint64(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+func makeVS(t *testing.T, value, fee *uint256.Uint256, txOutIdx uint32, txHash []byte) *objs.TXOut {
+	t.Helper()
+	owner := &objs.ValueStoreOwner{}
+	owner.New(crypto.Hasher([]byte("owner"))[:20], constants.CurveSecp256k1)
+	utxo := &objs.TXOut{}
+	err := utxo.NewValueStore(&objs.ValueStore{
+		VSPreImage: &objs.VSPreImage{
+			ChainID:  testingChainID,
+			Value:    value,
+			TXOutIdx: txOutIdx,
+			Owner:    owner,
+			Fee:      fee,
+		},
+		TxHash: txHash,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return utxo
+}
+
+func makeDeposit(t *testing.T, value *uint256.Uint256) *objs.TXOut {
+	t.Helper()
+	return makeVS(t, value, uint256.Zero(), constants.MaxUint32, make([]byte, constants.HashLen))
+}
+
+func makeDS(t *testing.T, deposit, fee *uint256.Uint256, rawData []byte, issuedAt uint32, txHash []byte) *objs.TXOut {
+	t.Helper()
+	owner := &objs.DataStoreOwner{}
+	owner.New(crypto.Hasher([]byte("owner"))[:20], constants.CurveSecp256k1)
+	utxo := &objs.TXOut{}
+	err := utxo.NewDataStore(&objs.DataStore{
+		DSLinker: &objs.DSLinker{
+			DSPreImage: &objs.DSPreImage{
+				ChainID:  testingChainID,
+				Index:    crypto.Hasher([]byte("index")),
+				IssuedAt: issuedAt,
+				Deposit:  deposit,
+				RawData:  rawData,
+				TXOutIdx: 1,
+				Owner:    owner,
+				Fee:      fee,
+			},
+			TxHash: txHash,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return utxo
+}
+
+func makeTxFee(t *testing.T, fee *uint256.Uint256, txOutIdx uint32) *objs.TXOut {
+	t.Helper()
+	utxo := &objs.TXOut{}
+	err := utxo.NewTxFee(&objs.TxFee{
+		TFPreImage: &objs.TFPreImage{
+			ChainID:  testingChainID,
+			TXOutIdx: txOutIdx,
+			Fee:      fee,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return utxo
+}
+
+// testChain returns the txs and consumed UTXOs of two heights. The first
+// consumes a deposit to create a ValueStore and a DataStore; the second,
+// in a later epoch, consumes the DataStore.
+func testChain(t *testing.T) ([]objs.TxVec, []objs.Vout, []uint32) {
+	rawData := make([]byte, 64)
+	dsDeposit, err := objs.BaseDepositEquation(uint32(len(rawData)), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 1000 = 900 + 10 + dsDeposit + 5 + 7
+	depositValue := u256(t, 922)
+	if _, err := depositValue.Add(depositValue, dsDeposit); err != nil {
+		t.Fatal(err)
+	}
+	txHash1 := crypto.Hasher([]byte("tx1"))
+	ds := makeDS(t, dsDeposit, u256(t, 5), rawData, 1, txHash1)
+	tx1 := &objs.Tx{
+		Vout: objs.Vout{
+			makeVS(t, u256(t, 900), u256(t, 10), 0, txHash1),
+			ds,
+			makeTxFee(t, u256(t, 7), 2),
+		},
+	}
+	height2 := 3*constants.EpochLength + 1
+	refund, err := ds.RemainingValue(height2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	refundOut, err := new(uint256.Uint256).Sub(refund, u256(t, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx2 := &objs.Tx{
+		Vout: objs.Vout{makeVS(t, refundOut, u256(t, 3), 0, crypto.Hasher([]byte("tx2")))},
+	}
+	txs := []objs.TxVec{{tx1}, {tx2}}
+	consumed := []objs.Vout{{makeDeposit(t, depositValue)}, {ds}}
+	return txs, consumed, []uint32{1, height2}
+}
+
+func supplyEqual(t *testing.T, a, b *Supply) {
+	t.Helper()
+	if a.Height != b.Height {
+		t.Fatalf("Height does not match: %v %v", a.Height, b.Height)
+	}
+	av, bv := a.values(), b.values()
+	for i := range av {
+		if !av[i].Eq(bv[i]) {
+			t.Fatalf("Value %v does not match: %v %v", i, av[i], bv[i])
+		}
+	}
+}
+
+func TestSupplyMarshal(t *testing.T) {
+	s := New(7, u256(t, 1000))
+	s.Deposits = u256(t, 1)
+	s.Fees = u256(t, 2)
+	s.DataStoreDeposits = u256(t, 3)
+	s.DataStoreRefunds = u256(t, 4)
+	s.DataStoreBurned = u256(t, 5)
+	s.Withdrawals = u256(t, 6)
+	data, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2 := &Supply{}
+	if err := s2.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	supplyEqual(t, s, s2)
+	if err := s2.UnmarshalBinary(data[1:]); err == nil {
+		t.Fatal("Should have raised error")
+	}
+}
+
+func TestSupplyApply(t *testing.T) {
+	txs, consumed, heights := testChain(t)
+
+	s1 := New(heights[0], uint256.Zero())
+	if err := s1.apply(txs[0], consumed[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := s1.Check(New(0, uint256.Zero())); err != nil {
+		t.Fatal(err)
+	}
+	dsValue, _ := txs[0][0].Vout[1].Value()
+	activeValue, _ := new(uint256.Uint256).Add(u256(t, 900), dsValue)
+	if !s1.Fees.Eq(u256(t, 22)) || !s1.DataStoreDeposits.Eq(dsValue) || !s1.ActiveValue.Eq(activeValue) {
+		t.Fatalf("Wrong supply: %+v", s1)
+	}
+
+	s2 := New(heights[1], s1.ActiveValue)
+	if err := s2.apply(txs[1], consumed[1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := s2.Check(s1); err != nil {
+		t.Fatal(err)
+	}
+	if !s2.Deposits.IsZero() || !s2.Fees.Eq(u256(t, 3)) || s2.DataStoreBurned.IsZero() {
+		t.Fatalf("Wrong supply: %+v", s2)
+	}
+	burned, _ := new(uint256.Uint256).Sub(dsValue, s2.DataStoreRefunds)
+	if !burned.Eq(s2.DataStoreBurned) {
+		t.Fatalf("Wrong burned value: %v %v", burned, s2.DataStoreBurned)
+	}
+
+	// value which appears from nowhere breaks the invariant
+	s2.ActiveValue.Add(s2.ActiveValue, u256(t, 1))
+	if err := s2.Check(s1); err == nil {
+		t.Fatal("Should have raised error")
+	}
+}
+
+// mockState is a state trie which holds active value at every height. The
+// root at a height is the hash of the height and version; a state with
+// another version stands for the state after a rollback.
+type mockState struct {
+	version uint32
+	active  *uint256.Uint256
+}
+
+func (ms *mockState) GetRootForHeight(txn *badger.Txn, height uint32) ([]byte, error) {
+	return crypto.Hasher(utils.MarshalUint32(height), utils.MarshalUint32(ms.version)), nil
+}
+
+func (ms *mockState) ActiveValue(txn *badger.Txn, root []byte) (*uint256.Uint256, error) {
+	return ms.active.Clone(), nil
+}
+
+func checkLedger(t *testing.T, txn *badger.Txn, ledger *Ledger, height uint32, state State) {
+	t.Helper()
+	r, err := ledger.Check(txn, height, state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r == nil {
+		return
+	}
+	if err := ledger.Store(txn, height, r, state); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLedger(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	db, err := badger.Open(badger.DefaultOptions(dir).WithLogger(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	txs, consumed, heights := testChain(t)
+	ledger := NewLedger()
+	state := &mockState{}
+	err = db.Update(func(txn *badger.Txn) error {
+		for h := uint32(1); h <= heights[1]; h++ {
+			var hTxs objs.TxVec
+			var hConsumed objs.Vout
+			for i := range heights {
+				if heights[i] == h {
+					hTxs, hConsumed = txs[i], consumed[i]
+				}
+			}
+			if err := ledger.Record(txn, h, hTxs, hConsumed); err != nil {
+				t.Fatal(err)
+			}
+		}
+		last, err := ledger.Get(txn, heights[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		snap, err := ledger.Get(txn, 3*constants.EpochLength)
+		if err != nil {
+			t.Fatal(err)
+		}
+		first, err := ledger.Get(txn, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !snap.ActiveValue.Eq(first.ActiveValue) {
+			t.Fatalf("Active value changed without txs: %v %v", first.ActiveValue, snap.ActiveValue)
+		}
+
+		// a result is dropped if the state changed after the check
+		state.active = snap.ActiveValue
+		before := testutil.ToFloat64(mismatches)
+		r, err := ledger.Check(txn, heights[1], state)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r == nil || r.Height != 3*constants.EpochLength || r.Seed != nil {
+			t.Fatalf("Wrong result: %+v", r)
+		}
+		if err := ledger.Store(txn, heights[1], r, &mockState{version: 1}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := getLastChecked(txn); err != badger.ErrKeyNotFound {
+			t.Fatalf("Should have raised ErrKeyNotFound: %v", err)
+		}
+
+		// the trie agrees with the ledger
+		checkLedger(t, txn, ledger, heights[1], state)
+		if testutil.ToFloat64(mismatches) != before {
+			t.Fatal("Should not have found a mismatch")
+		}
+		checked, err := getLastChecked(txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if checked != 3*constants.EpochLength {
+			t.Fatalf("Wrong checked height: %v", checked)
+		}
+
+		// the supply below the checked height is pruned
+		if _, err := ledger.Get(txn, 1); err != badger.ErrKeyNotFound {
+			t.Fatalf("Should have raised ErrKeyNotFound: %v", err)
+		}
+		if _, err := ledger.Get(txn, 3*constants.EpochLength-1); err != badger.ErrKeyNotFound {
+			t.Fatalf("Should have raised ErrKeyNotFound: %v", err)
+		}
+		if _, err := ledger.Get(txn, 3*constants.EpochLength); err != nil {
+			t.Fatal(err)
+		}
+
+		// rolling back the height removes it
+		if err := ledger.Rollback(txn, heights[1]); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ledger.Get(txn, heights[1]); err != badger.ErrKeyNotFound {
+			t.Fatalf("Should have raised ErrKeyNotFound: %v", err)
+		}
+		if err := ledger.Record(txn, heights[1], txs[1], consumed[1]); err != nil {
+			t.Fatal(err)
+		}
+		again, err := ledger.Get(txn, heights[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		supplyEqual(t, last, again)
+
+		// a height after a gap is not recorded
+		if err := ledger.Record(txn, heights[1]+2, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ledger.Get(txn, heights[1]+2); err != badger.ErrKeyNotFound {
+			t.Fatalf("Should have raised ErrKeyNotFound: %v", err)
+		}
+
+		// the ledger is not seeded once a later height was applied
+		seedHeight := 4*constants.EpochLength + 5
+		state.active = u256(t, 12345)
+		r, err = ledger.Check(txn, seedHeight, state)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r == nil || r.Seed == nil {
+			t.Fatalf("Wrong result: %+v", r)
+		}
+		if err := ledger.Store(txn, seedHeight+1, r, state); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ledger.Get(txn, seedHeight); err != badger.ErrKeyNotFound {
+			t.Fatalf("Should have raised ErrKeyNotFound: %v", err)
+		}
+
+		// the ledger is seeded where it has no entry
+		checkLedger(t, txn, ledger, seedHeight, state)
+		seeded, err := ledger.Get(txn, seedHeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !seeded.ActiveValue.Eq(state.active) {
+			t.Fatalf("Wrong seeded value: %v", seeded.ActiveValue)
+		}
+		for h := seedHeight + 1; h <= 5*constants.EpochLength; h++ {
+			if err := ledger.Record(txn, h, nil, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// the trie disagrees with the ledger
+		state.active = u256(t, 1)
+		before = testutil.ToFloat64(mismatches)
+		checkLedger(t, txn, ledger, 5*constants.EpochLength, state)
+		if testutil.ToFloat64(mismatches) != before+1 {
+			t.Fatal("Should have found a mismatch")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
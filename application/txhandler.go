@@ -12,6 +12,7 @@ import (
 	"github.com/MadBase/MadNet/application/objs"
 	"github.com/MadBase/MadNet/application/objs/uint256"
 	"github.com/MadBase/MadNet/application/pendingtx"
+	"github.com/MadBase/MadNet/application/supply"
 	"github.com/MadBase/MadNet/application/utxohandler"
 	"github.com/MadBase/MadNet/application/wrapper"
 	trie "github.com/MadBase/MadNet/badgerTrie"
@@ -32,6 +33,7 @@ type txHandler struct {
 	mTxHdlr *minedtx.MinedTxHandler
 	dHdlr   *deposit.Handler
 	uHdlr   *utxohandler.UTXOHandler
+	sLdgr   *supply.Ledger
 	storage *wrapper.Storage
 }
 
@@ -72,6 +74,10 @@ func (tm *txHandler) ApplyState(txn *badger.Txn, chainID uint32, height uint32,
 			utils.DebugTrace(tm.logger, err)
 			return nil, err
 		}
+		if err := tm.sLdgr.Record(txn, height, nil, nil); err != nil {
+			utils.DebugTrace(tm.logger, err)
+			return nil, err
+		}
 		return hsh, nil
 	}
 	txs := objs.TxVec(tx)
@@ -93,6 +99,10 @@ func (tm *txHandler) ApplyState(txn *badger.Txn, chainID uint32, height uint32,
 		utils.DebugTrace(tm.logger, err)
 		return nil, err
 	}
+	if err := tm.sLdgr.Record(txn, height, txs, vout); err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
 	if err := tm.mTxHdlr.Add(txn, height, txs); err != nil {
 		utils.DebugTrace(tm.logger, err)
 		return nil, err
@@ -109,6 +119,31 @@ func (tm *txHandler) ApplyState(txn *badger.Txn, chainID uint32, height uint32,
 	return rootHash, nil
 }
 
+func (tm *txHandler) RollbackState(txn *badger.Txn, height uint32, txHashes [][]byte) ([]byte, error) {
+	txs, missing, err := tm.mTxHdlr.Get(txn, txHashes)
+	if err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	if len(missing) > 0 {
+		return nil, errorz.ErrInvalid{}.New("mined txs of the height are missing")
+	}
+	rootHash, err := tm.uHdlr.RollbackState(txn, txs, height)
+	if err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	if err := tm.mTxHdlr.Delete(txn, txHashes); err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	if err := tm.sLdgr.Rollback(txn, height); err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	return rootHash, nil
+}
+
 func (tm *txHandler) GetTxsForProposal(txn *badger.Txn, chainID uint32, height uint32, curveSpec constants.CurveSpec, signer objs.Signer, maxBytes uint32) (objs.TxVec, []byte, error) {
 	ctx := context.Background()
 	subCtx, cf := context.WithTimeout(ctx, 1*time.Second)
@@ -196,36 +231,19 @@ func (tm *txHandler) GetStateRootForProposal(txn *badger.Txn, tx []*objs.Tx) ([]
 
 func (tm *txHandler) PendingTxAdd(txn *badger.Txn, chainID uint32, height uint32, tx []*objs.Tx) error {
 	txs := objs.TxVec(tx)
-	if err := txs.PreValidatePending(chainID); err != nil {
-		utils.DebugTrace(tm.logger, err)
-		return err
-	}
-	txHashes, err := txs.TxHash()
+	missing, err := tm.validatePending(txn, chainID, height, txs)
 	if err != nil {
-		utils.DebugTrace(tm.logger, err)
 		return err
 	}
-	missing, err := tm.pTxHdlr.Contains(txn, height, txHashes)
+	txHashes, err := txs.TxHash()
 	if err != nil {
 		utils.DebugTrace(tm.logger, err)
 		return err
 	}
-	if len(missing) == 0 {
-		return errorz.ErrInvalid{}.New("duplicate")
-	}
 	missingMap := make(map[string]int)
 	for i := 0; i < len(txHashes); i++ {
 		missingMap[string(txHashes[i])] = i
 	}
-	consumedUTXOs, err := tm.IsValid(txn, tx, height)
-	if err != nil {
-		utils.DebugTrace(tm.logger, err)
-		return err
-	}
-	if err := txs.PostValidatePending(height, consumedUTXOs); err != nil {
-		utils.DebugTrace(tm.logger, err)
-		return err
-	}
 	for i := 0; i < len(missing); i++ {
 		idx := missingMap[string(missing[i])]
 		txb, err := txs[idx].MarshalBinary()
@@ -245,6 +263,54 @@ func (tm *txHandler) PendingTxAdd(txn *badger.Txn, chainID uint32, height uint32
 	return nil
 }
 
+// validatePending runs the checks txs must pass before they are added to the
+// pending tx pool. The hashes of the txs which are not pending yet are
+// returned; txs which are all pending already are rejected as duplicates.
+func (tm *txHandler) validatePending(txn *badger.Txn, chainID uint32, height uint32, txs objs.TxVec) ([][]byte, error) {
+	if err := txs.PreValidatePending(chainID); err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	if err := txs.ValidateWithdrawals(height, tm.storage); err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	if err := txs.ValidateTimelocks(height, tm.storage); err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	txHashes, err := txs.TxHash()
+	if err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	missing, err := tm.pTxHdlr.Contains(txn, height, txHashes)
+	if err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	if len(missing) == 0 {
+		return nil, errorz.ErrInvalid{}.New("duplicate")
+	}
+	consumedUTXOs, err := tm.IsValid(txn, txs, height)
+	if err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	if err := txs.PostValidatePending(height, consumedUTXOs); err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	return missing, nil
+}
+
+func (tm *txHandler) PendingTxDrop(txHashes [][]byte) error {
+	if len(txHashes) == 0 {
+		return tm.pTxHdlr.Drop()
+	}
+	return tm.pTxHdlr.Delete(nil, txHashes)
+}
+
 func (tm *txHandler) MinedTxGet(txn *badger.Txn, txHash [][]byte) ([]*objs.Tx, [][]byte, error) {
 	return tm.mTxHdlr.Get(txn, txHash)
 }
@@ -265,7 +331,7 @@ func (tm *txHandler) UTXOGetData(txn *badger.Txn, owner *objs.Owner, dataIdx []b
 	return tm.uHdlr.GetData(txn, owner, dataIdx)
 }
 
-func (tm *txHandler) GetValueForOwner(txn *badger.Txn, owner *objs.Owner, minValue *uint256.Uint256, pt *objs.PaginationToken) ([][]byte, *uint256.Uint256, *objs.PaginationToken, error) {
+func (tm *txHandler) GetValueForOwner(txn *badger.Txn, owner *objs.Owner, minValue *uint256.Uint256, currentHeight uint32, pt *objs.PaginationToken) ([][]byte, *uint256.Uint256, *objs.PaginationToken, error) {
 	const maxCount = 256
 	allIds := [][]byte{}
 
@@ -283,7 +349,9 @@ func (tm *txHandler) GetValueForOwner(txn *badger.Txn, owner *objs.Owner, minVal
 		retrieve func(*badger.Txn, *objs.Owner, *uint256.Uint256, int, []byte) ([][]byte, *uint256.Uint256, []byte, error)
 		lpType   objs.LastPaginatedType
 	}{
-		{tm.uHdlr.GetValueForOwner, objs.LastPaginatedUtxo},
+		{func(txn *badger.Txn, owner *objs.Owner, minValue *uint256.Uint256, maxCount int, lastKey []byte) ([][]byte, *uint256.Uint256, []byte, error) {
+			return tm.uHdlr.GetValueForOwner(txn, owner, minValue, currentHeight, maxCount, lastKey)
+		}, objs.LastPaginatedUtxo},
 		{tm.dHdlr.GetValueForOwner, objs.LastPaginatedDeposit},
 	}
 
@@ -379,16 +447,78 @@ func (tm *txHandler) PaginateDataByOwner(txn *badger.Txn, owner *objs.Owner, hei
 	return tm.uHdlr.PaginateDataByOwner(txn, owner, height, numItems, startIndex)
 }
 
+func (tm *txHandler) GetWithdrawalProof(txn *badger.Txn, utxoID []byte, stateRoot []byte) (*objs.TXOut, *consensusdb.MerkleProof, error) {
+	return tm.uHdlr.GetWithdrawalProof(txn, utxoID, stateRoot)
+}
+
+func (tm *txHandler) GetWithdrawalsForAccount(txn *badger.Txn, account []byte, maxCount int, startKey []byte) ([][]byte, []byte, error) {
+	return tm.uHdlr.GetWithdrawalsForAccount(txn, account, maxCount, startKey)
+}
+
 func (tm *txHandler) GetHeightForTx(txn *badger.Txn, txHash []byte) (uint32, error) {
 	return tm.mTxHdlr.GetHeightForTx(txn, txHash)
 }
 
+func (tm *txHandler) GetSpendingTx(txn *badger.Txn, utxoID []byte) ([]byte, uint32, error) {
+	return tm.mTxHdlr.GetSpendingTx(txn, utxoID)
+}
+
+func (tm *txHandler) GetSupply(txn *badger.Txn, height uint32) (*supply.Supply, error) {
+	return tm.sLdgr.Get(txn, height)
+}
+
+func (tm *txHandler) CheckSupply(txn *badger.Txn, height uint32) (*supply.Result, error) {
+	return tm.sLdgr.Check(txn, height, tm)
+}
+
+func (tm *txHandler) StoreSupplyCheck(txn *badger.Txn, height uint32, r *supply.Result) error {
+	return tm.sLdgr.Store(txn, height, r, tm)
+}
+
+// GetRootForHeight implements supply.State
+func (tm *txHandler) GetRootForHeight(txn *badger.Txn, height uint32) ([]byte, error) {
+	return tm.uHdlr.GetRootForHeight(txn, height)
+}
+
+// ActiveValue implements supply.State
+func (tm *txHandler) ActiveValue(txn *badger.Txn, root []byte) (*uint256.Uint256, error) {
+	total := uint256.Zero()
+	err := tm.uHdlr.WalkState(txn, root, func(utxoID []byte, utxo *objs.TXOut) error {
+		if utxo == nil {
+			_, _, spent, err := tm.dHdlr.Get(txn, [][]byte{utxoID})
+			if err != nil {
+				return err
+			}
+			if len(spent) == 0 {
+				return errorz.ErrInvalid{}.New("state trie holds an unknown utxoID")
+			}
+			return nil
+		}
+		value, err := supply.ActiveValue(utxo)
+		if err != nil {
+			return err
+		}
+		_, err = total.Add(total, value)
+		return err
+	})
+	if err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	return total, nil
+}
+
 func (tm *txHandler) StoreSnapShotNode(txn *badger.Txn, batch []byte, root []byte, layer int) ([][]byte, int, []trie.LeafNode, error) {
 	return tm.uHdlr.StoreSnapShotNode(txn, batch, root, layer)
 }
 
 func (tm *txHandler) FinalizeSnapShotRoot(txn *badger.Txn, root []byte, height uint32) error {
-	return tm.uHdlr.FinalizeSnapShotRoot(txn, root, height)
+	if err := tm.uHdlr.FinalizeSnapShotRoot(txn, root, height); err != nil {
+		return err
+	}
+	// the txs up to the snapshot are not downloaded, so the spent-by index
+	// starts after it
+	return tm.mTxHdlr.SetSpendingTxStart(txn, height+1)
 }
 
 func (tm *txHandler) GetSnapShotNode(txn *badger.Txn, height uint32, key []byte) ([]byte, error) {
@@ -419,6 +549,15 @@ func (tm *txHandler) BeginSnapShotSync(txn *badger.Txn) error {
 	if err := tm.db.DropPrefix(dbprefix.PrefixMinedTxIndexKey()); err != nil {
 		return err
 	}
+	if err := tm.db.DropPrefix(dbprefix.PrefixMinedTxSpentByKey()); err != nil {
+		return err
+	}
+	if err := tm.db.DropPrefix(dbprefix.PrefixSupplyLedgerKey()); err != nil {
+		return err
+	}
+	if err := tm.db.DropPrefix(dbprefix.PrefixSupplyCheckedKey()); err != nil {
+		return err
+	}
 	if err := tm.db.DropPrefix(dbprefix.PrefixMinedUTXO()); err != nil {
 		return err
 	}
@@ -440,5 +579,8 @@ func (tm *txHandler) BeginSnapShotSync(txn *badger.Txn) error {
 	if err := tm.db.DropPrefix(dbprefix.PrefixMinedUTXOValueKey()); err != nil {
 		return err
 	}
+	if err := tm.db.DropPrefix(dbprefix.PrefixMinedUTXOTimelockKey()); err != nil {
+		return err
+	}
 	return nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/MadBase/MadNet/application/utxohandler/utxotrie"
 	"github.com/MadBase/MadNet/application/wrapper"
 	trie "github.com/MadBase/MadNet/badgerTrie"
+	consensusdb "github.com/MadBase/MadNet/consensus/db"
 	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/crypto"
 	"github.com/MadBase/MadNet/logging"
@@ -39,6 +40,8 @@ func NewUTXOHandler(dB *badger.DB) *UTXOHandler {
 		expIndex:   indexer.NewExpSizeIndex(dbprefix.PrefixMinedUTXOEpcKey, dbprefix.PrefixMinedUTXOEpcRefKey),
 		dataIndex:  indexer.NewDataIndex(dbprefix.PrefixMinedUTXODataKey, dbprefix.PrefixMinedUTXODataRefKey),
 		valueIndex: indexer.NewValueIndex(dbprefix.PrefixMinedUTXOValueKey, dbprefix.PrefixMinedUTXOValueRefKey),
+		lockIndex:  indexer.NewTimelockIndex(dbprefix.PrefixMinedUTXOTimelockKey),
+		wdIndex:    indexer.NewWithdrawalIndex(dbprefix.PrefixMinedUTXOWithdrawalKey, dbprefix.PrefixMinedUTXOWithdrawalRefKey),
 		db:         dB,
 	}
 }
@@ -52,6 +55,8 @@ type UTXOHandler struct {
 	expIndex   *indexer.ExpSizeIndex
 	dataIndex  *indexer.DataIndex
 	valueIndex *indexer.ValueIndex
+	lockIndex  *indexer.TimelockIndex
+	wdIndex    *indexer.WithdrawalIndex
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -96,13 +101,8 @@ func (ut *UTXOHandler) IsValid(txn *badger.Txn, txs objs.TxVec, currentHeight ui
 	// datastore index as an output, it must either not already exist
 	// OR it must be consumed in the same transaction
 	var tx *objs.Tx
-	var utxo *objs.TXOut
-	var key []byte
 	for i := 0; i < len(txs); i++ {
 		tx = txs[i]
-		knownIndexes := make(map[string]bool)
-		outputIndexes := make(map[string]bool)
-		inputIndexes := make(map[string]bool)
 		consumedUTXOIDs, err := objs.TxVec([]*objs.Tx{tx}).ConsumedUTXOIDNoDeposits()
 		if err != nil {
 			utils.DebugTrace(ut.logger, err)
@@ -137,81 +137,13 @@ func (ut *UTXOHandler) IsValid(txn *badger.Txn, txs objs.TxVec, currentHeight ui
 			utils.DebugTrace(ut.logger, err)
 			return nil, err
 		}
-		for j := 0; j < len(utxos); j++ {
-			utxo = utxos[j]
-			if utxo.HasDataStore() {
-				owner, err := utxo.GenericOwner()
-				if err != nil {
-					utils.DebugTrace(ut.logger, err)
-					return nil, err
-				}
-				ownerBytes, err := owner.MarshalBinary()
-				if err != nil {
-					utils.DebugTrace(ut.logger, err)
-					return nil, err
-				}
-				ds, err := utxo.DataStore()
-				if err != nil {
-					utils.DebugTrace(ut.logger, err)
-					return nil, err
-				}
-				index, err := ds.Index()
-				if err != nil {
-					utils.DebugTrace(ut.logger, err)
-					return nil, err
-				}
-				key = []byte{}
-				key = append(key, utils.CopySlice(ownerBytes)...)
-				key = append(key, utils.CopySlice(index)...)
-				inputIndexes[string(key)] = true
-			}
-		}
-		for j := 0; j < len(tx.Vout); j++ {
-			utxo = tx.Vout[j]
-			if utxo.HasDataStore() {
-				owner, err := utxo.GenericOwner()
-				if err != nil {
-					utils.DebugTrace(ut.logger, err)
-					return nil, err
-				}
-				ownerBytes, err := owner.MarshalBinary()
-				if err != nil {
-					utils.DebugTrace(ut.logger, err)
-					return nil, err
-				}
-				ds, err := utxo.DataStore()
-				if err != nil {
-					utils.DebugTrace(ut.logger, err)
-					return nil, err
-				}
-				index, err := ds.Index()
-				if err != nil {
-					utils.DebugTrace(ut.logger, err)
-					return nil, err
-				}
-				key = []byte{}
-				key = append(key, utils.CopySlice(ownerBytes)...)
-				key = append(key, utils.CopySlice(index)...)
-				outputIndexes[string(key)] = true
-				ok, err := ut.dataIndex.Contains(txn, owner, utils.CopySlice(index))
-				if err != nil {
-					utils.DebugTrace(ut.logger, err)
-					return nil, err
-				}
-				if ok {
-					knownIndexes[string(key)] = true
-				}
-			}
+		colliding, err := ut.CollidingDataStores(txn, tx, utxos)
+		if err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return nil, err
 		}
-		// if we are generating a utxo with a data index and that index already
-		// exists, we must also be consuming the utxo with that index in the same
-		// transaction.
-		for k := range outputIndexes {
-			if knownIndexes[k] {
-				if !inputIndexes[k] {
-					return nil, errorz.ErrInvalid{}.New("duplicate datastore index")
-				}
-			}
+		if len(colliding) > 0 {
+			return nil, errorz.ErrInvalid{}.New("duplicate datastore index")
 		}
 	}
 
@@ -279,6 +211,72 @@ func (ut *UTXOHandler) IsValid(txn *badger.Txn, txs objs.TxVec, currentHeight ui
 	return utxos, nil
 }
 
+// CollidingDataStores returns the positions in tx.Vout of the DataStores whose
+// owner and index are already in use by a DataStore which tx does not
+// consume. consumed holds the objects consumed by tx.
+func (ut *UTXOHandler) CollidingDataStores(txn *badger.Txn, tx *objs.Tx, consumed objs.Vout) ([]int, error) {
+	inputIndexes := make(map[string]bool)
+	for j := 0; j < len(consumed); j++ {
+		if !consumed[j].HasDataStore() {
+			continue
+		}
+		key, _, _, err := dataStoreKey(consumed[j])
+		if err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return nil, err
+		}
+		inputIndexes[string(key)] = true
+	}
+	colliding := []int{}
+	for j := 0; j < len(tx.Vout); j++ {
+		if !tx.Vout[j].HasDataStore() {
+			continue
+		}
+		key, owner, index, err := dataStoreKey(tx.Vout[j])
+		if err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return nil, err
+		}
+		if inputIndexes[string(key)] {
+			continue
+		}
+		ok, err := ut.dataIndex.Contains(txn, owner, index)
+		if err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return nil, err
+		}
+		if ok {
+			colliding = append(colliding, j)
+		}
+	}
+	return colliding, nil
+}
+
+// dataStoreKey returns the owner and index of the DataStore in utxo along
+// with their concatenation, which identifies the DataStore in the data index
+func dataStoreKey(utxo *objs.TXOut) ([]byte, *objs.Owner, []byte, error) {
+	owner, err := utxo.GenericOwner()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ownerBytes, err := owner.MarshalBinary()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ds, err := utxo.DataStore()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	index, err := ds.Index()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	key := []byte{}
+	key = append(key, utils.CopySlice(ownerBytes)...)
+	key = append(key, utils.CopySlice(index)...)
+	return key, owner, utils.CopySlice(index), nil
+}
+
 // ApplyState will update the state trie with the given proposal data.
 // Consumed UTXOs will be deleted from the trie.
 // New UTXOs will be added to the trie.
@@ -326,6 +324,55 @@ func (ut *UTXOHandler) ApplyState(txn *badger.Txn, txs objs.TxVec, height uint32
 	return stateRoot, nil
 }
 
+// RollbackState undoes ApplyState for the txs of the most recent height.
+// Generated UTXOs are deleted and consumed UTXOs are indexed again. Consumed
+// UTXOs are never deleted from storage by ApplyState, so they can always be
+// restored. The state root of the height before is returned.
+func (ut *UTXOHandler) RollbackState(txn *badger.Txn, txs objs.TxVec, height uint32) ([]byte, error) {
+	stateRoot, err := ut.trie.RollbackState(txn, height)
+	if err != nil {
+		utils.DebugTrace(ut.logger, err)
+		return nil, err
+	}
+	if len(txs) > 0 {
+		newUTXOIDs, err := txs.GeneratedUTXOID()
+		if err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return nil, err
+		}
+		for _, utxoID := range newUTXOIDs {
+			if err := ut.dropFromIndexes(txn, utils.CopySlice(utxoID)); err != nil {
+				utils.DebugTrace(ut.logger, err)
+				return nil, err
+			}
+			if err := utils.DeleteValue(txn, ut.makeUTXOKey(utxoID)); err != nil {
+				utils.DebugTrace(ut.logger, err)
+				return nil, err
+			}
+		}
+		consumedUTXOIDs, err := txs.ConsumedUTXOIDNoDeposits()
+		if err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return nil, err
+		}
+		for _, utxoID := range consumedUTXOIDs {
+			utxo, err := ut.getInternal(txn, utils.CopySlice(utxoID))
+			if err != nil {
+				if err == badger.ErrKeyNotFound {
+					return nil, errorz.ErrInvalid{}.New("missing consumed utxo for utxoID")
+				}
+				utils.DebugTrace(ut.logger, err)
+				return nil, err
+			}
+			if err := ut.addToIndexes(txn, utils.CopySlice(utxoID), utxo); err != nil {
+				utils.DebugTrace(ut.logger, err)
+				return nil, err
+			}
+		}
+	}
+	return stateRoot, nil
+}
+
 // GetStateRootForProposal allows a new stateRoot to be calculated for a
 // proposal without committing the changes to the trie.
 func (ut *UTXOHandler) GetStateRootForProposal(txn *badger.Txn, txs objs.TxVec) ([]byte, error) {
@@ -509,10 +556,14 @@ func (ut *UTXOHandler) GetExpiredForProposal(txn *badger.Txn, ctx context.Contex
 
 // GetValueForOwner allows a list of utxoIDs to be returned that are equal or
 // greater than the value passed as minValue, and are owned by owner.
-func (ut *UTXOHandler) GetValueForOwner(txn *badger.Txn, owner *objs.Owner, minValue *uint256.Uint256, maxCount int, startKey []byte) ([][]byte, *uint256.Uint256, []byte, error) {
+// UTXOs which are timelocked at currentHeight are excluded.
+func (ut *UTXOHandler) GetValueForOwner(txn *badger.Txn, owner *objs.Owner, minValue *uint256.Uint256, currentHeight uint32, maxCount int, startKey []byte) ([][]byte, *uint256.Uint256, []byte, error) {
 	// This function operates under the assumption that the valueIndex and the trie are always in sync
 	// If you make any change breaking this assumption, the results must be checked against the trie
-	return ut.valueIndex.GetValueForOwner(txn, owner, minValue, nil, maxCount, startKey)
+	excludeLocked := func(utxoID []byte) (bool, error) {
+		return ut.lockIndex.IsLocked(txn, utils.CopySlice(utxoID), utils.Epoch(currentHeight))
+	}
+	return ut.valueIndex.GetValueForOwner(txn, owner, minValue, excludeLocked, maxCount, startKey)
 }
 
 // PaginateDataByOwner ...
@@ -566,6 +617,62 @@ func (ut *UTXOHandler) PaginateDataByOwner(txn *badger.Txn, owner *objs.Owner, c
 	}
 }
 
+// GetWithdrawalsForAccount returns up to maxCount utxoIDs of the withdrawals
+// to the Ethereum address account. If more remain, the key to continue
+// the lookup from is returned as well.
+func (ut *UTXOHandler) GetWithdrawalsForAccount(txn *badger.Txn, account []byte, maxCount int, startKey []byte) ([][]byte, []byte, error) {
+	return ut.wdIndex.GetUTXOIDs(txn, account, maxCount, startKey)
+}
+
+// GetWithdrawalProof returns the Withdrawal with utxoID and a proof of its
+// inclusion in the UTXO trie with root stateRoot. An error is returned if
+// the Withdrawal is not included under stateRoot.
+func (ut *UTXOHandler) GetWithdrawalProof(txn *badger.Txn, utxoID []byte, stateRoot []byte) (*objs.TXOut, *consensusdb.MerkleProof, error) {
+	utxo, err := ut.getInternal(txn, utxoID)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, nil, errorz.ErrInvalid{}.New("unknown withdrawal")
+		}
+		utils.DebugTrace(ut.logger, err)
+		return nil, nil, err
+	}
+	if !utxo.HasWithdrawal() {
+		return nil, nil, errorz.ErrInvalid{}.New("utxo is not a withdrawal")
+	}
+	proof, err := ut.trie.GetProof(txn, stateRoot, utxoID)
+	if err != nil {
+		utils.DebugTrace(ut.logger, err)
+		return nil, nil, err
+	}
+	if !proof.Included {
+		return nil, nil, errorz.ErrInvalid{}.New("withdrawal is not in the state of the snapshot yet")
+	}
+	return utxo, proof, nil
+}
+
+// GetRootForHeight returns the root of the state trie at height
+func (ut *UTXOHandler) GetRootForHeight(txn *badger.Txn, height uint32) ([]byte, error) {
+	return ut.trie.GetRootForHeight(txn, height)
+}
+
+// WalkState calls cb with every UTXO in the state trie with root. Consumed
+// deposits are kept in the trie as well; as they are not stored here, cb is
+// called with a nil UTXO for them. UTXOs are never deleted when they are
+// consumed, so the trie of an earlier height may be walked as well.
+func (ut *UTXOHandler) WalkState(txn *badger.Txn, root []byte, cb func(utxoID []byte, utxo *objs.TXOut) error) error {
+	return ut.trie.WalkLeaves(txn, root, func(utxoID []byte, _ []byte) error {
+		utxo, err := ut.getInternal(txn, utxoID)
+		if err != nil {
+			if err != badger.ErrKeyNotFound {
+				utils.DebugTrace(ut.logger, err)
+				return err
+			}
+			return cb(utxoID, nil)
+		}
+		return cb(utxoID, utxo)
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 ///////////PRIVATE METHODS//////////////////////////////////////////////////////
@@ -586,6 +693,23 @@ func (ut *UTXOHandler) addOne(txn *badger.Txn, utxo *objs.TXOut) error {
 		utils.DebugTrace(ut.logger, err)
 		return errorz.ErrInvalid{}.New("utxoID conflict")
 	}
+	if err := ut.addToIndexes(txn, utxoID, utxo); err != nil {
+		utils.DebugTrace(ut.logger, err)
+		return err
+	}
+	key := ut.makeUTXOKey(utxoID)
+	if err := db.SetUTXO(txn, key, utxo); err != nil {
+		utils.DebugTrace(ut.logger, err)
+		return err
+	}
+	return nil
+}
+
+// addToIndexes adds a stored utxo to the indexers
+func (ut *UTXOHandler) addToIndexes(txn *badger.Txn, utxoID []byte, utxo *objs.TXOut) error {
+	if utxo.HasWithdrawal() {
+		return ut.addToWithdrawalIndex(txn, utxoID, utxo)
+	}
 	owner, err := utxo.GenericOwner()
 	if err != nil {
 		utils.DebugTrace(ut.logger, err)
@@ -633,11 +757,10 @@ func (ut *UTXOHandler) addOne(txn *badger.Txn, utxo *objs.TXOut) error {
 			utils.DebugTrace(ut.logger, err)
 			return err
 		}
-	}
-	key := ut.makeUTXOKey(utxoID)
-	if err := db.SetUTXO(txn, key, utxo); err != nil {
-		utils.DebugTrace(ut.logger, err)
-		return err
+		if err := ut.addToLockIndex(txn, utxoID, utxo); err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return err
+		}
 	}
 	return nil
 }
@@ -661,6 +784,14 @@ func (ut *UTXOHandler) dropFromIndexes(txn *badger.Txn, utxoID []byte) error {
 		utils.DebugTrace(ut.logger, err)
 		return err
 	}
+	if utxo.HasWithdrawal() {
+		err = ut.wdIndex.Drop(txn, utxoID)
+		if err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return err
+		}
+		return nil
+	}
 	if utxo.HasDataStore() {
 		err = ut.expIndex.Drop(txn, utxoID)
 		if err != nil {
@@ -678,6 +809,47 @@ func (ut *UTXOHandler) dropFromIndexes(txn *badger.Txn, utxoID []byte) error {
 			utils.DebugTrace(ut.logger, err)
 			return err
 		}
+		err = ut.lockIndex.Drop(txn, utxoID)
+		if err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// addToLockIndex adds timelocked ValueStores to the lock index so they may
+// be excluded from value lookups until their lock epoch
+func (ut *UTXOHandler) addToLockIndex(txn *badger.Txn, utxoID []byte, utxo *objs.TXOut) error {
+	if !utxo.HasValueStore() {
+		return nil
+	}
+	vs, err := utxo.ValueStore()
+	if err != nil {
+		return err
+	}
+	lockEpoch, err := vs.LockEpoch()
+	if err != nil {
+		return err
+	}
+	if lockEpoch == 0 {
+		return nil
+	}
+	return ut.lockIndex.Add(txn, utxoID, lockEpoch)
+}
+
+// addToWithdrawalIndex adds a Withdrawal to the withdrawal index so it may
+// be looked up by the Ethereum account it is withdrawn to
+func (ut *UTXOHandler) addToWithdrawalIndex(txn *badger.Txn, utxoID []byte, utxo *objs.TXOut) error {
+	account, err := utxo.Account()
+	if err != nil {
+		utils.DebugTrace(ut.logger, err)
+		return err
+	}
+	err = ut.wdIndex.Add(txn, utxoID, account)
+	if err != nil {
+		utils.DebugTrace(ut.logger, err)
+		return err
 	}
 	return nil
 }
@@ -697,6 +869,18 @@ func (ut *UTXOHandler) addOneFastSync(txn *badger.Txn, utxo *objs.TXOut) error {
 		utils.DebugTrace(ut.logger, err)
 		return err
 	}
+	if utxo.HasWithdrawal() {
+		if err := ut.addToWithdrawalIndex(txn, utxoID, utxo); err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return err
+		}
+		key := ut.makeUTXOKey(utxoID)
+		if err := db.SetUTXO(txn, key, utxo); err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return err
+		}
+		return nil
+	}
 	owner, err := utxo.GenericOwner()
 	if err != nil {
 		utils.DebugTrace(ut.logger, err)
@@ -744,6 +928,10 @@ func (ut *UTXOHandler) addOneFastSync(txn *badger.Txn, utxo *objs.TXOut) error {
 			utils.DebugTrace(ut.logger, err)
 			return err
 		}
+		if err := ut.addToLockIndex(txn, utxoID, utxo); err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return err
+		}
 	}
 	key := ut.makeUTXOKey(utxoID)
 	if err := db.SetUTXO(txn, key, utxo); err != nil {
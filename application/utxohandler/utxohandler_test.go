@@ -1,6 +1,7 @@
 package utxohandler
 
 import (
+	"bytes"
 	"io/ioutil"
 	"os"
 	"strconv"
@@ -170,3 +171,186 @@ func TestUTXOTrie(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestUTXOHandlerRollbackState(t *testing.T) {
+	db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	signer := &crypto.Secp256k1Signer{}
+	err = signer.SetPrivk(crypto.Hasher([]byte("secret")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hndlr := NewUTXOHandler(db)
+	err = hndlr.Init(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := makeDeposit(t, signer, 1, 1, uint256.One())
+	tx := makeTxs(t, signer, d)
+	utxoIDs, err := tx.GeneratedUTXOID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var root1 []byte
+	err = db.Update(func(txn *badger.Txn) error {
+		root1, err = hndlr.ApplyState(txn, []*objs.Tx{}, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		root2, err := hndlr.ApplyState(txn, []*objs.Tx{tx}, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(root1, root2) {
+			t.Fatal("state root did not change")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.Update(func(txn *badger.Txn) error {
+		if _, err := hndlr.RollbackState(txn, []*objs.Tx{tx}, 1); err == nil {
+			t.Fatal("should fail")
+		}
+		root, err := hndlr.RollbackState(txn, []*objs.Tx{tx}, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(root, root1) {
+			t.Fatalf("rolled back to %x, want %x", root, root1)
+		}
+		current, err := hndlr.trie.GetCurrentStateRoot(txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(current, root1) {
+			t.Fatalf("current state root is %x, want %x", current, root1)
+		}
+		_, missing, err := hndlr.Get(txn, utxoIDs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(missing) != 1 {
+			t.Fatal("generated utxoID not removed")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.Update(func(txn *badger.Txn) error {
+		_, err := hndlr.ApplyState(txn, []*objs.Tx{tx}, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUTXOHandlerWithdrawal(t *testing.T) {
+	db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	signer := &crypto.Secp256k1Signer{}
+	err = signer.SetPrivk(crypto.Hasher([]byte("secret")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hndlr := NewUTXOHandler(db)
+	err = hndlr.Init(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := makeDeposit(t, signer, 1, 1, uint256.One())
+	txIn, err := d.MakeTxIn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	acct := crypto.Hasher([]byte("ethereum"))[:constants.OwnerLen]
+	wdUTXO := &objs.TXOut{}
+	err = wdUTXO.CreateWithdrawal(1, uint256.One(), uint256.Zero(), acct, make([]byte, constants.HashLen))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := &objs.Tx{Vin: []*objs.TXIn{txIn}, Vout: []*objs.TXOut{wdUTXO}}
+	if err := tx.SetTxHash(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sign(tx.Vin[0], signer); err != nil {
+		t.Fatal(err)
+	}
+	utxoID, err := wdUTXO.UTXOID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var root1, root2 []byte
+	err = db.Update(func(txn *badger.Txn) error {
+		root1, err = hndlr.ApplyState(txn, []*objs.Tx{}, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		root2, err = hndlr.ApplyState(txn, []*objs.Tx{tx}, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.View(func(txn *badger.Txn) error {
+		utxoIDs, _, err := hndlr.GetWithdrawalsForAccount(txn, acct, 10, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(utxoIDs) != 1 || !bytes.Equal(utxoIDs[0], utxoID) {
+			t.Fatal("withdrawal not indexed")
+		}
+		utxo, proof, err := hndlr.GetWithdrawalProof(txn, utxoID, root2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !utxo.HasWithdrawal() {
+			t.Fatal("should be a withdrawal")
+		}
+		preHash, err := utxo.PreHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(proof.Key, utxoID) || !bytes.Equal(proof.ProofValue, preHash) {
+			t.Fatal("proof does not match withdrawal")
+		}
+		if _, _, err := hndlr.GetWithdrawalProof(txn, utxoID, root1); err == nil {
+			t.Fatal("should fail for a root without the withdrawal")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.Update(func(txn *badger.Txn) error {
+		if _, err := hndlr.RollbackState(txn, []*objs.Tx{tx}, 2); err != nil {
+			t.Fatal(err)
+		}
+		utxoIDs, _, err := hndlr.GetWithdrawalsForAccount(txn, acct, 10, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(utxoIDs) != 0 {
+			t.Fatal("withdrawal not dropped from index")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
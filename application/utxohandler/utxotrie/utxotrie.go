@@ -5,8 +5,10 @@ import (
 
 	"github.com/MadBase/MadNet/application/objs"
 	trie "github.com/MadBase/MadNet/badgerTrie"
+	consensusdb "github.com/MadBase/MadNet/consensus/db"
 	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/constants/dbprefix"
+	"github.com/MadBase/MadNet/errorz"
 	"github.com/MadBase/MadNet/logging"
 	"github.com/MadBase/MadNet/utils"
 	"github.com/dgraph-io/badger/v2"
@@ -392,3 +394,110 @@ func (ut *UTXOTrie) GetSnapShotNode(txn *badger.Txn, height uint32, key []byte)
 	}
 	return snapShotNode, nil
 }
+
+// GetRootForHeight returns the state root committed at height
+func (ut *UTXOTrie) GetRootForHeight(txn *badger.Txn, height uint32) ([]byte, error) {
+	return getRootForHeight(txn, height)
+}
+
+// WalkLeaves calls cb with the utxoID and hash of every leaf of the trie
+// with root. The leaves are not visited in any particular order.
+func (ut *UTXOTrie) WalkLeaves(txn *badger.Txn, root []byte, cb func(utxoID []byte, utxoHash []byte) error) error {
+	if bytes.Equal(root, make([]byte, constants.HashLen)) {
+		root = nil
+	}
+	t := trie.NewSMT(root, trie.Hasher, func() []byte { return getTriePrefix() })
+	return t.WalkLeaves(txn, root, cb)
+}
+
+// GetProof returns a compressed proof of inclusion or exclusion of utxoID
+// in the trie with the given root
+func (ut *UTXOTrie) GetProof(txn *badger.Txn, root []byte, utxoID []byte) (*consensusdb.MerkleProof, error) {
+	if bytes.Equal(root, make([]byte, constants.HashLen)) {
+		root = nil
+	}
+	t := trie.NewSMT(root, trie.Hasher, func() []byte { return getTriePrefix() })
+	bitmap, path, keyHeight, included, proofKey, proofVal, err := t.MerkleProofCompressed(txn, utxoID)
+	if err != nil {
+		utils.DebugTrace(ut.logger, err)
+		return nil, err
+	}
+	mproof := &consensusdb.MerkleProof{
+		Included:   included,
+		KeyHeight:  keyHeight,
+		Key:        utils.CopySlice(utxoID),
+		ProofKey:   proofKey,
+		ProofValue: proofVal,
+		Bitmap:     bitmap,
+		Path:       path,
+	}
+	return mproof, nil
+}
+
+// RollbackState returns the trie to the state root committed at the height
+// before height. Nodes of the trie are never pruned, so the root of every
+// committed height remains readable. The pending and canonical roots are
+// restored from the epoch boundaries which precede the new height.
+func (ut *UTXOTrie) RollbackState(txn *badger.Txn, height uint32) ([]byte, error) {
+	if height < 2 {
+		return nil, errorz.ErrInvalid{}.New("the state of the first height can not be rolled back")
+	}
+	current, err := GetCurrentStateRoot(txn)
+	if err != nil {
+		utils.DebugTrace(ut.logger, err)
+		return nil, err
+	}
+	root, err := getRootForHeight(txn, height)
+	if err != nil {
+		utils.DebugTrace(ut.logger, err)
+		return nil, err
+	}
+	if !bytes.Equal(current, root) {
+		return nil, errorz.ErrInvalid{}.New("only the most recent height can be rolled back")
+	}
+	prevRoot, err := getRootForHeight(txn, height-1)
+	if err != nil {
+		utils.DebugTrace(ut.logger, err)
+		return nil, err
+	}
+	if height%constants.EpochLength == 0 {
+		pendingHeight := epochBoundaryBefore(height)
+		pendingRoot, err := getRootForHeight(txn, pendingHeight)
+		if err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return nil, err
+		}
+		canonicalRoot, err := getRootForHeight(txn, epochBoundaryBefore(pendingHeight))
+		if err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return nil, err
+		}
+		if err := SetPendingStateRoot(txn, pendingRoot); err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return nil, err
+		}
+		if err := SetCanonicalStateRoot(txn, canonicalRoot); err != nil {
+			utils.DebugTrace(ut.logger, err)
+			return nil, err
+		}
+	}
+	if err := SetCurrentStateRoot(txn, prevRoot); err != nil {
+		utils.DebugTrace(ut.logger, err)
+		return nil, err
+	}
+	if err := utils.DeleteValue(txn, makeheightKey(height)); err != nil {
+		utils.DebugTrace(ut.logger, err)
+		return nil, err
+	}
+	return prevRoot, nil
+}
+
+// epochBoundaryBefore returns the last height before height at which the
+// pending root was moved. These are the first height and every multiple of
+// the epoch length.
+func epochBoundaryBefore(height uint32) uint32 {
+	if height <= constants.EpochLength {
+		return 1
+	}
+	return ((height - 1) / constants.EpochLength) * constants.EpochLength
+}
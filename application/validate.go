@@ -0,0 +1,235 @@
+package application
+
+import (
+	"github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+// TxValidation is the outcome of validating a transaction without adding it
+// to the pending tx pool. Errors concerning the transaction as a whole are
+// listed in TxErrors; errors concerning a single consumed or generated object
+// are keyed by the position of the object in Vin or Vout.
+type TxValidation struct {
+	TxHash     []byte
+	TxErrors   []error
+	VinErrors  map[int]error
+	VoutErrors map[int]error
+}
+
+// IsValid returns true if no errors were found
+func (v *TxValidation) IsValid() bool {
+	return len(v.TxErrors) == 0 && len(v.VinErrors) == 0 && len(v.VoutErrors) == 0
+}
+
+func (v *TxValidation) addTxError(err error) {
+	v.TxErrors = append(v.TxErrors, err)
+}
+
+func (v *TxValidation) addVinError(i int, err error) {
+	if _, ok := v.VinErrors[i]; !ok {
+		v.VinErrors[i] = err
+	}
+}
+
+func (v *TxValidation) addVoutError(i int, err error) {
+	if _, ok := v.VoutErrors[i]; !ok {
+		v.VoutErrors[i] = err
+	}
+}
+
+// ValidateTx runs the checks performed before a tx is accepted into the
+// pending tx pool and reports every failure found rather than only the first.
+// The helpers of validatePending and of the fee validation are run on each
+// consumed and generated object on its own, so that a failure is reported
+// against the object which caused it; the tx is then run through
+// validatePending as a whole so that a tx which would be rejected is never
+// reported as valid. The tx is not added to the pending tx pool. An error is
+// returned only if the checks themselves could not be run.
+func (tm *txHandler) ValidateTx(txn *badger.Txn, chainID uint32, height uint32, tx *objs.Tx) (*TxValidation, error) {
+	v := &TxValidation{
+		VinErrors:  make(map[int]error),
+		VoutErrors: make(map[int]error),
+	}
+	txHash, err := tx.TxHash()
+	if err != nil {
+		v.addTxError(err)
+		return v, nil
+	}
+	v.TxHash = txHash
+	if len(tx.Vin) == 0 || len(tx.Vout) == 0 {
+		v.addTxError(errorz.ErrInvalid{}.New("empty input or output vector in tx"))
+		return v, nil
+	}
+
+	// the checks of PreValidatePending; the presignatures are checked for
+	// each generated object below
+	if err := tx.ValidateChainID(chainID); err != nil {
+		v.addTxError(err)
+	}
+	if _, err := tx.ValidateUnique(nil); err != nil {
+		v.addTxError(err)
+	}
+	if err := tx.ValidateTxHash(); err != nil {
+		v.addTxError(err)
+	}
+	notPending, err := tm.pTxHdlr.Contains(txn, height, [][]byte{txHash})
+	if err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	if len(notPending) == 0 {
+		v.addTxError(errorz.ErrInvalid{}.New("duplicate"))
+	}
+	_, notMined, err := tm.mTxHdlr.Get(txn, [][]byte{txHash})
+	if err != nil {
+		utils.DebugTrace(tm.logger, err)
+		return nil, err
+	}
+	if len(notMined) == 0 {
+		v.addTxError(errorz.ErrInvalid{}.New("already mined"))
+	}
+
+	for i, utxo := range tx.Vout {
+		vout := objs.Vout{utxo}
+		if err := vout.ValidatePreSignature(); err != nil {
+			v.addVoutError(i, err)
+			continue
+		}
+		if err := vout.ValidateWithdrawals(height, tm.storage); err != nil {
+			v.addVoutError(i, err)
+		}
+		if err := vout.ValidateTimelocks(height, tm.storage); err != nil {
+			v.addVoutError(i, err)
+		}
+		utxoID, err := utxo.UTXOID()
+		if err != nil {
+			v.addVoutError(i, err)
+			continue
+		}
+		ok, err := tm.uHdlr.TrieContains(txn, utxoID)
+		if err != nil {
+			utils.DebugTrace(tm.logger, err)
+			return nil, err
+		}
+		if ok {
+			v.addVoutError(i, errorz.ErrInvalid{}.New("utxoID already in trie"))
+		}
+	}
+
+	// the checks of PostValidatePending, in Vin order
+	refUTXOs := objs.Vout{}
+	for i, txIn := range tx.Vin {
+		utxo, err := tm.getConsumed(txn, txIn)
+		if err != nil {
+			if _, ok := err.(*errorz.ErrInvalid); !ok {
+				utils.DebugTrace(tm.logger, err)
+				return nil, err
+			}
+			v.addVinError(i, err)
+			continue
+		}
+		refUTXOs = append(refUTXOs, utxo)
+		consumed := objs.Vout{utxo}
+		if err := consumed.ValidateUnlocked(height); err != nil {
+			v.addVinError(i, err)
+		}
+		if err := consumed.ValidateSignature(height, objs.Vin{txIn}); err != nil {
+			v.addVinError(i, err)
+		}
+	}
+	if len(refUTXOs) != len(tx.Vin) {
+		// the remaining checks depend upon every consumed object
+		for i, utxo := range tx.Vout {
+			if err := utxo.ValidateFee(tm.storage); err != nil {
+				v.addVoutError(i, err)
+			}
+		}
+		return v, nil
+	}
+	if err := tx.ValidateEqualVinVout(height, refUTXOs); err != nil {
+		v.addTxError(err)
+	}
+
+	// the checks of ValidateFees, which are made when the tx is mined
+	if !tx.IsCleanupTx(height, refUTXOs) {
+		renewals, err := tx.Vout.Renewals(height, refUTXOs, tm.storage)
+		if err != nil {
+			v.addTxError(err)
+		}
+		for i, utxo := range tx.Vout {
+			renewal := make(map[int]*objs.DataStore)
+			if consumed, ok := renewals[i]; ok {
+				renewal[0] = consumed
+			}
+			if err := (objs.Vout{utxo}).ValidateFees(tm.storage, renewal); err != nil {
+				v.addVoutError(i, err)
+			}
+		}
+		if err := tx.Vout.ValidateTxFee(tm.storage); err != nil {
+			v.addTxError(err)
+		}
+	}
+	colliding, err := tm.uHdlr.CollidingDataStores(txn, tx, refUTXOs)
+	if err != nil {
+		if _, ok := err.(*errorz.ErrInvalid); !ok {
+			utils.DebugTrace(tm.logger, err)
+			return nil, err
+		}
+		v.addTxError(err)
+	}
+	for _, i := range colliding {
+		v.addVoutError(i, errorz.ErrInvalid{}.New("duplicate datastore index"))
+	}
+
+	// should the checks above have missed a reason for which the tx would
+	// be rejected, report it for the tx as a whole
+	if v.IsValid() {
+		if _, err := tm.validatePending(txn, chainID, height, objs.TxVec{tx}); err != nil {
+			if _, ok := err.(*errorz.ErrInvalid); !ok {
+				utils.DebugTrace(tm.logger, err)
+				return nil, err
+			}
+			v.addTxError(err)
+		}
+	}
+	return v, nil
+}
+
+// getConsumed returns the unspent object consumed by txIn. An ErrInvalid is
+// returned if the object does not exist or has already been spent.
+func (tm *txHandler) getConsumed(txn *badger.Txn, txIn *objs.TXIn) (*objs.TXOut, error) {
+	utxoID, err := txIn.UTXOID()
+	if err != nil {
+		return nil, errorz.ErrInvalid{}.New(err.Error())
+	}
+	if txIn.IsDeposit() {
+		found, _, spent, err := tm.dHdlr.Get(txn, [][]byte{utxoID})
+		if err != nil {
+			return nil, err
+		}
+		if len(spent) > 0 {
+			return nil, errorz.ErrInvalid{}.New("consumed deposit has already been spent")
+		}
+		if len(found) == 0 {
+			return nil, errorz.ErrInvalid{}.New("consumed deposit does not exist")
+		}
+		ok, err := tm.uHdlr.TrieContains(txn, utxoID)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return nil, errorz.ErrInvalid{}.New("double spend of deposit found in trie")
+		}
+		return found[0], nil
+	}
+	found, _, err := tm.uHdlr.Get(txn, [][]byte{utxoID})
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, errorz.ErrInvalid{}.New("consumed utxo does not exist")
+	}
+	return found[0], nil
+}
@@ -0,0 +1,402 @@
+package application
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/MadBase/MadNet/application/deposit"
+	"github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	consensusdb "github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+const validateTestChainID uint32 = 42
+
+func setupValidateTest(t *testing.T) (*Application, *consensusdb.Database, *deposit.Handler, *dynamics.Storage) {
+	t.Helper()
+	ctx, cf := context.WithCancel(context.Background())
+	t.Cleanup(cf)
+	rawDB, err := utils.OpenBadger(ctx.Done(), "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rawDB.Close() })
+	txPoolDB, err := utils.OpenBadger(ctx.Done(), "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { txPoolDB.Close() })
+	database := &consensusdb.Database{}
+	database.Init(rawDB)
+	storage := &dynamics.Storage{}
+	if err := storage.Init(database, logging.GetLogger(constants.LoggerDB)); err != nil {
+		t.Fatal(err)
+	}
+	storage.Start()
+	dph := &deposit.Handler{}
+	dph.Init()
+	app := &Application{}
+	if err := app.Init(database, txPoolDB, dph, storage); err != nil {
+		t.Fatal(err)
+	}
+	return app, database, dph, storage
+}
+
+// addDeposit adds a deposit of value owned by signer and returns it
+func addDeposit(t *testing.T, database *consensusdb.Database, dph *deposit.Handler, signer objs.Signer, nonce string, value int64) *objs.TXOut {
+	t.Helper()
+	pubk, err := signer.Pubkey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := &objs.Owner{}
+	if err := owner.New(crypto.GetAccount(pubk), constants.CurveSecp256k1); err != nil {
+		t.Fatal(err)
+	}
+	utxoID := crypto.Hasher([]byte(nonce))
+	var utxo *objs.TXOut
+	err = database.Update(func(txn *badger.Txn) error {
+		if err := dph.Add(txn, validateTestChainID, utxoID, big.NewInt(value), owner); err != nil {
+			return err
+		}
+		found, _, _, err := dph.Get(txn, [][]byte{utxoID})
+		if err != nil {
+			return err
+		}
+		utxo = found[0]
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return utxo
+}
+
+// makeDataStoreTx returns a tx which consumes the deposit and writes rawData
+// at index, returning the change to signer
+func makeDataStoreTx(t *testing.T, signer objs.Signer, consumed *objs.TXOut, index []byte, rawData []byte) *objs.Tx {
+	t.Helper()
+	pubk, err := signer.Pubkey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := crypto.GetAccount(pubk)
+	txIn, err := consumed.MakeTxIn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := consumed.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deposit, err := objs.BaseDepositEquation(uint32(len(rawData)), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := &objs.DataStoreOwner{}
+	owner.New(account, constants.CurveSecp256k1)
+	ds := &objs.DataStore{
+		DSLinker: &objs.DSLinker{
+			DSPreImage: &objs.DSPreImage{
+				ChainID:  validateTestChainID,
+				Index:    index,
+				IssuedAt: 1,
+				Deposit:  deposit,
+				RawData:  rawData,
+				Owner:    owner,
+				Fee:      uint256.Zero(),
+			},
+			TxHash: make([]byte, constants.HashLen),
+		},
+	}
+	dsUTXO := &objs.TXOut{}
+	if err := dsUTXO.NewDataStore(ds); err != nil {
+		t.Fatal(err)
+	}
+	change, err := new(uint256.Uint256).Sub(value, deposit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vs := &objs.ValueStore{}
+	if err := vs.New(validateTestChainID, change, uint256.Zero(), account, constants.CurveSecp256k1, make([]byte, constants.HashLen)); err != nil {
+		t.Fatal(err)
+	}
+	vsUTXO := &objs.TXOut{}
+	if err := vsUTXO.NewValueStore(vs); err != nil {
+		t.Fatal(err)
+	}
+	tx := &objs.Tx{Vin: objs.Vin{txIn}, Vout: objs.Vout{dsUTXO, vsUTXO}}
+	if err := tx.Vout.SetTxOutIdx(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.SetTxHash(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ds.PreSign(signer); err != nil {
+		t.Fatal(err)
+	}
+	consumedVS, err := consumed.ValueStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := consumedVS.Sign(tx.Vin[0], signer); err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+// makeWithdrawalTx returns a tx which burns the value of the deposit in a
+// Withdrawal to acct
+func makeWithdrawalTx(t *testing.T, signer objs.Signer, consumed *objs.TXOut, acct []byte) *objs.Tx {
+	t.Helper()
+	txIn, err := consumed.MakeTxIn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := consumed.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wdUTXO := &objs.TXOut{}
+	if err := wdUTXO.CreateWithdrawal(validateTestChainID, value, uint256.Zero(), acct, make([]byte, constants.HashLen)); err != nil {
+		t.Fatal(err)
+	}
+	tx := &objs.Tx{Vin: objs.Vin{txIn}, Vout: objs.Vout{wdUTXO}}
+	if err := tx.Vout.SetTxOutIdx(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.SetTxHash(); err != nil {
+		t.Fatal(err)
+	}
+	consumedVS, err := consumed.ValueStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := consumedVS.Sign(tx.Vin[0], signer); err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+// makeTimelockTx returns a tx which moves the value of the deposit to a
+// ValueStore of signer locked until lockEpoch
+func makeTimelockTx(t *testing.T, signer objs.Signer, consumed *objs.TXOut, lockEpoch uint32) *objs.Tx {
+	t.Helper()
+	pubk, err := signer.Pubkey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	txIn, err := consumed.MakeTxIn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := consumed.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vs := &objs.ValueStore{}
+	if err := vs.NewTimelock(validateTestChainID, value, uint256.Zero(), crypto.GetAccount(pubk), constants.CurveSecp256k1, lockEpoch, make([]byte, constants.HashLen)); err != nil {
+		t.Fatal(err)
+	}
+	vsUTXO := &objs.TXOut{}
+	if err := vsUTXO.NewValueStore(vs); err != nil {
+		t.Fatal(err)
+	}
+	tx := &objs.Tx{Vin: objs.Vin{txIn}, Vout: objs.Vout{vsUTXO}}
+	if err := tx.Vout.SetTxOutIdx(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.SetTxHash(); err != nil {
+		t.Fatal(err)
+	}
+	consumedVS, err := consumed.ValueStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := consumedVS.Sign(tx.Vin[0], signer); err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func validateTx(t *testing.T, app *Application, database *consensusdb.Database, height uint32, tx *objs.Tx) *TxValidation {
+	t.Helper()
+	var v *TxValidation
+	err := database.View(func(txn *badger.Txn) error {
+		tmp, err := app.ValidateTx(txn, validateTestChainID, height, tx)
+		v = tmp
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func pendingTxAdd(app *Application, database *consensusdb.Database, height uint32, tx *objs.Tx) error {
+	return database.Update(func(txn *badger.Txn) error {
+		return app.PendingTxAdd(txn, validateTestChainID, height, []interfaces.Transaction{tx})
+	})
+}
+
+func TestValidateTxMatchesPendingTxAdd(t *testing.T) {
+	app, database, dph, _ := setupValidateTest(t)
+	signer := &crypto.Secp256k1Signer{}
+	if err := signer.SetPrivk(crypto.Hasher([]byte("secret"))); err != nil {
+		t.Fatal(err)
+	}
+	index := crypto.Hasher([]byte("index"))
+
+	d1 := addDeposit(t, database, dph, signer, "d1", 100000)
+	tx1 := makeDataStoreTx(t, signer, d1, index, []byte("data"))
+	if v := validateTx(t, app, database, 1, tx1); !v.IsValid() {
+		t.Fatalf("tx should be valid: %v %v %v", v.TxErrors, v.VinErrors, v.VoutErrors)
+	}
+	err := database.Update(func(txn *badger.Txn) error {
+		_, err := app.ApplyState(txn, validateTestChainID, 1, []interfaces.Transaction{tx1})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a second datastore at the same index which does not consume the first
+	d2 := addDeposit(t, database, dph, signer, "d2", 100000)
+	tx2 := makeDataStoreTx(t, signer, d2, index, []byte("other data"))
+	if err := pendingTxAdd(app, database, 1, tx2); err == nil {
+		t.Fatal("PendingTxAdd should have raised an error")
+	}
+	v := validateTx(t, app, database, 1, tx2)
+	if v.IsValid() {
+		t.Fatal("tx rejected by PendingTxAdd should be invalid")
+	}
+	if v.VoutErrors[0] == nil {
+		t.Fatalf("the datastore should be reported: %v %v %v", v.TxErrors, v.VinErrors, v.VoutErrors)
+	}
+
+	// a tx which is already pending
+	tx3 := makeDataStoreTx(t, signer, d2, crypto.Hasher([]byte("index2")), []byte("data"))
+	if err := pendingTxAdd(app, database, 1, tx3); err != nil {
+		t.Fatal(err)
+	}
+	if err := pendingTxAdd(app, database, 1, tx3); err == nil {
+		t.Fatal("PendingTxAdd should have raised an error")
+	}
+	v = validateTx(t, app, database, 1, tx3)
+	if v.IsValid() || len(v.TxErrors) == 0 {
+		t.Fatalf("pending tx should be reported: %v %v %v", v.TxErrors, v.VinErrors, v.VoutErrors)
+	}
+
+	// the mined tx
+	v = validateTx(t, app, database, 1, tx1)
+	if v.IsValid() {
+		t.Fatal("mined tx should be invalid")
+	}
+}
+
+func TestValidateTxWithdrawalFeature(t *testing.T) {
+	app, database, dph, storage := setupValidateTest(t)
+	signer := &crypto.Secp256k1Signer{}
+	if err := signer.SetPrivk(crypto.Hasher([]byte("secret"))); err != nil {
+		t.Fatal(err)
+	}
+	acct := crypto.Hasher([]byte("ethereum"))[:constants.OwnerLen]
+	d := addDeposit(t, database, dph, signer, "d", 100000)
+	tx := makeWithdrawalTx(t, signer, d, acct)
+
+	// the feature is not active yet
+	if err := pendingTxAdd(app, database, 1, tx); err == nil {
+		t.Fatal("PendingTxAdd should have raised an error")
+	}
+	v := validateTx(t, app, database, 1, tx)
+	if v.IsValid() || v.VoutErrors[0] == nil {
+		t.Fatalf("the withdrawal should be reported: %v %v %v", v.TxErrors, v.VinErrors, v.VoutErrors)
+	}
+	valid := true
+	err := database.View(func(txn *badger.Txn) error {
+		ok, err := app.IsValid(txn, validateTestChainID, 1, nil, []interfaces.Transaction{tx})
+		valid = ok
+		return err
+	})
+	if valid && err == nil {
+		t.Fatal("a block with the withdrawal should be invalid")
+	}
+
+	// activate the feature from epoch 1
+	update, err := dynamics.NewUpdate(dynamics.FeatureUpdatePrefix+string(objs.FeatureWithdrawal), "true", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = database.Update(func(txn *badger.Txn) error {
+		if err := storage.UpdateStorage(txn, update); err != nil {
+			return err
+		}
+		return storage.LoadStorage(txn, 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := validateTx(t, app, database, 1, tx); !v.IsValid() {
+		t.Fatalf("tx should be valid: %v %v %v", v.TxErrors, v.VinErrors, v.VoutErrors)
+	}
+	if err := pendingTxAdd(app, database, 1, tx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateTxTimelockFeature(t *testing.T) {
+	app, database, dph, storage := setupValidateTest(t)
+	signer := &crypto.Secp256k1Signer{}
+	if err := signer.SetPrivk(crypto.Hasher([]byte("secret"))); err != nil {
+		t.Fatal(err)
+	}
+	d := addDeposit(t, database, dph, signer, "d", 100000)
+	tx := makeTimelockTx(t, signer, d, 3)
+
+	// the feature is not active yet
+	if err := pendingTxAdd(app, database, 1, tx); err == nil {
+		t.Fatal("PendingTxAdd should have raised an error")
+	}
+	v := validateTx(t, app, database, 1, tx)
+	if v.IsValid() || v.VoutErrors[0] == nil {
+		t.Fatalf("the timelock should be reported: %v %v %v", v.TxErrors, v.VinErrors, v.VoutErrors)
+	}
+	valid := true
+	err := database.View(func(txn *badger.Txn) error {
+		ok, err := app.IsValid(txn, validateTestChainID, 1, nil, []interfaces.Transaction{tx})
+		valid = ok
+		return err
+	})
+	if valid && err == nil {
+		t.Fatal("a block with the timelock should be invalid")
+	}
+
+	// activate the feature from epoch 1
+	update, err := dynamics.NewUpdate(dynamics.FeatureUpdatePrefix+string(objs.FeatureTimelock), "true", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = database.Update(func(txn *badger.Txn) error {
+		if err := storage.UpdateStorage(txn, update); err != nil {
+			return err
+		}
+		return storage.LoadStorage(txn, 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := validateTx(t, app, database, 1, tx); !v.IsValid() {
+		t.Fatalf("tx should be valid: %v %v %v", v.TxErrors, v.VinErrors, v.VoutErrors)
+	}
+	if err := pendingTxAdd(app, database, 1, tx); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -59,11 +59,24 @@ func (s *Storage) GetValueStoreFee() (*uint256.Uint256, error) {
 
 // GetMinTxFee returns the minimum TxFee
 func (s *Storage) GetMinTxFee() (*uint256.Uint256, error) {
-	fee := s.storage.GetMinTxFee()
+	fee, err := s.storage.GetMinTxFee()
+	if err != nil {
+		return nil, err
+	}
 	feeUint256 := &uint256.Uint256{}
-	_, err := feeUint256.FromBigInt(fee)
+	_, err = feeUint256.FromBigInt(fee)
 	if err != nil {
 		return nil, err
 	}
 	return feeUint256, nil
 }
+
+// IsActive returns true if feature is active at epoch
+func (s *Storage) IsActive(feature dynamics.Feature, epoch uint32) bool {
+	return s.storage.IsActive(feature, epoch)
+}
+
+// GetFeatures returns the registered and the scheduled features
+func (s *Storage) GetFeatures() []*dynamics.FeatureInfo {
+	return s.storage.GetFeatures()
+}
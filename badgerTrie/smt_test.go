@@ -1342,6 +1342,86 @@ func TestWalkers(t *testing.T) {
 	}
 }
 
+func TestWalkLeaves(t *testing.T) {
+	smt := NewSMT(nil, Hasher, prefixFn)
+	fn := func(txn *badger.Txn) error {
+		if err := smt.WalkLeaves(txn, smt.Root, func(key, value []byte) error {
+			t.Fatal("empty trie has no leaves")
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		leaves := make(map[string][]byte)
+		for height := uint32(1); height <= 5; height++ {
+			keys := GetFreshData(1+int(height*height*10), 32)
+			values := GetFreshData(len(keys), 32)
+			if _, err := smt.Update(txn, keys, values); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := smt.Commit(txn, height); err != nil {
+				t.Fatal(err)
+			}
+			for i := range keys {
+				leaves[string(keys[i])] = values[i]
+			}
+			seen := make(map[string]bool)
+			err := smt.WalkLeaves(txn, smt.Root, func(key, value []byte) error {
+				if seen[string(key)] {
+					t.Fatalf("leaf visited twice: %x", key)
+				}
+				seen[string(key)] = true
+				if !bytes.Equal(leaves[string(key)], value) {
+					t.Fatalf("wrong value for leaf %x", key)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(seen) != len(leaves) {
+				t.Fatalf("visited %v leaves of %v", len(seen), len(leaves))
+			}
+		}
+		count := 0
+		err := smt.WalkLeaves(txn, smt.Root, func(key, value []byte) error {
+			count++
+			return ErrCBDone
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 1 {
+			t.Fatalf("walk did not stop: %v", count)
+		}
+		// a trie with one key has a shortcut batch as its root
+		single := NewSMT(nil, Hasher, prefixFn)
+		keys := GetFreshData(1, 32)
+		values := GetFreshData(1, 32)
+		if _, err := single.Update(txn, keys, values); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := single.Commit(txn, 1); err != nil {
+			t.Fatal(err)
+		}
+		count = 0
+		err = single.WalkLeaves(txn, single.Root, func(key, value []byte) error {
+			count++
+			if !bytes.Equal(key, keys[0]) || !bytes.Equal(value, values[0]) {
+				t.Fatalf("wrong leaf: %x %x", key, value)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 1 {
+			t.Fatalf("visited %v leaves of 1", count)
+		}
+		return nil
+	}
+	testDb(t, fn)
+}
+
 func TestBigDelete(t *testing.T) {
 	dir, err := ioutil.TempDir("", "badger-test")
 	if err != nil {
@@ -98,3 +98,29 @@ func (s *SMT) Drop(bDB *badger.DB) error {
 func (s *SMT) Height(txn *badger.Txn) (uint32, error) {
 	return s.db.getCommitHeightDB(txn)
 }
+
+// WalkLeaves calls cb with the key and value of every leaf of the trie with
+// the given root. The leaves are not visited in any particular order. The
+// callback may return ErrCBDone to stop the walk early.
+func (s *SMT) WalkLeaves(txn *badger.Txn, root []byte, cb func(key []byte, value []byte) error) error {
+	if len(root) == 0 {
+		return nil
+	}
+	err := s.walkNodes(txn, root, func(_ []byte, dbval []byte) error {
+		batch, err := s.parseBatch(dbval)
+		if err != nil {
+			return err
+		}
+		for _, ln := range s.getFinalLeafNodes(batch, 0) {
+			if err := cb(utils.CopySlice(ln.Key), utils.CopySlice(ln.Value)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == ErrCBDone {
+		// walkNodes only hides ErrCBDone for the nodes below the root
+		return nil
+	}
+	return err
+}
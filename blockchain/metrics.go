@@ -0,0 +1,55 @@
+package blockchain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MadBase/MadNet/blockchain/interfaces"
+	"github.com/MadBase/MadNet/metrics"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	txnQueueReceiptWait = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "txnqueue",
+		Name:      "receipt_wait_seconds",
+		Help:      "Time from queueing an Ethereum transaction until its receipt is collected.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+	}, []string{"function"})
+	txnQueueGasUsed = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "txnqueue",
+		Name:      "gas_used",
+		Help:      "Gas used by the Ethereum transactions sent through the queue.",
+		Buckets:   prometheus.ExponentialBuckets(25000, 2, 10),
+	}, []string{"function"})
+	txnQueueReceipts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "txnqueue",
+		Name:      "receipts_total",
+		Help:      "Number of receipts collected by the queue.",
+	}, []string{"function", "status"})
+)
+
+func init() {
+	metrics.MustRegister(txnQueueReceiptWait, txnQueueGasUsed, txnQueueReceipts)
+}
+
+// observeReceipt records the receipt of a transaction queued at queuedAt.
+// Transactions are labelled by the signature of the function they call, or by
+// the selector if the function is unknown.
+func observeReceipt(selector interfaces.FuncSelector, sig string, queuedAt time.Time, rcpt *types.Receipt) {
+	function := sig
+	if function == "" {
+		function = fmt.Sprintf("%x", selector)
+	}
+	status := "failed"
+	if rcpt.Status == uint64(1) {
+		status = "successful"
+	}
+	txnQueueReceiptWait.WithLabelValues(function).Observe(time.Since(queuedAt).Seconds())
+	txnQueueGasUsed.WithLabelValues(function).Observe(float64(rcpt.GasUsed))
+	txnQueueReceipts.WithLabelValues(function, status).Inc()
+}
@@ -0,0 +1,140 @@
+package monitor
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/blockchain/objects"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Health is a snapshot of the progress of the monitor taken after each tick
+type Health struct {
+	Ticked                 bool
+	LastTick               time.Time
+	EndpointInSync         bool
+	PeerCount              uint32
+	CommunicationFailures  uint32
+	HighestBlockFinalized  uint64
+	HighestBlockProcessed  uint64
+	HighestEpochProcessed  uint32
+	HighestEpochSeen       uint32
+	LatestDepositProcessed uint32
+	LatestDepositSeen      uint32
+}
+
+// DKGPhase is the progress of the local validator in a phase of ETHDKG
+type DKGPhase struct {
+	Name string
+	Done bool
+	// Start and End are the Ethereum blocks the phase runs between
+	Start uint64
+	End   uint64
+}
+
+// DKGStatus is a snapshot of the ETHDKG state of the local validator taken
+// after each tick. It leaves out the secrets of the state.
+type DKGStatus struct {
+	Account            common.Address
+	Index              int
+	NumberOfValidators int
+	ValidatorThreshold int
+	Participants       int
+	Phases             []DKGPhase
+}
+
+func newDKGStatus(s *objects.DkgState) DKGStatus {
+	if s == nil {
+		return DKGStatus{}
+	}
+	s.RLock()
+	defer s.RUnlock()
+	return DKGStatus{
+		Account:            s.Account.Address,
+		Index:              s.Index,
+		NumberOfValidators: s.NumberOfValidators,
+		ValidatorThreshold: s.ValidatorThreshold,
+		Participants:       len(s.Participants),
+		Phases: []DKGPhase{
+			{"Registration", s.Registration, s.RegistrationStart, s.RegistrationEnd},
+			{"ShareDistribution", s.ShareDistribution, s.ShareDistributionStart, s.ShareDistributionEnd},
+			{"Dispute", s.Dispute, s.DisputeStart, s.DisputeEnd},
+			{"KeyShareSubmission", s.KeyShareSubmission, s.KeyShareSubmissionStart, s.KeyShareSubmissionEnd},
+			{"MPKSubmission", s.MPKSubmission, s.MPKSubmissionStart, s.MPKSubmissionEnd},
+			{"GPKJSubmission", s.GPKJSubmission, s.GPKJSubmissionStart, s.GPKJSubmissionEnd},
+			{"GPKJGroupAccusation", s.GPKJGroupAccusation, s.GPKJGroupAccusationStart, s.GPKJGroupAccusationEnd},
+			{"Complete", s.Complete, s.CompleteStart, s.CompleteEnd},
+		},
+	}
+}
+
+// EndpointStatus reports whether the Ethereum endpoint is reachable, in sync
+// and has at least minPeers peers
+func (h Health) EndpointStatus(minPeers int) (bool, string) {
+	switch {
+	case !h.Ticked:
+		return false, "monitor has not contacted the endpoint yet"
+	case h.CommunicationFailures > 0:
+		return false, fmt.Sprintf("endpoint unreachable: %d consecutive failures", h.CommunicationFailures)
+	case !h.EndpointInSync:
+		return false, "endpoint is syncing"
+	case h.PeerCount < uint32(minPeers):
+		return false, fmt.Sprintf("endpoint has %d of %d required peers", h.PeerCount, minPeers)
+	}
+	return true, fmt.Sprintf("endpoint in sync with %d peers", h.PeerCount)
+}
+
+// ProgressStatus reports whether the monitor has processed every finalized
+// block up to finalityDelay blocks
+func (h Health) ProgressStatus(finalityDelay uint64) (bool, string) {
+	if !h.Ticked {
+		return false, "monitor has not run yet"
+	}
+	lag := uint64(0)
+	if h.HighestBlockFinalized > h.HighestBlockProcessed {
+		lag = h.HighestBlockFinalized - h.HighestBlockProcessed
+	}
+	if lag > finalityDelay {
+		return false, fmt.Sprintf("processed block %d is %d blocks behind finalized block %d", h.HighestBlockProcessed, lag, h.HighestBlockFinalized)
+	}
+	return true, fmt.Sprintf("processed block %d of finalized block %d", h.HighestBlockProcessed, h.HighestBlockFinalized)
+}
+
+// healthTracker holds the latest Health and DKGStatus of a monitor
+type healthTracker struct {
+	sync.RWMutex
+	health Health
+	dkg    DKGStatus
+}
+
+func (ht *healthTracker) update(s *objects.MonitorState) {
+	ht.Lock()
+	defer ht.Unlock()
+	ht.health = Health{
+		Ticked:                 true,
+		LastTick:               time.Now(),
+		EndpointInSync:         s.EndpointInSync,
+		PeerCount:              s.PeerCount,
+		CommunicationFailures:  s.CommunicationFailures,
+		HighestBlockFinalized:  s.HighestBlockFinalized,
+		HighestBlockProcessed:  s.HighestBlockProcessed,
+		HighestEpochProcessed:  s.HighestEpochProcessed,
+		HighestEpochSeen:       s.HighestEpochSeen,
+		LatestDepositProcessed: s.LatestDepositProcessed,
+		LatestDepositSeen:      s.LatestDepositSeen,
+	}
+	ht.dkg = newDKGStatus(s.EthDKG)
+}
+
+func (ht *healthTracker) get() Health {
+	ht.RLock()
+	defer ht.RUnlock()
+	return ht.health
+}
+
+func (ht *healthTracker) getDKG() DKGStatus {
+	ht.RLock()
+	defer ht.RUnlock()
+	return ht.dkg
+}
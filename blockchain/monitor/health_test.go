@@ -0,0 +1,64 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/MadBase/MadNet/blockchain/objects"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthStatus(t *testing.T) {
+	h := Health{}
+	ok, _ := h.EndpointStatus(1)
+	assert.False(t, ok)
+	ok, _ = h.ProgressStatus(6)
+	assert.False(t, ok)
+
+	ht := &healthTracker{}
+	ht.update(&objects.MonitorState{
+		EndpointInSync:        true,
+		PeerCount:             2,
+		HighestBlockFinalized: 110,
+		HighestBlockProcessed: 100,
+	})
+	h = ht.get()
+
+	ok, _ = h.EndpointStatus(2)
+	assert.True(t, ok)
+	ok, reason := h.EndpointStatus(3)
+	assert.False(t, ok)
+	assert.Contains(t, reason, "2 of 3")
+
+	ok, _ = h.ProgressStatus(10)
+	assert.True(t, ok)
+	ok, reason = h.ProgressStatus(6)
+	assert.False(t, ok)
+	assert.Contains(t, reason, "10 blocks behind")
+
+	h.CommunicationFailures = 1
+	ok, _ = h.EndpointStatus(2)
+	assert.False(t, ok)
+}
+
+func TestHealthDKG(t *testing.T) {
+	ht := &healthTracker{}
+	ht.update(&objects.MonitorState{})
+	assert.Empty(t, ht.getDKG().Phases)
+
+	dkg := objects.NewDkgState(accounts.Account{Address: common.HexToAddress("0x546f99f244b7b58b855330ae0e2bc1b30b41302f")})
+	dkg.Index = 2
+	dkg.NumberOfValidators = 4
+	dkg.Registration = true
+	dkg.ShareDistributionStart = 10
+	dkg.ShareDistributionEnd = 20
+	ht.update(&objects.MonitorState{EthDKG: dkg})
+
+	status := ht.getDKG()
+	assert.Equal(t, dkg.Account.Address, status.Account)
+	assert.Equal(t, 2, status.Index)
+	assert.Equal(t, 4, status.NumberOfValidators)
+	assert.Equal(t, DKGPhase{"Registration", true, 0, 0}, status.Phases[0])
+	assert.Equal(t, DKGPhase{"ShareDistribution", false, 10, 20}, status.Phases[1])
+}
@@ -0,0 +1,64 @@
+package monitor
+
+import (
+	"github.com/MadBase/MadNet/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	monitorFinalized = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "monitor",
+		Name:      "highest_block_finalized",
+		Help:      "Highest finalized Ethereum block seen by the monitor.",
+	})
+	monitorProcessed = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "monitor",
+		Name:      "highest_block_processed",
+		Help:      "Highest Ethereum block whose events have been processed by the monitor.",
+	})
+	monitorLag = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "monitor",
+		Name:      "lag_blocks",
+		Help:      "Number of finalized Ethereum blocks not yet processed by the monitor.",
+	})
+	monitorPeers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "monitor",
+		Name:      "endpoint_peers",
+		Help:      "Number of peers of the Ethereum endpoint.",
+	})
+	monitorEndpointInSync = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "monitor",
+		Name:      "endpoint_in_sync",
+		Help:      "Set to 1 while the Ethereum endpoint reports being in sync.",
+	})
+)
+
+func init() {
+	metrics.MustRegister(monitorFinalized, monitorProcessed, monitorLag, monitorPeers, monitorEndpointInSync)
+}
+
+// observeProgress records the blocks finalized and processed by the monitor
+func observeProgress(finalized uint64, processed uint64) {
+	monitorFinalized.Set(float64(finalized))
+	monitorProcessed.Set(float64(processed))
+	lag := uint64(0)
+	if finalized > processed {
+		lag = finalized - processed
+	}
+	monitorLag.Set(float64(lag))
+}
+
+// observeEndpoint records the state of the Ethereum endpoint
+func observeEndpoint(inSync bool, peerCount uint32) {
+	monitorPeers.Set(float64(peerCount))
+	if inSync {
+		monitorEndpointInSync.Set(1)
+		return
+	}
+	monitorEndpointInSync.Set(0)
+}
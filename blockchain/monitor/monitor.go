@@ -38,6 +38,8 @@ type Monitor interface {
 	Start() error
 	Close()
 	GetStatus() <-chan string
+	Health() Health
+	DKG() DKGStatus
 }
 
 type monitor struct {
@@ -57,6 +59,7 @@ type monitor struct {
 	State          *objects.MonitorState
 	wg             *sync.WaitGroup
 	batchSize      uint64
+	health         healthTracker
 }
 
 // NewMonitor creates a new Monitor
@@ -190,6 +193,16 @@ func (mon *monitor) GetStatus() <-chan string {
 	return mon.statusChan
 }
 
+// Health returns the state of the monitor after the latest tick
+func (mon *monitor) Health() Health {
+	return mon.health.get()
+}
+
+// DKG returns the ETHDKG state of the local validator after the latest tick
+func (mon *monitor) DKG() DKGStatus {
+	return mon.health.getDKG()
+}
+
 func (mon *monitor) Close() {
 	mon.cancelChan <- true
 }
@@ -279,6 +292,7 @@ func (mon *monitor) eventLoop(wg *sync.WaitGroup, logger *logrus.Entry, cancelCh
 			if err := MonitorTick(ctx, cf, wg, mon.eth, mon.State, mon.logger, mon.eventMap, mon.adminHandler, mon.batchSize); err != nil {
 				logger.Errorf("Failed MonitorTick(...): %v", err)
 			}
+			mon.health.update(mon.State)
 
 			diff, shouldWrite := oldMonitorState.Diff(mon.State)
 
@@ -368,9 +382,11 @@ func MonitorTick(ctx context.Context, cf context.CancelFunc, wg *sync.WaitGroup,
 	monitorState.PeerCount = peerCount
 	monitorState.EndpointInSync = inSync
 	monitorState.HighestBlockFinalized = finalized
+	observeEndpoint(inSync, peerCount)
 
 	// 3. Grab up to the next _batch size_ unprocessed block(s)
 	processed := monitorState.HighestBlockProcessed
+	observeProgress(finalized, processed)
 	if processed >= finalized {
 		return nil
 	}
@@ -450,6 +466,7 @@ func MonitorTick(ctx context.Context, cf context.CancelFunc, wg *sync.WaitGroup,
 
 	// Only after batch is processed do we update monitor State
 	monitorState.HighestBlockProcessed = processed
+	observeProgress(finalized, processed)
 	return nil
 }
 
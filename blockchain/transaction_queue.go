@@ -59,6 +59,7 @@ type Behind struct {
 	waitingTxns    map[common.Hash]context.Context                // Just a MAP of transactions whose receipts we're looking for
 	readyTxns      map[common.Hash]*types.Receipt                 // All the transaction -> receipt pairs we know of
 	selectors      map[common.Hash]interfaces.FuncSelector        // Maps a transaction to it's function selector
+	queuedAt       map[common.Hash]time.Time                      // When each waiting transaction was queued
 	groups         map[int][]common.Hash                          // A group is just an ID and a list of transactions
 	aggregates     map[interfaces.FuncSelector]TransactionProfile //
 	client         interfaces.GethClient                          // An interface with the Geth functionality we need
@@ -135,6 +136,7 @@ func (b *Behind) collectReceipts() {
 				// most likely indicates a failure, and cancellation of polling
 				// prevents a memory leak
 				delete(b.waitingTxns, txn)
+				delete(b.queuedAt, txn)
 			default:
 				// context is good on the tx level object, so check for receipt
 				rcpt, err := b.client.TransactionReceipt(ctx, txn)
@@ -175,6 +177,10 @@ func (b *Behind) collectReceipts() {
 				}
 
 				b.aggregates[selector] = profile
+				if queuedAt, ok := b.queuedAt[txn]; ok {
+					observeReceipt(selector, sig, queuedAt, rcpt)
+					delete(b.queuedAt, txn)
+				}
 				logEntry := b.logger.WithField("Transaction", rcpt.TxHash.Hex()).
 					WithField("Function", sig).
 					WithField("Selector", fmt.Sprintf("%x", selector)).
@@ -229,6 +235,7 @@ func (b *Behind) queue(req *Request) *Response {
 
 	b.selectors[txnHash] = selector
 	b.waitingTxns[txnHash] = req.ctx
+	b.queuedAt[txnHash] = time.Now()
 
 	if _, present := b.groups[req.group]; !present {
 		b.groups[req.group] = make([]common.Hash, 0, 10)
@@ -354,6 +361,7 @@ func NewTxnQueue(client interfaces.GethClient, sm interfaces.SelectorMap, to tim
 		waitingTxns:    make(map[common.Hash]context.Context),
 		readyTxns:      make(map[common.Hash]*types.Receipt),
 		selectors:      make(map[common.Hash]interfaces.FuncSelector),
+		queuedAt:       make(map[common.Hash]time.Time),
 		aggregates:     make(map[interfaces.FuncSelector]TransactionProfile),
 		knownSelectors: sm,
 		timeout:        to,
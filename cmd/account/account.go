@@ -0,0 +1,269 @@
+package account
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	aobjs "github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/wallet"
+	"github.com/spf13/cobra"
+)
+
+// Command is the cobra.Command for managing the keys of MadNet accounts.
+// The keys are kept in the encrypted keystore file account.keystore.
+var Command = cobra.Command{
+	Use:   "account",
+	Short: "Manages the keys of MadNet accounts",
+	Long: "The account commands keep secp256k1 and BN256 keys in the encrypted keystore file " +
+		"account.keystore. A key is encrypted with account.passphrase, which is read from stdin if it " +
+		"is not set. The curve is given as secp256k1 or bn256."}
+
+// NewCommand generates a key
+var NewCommand = cobra.Command{
+	Use:   "new <curve>",
+	Short: "Generates a key and prints its account",
+	Args:  cobra.ExactArgs(1),
+	Run:   newKey}
+
+// ImportCommand imports a key given in hex
+var ImportCommand = cobra.Command{
+	Use:   "import <curve> <hex private key>",
+	Short: "Imports a key and prints its account",
+	Args:  cobra.ExactArgs(2),
+	Run:   importKey}
+
+// ListCommand lists the accounts in the keystore
+var ListCommand = cobra.Command{
+	Use:   "list",
+	Short: "Lists the accounts in the keystore and their curves",
+	Args:  cobra.NoArgs,
+	Run:   list}
+
+// SignCommand signs a message
+var SignCommand = cobra.Command{
+	Use:   "sign <account> <message>",
+	Short: "Signs a message with the key of an account and prints the signature in hex",
+	Args:  cobra.ExactArgs(2),
+	Run:   sign}
+
+// VerifyCommand verifies a signature
+var VerifyCommand = cobra.Command{
+	Use:   "verify <curve> <hex signature> <message> [account]",
+	Short: "Verifies the signature of a message and prints the account which signed it",
+	Long: "Prints the account which signed the message. A secp256k1 signature of a different message " +
+		"recovers a different account, so the expected account should be given; verify fails if the " +
+		"signer does not match it.",
+	Args: cobra.RangeArgs(3, 4),
+	Run:  verify}
+
+// OwnerCommand prints the owner bytes of an account
+var OwnerCommand = cobra.Command{
+	Use:   "owner <account> [curve]",
+	Short: "Prints the ValueStoreOwner and DataStoreOwner of an account in hex",
+	Long: "Prints the owner of an account serialized as ValueStoreOwner and DataStoreOwner expect it. " +
+		"The curve of an account which is not in the keystore must be given.",
+	Args: cobra.RangeArgs(1, 2),
+	Run:  owner}
+
+func newKey(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerApp)
+	curveSpec, err := parseCurve(args[0])
+	if err != nil {
+		logger.Fatal(err)
+	}
+	ks := mustOpenKeystore()
+	account, err := ks.Generate(curveSpec, passphrase())
+	if err != nil {
+		logger.Fatalf("Could not generate the key: %v", err)
+	}
+	if err := ks.Save(); err != nil {
+		logger.Fatalf("Could not save the keystore: %v", err)
+	}
+	fmt.Printf("%x\n", account)
+}
+
+func importKey(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerApp)
+	curveSpec, err := parseCurve(args[0])
+	if err != nil {
+		logger.Fatal(err)
+	}
+	privk, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+	if err != nil {
+		logger.Fatalf("Invalid private key: %v", err)
+	}
+	ks := mustOpenKeystore()
+	account, err := ks.Import(curveSpec, privk, passphrase())
+	if err != nil {
+		logger.Fatalf("Could not import the key: %v", err)
+	}
+	if err := ks.Save(); err != nil {
+		logger.Fatalf("Could not save the keystore: %v", err)
+	}
+	fmt.Printf("%x\n", account)
+}
+
+func list(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerApp)
+	ks := mustOpenKeystore()
+	keys, err := ks.Keys()
+	if err != nil {
+		logger.Fatalf("Could not read the keystore: %v", err)
+	}
+	for _, key := range keys {
+		fmt.Printf("%x %v\n", key.Account, curveName(key.CurveSpec))
+	}
+}
+
+func sign(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerApp)
+	account, err := parseAccount(args[0])
+	if err != nil {
+		logger.Fatal(err)
+	}
+	ks := mustOpenKeystore()
+	signer, err := ks.Signer(account, passphrase())
+	if err != nil {
+		logger.Fatalf("Could not load the key: %v", err)
+	}
+	sig, err := signer.Sign([]byte(args[1]))
+	if err != nil {
+		logger.Fatalf("Could not sign: %v", err)
+	}
+	fmt.Printf("%x\n", sig)
+}
+
+func verify(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerApp)
+	curveSpec, err := parseCurve(args[0])
+	if err != nil {
+		logger.Fatal(err)
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+	if err != nil {
+		logger.Fatalf("Invalid signature: %v", err)
+	}
+	var pubk []byte
+	switch curveSpec {
+	case constants.CurveSecp256k1:
+		pubk, err = (&crypto.Secp256k1Validator{}).Validate([]byte(args[2]), sig)
+	case constants.CurveBN256Eth:
+		pubk, err = (&crypto.BNValidator{}).Validate([]byte(args[2]), sig)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid signature: %v\n", err)
+		os.Exit(1)
+	}
+	signer := crypto.GetAccount(pubk)
+	if len(args) == 4 {
+		account, err := parseAccount(args[3])
+		if err != nil {
+			logger.Fatal(err)
+		}
+		if !bytes.Equal(account, signer) {
+			fmt.Fprintf(os.Stderr, "Signed by %x, not %x\n", signer, account)
+			os.Exit(1)
+		}
+	}
+	fmt.Printf("%x\n", signer)
+}
+
+func owner(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerApp)
+	account, err := parseAccount(args[0])
+	if err != nil {
+		logger.Fatal(err)
+	}
+	var curveSpec constants.CurveSpec
+	if len(args) == 2 {
+		curveSpec, err = parseCurve(args[1])
+	} else {
+		curveSpec, err = mustOpenKeystore().CurveSpec(account)
+	}
+	if err != nil {
+		logger.Fatal(err)
+	}
+	vso := &aobjs.ValueStoreOwner{}
+	vso.New(account, curveSpec)
+	vsob, err := vso.MarshalBinary()
+	if err != nil {
+		logger.Fatal(err)
+	}
+	dso := &aobjs.DataStoreOwner{}
+	dso.New(account, curveSpec)
+	dsob, err := dso.MarshalBinary()
+	if err != nil {
+		logger.Fatal(err)
+	}
+	fmt.Printf("ValueStoreOwner: %x\n", vsob)
+	fmt.Printf("DataStoreOwner:  %x\n", dsob)
+}
+
+func mustOpenKeystore() *wallet.Keystore {
+	logger := logging.GetLogger(constants.LoggerApp)
+	path := config.Configuration.Account.Keystore
+	if path == "" {
+		logger.Fatal("account.keystore must be set")
+	}
+	ks, err := wallet.OpenKeystore(path)
+	if err != nil {
+		logger.Fatalf("Could not open the keystore: %v", err)
+	}
+	return ks
+}
+
+// passphrase returns account.passphrase or reads a line from stdin if it is
+// not set
+func passphrase() string {
+	if p := config.Configuration.Account.Passphrase; p != "" {
+		return p
+	}
+	fmt.Fprint(os.Stderr, "Passphrase: ")
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		logging.GetLogger(constants.LoggerApp).Fatalf("Could not read the passphrase: %v", err)
+	}
+	return strings.TrimRight(line, "\r\n")
+}
+
+func parseCurve(s string) (constants.CurveSpec, error) {
+	switch strings.ToLower(s) {
+	case "secp256k1", "1":
+		return constants.CurveSecp256k1, nil
+	case "bn256", "2":
+		return constants.CurveBN256Eth, nil
+	default:
+		return 0, fmt.Errorf("invalid curve %q: must be secp256k1 or bn256", s)
+	}
+}
+
+func curveName(curveSpec constants.CurveSpec) string {
+	switch curveSpec {
+	case constants.CurveSecp256k1:
+		return "secp256k1"
+	case constants.CurveBN256Eth:
+		return "bn256"
+	default:
+		return fmt.Sprintf("curve%d", curveSpec)
+	}
+}
+
+func parseAccount(s string) ([]byte, error) {
+	account, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid account: %v", err)
+	}
+	if len(account) != constants.OwnerLen {
+		return nil, errors.New("invalid account: must be 20 bytes")
+	}
+	return account, nil
+}
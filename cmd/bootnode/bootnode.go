@@ -54,7 +54,7 @@ func bootNode(cmd *cobra.Command, args []string) {
 		panic(err)
 	}
 	// Establish P2P listener
-	xport, err := transport.NewP2PTransport(logger, cid, privateKeyHex, int(p2pPort), host)
+	xport, err := transport.NewP2PTransport(logger, cid, nil, privateKeyHex, int(p2pPort), host)
 	if err != nil {
 		logger.Panic(err)
 	}
@@ -0,0 +1,191 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/MadBase/MadNet/application"
+	"github.com/MadBase/MadNet/application/deposit"
+	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/consensus/archive"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/spf13/cobra"
+)
+
+// Command is the cobra.Command for moving chain history between nodes
+var Command = cobra.Command{
+	Use:   "chain",
+	Short: "Exports and imports the committed chain of a stopped node",
+	Long: "The chain commands open the badger directories configured by chain.stateDB and " +
+		"chain.transactionDB. The node must be stopped, badger refuses to open a directory in use."}
+
+// ExportCommand writes committed blocks to an archive
+var ExportCommand = cobra.Command{
+	Use:   "export <file> [from] [to]",
+	Short: "Writes the committed blocks, their transactions and validator sets to an archive",
+	Long: "Writes the blocks from height from, 1 by default, to height to, the most recent block by " +
+		"default, to a versioned and checksummed archive. A file of - writes to stdout.",
+	Args: cobra.RangeArgs(1, 3),
+	Run:  export}
+
+// ImportCommand applies the blocks of an archive
+var ImportCommand = cobra.Command{
+	Use:   "import <file>",
+	Short: "Verifies and applies the blocks of an archive",
+	Long: "Applies the blocks of an archive above the most recent block of the node. Every block is " +
+		"checked against the validator sets and its transactions are validated and applied like " +
+		"blocks received from peers. The node must hold the genesis block of the chain, and the " +
+		"validator sets and deposits of the imported blocks, which it learns from Ethereum. The " +
+		"validator sets of the archive must match those of the node.",
+	Args: cobra.ExactArgs(1),
+	Run:  importChain}
+
+// openDatabase opens the badger directory at path. It fails rather than
+// creating the directory when it does not exist.
+func openDatabase(ctx context.Context, path string) (*badger.DB, error) {
+	if path == "" {
+		return nil, fmt.Errorf("no path is configured")
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	return utils.OpenBadger(ctx.Done(), path, false)
+}
+
+// newApplication returns the application of the node, with the txPool in
+// txPoolDB
+func newApplication(consDB *db.Database, txPoolDB *badger.DB) (*application.Application, error) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	storage := &dynamics.Storage{}
+	if err := storage.Init(consDB, logger); err != nil {
+		return nil, err
+	}
+	storage.Start()
+	dph := &deposit.Handler{}
+	dph.Init()
+	app := &application.Application{}
+	if err := app.Init(consDB, txPoolDB, dph, storage); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+func parseHeight(s string) (uint32, error) {
+	h, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid height %q", s)
+	}
+	return uint32(h), nil
+}
+
+func export(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	var from, to uint32
+	for i, p := range []*uint32{&from, &to} {
+		if len(args) > i+1 {
+			h, err := parseHeight(args[i+1])
+			if err != nil {
+				logger.Fatal(err)
+			}
+			*p = h
+		}
+	}
+
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+	rawDB, err := openDatabase(ctx, config.Configuration.Chain.StateDbPath)
+	if err != nil {
+		logger.Fatalf("Could not open the state database: %v", err)
+	}
+	defer rawDB.Close()
+	fatalf := func(format string, args ...interface{}) {
+		rawDB.Close()
+		logger.Fatalf(format, args...)
+	}
+	memDB, err := utils.OpenBadger(ctx.Done(), "", true)
+	if err != nil {
+		fatalf("Could not open the scratch database: %v", err)
+	}
+	defer memDB.Close()
+	consDB := &db.Database{}
+	consDB.Init(rawDB)
+	app, err := newApplication(consDB, memDB)
+	if err != nil {
+		fatalf("Could not initialize the application: %v", err)
+	}
+
+	out := os.Stdout
+	if args[0] != "-" {
+		f, err := os.Create(args[0])
+		if err != nil {
+			fatalf("Could not create the archive: %v", err)
+		}
+		defer f.Close()
+		out = f
+	}
+	n, err := archive.Export(consDB, app, out, from, to)
+	if err != nil {
+		fatalf("Export failed after %d blocks: %v", n, err)
+	}
+	if err := out.Sync(); err != nil && out != os.Stdout {
+		fatalf("Could not write the archive: %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "exported %d blocks\n", n)
+}
+
+func importChain(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	f, err := os.Open(args[0])
+	if err != nil {
+		logger.Fatalf("Could not open the archive: %v", err)
+	}
+	defer f.Close()
+	ar, err := archive.NewReader(f)
+	if err != nil {
+		logger.Fatalf("Could not read the archive: %v", err)
+	}
+
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+	rawDB, err := openDatabase(ctx, config.Configuration.Chain.StateDbPath)
+	if err != nil {
+		logger.Fatalf("Could not open the state database: %v", err)
+	}
+	defer rawDB.Close()
+	fatalf := func(format string, args ...interface{}) {
+		rawDB.Close()
+		logger.Fatalf(format, args...)
+	}
+	txPoolDB, err := openDatabase(ctx, config.Configuration.Chain.TransactionDbPath)
+	if err != nil {
+		fatalf("Could not open the transaction database: %v", err)
+	}
+	defer txPoolDB.Close()
+	consDB := &db.Database{}
+	consDB.Init(rawDB)
+	app, err := newApplication(consDB, txPoolDB)
+	if err != nil {
+		txPoolDB.Close()
+		fatalf("Could not initialize the application: %v", err)
+	}
+
+	im := &archive.Importer{}
+	im.Init(consDB, app)
+	report, err := im.Import(ar)
+	if report != nil {
+		fmt.Printf("skipped:   %d\n", report.Skipped)
+		fmt.Printf("imported:  %d\n", report.Imported)
+		fmt.Printf("height:    %d\n", report.Height)
+	}
+	if err != nil {
+		txPoolDB.Close()
+		fatalf("Import failed: %v", err)
+	}
+}
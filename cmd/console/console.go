@@ -0,0 +1,111 @@
+package console
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/ipc"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/spf13/cobra"
+)
+
+// Command is the cobra.Command for the admin console of a validator
+var Command = cobra.Command{
+	Use:   "console [method] [args...]",
+	Short: "Opens the admin console of a running validator",
+	Long: "Connects to the admin API a validator serves on validator.adminSocket when validator.repl " +
+		"is set. Without arguments commands are read line by line, help lists them. With arguments a " +
+		"single method is called and its result printed.",
+	Run: console}
+
+func console(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerIPC)
+
+	socket := config.Configuration.Console.Socket
+	if socket == "" {
+		logger.Fatal("console.socket must be set")
+	}
+	client, err := ipc.DialAdmin(socket)
+	if err != nil {
+		logger.Fatalf("Could not connect to %v: %v", socket, err)
+	}
+	defer client.Close()
+
+	if len(args) > 0 {
+		if err := call(os.Stdout, client, args); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			client.Close()
+			os.Exit(1)
+		}
+		return
+	}
+	repl(os.Stdin, os.Stdout, client)
+}
+
+// repl calls the methods named on each line read from in until it is
+// exhausted or exit is read
+func repl(in io.Reader, out io.Writer, client *ipc.AdminClient) {
+	scanner := bufio.NewScanner(in)
+	fmt.Fprint(out, "madnet> ")
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		switch {
+		case len(fields) == 0:
+		case fields[0] == "exit" || fields[0] == "quit":
+			return
+		case fields[0] == "help":
+			if err := help(out, client); err != nil {
+				fmt.Fprintln(out, err)
+			}
+		default:
+			if err := call(out, client, fields); err != nil {
+				fmt.Fprintln(out, err)
+			}
+		}
+		fmt.Fprint(out, "madnet> ")
+	}
+	fmt.Fprintln(out)
+}
+
+// help prints the methods of the admin API
+func help(out io.Writer, client *ipc.AdminClient) error {
+	result, err := client.Call("methods")
+	if err != nil {
+		return err
+	}
+	var methods []ipc.AdminMethodInfo
+	if err := json.Unmarshal(result, &methods); err != nil {
+		return err
+	}
+	for _, m := range methods {
+		fmt.Fprintf(out, "  %-12s %s\n", m.Name, m.Usage)
+	}
+	fmt.Fprintf(out, "  %-12s %s\n", "exit", "closes the console")
+	return nil
+}
+
+// call calls the method fields[0] with the other fields as arguments and
+// prints the result
+func call(out io.Writer, client *ipc.AdminClient, fields []string) error {
+	result, err := client.Call(fields[0], fields[1:]...)
+	if err != nil {
+		return err
+	}
+	if len(result) == 0 {
+		fmt.Fprintln(out, "ok")
+		return nil
+	}
+	indented := &bytes.Buffer{}
+	if err := json.Indent(indented, result, "", "  "); err != nil {
+		return err
+	}
+	fmt.Fprintln(out, indented.String())
+	return nil
+}
@@ -0,0 +1,192 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/constants/dbprefix"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/spf13/cobra"
+)
+
+// Command is the cobra.Command for inspecting and repairing the databases
+// of a stopped node. The databases are found through chain.stateDB,
+// chain.transactionDB and chain.monitorDB.
+var Command = cobra.Command{
+	Use:   "db",
+	Short: "Inspects and repairs the databases of a stopped node",
+	Long: "The db commands open the badger directories configured by chain.stateDB, chain.transactionDB " +
+		"and chain.monitorDB. The node must be stopped, badger refuses to open a directory in use."}
+
+// PrefixesCommand lists the key counts and sizes by prefix
+var PrefixesCommand = cobra.Command{
+	Use:   "prefixes",
+	Short: "Lists the number of keys and bytes stored under every prefix",
+	Args:  cobra.NoArgs,
+	Run:   prefixes}
+
+// GetCommand decodes the value of a single key
+var GetCommand = cobra.Command{
+	Use:   "get <hex key>",
+	Short: "Prints the decoded value stored for a key",
+	Args:  cobra.ExactArgs(1),
+	Run:   get}
+
+// DumpCommand decodes every key under a prefix
+var DumpCommand = cobra.Command{
+	Use:   "dump <prefix> [limit]",
+	Short: "Prints the decoded values stored under a prefix",
+	Long: "The prefix is given by its two character identifier, by its name as listed by db prefixes or " +
+		"in hex. At most limit entries are printed, 20 by default and all of them if limit is 0.",
+	Args: cobra.RangeArgs(1, 2),
+	Run:  dump}
+
+// DecodeCommand decodes an object given in hex
+var DecodeCommand = cobra.Command{
+	Use:   "decode <type> <hex>",
+	Short: "Decodes a serialized consensus or application object",
+	Long:  fmt.Sprintf("Decodes an object serialized as it is stored or sent over the wire. The type is one of %v.", decoderNames()),
+	Args:  cobra.ExactArgs(2),
+	Run:   decode}
+
+// VerifyCommand checks the header trie and the UTXO trie against the
+// committed block headers
+var VerifyCommand = cobra.Command{
+	Use:   "verify",
+	Short: "Verifies the header trie and state trie roots against the committed headers",
+	Args:  cobra.NoArgs,
+	Run:   verify}
+
+// RollbackCommand returns the chain to an earlier height
+var RollbackCommand = cobra.Command{
+	Use:   "rollback <height>",
+	Short: "Rolls the chain back to a height",
+	Long: "Removes the blocks above height and undoes their transactions. Every height is rolled back " +
+		"in its own database transaction, so an interrupted rollback leaves the node at a consistent " +
+		"intermediate height. The data needed to undo every block is checked before anything is written. " +
+		"The validator statistics of the removed heights are dropped and those of the epoch of height are " +
+		"rebuilt from the round states the node still holds. " +
+		"The node will sync the removed blocks from its peers again when it is started.",
+	Args: cobra.ExactArgs(1),
+	Run:  rollback}
+
+// ResetCommand clears the state database except for the keys of the node
+var ResetCommand = cobra.Command{
+	Use:   "reset",
+	Short: "Removes everything but the encrypted key store from the state database",
+	Long: "Drops every prefix of the state database except the encrypted key store. The node keeps its " +
+		"keys and syncs the chain from its peers again when it is started.",
+	Args: cobra.NoArgs,
+	Run:  reset}
+
+// GCCommand compacts the state database
+var GCCommand = cobra.Command{
+	Use:   "gc",
+	Short: "Compacts the state database and garbage collects its value log",
+	Args:  cobra.NoArgs,
+	Run:   gc}
+
+// RekeyCommand re-encrypts the encrypted key store with the primary key
+var RekeyCommand = cobra.Command{
+	Use:   "rekey",
+	Short: "Re-encrypts the encrypted key store with the primary key",
+	Long: "Decrypts every entry of the encrypted key store which is not encrypted with the key " +
+		"validator.primaryKeyId and encrypts it with that key. The keys are validator.symmetricKey and " +
+		"the keys listed in the file validator.symmetricKeys. No entry is rewritten if any of them can " +
+		"not be decrypted. The old keys can be removed from validator.symmetricKeys afterwards.",
+	Args: cobra.NoArgs,
+	Run:  rekey}
+
+// database names a badger directory of the node
+type database string
+
+const (
+	stateDatabase       database = "state"
+	transactionDatabase database = "transaction"
+	monitorDatabase     database = "monitor"
+)
+
+var databases = []database{stateDatabase, transactionDatabase, monitorDatabase}
+
+func (d database) path() string {
+	switch d {
+	case stateDatabase:
+		return config.Configuration.Chain.StateDbPath
+	case transactionDatabase:
+		return config.Configuration.Chain.TransactionDbPath
+	case monitorDatabase:
+		return config.Configuration.Chain.MonitorDbPath
+	}
+	return ""
+}
+
+// open opens the badger directory of d. It fails rather than creating the
+// directory when it does not exist.
+func (d database) open(ctx context.Context) (*badger.DB, error) {
+	path := d.path()
+	if path == "" {
+		return nil, fmt.Errorf("no path is configured for the %v database", d)
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	return utils.OpenBadger(ctx.Done(), path, false)
+}
+
+// mustOpen opens the badger directory of d or exits
+func (d database) mustOpen(ctx context.Context) *badger.DB {
+	rawDB, err := d.open(ctx)
+	if err != nil {
+		logging.GetLogger(constants.LoggerDB).Fatalf("Could not open the %v database: %v", d, err)
+	}
+	return rawDB
+}
+
+func reset(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+	rawDB := stateDatabase.mustOpen(ctx)
+	defer rawDB.Close()
+
+	drop := [][]byte{}
+	for _, p := range prefixTable {
+		if p.db == stateDatabase && !bytes.Equal(p.key, dbprefix.PrefixEncryptedStore()) {
+			drop = append(drop, p.key)
+		}
+	}
+	if err := rawDB.DropPrefix(drop...); err != nil {
+		rawDB.Close()
+		logger.Fatalf("Could not reset the state database: %v", err)
+	}
+}
+
+func gc(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+	rawDB := stateDatabase.mustOpen(ctx)
+	defer rawDB.Close()
+
+	if err := rawDB.Flatten(4); err != nil {
+		logger.Fatalf("Could not flatten the database: %v", err)
+	}
+	for i := 1; ; i++ {
+		if err := rawDB.RunValueLogGC(0.01); err != nil {
+			if err != badger.ErrNoRewrite {
+				logger.Fatalf("Value log GC failed: %v", err)
+			}
+			break
+		}
+		fmt.Printf("rewrote value log file %d\n", i)
+	}
+	if err := rawDB.Flatten(4); err != nil {
+		logger.Fatalf("Could not flatten the database: %v", err)
+	}
+}
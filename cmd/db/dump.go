@@ -0,0 +1,265 @@
+package db
+
+import (
+	"context"
+	"encoding"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	aobjs "github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/spf13/cobra"
+)
+
+// decoders maps the type names accepted by db decode to constructors of
+// the objects they decode into
+var decoders = map[string]func() encoding.BinaryUnmarshaler{
+	"blockheader":        func() encoding.BinaryUnmarshaler { return &objs.BlockHeader{} },
+	"bclaims":            func() encoding.BinaryUnmarshaler { return &objs.BClaims{} },
+	"encryptedstore":     func() encoding.BinaryUnmarshaler { return &objs.EncryptedStore{} },
+	"nextheight":         func() encoding.BinaryUnmarshaler { return &objs.NextHeight{} },
+	"nextround":          func() encoding.BinaryUnmarshaler { return &objs.NextRound{} },
+	"ownstate":           func() encoding.BinaryUnmarshaler { return &objs.OwnState{} },
+	"ownvalidatingstate": func() encoding.BinaryUnmarshaler { return &objs.OwnValidatingState{} },
+	"precommit":          func() encoding.BinaryUnmarshaler { return &objs.PreCommit{} },
+	"precommitnil":       func() encoding.BinaryUnmarshaler { return &objs.PreCommitNil{} },
+	"prevote":            func() encoding.BinaryUnmarshaler { return &objs.PreVote{} },
+	"prevotenil":         func() encoding.BinaryUnmarshaler { return &objs.PreVoteNil{} },
+	"proposal":           func() encoding.BinaryUnmarshaler { return &objs.Proposal{} },
+	"rcert":              func() encoding.BinaryUnmarshaler { return &objs.RCert{} },
+	"roundstate":         func() encoding.BinaryUnmarshaler { return &objs.RoundState{} },
+	"validatorset":       func() encoding.BinaryUnmarshaler { return &objs.ValidatorSet{} },
+	"tx":                 func() encoding.BinaryUnmarshaler { return &aobjs.Tx{} },
+	"utxo":               func() encoding.BinaryUnmarshaler { return &aobjs.TXOut{} },
+}
+
+func decoderNames() string {
+	names := make([]string, 0, len(decoders))
+	for name := range decoders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// decodeValue decodes data with the named decoder. Some objects panic
+// rather than fail on malformed data, which is recovered from.
+func decodeValue(name string, data []byte) (v interface{}, err error) {
+	newObj, ok := decoders[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q, the type is one of %v", name, decoderNames())
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			v, err = nil, errorz.ErrInvalid{}.New("bad serialization")
+		}
+	}()
+	obj := newObj()
+	if err := obj.UnmarshalBinary(utils.CopySlice(data)); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func get(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	key, err := hex.DecodeString(args[0])
+	if err != nil {
+		logger.Fatalf("Invalid key: %v", err)
+	}
+	p, ok := prefixOfKey(key)
+	if !ok {
+		p = prefix{name: "unknown", db: stateDatabase}
+	}
+
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+	rawDB := p.db.mustOpen(ctx)
+	defer rawDB.Close()
+
+	var value []byte
+	err = rawDB.View(func(txn *badger.Txn) error {
+		v, err := utils.GetValue(txn, key)
+		value = v
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		fmt.Fprintf(os.Stderr, "key %x not found in the %v database\n", key, p.db)
+		cf()
+		rawDB.Close()
+		os.Exit(1)
+	}
+	if err != nil {
+		logger.Fatalf("Could not read the key: %v", err)
+	}
+	printEntry(os.Stdout, p, key, value)
+}
+
+func dump(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	p, ok := lookupPrefix(args[0])
+	if !ok {
+		logger.Fatalf("Unknown prefix %q, see db prefixes", args[0])
+	}
+	limit := 20
+	if len(args) > 1 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 0 {
+			logger.Fatalf("Invalid limit %q", args[1])
+		}
+		limit = n
+	}
+
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+	rawDB := p.db.mustOpen(ctx)
+	defer rawDB.Close()
+
+	err := rawDB.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = p.key
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		n := 0
+		for it.Rewind(); it.Valid(); it.Next() {
+			if limit > 0 && n == limit {
+				fmt.Printf("... stopped after %d entries\n", limit)
+				break
+			}
+			item := it.Item()
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			printEntry(os.Stdout, p, item.KeyCopy(nil), value)
+			n++
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Fatalf("Could not iterate the prefix: %v", err)
+	}
+}
+
+func decode(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	data, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+	if err != nil {
+		logger.Fatalf("Invalid hex: %v", err)
+	}
+	obj, err := decodeValue(args[0], data)
+	if err != nil {
+		logger.Fatalf("Could not decode the object: %v", err)
+	}
+	printValue(os.Stdout, reflect.ValueOf(obj), 0)
+	fmt.Println()
+}
+
+// printEntry prints a key and its value decoded with the decoder of its
+// prefix. Values without a decoder, or which fail to decode, are printed
+// in hex.
+func printEntry(w io.Writer, p prefix, key, value []byte) {
+	fmt.Fprintf(w, "key:   %x (%v)\n", key, p.name)
+	if p.decoder != "" {
+		obj, err := decodeValue(p.decoder, value)
+		if err == nil {
+			fmt.Fprint(w, "value: ")
+			printValue(w, reflect.ValueOf(obj), 1)
+			fmt.Fprintln(w)
+			return
+		}
+		fmt.Fprintf(w, "error: could not decode as %v: %v\n", p.decoder, err)
+	}
+	fmt.Fprintf(w, "value: %x\n", value)
+}
+
+// txOutView exposes the unexported fields of a TXOut to printValue
+type txOutView struct {
+	DataStore  *aobjs.DataStore
+	ValueStore *aobjs.ValueStore
+	AtomicSwap *aobjs.AtomicSwap
+	TxFee      *aobjs.TxFee
+	Withdrawal *aobjs.Withdrawal
+}
+
+func newTxOutView(utxo *aobjs.TXOut) *txOutView {
+	view := &txOutView{}
+	if utxo.HasDataStore() {
+		view.DataStore, _ = utxo.DataStore()
+	}
+	if utxo.HasValueStore() {
+		view.ValueStore, _ = utxo.ValueStore()
+	}
+	if utxo.HasAtomicSwap() {
+		view.AtomicSwap, _ = utxo.AtomicSwap()
+	}
+	if utxo.HasTxFee() {
+		view.TxFee, _ = utxo.TxFee()
+	}
+	if utxo.HasWithdrawal() {
+		view.Withdrawal, _ = utxo.Withdrawal()
+	}
+	return view
+}
+
+var (
+	bytesType    = reflect.TypeOf([]byte(nil))
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// printValue prints the exported fields of v, one per line and indented by
+// depth. Byte slices are printed in hex and nil pointers as nil.
+func printValue(w io.Writer, v reflect.Value, depth int) {
+	indent := strings.Repeat("  ", depth)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		if utxo, ok := v.Interface().(*aobjs.TXOut); ok {
+			v = reflect.ValueOf(newTxOutView(utxo))
+		}
+	}
+	switch {
+	case (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil():
+		fmt.Fprint(w, "nil")
+	case v.Type() == bytesType:
+		fmt.Fprintf(w, "%x", v.Bytes())
+	case v.Type().Implements(stringerType) && v.Kind() == reflect.Ptr:
+		fmt.Fprint(w, v.Interface().(fmt.Stringer).String())
+	case v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface:
+		printValue(w, v.Elem(), depth)
+	case v.Kind() == reflect.Struct:
+		fmt.Fprint(w, "{")
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" || f.Type.Kind() == reflect.Map {
+				continue
+			}
+			fmt.Fprintf(w, "\n%v  %v: ", indent, f.Name)
+			printValue(w, v.Field(i), depth+1)
+		}
+		fmt.Fprintf(w, "\n%v}", indent)
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		if v.Len() == 0 {
+			fmt.Fprint(w, "[]")
+			return
+		}
+		fmt.Fprint(w, "[")
+		for i := 0; i < v.Len(); i++ {
+			fmt.Fprintf(w, "\n%v  ", indent)
+			printValue(w, v.Index(i), depth+1)
+		}
+		fmt.Fprintf(w, "\n%v]", indent)
+	default:
+		fmt.Fprintf(w, "%v", v.Interface())
+	}
+}
@@ -0,0 +1,201 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/constants/dbprefix"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/spf13/cobra"
+)
+
+// prefix describes a key prefix of constants/dbprefix: the database it is
+// stored in and the decoder for its values
+type prefix struct {
+	key     []byte
+	name    string
+	db      database
+	decoder string
+}
+
+var prefixTable = []prefix{
+	{dbprefix.PrefixOwnValidatingState(), "OwnValidatingState", stateDatabase, "ownvalidatingstate"},
+	{dbprefix.PrefixCurrentRoundState(), "CurrentRoundState", stateDatabase, "roundstate"},
+	{dbprefix.PrefixHistoricRoundState(), "HistoricRoundState", stateDatabase, "roundstate"},
+	{dbprefix.PrefixEncryptedStore(), "EncryptedStore", stateDatabase, "encryptedstore"},
+	{dbprefix.PrefixOwnState(), "OwnState", stateDatabase, "ownstate"},
+	{dbprefix.PrefixValidatorSet(), "ValidatorSet", stateDatabase, "validatorset"},
+	{dbprefix.PrefixBroadcastRCert(), "BroadcastRCert", stateDatabase, "rcert"},
+	{dbprefix.PrefixBroadcastProposal(), "BroadcastProposal", stateDatabase, "proposal"},
+	{dbprefix.PrefixBroadcastPreVote(), "BroadcastPreVote", stateDatabase, "prevote"},
+	{dbprefix.PrefixBroadcastPreVoteNil(), "BroadcastPreVoteNil", stateDatabase, "prevotenil"},
+	{dbprefix.PrefixBroadcastPreCommit(), "BroadcastPreCommit", stateDatabase, "precommit"},
+	{dbprefix.PrefixBroadcastPreCommitNil(), "BroadcastPreCommitNil", stateDatabase, "precommitnil"},
+	{dbprefix.PrefixBroadcastNextRound(), "BroadcastNextRound", stateDatabase, "nextround"},
+	{dbprefix.PrefixBroadcastNextHeight(), "BroadcastNextHeight", stateDatabase, "nextheight"},
+	{dbprefix.PrefixBroadcastBlockHeader(), "BroadcastBlockHeader", stateDatabase, "blockheader"},
+	{dbprefix.PrefixCommittedBlockHeader(), "CommittedBlockHeader", stateDatabase, "blockheader"},
+	{dbprefix.PrefixCommittedBlockHeaderHashIndex(), "CommittedBlockHeaderHashIndex", stateDatabase, ""},
+	{dbprefix.PrefixBlockHeaderTrie(), "BlockHeaderTrie", stateDatabase, ""},
+	{dbprefix.PrefixBlockHeaderTrieRootCurrent(), "BlockHeaderTrieRootCurrent", stateDatabase, ""},
+	{dbprefix.PrefixBlockHeaderTrieRootHistoric(), "BlockHeaderTrieRootHistoric", stateDatabase, ""},
+	{dbprefix.PrefixSafeToProceed(), "SafeToProceed", stateDatabase, ""},
+	{dbprefix.PrefixBroadcastTransaction(), "BroadcastTransaction", stateDatabase, "tx"},
+	{dbprefix.PrefixSnapshotBlockHeader(), "SnapshotBlockHeader", stateDatabase, "blockheader"},
+	{dbprefix.PrefixTxCache(), "TxCache", stateDatabase, "tx"},
+	{dbprefix.PrefixPendingNodeKey(), "PendingNodeKey", stateDatabase, ""},
+	{dbprefix.PrefixPendingLeafKey(), "PendingLeafKey", stateDatabase, ""},
+	{dbprefix.PrefixPendingHdrNodeKey(), "PendingHdrNodeKey", stateDatabase, ""},
+	{dbprefix.PrefixPendingHdrLeafKey(), "PendingHdrLeafKey", stateDatabase, ""},
+	{dbprefix.PrefixStagedBlockHeaderKey(), "StagedBlockHeaderKey", stateDatabase, "blockheader"},
+	{dbprefix.PrefixRawStorageKey(), "RawStorageKey", stateDatabase, ""},
+	{dbprefix.PrefixStorageNodeKey(), "StorageNodeKey", stateDatabase, ""},
+	{dbprefix.PrefixPendingNodeKeyCount(), "PendingNodeKeyCount", stateDatabase, ""},
+	{dbprefix.PrefixPendingLeafKeyCount(), "PendingLeafKeyCount", stateDatabase, ""},
+	{dbprefix.PrefixPendingHdrNodeKeyCount(), "PendingHdrNodeKeyCount", stateDatabase, ""},
+	{dbprefix.PrefixPendingHdrLeafKeyCount(), "PendingHdrLeafKeyCount", stateDatabase, ""},
+	{dbprefix.PrefixCommittedBlockHeaderCount(), "CommittedBlockHeaderCount", stateDatabase, ""},
+	{dbprefix.PrefixCommittedBlockRound(), "CommittedBlockRound", stateDatabase, ""},
+	{dbprefix.PrefixValidatorStatsEpoch(), "ValidatorStatsEpoch", stateDatabase, ""},
+	{dbprefix.PrefixValidatorStatsHeight(), "ValidatorStatsHeight", stateDatabase, ""},
+	{dbprefix.PrefixGenesisHash(), "GenesisHash", stateDatabase, ""},
+
+	{dbprefix.PrefixMinedTx(), "MinedTx", stateDatabase, "tx"},
+	{dbprefix.PrefixMinedTxIndexRefKey(), "MinedTxIndexRefKey", stateDatabase, ""},
+	{dbprefix.PrefixMinedTxIndexKey(), "MinedTxIndexKey", stateDatabase, ""},
+	{dbprefix.PrefixMinedTxSpentByKey(), "MinedTxSpentByKey", stateDatabase, ""},
+	{dbprefix.PrefixSupplyLedgerKey(), "SupplyLedgerKey", stateDatabase, ""},
+	{dbprefix.PrefixSupplyCheckedKey(), "SupplyCheckedKey", stateDatabase, ""},
+	{dbprefix.PrefixTrieRootForHeight(), "TrieRootForHeight", stateDatabase, ""},
+	{dbprefix.PrefixUTXOTrie(), "UTXOTrie", stateDatabase, ""},
+	{dbprefix.PrefixCurrentStateRoot(), "CurrentStateRoot", stateDatabase, ""},
+	{dbprefix.PrefixPendingStateRoot(), "PendingStateRoot", stateDatabase, ""},
+	{dbprefix.PrefixCanonicalStateRoot(), "CanonicalStateRoot", stateDatabase, ""},
+	{dbprefix.PrefixPendingTx(), "PendingTx", transactionDatabase, "tx"},
+	{dbprefix.PrefixPendingTxEpochConstraintListRef(), "PendingTxEpochConstraintListRef", transactionDatabase, ""},
+	{dbprefix.PrefixPendingTxEpochConstraintList(), "PendingTxEpochConstraintList", transactionDatabase, ""},
+	{dbprefix.PrefixPendingTxInsertionOrderIndex(), "PendingTxInsertionOrderIndex", transactionDatabase, ""},
+	{dbprefix.PrefixPendingTxInsertionOrderReverseIndex(), "PendingTxInsertionOrderReverseIndex", transactionDatabase, ""},
+	{dbprefix.PrefixMinedUTXO(), "MinedUTXO", stateDatabase, "utxo"},
+	{dbprefix.PrefixMinedUTXOEpcKey(), "MinedUTXOEpcKey", stateDatabase, ""},
+	{dbprefix.PrefixMinedUTXOEpcRefKey(), "MinedUTXOEpcRefKey", stateDatabase, ""},
+	{dbprefix.PrefixMinedUTXODataKey(), "MinedUTXODataKey", stateDatabase, ""},
+	{dbprefix.PrefixMinedUTXODataRefKey(), "MinedUTXODataRefKey", stateDatabase, ""},
+	{dbprefix.PrefixMinedUTXOValueRefKey(), "MinedUTXOValueRefKey", stateDatabase, ""},
+	{dbprefix.PrefixMinedUTXOValueKey(), "MinedUTXOValueKey", stateDatabase, ""},
+	{dbprefix.PrefixUTXORefLinker(), "UTXORefLinker", transactionDatabase, ""},
+	{dbprefix.PrefixUTXORefLinkerRev(), "UTXORefLinkerRev", transactionDatabase, ""},
+	{dbprefix.PrefixUTXOCounter(), "UTXOCounter", transactionDatabase, ""},
+	{dbprefix.PrefixDeposit(), "Deposit", stateDatabase, "utxo"},
+	{dbprefix.PrefixDepositValueRefKey(), "DepositValueRefKey", stateDatabase, ""},
+	{dbprefix.PrefixDepositValueKey(), "DepositValueKey", stateDatabase, ""},
+	{dbprefix.PrefixPendingTxCooldownKey(), "PendingTxCooldownKey", transactionDatabase, ""},
+	{dbprefix.PrefixMinedUTXOTimelockKey(), "MinedUTXOTimelockKey", stateDatabase, ""},
+	{dbprefix.PrefixMinedUTXOWithdrawalKey(), "MinedUTXOWithdrawalKey", stateDatabase, ""},
+	{dbprefix.PrefixMinedUTXOWithdrawalRefKey(), "MinedUTXOWithdrawalRefKey", stateDatabase, ""},
+}
+
+// lookupPrefix finds a prefix by its identifier, its name or its hex
+// encoding
+func lookupPrefix(s string) (prefix, bool) {
+	for _, p := range prefixTable {
+		if s == string(p.key) || strings.EqualFold(s, p.name) || s == hex.EncodeToString(p.key) {
+			return p, true
+		}
+	}
+	return prefix{}, false
+}
+
+// prefixOfKey returns the prefix a key is stored under
+func prefixOfKey(key []byte) (prefix, bool) {
+	for _, p := range prefixTable {
+		if bytes.HasPrefix(key, p.key) {
+			return p, true
+		}
+	}
+	return prefix{}, false
+}
+
+type prefixUsage struct {
+	name       string
+	keys       int
+	keyBytes   int64
+	valueBytes int64
+}
+
+func prefixes(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "DATABASE\tPREFIX\tNAME\tKEYS\tKEY BYTES\tVALUE BYTES\t")
+	for _, d := range databases {
+		if d.path() == "" {
+			continue
+		}
+		rawDB, err := d.open(ctx)
+		if err != nil {
+			logger.Errorf("Could not open the %v database: %v", d, err)
+			continue
+		}
+		usage, err := scanPrefixes(rawDB, d)
+		rawDB.Close()
+		if err != nil {
+			logger.Fatalf("Could not scan the %v database: %v", d, err)
+		}
+		ids := make([]string, 0, len(usage))
+		for id := range usage {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			u := usage[id]
+			fmt.Fprintf(w, "%v\t%q\t%v\t%d\t%d\t%d\t\n", d, id, u.name, u.keys, u.keyBytes, u.valueBytes)
+		}
+	}
+	w.Flush()
+}
+
+// scanPrefixes counts the keys and bytes of rawDB by prefix. Keys which do
+// not start with a known prefix are counted by their first two bytes.
+func scanPrefixes(rawDB *badger.DB, d database) (map[string]*prefixUsage, error) {
+	usage := make(map[string]*prefixUsage)
+	err := rawDB.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			key := item.Key()
+			id, name := string(key), "unknown"
+			if p, ok := prefixOfKey(key); ok {
+				id, name = string(p.key), p.name
+				if p.db != d {
+					name += " (misplaced)"
+				}
+			} else if len(key) > 2 {
+				id = string(key[:2])
+			}
+			u, ok := usage[id]
+			if !ok {
+				u = &prefixUsage{name: name}
+				usage[id] = u
+			}
+			u.keys++
+			u.keyBytes += int64(len(key))
+			u.valueBytes += item.ValueSize()
+		}
+		return nil
+	})
+	return usage, err
+}
@@ -0,0 +1,39 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/consensus/admin"
+	consensusdb "github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/spf13/cobra"
+)
+
+func rekey(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	keyring, err := admin.LoadKeyring(
+		crypto.Hasher([]byte(config.Configuration.Validator.SymmetricKey)),
+		config.Configuration.Validator.SymmetricKeys,
+		config.Configuration.Validator.PrimaryKeyID)
+	if err != nil {
+		logger.Fatalf("Could not load the keys: %v", err)
+	}
+
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+	rawDB := stateDatabase.mustOpen(ctx)
+	defer rawDB.Close()
+	consDB := &consensusdb.Database{}
+	consDB.Init(rawDB)
+
+	n, err := keyring.Rotate(consDB)
+	if err != nil {
+		rawDB.Close()
+		logger.Fatalf("Could not re-encrypt the key store: %v", err)
+	}
+	fmt.Printf("re-encrypted %d entries with key %q\n", n, keyring.Primary())
+}
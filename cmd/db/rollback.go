@@ -0,0 +1,239 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/MadBase/MadNet/application"
+	"github.com/MadBase/MadNet/application/deposit"
+	"github.com/MadBase/MadNet/application/utxohandler/utxotrie"
+	consensusdb "github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/consensus/stats"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/constants/dbprefix"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/spf13/cobra"
+)
+
+// broadcastPrefixes are the keys of the objects waiting to be gossiped
+var broadcastPrefixes = [][]byte{
+	dbprefix.PrefixBroadcastRCert(),
+	dbprefix.PrefixBroadcastProposal(),
+	dbprefix.PrefixBroadcastPreVote(),
+	dbprefix.PrefixBroadcastPreVoteNil(),
+	dbprefix.PrefixBroadcastPreCommit(),
+	dbprefix.PrefixBroadcastPreCommitNil(),
+	dbprefix.PrefixBroadcastNextRound(),
+	dbprefix.PrefixBroadcastNextHeight(),
+	dbprefix.PrefixBroadcastBlockHeader(),
+}
+
+func rollback(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	h, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil || h == 0 {
+		logger.Fatalf("Invalid height %q", args[0])
+	}
+	height := uint32(h)
+
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+	rawDB := stateDatabase.mustOpen(ctx)
+	defer rawDB.Close()
+	fatalf := func(format string, args ...interface{}) {
+		rawDB.Close()
+		logger.Fatalf(format, args...)
+	}
+
+	// the pending tx pool is not touched, the application only needs a
+	// scratch database for it
+	memDB, err := utils.OpenBadger(ctx.Done(), "", true)
+	if err != nil {
+		fatalf("Could not open the scratch database: %v", err)
+	}
+	defer memDB.Close()
+	consDB := &consensusdb.Database{}
+	consDB.Init(rawDB)
+	dph := &deposit.Handler{}
+	dph.Init()
+	app := &application.Application{}
+	if err := app.Init(consDB, memDB, dph, nil); err != nil {
+		fatalf("Could not initialize the application: %v", err)
+	}
+
+	var tip uint32
+	err = consDB.View(func(txn *badger.Txn) error {
+		t, err := checkRollback(txn, consDB, app, utxotrie.NewUTXOTrie(rawDB), height)
+		tip = t
+		return err
+	})
+	if err != nil {
+		fatalf("Can not roll back to height %d: %v", height, err)
+	}
+
+	// the validator statistics are rolled back first; should the rollback
+	// be interrupted, the indexer counts the heights which remain again
+	ix := &stats.Indexer{}
+	ix.Init(consDB)
+	err = consDB.Update(func(txn *badger.Txn) error {
+		return ix.Rollback(txn, height)
+	})
+	if err != nil {
+		fatalf("Could not roll back the validator statistics: %v", err)
+	}
+
+	for h := tip; h > height; h-- {
+		err := consDB.Update(func(txn *badger.Txn) error {
+			return rollbackHeight(txn, consDB, app, h)
+		})
+		if err != nil {
+			fatalf("Could not roll back height %d, the chain remains at height %d: %v", h, h, err)
+		}
+		fmt.Printf("rolled back height %d\n", h)
+	}
+}
+
+// checkRollback verifies that every height above height can be rolled back
+// and returns the current height
+func checkRollback(txn *badger.Txn, consDB *consensusdb.Database, app *application.Application, ut *utxotrie.UTXOTrie, height uint32) (uint32, error) {
+	os, err := consDB.GetOwnState(txn)
+	if err != nil {
+		return 0, err
+	}
+	tip := os.SyncToBH.BClaims.Height
+	if height >= tip {
+		return 0, errorz.ErrInvalid{}.New(fmt.Sprintf("the chain is at height %d", tip))
+	}
+	for h := tip; h > height; h-- {
+		bh, err := consDB.GetCommittedBlockHeader(txn, h)
+		if err != nil {
+			return 0, fmt.Errorf("block header %d: %v", h, err)
+		}
+		_, missing, err := app.MinedTxGet(txn, bh.TxHshLst)
+		if err != nil {
+			return 0, fmt.Errorf("transactions of height %d: %v", h, err)
+		}
+		if len(missing) > 0 {
+			return 0, fmt.Errorf("%d transactions of height %d are missing", len(missing), h)
+		}
+		if _, err := ut.GetRootForHeight(txn, h); err != nil {
+			return 0, fmt.Errorf("state root of height %d: %v", h, err)
+		}
+		if h%constants.EpochLength == 0 {
+			if _, _, err := snapshotHeaders(txn, consDB, h-1); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if _, err := ut.GetRootForHeight(txn, height); err != nil {
+		return 0, fmt.Errorf("state root of height %d: %v", height, err)
+	}
+	if _, err := consDB.GetCommittedBlockHeader(txn, height); err != nil {
+		return 0, fmt.Errorf("block header %d: %v", height, err)
+	}
+	return tip, nil
+}
+
+// rollbackHeight removes the block at height, which must be the most recent
+// block, along with the records kept for its height, and returns the
+// validator to the first round of height
+func rollbackHeight(txn *badger.Txn, consDB *consensusdb.Database, app *application.Application, height uint32) error {
+	bh, err := consDB.GetCommittedBlockHeader(txn, height)
+	if err != nil {
+		return err
+	}
+	prev, err := consDB.GetCommittedBlockHeader(txn, height-1)
+	if err != nil {
+		return err
+	}
+
+	stateRoot, err := app.RollbackState(txn, height, bh.TxHshLst)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(stateRoot, prev.BClaims.StateRoot) {
+		return fmt.Errorf("the state root after the rollback is %x, block %d has %x", stateRoot, height-1, prev.BClaims.StateRoot)
+	}
+	if err := consDB.DeleteCommittedBlockHeader(txn, height); err != nil {
+		return err
+	}
+	headerRoot, err := consDB.GetHeaderRootForProposal(txn)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(headerRoot, bh.BClaims.HeaderRoot) {
+		return fmt.Errorf("the header root after the rollback is %x, block %d has %x", headerRoot, height, bh.BClaims.HeaderRoot)
+	}
+	for _, h := range []uint32{height, height + 1} {
+		if err := consDB.DeleteBeforeHistoricRoundState(txn, h, math.MaxInt32); err != nil {
+			return err
+		}
+	}
+
+	os, err := consDB.GetOwnState(txn)
+	if err != nil {
+		return err
+	}
+	os.SyncToBH = prev
+	os.MaxBHSeen = prev
+	if height%constants.EpochLength == 0 {
+		pending, canonical, err := snapshotHeaders(txn, consDB, height-1)
+		if err != nil {
+			return err
+		}
+		os.PendingSnapShot = pending
+		os.CanonicalSnapShot = canonical
+	}
+	if err := consDB.SetOwnState(txn, os); err != nil {
+		return err
+	}
+	if err := consDB.ResetRoundStates(txn, prev); err != nil {
+		return err
+	}
+	for _, key := range broadcastPrefixes {
+		if err := utils.DeleteValue(txn, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshotHeaders returns the pending and canonical snapshot headers of a
+// node at height. Both are the genesis header until the first epoch
+// boundary is committed.
+func snapshotHeaders(txn *badger.Txn, consDB *consensusdb.Database, height uint32) (*objs.BlockHeader, *objs.BlockHeader, error) {
+	pendingHeight, canonicalHeight := uint32(1), uint32(1)
+	if height >= constants.EpochLength {
+		pendingHeight = (height / constants.EpochLength) * constants.EpochLength
+	}
+	if pendingHeight > constants.EpochLength {
+		canonicalHeight = pendingHeight - constants.EpochLength
+	}
+	pending, err := snapshotHeader(txn, consDB, pendingHeight)
+	if err != nil {
+		return nil, nil, err
+	}
+	canonical, err := snapshotHeader(txn, consDB, canonicalHeight)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pending, canonical, nil
+}
+
+func snapshotHeader(txn *badger.Txn, consDB *consensusdb.Database, height uint32) (*objs.BlockHeader, error) {
+	bh, err := consDB.GetCommittedBlockHeader(txn, height)
+	if err == badger.ErrKeyNotFound {
+		bh, err = consDB.GetSnapshotBlockHeader(txn, height)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("snapshot block header %d: %v", height, err)
+	}
+	return bh, nil
+}
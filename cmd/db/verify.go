@@ -0,0 +1,165 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/MadBase/MadNet/application/utxohandler/utxotrie"
+	trie "github.com/MadBase/MadNet/badgerTrie"
+	consensusdb "github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/constants/dbprefix"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/spf13/cobra"
+)
+
+// verifyReport collects the results of db verify
+type verifyReport struct {
+	tip          uint32
+	headers      int
+	headerRoots  int
+	stateRoots   int
+	stateTries   int
+	skipped      int
+	inconsistent []string
+}
+
+func (r *verifyReport) fail(format string, args ...interface{}) {
+	r.inconsistent = append(r.inconsistent, fmt.Sprintf(format, args...))
+}
+
+func verify(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+	rawDB := stateDatabase.mustOpen(ctx)
+	defer rawDB.Close()
+
+	consDB := &consensusdb.Database{}
+	consDB.Init(rawDB)
+	report := &verifyReport{}
+	err := consDB.View(func(txn *badger.Txn) error {
+		return verifyTries(txn, consDB, utxotrie.NewUTXOTrie(rawDB), report)
+	})
+	if err != nil {
+		rawDB.Close()
+		logger.Fatalf("Verification failed: %v", err)
+	}
+
+	fmt.Printf("height:           %d\n", report.tip)
+	fmt.Printf("headers:          %d\n", report.headers)
+	fmt.Printf("header roots:     %d\n", report.headerRoots)
+	fmt.Printf("state roots:      %d (%d distinct tries)\n", report.stateRoots, report.stateTries)
+	fmt.Printf("skipped:          %d\n", report.skipped)
+	fmt.Printf("inconsistencies:  %d\n", len(report.inconsistent))
+	for _, s := range report.inconsistent {
+		fmt.Printf("  %v\n", s)
+	}
+	if len(report.inconsistent) > 0 {
+		rawDB.Close()
+		os.Exit(1)
+	}
+}
+
+// verifyTries checks every committed block header against the header trie
+// and the state root it commits to against the UTXO trie. Heights for which
+// no root is stored, as below the snapshot of a node which fast synced, are
+// counted as skipped.
+func verifyTries(txn *badger.Txn, consDB *consensusdb.Database, ut *utxotrie.UTXOTrie, r *verifyReport) error {
+	tip, err := consDB.GetMostRecentCommittedBlockHeaderFastSync(txn)
+	if err != nil {
+		return err
+	}
+	r.tip = tip.BClaims.Height
+	headerRoot, err := consDB.GetHeaderRootForProposal(txn)
+	if err != nil {
+		return err
+	}
+	if rt, err := consDB.GetHeaderTrieRoot(txn, r.tip); err == nil && !bytes.Equal(rt, headerRoot) {
+		r.fail("current header root %x differs from the header root %x of height %d", headerRoot, rt, r.tip)
+	}
+	stateRoot, err := ut.GetCurrentStateRoot(txn)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(stateRoot, tip.BClaims.StateRoot) {
+		r.fail("current state root %x differs from the state root %x of height %d", stateRoot, tip.BClaims.StateRoot, r.tip)
+	}
+
+	var lastStateRoot []byte
+	for height := uint32(1); height <= r.tip; height++ {
+		bh, err := consDB.GetCommittedBlockHeader(txn, height)
+		if err != nil {
+			if err != badger.ErrKeyNotFound {
+				return err
+			}
+			r.skipped++
+			continue
+		}
+		r.headers++
+		bHash, err := bh.BlockHash()
+		if err != nil {
+			return err
+		}
+		leaf, err := consDB.GetHeaderTrieHash(txn, headerRoot, height)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(leaf, bHash) {
+			r.fail("height %d: the header trie holds %x, the block hash is %x", height, leaf, bHash)
+		}
+
+		if height > 1 {
+			rt, err := consDB.GetHeaderTrieRoot(txn, height-1)
+			switch {
+			case err == badger.ErrKeyNotFound:
+				r.skipped++
+			case err != nil:
+				return err
+			default:
+				r.headerRoots++
+				if !bytes.Equal(rt, bh.BClaims.HeaderRoot) {
+					r.fail("height %d: the header root is %x, the header trie root of height %d is %x", height, bh.BClaims.HeaderRoot, height-1, rt)
+				}
+			}
+		}
+
+		rt, err := ut.GetRootForHeight(txn, height)
+		if err != nil {
+			if err != badger.ErrKeyNotFound {
+				return err
+			}
+			r.skipped++
+			continue
+		}
+		r.stateRoots++
+		if !bytes.Equal(rt, bh.BClaims.StateRoot) {
+			r.fail("height %d: the state root is %x, the state trie root is %x", height, bh.BClaims.StateRoot, rt)
+		}
+		if bytes.Equal(rt, lastStateRoot) {
+			continue
+		}
+		lastStateRoot = rt
+		r.stateTries++
+		if err := readStateTrie(txn, rt); err != nil {
+			r.fail("height %d: the state trie with root %x can not be read: %v", height, rt, err)
+		}
+	}
+	return nil
+}
+
+// readStateTrie walks the UTXO trie with root from its root node to a leaf
+func readStateTrie(txn *badger.Txn, root []byte) error {
+	if bytes.Equal(root, make([]byte, constants.HashLen)) {
+		return nil
+	}
+	smt := trie.NewSMT(root, trie.Hasher, dbprefix.PrefixUTXOTrie)
+	_, err := smt.Get(txn, make([]byte, constants.HashLen))
+	if err != nil && err != badger.ErrKeyNotFound {
+		return err
+	}
+	return nil
+}
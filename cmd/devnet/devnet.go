@@ -0,0 +1,106 @@
+package devnet
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/devnet"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// Defaults used when the devnet options are not set. The localrpc ports
+// match the ones of the validator configurations in assets/config.
+const (
+	defaultValidators         = 4
+	defaultChainID            = 42
+	defaultLocalStateHost     = "127.0.0.1"
+	defaultLocalStateBasePort = 8884
+)
+
+// Command is the cobra.Command for running a devnet
+var Command = cobra.Command{
+	Use:   "devnet",
+	Short: "Runs a network of validators in a single process without Ethereum",
+	Long: "Starts devnet.validators validators with in memory databases, connected in memory. The validators " +
+		"share a group key made up at start and watch a fake Ethereum, the comma separated deposits of devnet.deposits, " +
+		"each <address>=<amount>, are emitted once they run. Validator i serves localrpc on devnet.localStateHost at " +
+		"port devnet.localStateBasePort+i. Blocks are made once the validators are synchronized, about a minute " +
+		"after start. Nothing is kept once the devnet stops.",
+	Run: devnetNode}
+
+func devnetNode(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDevnet)
+
+	cfg := devnet.Config{
+		Validators:         config.Configuration.Devnet.Validators,
+		ChainID:            uint32(config.Configuration.Chain.ID),
+		LocalStateHost:     config.Configuration.Devnet.LocalStateHost,
+		LocalStateBasePort: config.Configuration.Devnet.LocalStateBasePort,
+	}
+	if cfg.Validators == 0 {
+		cfg.Validators = defaultValidators
+	}
+	if cfg.ChainID == 0 {
+		cfg.ChainID = defaultChainID
+	}
+	if cfg.LocalStateHost == "" {
+		cfg.LocalStateHost = defaultLocalStateHost
+	}
+	if cfg.LocalStateBasePort == 0 {
+		cfg.LocalStateBasePort = defaultLocalStateBasePort
+	}
+
+	deposits, err := parseDeposits(config.Configuration.Devnet.DepositList())
+	if err != nil {
+		logger.Fatalf("Invalid deposit: %v", err)
+	}
+
+	network, err := devnet.New(cfg)
+	if err != nil {
+		logger.Fatalf("Could not create the devnet: %v", err)
+	}
+	network.Start()
+	logger.Infof("Devnet of %v validators running on chain %v", cfg.Validators, cfg.ChainID)
+
+	for _, d := range deposits {
+		if _, err := network.Ethereum.Deposit(d.depositor, d.amount); err != nil {
+			logger.Errorf("Could not emit the deposit to %v: %v", d.depositor.Hex(), err)
+		}
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	<-signals
+	logger.Warning("Stopping the devnet")
+	network.Close()
+}
+
+type deposit struct {
+	depositor common.Address
+	amount    *big.Int
+}
+
+// parseDeposits parses deposits given as <address>=<amount>
+func parseDeposits(values []string) ([]deposit, error) {
+	deposits := []deposit{}
+	for _, v := range values {
+		parts := strings.SplitN(v, "=", 2)
+		if len(parts) != 2 || !common.IsHexAddress(parts[0]) {
+			return nil, fmt.Errorf("expected <address>=<amount>, got %q", v)
+		}
+		amount, ok := new(big.Int).SetString(parts[1], 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid amount in %q", v)
+		}
+		deposits = append(deposits, deposit{depositor: common.HexToAddress(parts[0]), amount: amount})
+	}
+	return deposits, nil
+}
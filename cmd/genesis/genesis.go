@@ -0,0 +1,128 @@
+package genesis
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MadBase/MadNet/application"
+	"github.com/MadBase/MadNet/application/deposit"
+	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/consensus/admin"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/genesis"
+	"github.com/MadBase/MadNet/ipc"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/spf13/cobra"
+)
+
+// Command is the cobra.Command for starting a chain from a genesis file
+var Command = cobra.Command{
+	Use:   "genesis",
+	Short: "Starts a chain from a genesis file",
+	Long: "A genesis file describes the first block of a chain: its chain ID, validators, group key, " +
+		"dynamic values and initial ValueStores. See the genesis package for its format."}
+
+// InitCommand builds the first block of a genesis file
+var InitCommand = cobra.Command{
+	Use:   "init <genesis.json> [groupKeyFile]",
+	Short: "Builds the height 1 state of a genesis file and prints its genesis hash",
+	Long: "Builds the state of the height 1 block of a genesis file in the empty directory configured " +
+		"by chain.stateDB, for the account ethereum.defaultAccount, and prints the genesis hash. Peers " +
+		"refuse connections from nodes with another genesis hash. The optional groupKeyFile holds the " +
+		"hex private group share of the node, which is stored encrypted with validator.symmetricKey.",
+	Args: cobra.RangeArgs(1, 2),
+	Run:  initChain}
+
+func initChain(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerDB)
+	g, err := genesis.Load(args[0])
+	if err != nil {
+		logger.Fatalf("Could not load the genesis file: %v", err)
+	}
+	if config.Configuration.Chain.ID != 0 && uint32(config.Configuration.Chain.ID) != g.ChainID {
+		logger.Fatalf("chain.id is %v, the genesis file is for chain %v", config.Configuration.Chain.ID, g.ChainID)
+	}
+	vAddr, err := hex.DecodeString(strings.TrimPrefix(config.Configuration.Ethereum.DefaultAccount, "0x"))
+	if err != nil || len(vAddr) != constants.OwnerLen {
+		logger.Fatalf("Invalid ethereum.defaultAccount %q", config.Configuration.Ethereum.DefaultAccount)
+	}
+	var groupPrivk []byte
+	if len(args) > 1 {
+		data, err := os.ReadFile(args[1])
+		if err != nil {
+			logger.Fatalf("Could not read the group key: %v", err)
+		}
+		groupPrivk, err = hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+		if err != nil || len(groupPrivk) == 0 {
+			logger.Fatal("The group key file does not hold a hex key")
+		}
+	}
+	if config.Configuration.Chain.StateDbPath == "" {
+		logger.Fatal("chain.stateDB is not configured")
+	}
+
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+	rawDB, err := utils.OpenBadger(ctx.Done(), config.Configuration.Chain.StateDbPath, false)
+	if err != nil {
+		logger.Fatalf("Could not open the state database: %v", err)
+	}
+	defer rawDB.Close()
+	fatalf := func(format string, args ...interface{}) {
+		rawDB.Close()
+		logger.Fatalf(format, args...)
+	}
+	memDB, err := utils.OpenBadger(ctx.Done(), "", true)
+	if err != nil {
+		fatalf("Could not open the scratch database: %v", err)
+	}
+	defer memDB.Close()
+
+	consDB := &db.Database{}
+	consDB.Init(rawDB)
+	storage := &dynamics.Storage{}
+	if err := storage.Init(consDB, logger); err != nil {
+		fatalf("Could not initialize storage: %v", err)
+	}
+	storage.Start()
+	dph := &deposit.Handler{}
+	dph.Init()
+	app := &application.Application{}
+	if err := app.Init(consDB, memDB, dph, storage); err != nil {
+		fatalf("Could not initialize the application: %v", err)
+	}
+	secret := crypto.Hasher([]byte(config.Configuration.Validator.SymmetricKey))
+	keyring, err := admin.LoadKeyring(secret, config.Configuration.Validator.SymmetricKeys, config.Configuration.Validator.PrimaryKeyID)
+	if err != nil {
+		fatalf("Could not load the keyring: %v", err)
+	}
+	ah := &admin.Handlers{}
+	ah.Init(g.ChainID, consDB, secret, app, nil, storage, ipc.NewServer(""))
+	ah.SetKeyring(keyring)
+	ah.SetAccount(vAddr)
+	defer ah.Close()
+	// there is no firewalld to tell about the validators
+	config.Configuration.Firewalld.Enabled = false
+
+	bh, hash, err := genesis.Build(g, consDB, ah, storage, dph, groupPrivk)
+	if err != nil {
+		fatalf("Could not build the genesis block: %v", err)
+	}
+	blockHash, err := bh.BlockHash()
+	if err != nil {
+		fatalf("Could not hash the genesis block: %v", err)
+	}
+	fmt.Printf("chainID:       %d\n", g.ChainID)
+	fmt.Printf("validators:    %d\n", len(g.Validators))
+	fmt.Printf("allocations:   %d\n", len(g.Allocations))
+	fmt.Printf("stateRoot:     %x\n", bh.BClaims.StateRoot)
+	fmt.Printf("blockHash:     %x\n", blockHash)
+	fmt.Printf("genesisHash:   %x\n", hash)
+}
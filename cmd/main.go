@@ -8,9 +8,17 @@ import (
 	"strings"
 	"time"
 
+	"github.com/MadBase/MadNet/cmd/account"
 	"github.com/MadBase/MadNet/cmd/bootnode"
+	"github.com/MadBase/MadNet/cmd/chain"
+	"github.com/MadBase/MadNet/cmd/console"
+	"github.com/MadBase/MadNet/cmd/db"
 	"github.com/MadBase/MadNet/cmd/deploy"
+	"github.com/MadBase/MadNet/cmd/devnet"
 	"github.com/MadBase/MadNet/cmd/firewalld"
+	"github.com/MadBase/MadNet/cmd/genesis"
+	"github.com/MadBase/MadNet/cmd/replay"
+	"github.com/MadBase/MadNet/cmd/signer"
 	"github.com/MadBase/MadNet/cmd/utils"
 	"github.com/MadBase/MadNet/cmd/validator"
 	"github.com/MadBase/MadNet/config"
@@ -137,6 +145,7 @@ func main() {
 			{"ethereum.passcodes", "", "Passcodes for keystore", &config.Configuration.Ethereum.Passcodes},
 			{"ethereum.startingBlock", "", "The first block we care about", &config.Configuration.Ethereum.StartingBlock},
 			{"ethereum.registryAddress", "", "", &config.Configuration.Ethereum.RegistryAddress},
+			{"ethereum.merkleProofContract", "", "Address of the contract which verifies withdrawal proofs", &config.Configuration.Ethereum.MerkleProofContract},
 			{"monitor.batchSize", "", "", &config.Configuration.Monitor.BatchSize},
 			{"monitor.interval", "", "", &config.Configuration.Monitor.Interval},
 			{"monitor.timeout", "", "", &config.Configuration.Monitor.Timeout},
@@ -149,12 +158,18 @@ func main() {
 			{"transport.p2pListeningAddress", "", "", &config.Configuration.Transport.P2PListeningAddress},
 			{"transport.discoveryListeningAddress", "", "", &config.Configuration.Transport.DiscoveryListeningAddress},
 			{"transport.upnp", "", "", &config.Configuration.Transport.UPnP},
+			{"transport.allowUnknownGenesis", "", "Accept peers which do not know or do not exchange their genesis hash", &config.Configuration.Transport.AllowUnknownGenesis},
 			{"transport.localStateListeningAddress", "", "", &config.Configuration.Transport.LocalStateListeningAddress},
+			{"transport.metricsListeningAddress", "", "Address serving Prometheus metrics at /metrics", &config.Configuration.Transport.MetricsListeningAddress},
 			{"transport.timeout", "", "", &config.Configuration.Transport.Timeout},
 			{"transport.firewallMode", "", "", &config.Configuration.Transport.FirewallMode},
 			{"transport.firewallHost", "", "", &config.Configuration.Transport.FirewallHost},
 			{"firewalld.enabled", "", "", &config.Configuration.Firewalld.Enabled},
 			{"firewalld.socketFile", "", "", &config.Configuration.Firewalld.SocketFile},
+			{"validator.symmetricKeys", "", "File of <key id>=<secret> lines holding the keys of the encrypted key store", &config.Configuration.Validator.SymmetricKeys},
+			{"validator.primaryKeyId", "", "ID of the key new entries of the encrypted key store are encrypted with", &config.Configuration.Validator.PrimaryKeyID},
+			{"account.keystore", "", "Keystore file of the account commands", &config.Configuration.Account.Keystore},
+			{"account.passphrase", "", "Passphrase of the keys of the account commands, read from stdin if not set", &config.Configuration.Account.Passphrase},
 		},
 
 		&utils.Command: {
@@ -177,7 +192,54 @@ func main() {
 
 		&validator.Command: {
 			{"validator.rewardAccount", "", "", &config.Configuration.Validator.RewardAccount},
-			{"validator.rewardCurveSpec", "", "", &config.Configuration.Validator.RewardCurveSpec}},
+			{"validator.rewardCurveSpec", "", "", &config.Configuration.Validator.RewardCurveSpec},
+			{"validator.recorderDir", "", "Directory to record consensus objects to for madnet replay", &config.Configuration.Validator.RecorderDir},
+			{"validator.recorderFileSize", "", "Size in MiB at which a consensus recording file is rotated", &config.Configuration.Validator.RecorderFileSize},
+			{"validator.recorderFiles", "", "Number of consensus recording files to keep", &config.Configuration.Validator.RecorderFiles},
+			{"validator.signerSocket", "", "Unix socket of a signer holding the consensus keys", &config.Configuration.Validator.SignerSocket},
+			{"validator.repl", "", "Serve the admin API for madnet console", &config.Configuration.Validator.Repl},
+			{"validator.adminSocket", "", "Unix socket to serve the admin API on", &config.Configuration.Validator.AdminSocket}},
+
+		&console.Command: {
+			{"console.socket", "", "Unix socket of the admin API of the validator", &config.Configuration.Console.Socket}},
+
+		&signer.Command: {
+			{"signer.socket", "", "Unix socket to serve the signer on", &config.Configuration.Signer.Socket},
+			{"signer.dir", "", "Directory holding the keys and the double sign guard state", &config.Configuration.Signer.Dir}},
+
+		&devnet.Command: {
+			{"devnet.validators", "", "Number of validators to run", &config.Configuration.Devnet.Validators},
+			{"devnet.localStateHost", "", "Host the localrpc servers of the validators listen on", &config.Configuration.Devnet.LocalStateHost},
+			{"devnet.localStateBasePort", "", "Localrpc port of the first validator, the others use the next ports", &config.Configuration.Devnet.LocalStateBasePort},
+			{"devnet.deposits", "", "Comma separated deposits to emit once the validators run, each <address>=<amount>", &config.Configuration.Devnet.Deposits}},
+
+		&replay.Command: {},
+
+		&account.Command:       {},
+		&account.NewCommand:    {},
+		&account.ImportCommand: {},
+		&account.ListCommand:   {},
+		&account.SignCommand:   {},
+		&account.VerifyCommand: {},
+		&account.OwnerCommand:  {},
+
+		&db.Command:         {},
+		&db.PrefixesCommand: {},
+		&db.GetCommand:      {},
+		&db.DumpCommand:     {},
+		&db.DecodeCommand:   {},
+		&db.VerifyCommand:   {},
+		&db.RollbackCommand: {},
+		&db.ResetCommand:    {},
+		&db.GCCommand:       {},
+		&db.RekeyCommand:    {},
+
+		&chain.Command:       {},
+		&chain.ExportCommand: {},
+		&chain.ImportCommand: {},
+
+		&genesis.Command:     {},
+		&genesis.InitCommand: {},
 
 		&deploy.Command: {
 			{"deploy.migrations", "", "", &config.Configuration.Deploy.Migrations},
@@ -190,6 +252,32 @@ func main() {
 		&bootnode.Command:            &rootCommand,
 		&validator.Command:           &rootCommand,
 		&deploy.Command:              &rootCommand,
+		&replay.Command:              &rootCommand,
+		&devnet.Command:              &rootCommand,
+		&signer.Command:              &rootCommand,
+		&console.Command:             &rootCommand,
+		&account.Command:             &rootCommand,
+		&account.NewCommand:          &account.Command,
+		&account.ImportCommand:       &account.Command,
+		&account.ListCommand:         &account.Command,
+		&account.SignCommand:         &account.Command,
+		&account.VerifyCommand:       &account.Command,
+		&account.OwnerCommand:        &account.Command,
+		&db.Command:                  &rootCommand,
+		&db.PrefixesCommand:          &db.Command,
+		&db.GetCommand:               &db.Command,
+		&db.DumpCommand:              &db.Command,
+		&db.DecodeCommand:            &db.Command,
+		&db.VerifyCommand:            &db.Command,
+		&db.RollbackCommand:          &db.Command,
+		&db.ResetCommand:             &db.Command,
+		&db.GCCommand:                &db.Command,
+		&db.RekeyCommand:             &db.Command,
+		&chain.Command:               &rootCommand,
+		&chain.ExportCommand:         &chain.Command,
+		&chain.ImportCommand:         &chain.Command,
+		&genesis.Command:             &rootCommand,
+		&genesis.InitCommand:         &genesis.Command,
 		&utils.Command:               &rootCommand,
 		&utils.ApproveTokensCommand:  &utils.Command,
 		&utils.EthdkgCommand:         &utils.Command,
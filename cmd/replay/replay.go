@@ -0,0 +1,65 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/MadBase/MadNet/consensus/lstate"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/spf13/cobra"
+)
+
+// Command is the cobra.Command for replaying a consensus recording
+var Command = cobra.Command{
+	Use:   "replay <recording directory>",
+	Short: "Replays a consensus recording",
+	Long: "Feeds a consensus recording written by a validator with validator.recorderDir set into a fresh " +
+		"consensus engine and reports every point at which the decisions of the engine differ from " +
+		"the decisions of the recorded node. The exit status is 1 if the decisions diverged.",
+	Args: cobra.ExactArgs(1),
+	Run:  replay}
+
+func replay(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(cmd.Name())
+
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+	rawDB, err := utils.OpenBadger(ctx.Done(), "", true)
+	if err != nil {
+		logger.Fatalf("Could not open the replay database: %v", err)
+	}
+
+	rr, err := lstate.OpenRecording(args[0])
+	if err != nil {
+		logger.Fatalf("Could not open the recording: %v", err)
+	}
+	defer rr.Close()
+
+	replayer, err := lstate.NewReplayer(rawDB)
+	if err != nil {
+		logger.Fatalf("Could not create the replay engine: %v", err)
+	}
+	report, err := replayer.Replay(rr)
+	if err != nil {
+		logger.Fatalf("Replay failed: %v", err)
+	}
+
+	fmt.Printf("records:      %d (%d before the first state)\n", report.Records, report.Skipped)
+	fmt.Printf("states:       %d\n", report.States)
+	fmt.Printf("steps:        %d\n", report.Steps)
+	fmt.Printf("received:     %d (%d rejected)\n", report.Received, report.Rejected)
+	fmt.Printf("cast:         %d\n", report.Casts)
+	if report.Truncated > 0 {
+		fmt.Printf("truncated:    %d files\n", report.Truncated)
+	}
+	fmt.Printf("divergences:  %d\n", len(report.Divergences))
+	for _, d := range report.Divergences {
+		fmt.Printf("  %v\n", d)
+	}
+	if len(report.Divergences) > 0 {
+		cf()
+		os.Exit(1)
+	}
+}
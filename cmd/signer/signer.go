@@ -0,0 +1,73 @@
+package signer
+
+import (
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/consensus/signer"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/logging"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// Command is the cobra.Command for running a signer
+var Command = cobra.Command{
+	Use:   "signer",
+	Short: "Runs a signer holding the consensus keys of a validator",
+	Long: "Serves the consensus keys kept in signer.dir over the Unix socket signer.socket. A validator " +
+		"with validator.signerSocket set signs its consensus messages through the signer, which refuses " +
+		"to sign conflicting votes for the same height and round. The hex encoded secp256k1 key of the " +
+		"validator must be stored in signer.dir as secp256k1.key; the validator hands over the group key " +
+		"shares of its EthDKG runs.",
+	Run: signerNode}
+
+func signerNode(cmd *cobra.Command, args []string) {
+	logger := logging.GetLogger(constants.LoggerSigner)
+
+	socket := config.Configuration.Signer.Socket
+	if socket == "" {
+		logger.Fatal("signer.socket must be set")
+	}
+	dir := config.Configuration.Signer.Dir
+	if dir == "" {
+		logger.Fatal("signer.dir must be set")
+	}
+
+	srv, err := signer.NewServer(dir)
+	if err != nil {
+		logger.Fatalf("Could not load the signer: %v", err)
+	}
+
+	// remove the socket left behind by a signer which did not shut down
+	if err := os.Remove(socket); err != nil && !os.IsNotExist(err) {
+		logger.Fatalf("Could not remove the stale socket: %v", err)
+	}
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		logger.Fatalf("Could not listen on %v: %v", socket, err)
+	}
+	if err := os.Chmod(socket, 0600); err != nil {
+		logger.Fatalf("Could not restrict the socket: %v", err)
+	}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterSignerServer(grpcServer, srv)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-signals
+		logger.Warning("Stopping the signer")
+		grpcServer.GracefulStop()
+	}()
+
+	logger.Infof("Signer listening on %v", socket)
+	if err := grpcServer.Serve(lis); err != nil {
+		logger.Fatalf("Signer failed: %v", err)
+	}
+}
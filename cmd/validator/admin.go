@@ -0,0 +1,153 @@
+package validator
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	"github.com/MadBase/MadNet/application"
+	"github.com/MadBase/MadNet/blockchain/monitor"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/lstate"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/ipc"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/peering"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// errWrongArgs is returned for a call of the admin API with the wrong
+// number of arguments
+var errWrongArgs = errors.New("wrong number of arguments, see methods")
+
+// adminDatabase is a database garbage collected by the gc method
+type adminDatabase struct {
+	name string
+	db   *badger.DB
+}
+
+// initAdminServer sets up the admin API served to madnet console
+func initAdminServer(socket string, consDB *db.Database, app *application.Application, pm *peering.PeerManager, mon monitor.Monitor, dbs ...adminDatabase) *ipc.AdminServer {
+	srv := ipc.NewAdminServer(socket)
+	sstore := &lstate.Store{}
+	sstore.Init(consDB)
+
+	srv.Handle("roundstates", "shows the round states of the validators", func(args []string) (interface{}, error) {
+		if len(args) != 0 {
+			return nil, errWrongArgs
+		}
+		var summary *lstate.RoundStatesSummary
+		err := consDB.View(func(txn *badger.Txn) error {
+			rs, err := sstore.LoadLocalState(txn)
+			if err != nil {
+				return err
+			}
+			summary = rs.Summary()
+			return nil
+		})
+		return summary, err
+	})
+
+	srv.Handle("peers", "lists the active, inactive and banned peers", func(args []string) (interface{}, error) {
+		if len(args) != 0 {
+			return nil, errWrongArgs
+		}
+		return pm.Peers(), nil
+	})
+	srv.Handle("ban", "<peer> disconnects a peer, given by public key or address, until unbanned or restarted", func(args []string) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, errWrongArgs
+		}
+		return nil, pm.Ban(args[0])
+	})
+	srv.Handle("unban", "<peer> lifts the ban of a peer", func(args []string) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, errWrongArgs
+		}
+		return nil, pm.Unban(args[0])
+	})
+
+	srv.Handle("droptxs", "all | <tx hash>... drops the whole txPool or the given transactions", func(args []string) (interface{}, error) {
+		if len(args) == 0 {
+			return nil, errWrongArgs
+		}
+		if len(args) == 1 && args[0] == "all" {
+			return nil, app.PendingTxDrop(nil)
+		}
+		txHashes := [][]byte{}
+		for _, arg := range args {
+			txHash, err := hex.DecodeString(strings.TrimPrefix(arg, "0x"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid tx hash %q: %v", arg, err)
+			}
+			txHashes = append(txHashes, txHash)
+		}
+		return nil, app.PendingTxDrop(txHashes)
+	})
+
+	srv.Handle("gc", "garbage collects the value logs of the databases and the memory of the node", func(args []string) (interface{}, error) {
+		if len(args) != 0 {
+			return nil, errWrongArgs
+		}
+		rewritten := make(map[string]int)
+		for _, d := range dbs {
+			n := 0
+			for {
+				err := d.db.RunValueLogGC(constants.BadgerDiscardRatio)
+				if err == badger.ErrNoRewrite || err == badger.ErrGCInMemoryMode {
+					break
+				}
+				if err != nil {
+					return nil, fmt.Errorf("%v database: %v", d.name, err)
+				}
+				n++
+			}
+			rewritten[d.name] = n
+		}
+		debug.FreeOSMemory()
+		return rewritten, nil
+	})
+
+	srv.Handle("loglevel", "[<logger> <level>] lists the log levels or sets the level of a logger", func(args []string) (interface{}, error) {
+		switch len(args) {
+		case 0:
+			levels := make(map[string]string)
+			for _, name := range logging.GetKnownLoggerNames() {
+				levels[name] = logging.GetLogger(name).GetLevel().String()
+			}
+			return levels, nil
+		case 2:
+			known := false
+			for _, name := range logging.GetKnownLoggerNames() {
+				known = known || name == strings.ToLower(args[0])
+			}
+			if !known {
+				return nil, fmt.Errorf("unknown logger %q", args[0])
+			}
+			level, err := logrus.ParseLevel(args[1])
+			if err != nil {
+				return nil, err
+			}
+			logging.GetLogger(args[0]).SetLevel(level)
+			return nil, nil
+		}
+		return nil, errWrongArgs
+	})
+
+	srv.Handle("monitor", "shows the progress of the Ethereum monitor", func(args []string) (interface{}, error) {
+		if len(args) != 0 {
+			return nil, errWrongArgs
+		}
+		return mon.Health(), nil
+	})
+	srv.Handle("dkg", "shows the ETHDKG state of the validator", func(args []string) (interface{}, error) {
+		if len(args) != 0 {
+			return nil, errWrongArgs
+		}
+		return mon.DKG(), nil
+	})
+	return srv
+}
@@ -26,18 +26,20 @@ import (
 	"github.com/MadBase/MadNet/consensus/gossip"
 	"github.com/MadBase/MadNet/consensus/lstate"
 	"github.com/MadBase/MadNet/consensus/request"
+	"github.com/MadBase/MadNet/consensus/signer"
 	"github.com/MadBase/MadNet/constants"
 	mncrypto "github.com/MadBase/MadNet/crypto"
 	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/health"
 	"github.com/MadBase/MadNet/ipc"
 	"github.com/MadBase/MadNet/localrpc"
 	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/metrics"
 	"github.com/MadBase/MadNet/peering"
 	"github.com/MadBase/MadNet/proto"
 	"github.com/MadBase/MadNet/status"
 	mnutils "github.com/MadBase/MadNet/utils"
 	"github.com/dgraph-io/badger/v2"
-	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
@@ -50,7 +52,7 @@ var Command = cobra.Command{
 	Long:  "Runs a MadNet node in mining or non-mining mode",
 	Run:   validatorNode}
 
-func initEthereumConnection(logger *logrus.Logger) (interfaces.Ethereum, *keystore.Key, []byte) {
+func initEthereumConnection(logger *logrus.Logger) interfaces.Ethereum {
 	// Ethereum connection setup
 	logger.Infof("Connecting to Ethereum...")
 	eth, err := blockchain.NewEthereumEndpoint(
@@ -91,8 +93,44 @@ func initEthereumConnection(logger *logrus.Logger) (interfaces.Ethereum, *keysto
 		}
 	}()
 
-	// Load accounts
+	return eth
+}
+
+// initSigner returns the signer of consensus messages, the client of the
+// remote signer if one is configured, and the public key of the validator.
+// A remote signer holds the secp256k1 key itself, which must be the key of
+// the Ethereum account, so the keystore is left locked and the key never
+// enters this process; the account can then not send Ethereum transactions
+// from this node. Otherwise the account is unlocked and its key signs
+// locally.
+func initSigner(logger *logrus.Logger, eth interfaces.Ethereum) (mncrypto.Signer, *signer.Client, []byte) {
 	acct := eth.GetDefaultAccount()
+	if socket := config.Configuration.Validator.SignerSocket; socket != "" {
+		signerClient, err := signer.Dial(socket)
+		if err != nil {
+			panic(err)
+		}
+		consSigner, err := signerClient.Secp256k1Signer()
+		if err != nil {
+			panic(err)
+		}
+		publicKey, err := consSigner.Pubkey()
+		if err != nil {
+			panic(err)
+		}
+		pubk, err := crypto.UnmarshalPubkey(publicKey)
+		if err != nil {
+			panic(err)
+		}
+		if crypto.PubkeyToAddress(*pubk) != acct.Address {
+			panic("the signer holds the key of another account")
+		}
+		logger.Infof("Account: %v Public Key: 0x%x", acct.Address.Hex(), publicKey)
+		logger.Infof("Signing consensus messages with the signer at %v", socket)
+		return consSigner, signerClient, publicKey
+	}
+
+	// Load accounts
 	if err := eth.UnlockAccount(acct); err != nil {
 		logger.Fatalf("Could not unlock account: %v", err)
 		panic(err)
@@ -105,19 +143,24 @@ func initEthereumConnection(logger *logrus.Logger) (interfaces.Ethereum, *keysto
 	publicKey := crypto.FromECDSAPub(&keys.PrivateKey.PublicKey)
 	logger.Infof("Account: %v Public Key: 0x%x", acct.Address.Hex(), publicKey)
 
-	return eth, keys, publicKey
+	secp256k1Signer := &mncrypto.Secp256k1Signer{}
+	if err := secp256k1Signer.SetPrivk(crypto.FromECDSA(keys.PrivateKey)); err != nil {
+		panic(err)
+	}
+	return secp256k1Signer, nil, publicKey
 }
 
 // Setup the peer manager:
 // Peer manager owns the raw TCP connections of the p2p system
 // Runs the gossip protocol
 // Provides functionality to access methods on a remote peer (validators, miners, those who care about voting and consensus)
-func initPeerManager(consGossipHandlers *gossip.Handlers, consReqHandler *request.Handler) *peering.PeerManager {
+func initPeerManager(consGossipHandlers *gossip.Handlers, consReqHandler *request.Handler, genesisHash []byte) *peering.PeerManager {
 	p2pDispatch := proto.NewP2PDispatch()
 
 	peerManager, err := peering.NewPeerManager(
 		proto.NewGeneratedP2PServer(p2pDispatch),
 		uint32(config.Configuration.Chain.ID),
+		genesisHash,
 		config.Configuration.Transport.PeerLimitMin,
 		config.Configuration.Transport.PeerLimitMax,
 		config.Configuration.Transport.FirewallMode,
@@ -159,24 +202,52 @@ func initLocalStateServer(localStateHandler *localrpc.Handlers) *localrpc.Handle
 	if err != nil {
 		panic(err)
 	}
-	localStateDispatch.RegisterLocalStateGetBlockNumber(localStateHandler)
-	localStateDispatch.RegisterLocalStateGetEpochNumber(localStateHandler)
-	localStateDispatch.RegisterLocalStateGetBlockHeader(localStateHandler)
-	localStateDispatch.RegisterLocalStateGetChainID(localStateHandler)
-	localStateDispatch.RegisterLocalStateSendTransaction(localStateHandler)
-	localStateDispatch.RegisterLocalStateGetValueForOwner(localStateHandler)
-	localStateDispatch.RegisterLocalStateGetUTXO(localStateHandler)
-	localStateDispatch.RegisterLocalStateGetMinedTransaction(localStateHandler)
-	localStateDispatch.RegisterLocalStateGetPendingTransaction(localStateHandler)
-	localStateDispatch.RegisterLocalStateGetRoundStateForValidator(localStateHandler)
-	localStateDispatch.RegisterLocalStateGetValidatorSet(localStateHandler)
-	localStateDispatch.RegisterLocalStateIterateNameSpace(localStateHandler)
-	localStateDispatch.RegisterLocalStateGetData(localStateHandler)
-	localStateDispatch.RegisterLocalStateGetTxBlockNumber(localStateHandler)
+	localStateHandler.Register(localStateDispatch)
 
 	return localStateServer
 }
 
+func initHealthChecker(ce *lstate.Engine, pm *peering.PeerManager, mon monitor.Monitor, dbs ...*badger.DB) *health.Checker {
+	checker := health.NewChecker()
+	checker.Register(health.ComponentDatabase, true, func() (bool, string) {
+		for _, db := range dbs {
+			if db.IsClosed() {
+				return false, "database closed"
+			}
+		}
+		return true, "databases open"
+	})
+	checker.Register(health.ComponentPeering, false, pm.Health)
+	checker.Register(health.ComponentEthereum, false, func() (bool, string) {
+		return mon.Health().EndpointStatus(config.Configuration.Ethereum.EndpointMinimumPeers)
+	})
+	checker.Register(health.ComponentMonitor, false, func() (bool, string) {
+		return mon.Health().ProgressStatus(uint64(config.Configuration.Ethereum.FinalityDelay))
+	})
+	checker.Register(health.ComponentFastSync, false, ce.SyncHealth)
+	checker.Register(health.ComponentValidatorKey, false, ce.ValidatorKeyHealth)
+	return checker
+}
+
+// loadGenesisHash returns the hash stored by madnet genesis init, or nil if
+// the chain was not started from a genesis file
+func loadGenesisHash(logger *logrus.Logger, consDB *db.Database) []byte {
+	var genesisHash []byte
+	err := consDB.View(func(txn *badger.Txn) error {
+		var err error
+		genesisHash, err = consDB.GetGenesisHash(txn)
+		return err
+	})
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			panic(err)
+		}
+		return nil
+	}
+	logger.Infof("Genesis hash: %x", genesisHash)
+	return genesisHash
+}
+
 func initDatabase(ctx context.Context, path string, inMemory bool) *badger.DB {
 	db, err := mnutils.OpenBadger(ctx.Done(), path, inMemory)
 	if err != nil {
@@ -199,7 +270,11 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	chainID := uint32(config.Configuration.Chain.ID)
 	batchSize := config.Configuration.Monitor.BatchSize
 
-	eth, keys, publicKey := initEthereumConnection(logger)
+	eth := initEthereumConnection(logger)
+	consSigner, signerClient, publicKey := initSigner(logger, eth)
+	if signerClient != nil {
+		defer signerClient.Close()
+	}
 
 	// Initialize consensus db: stores all state the consensus mechanism requires to work
 	rawConsensusDb := initDatabase(nodeCtx, config.Configuration.Chain.StateDbPath, config.Configuration.Chain.StateDbInMemory)
@@ -251,24 +326,17 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	// define storage to dynamic values
 	storage := &dynamics.Storage{}
 
-	// account signer for ETH accounts
-	secp256k1Signer := &mncrypto.Secp256k1Signer{}
-
 	// stdout logger
 	statusLogger := &status.Logger{}
 
+	consDB.Init(rawConsensusDb)
+
 	localStateHandler := &localrpc.Handlers{}
 	localStateServer := initLocalStateServer(localStateHandler)
-	peerManager := initPeerManager(consGossipHandlers, consReqHandler)
+	peerManager := initPeerManager(consGossipHandlers, consReqHandler, loadGenesisHash(logger, consDB))
 
 	ipcServer := ipc.NewServer(config.Configuration.Firewalld.SocketFile)
 
-	// Initialize the consensus engine signer
-	if err := secp256k1Signer.SetPrivk(crypto.FromECDSA(keys.PrivateKey)); err != nil {
-		panic(err)
-	}
-
-	consDB.Init(rawConsensusDb)
 	consTxPool.Init(consDB)
 
 	appDepositHandler.Init()
@@ -289,7 +357,44 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	consGossipHandlers.Init(consDB, peerManager.P2PClient(), app, consLSHandler, storage)
 	consGossipClient.Init(consDB, peerManager.P2PClient(), app, storage)
 	consAdminHandlers.Init(chainID, consDB, mncrypto.Hasher([]byte(config.Configuration.Validator.SymmetricKey)), app, publicKey, storage, ipcServer)
-	consLSEngine.Init(consDB, consDlManager, app, secp256k1Signer, consAdminHandlers, publicKey, consReqClient, storage)
+	keyring, err := admin.LoadKeyring(
+		mncrypto.Hasher([]byte(config.Configuration.Validator.SymmetricKey)),
+		config.Configuration.Validator.SymmetricKeys,
+		config.Configuration.Validator.PrimaryKeyID)
+	if err != nil {
+		panic(err)
+	}
+	consAdminHandlers.SetKeyring(keyring)
+	// refuse to start with keys the encrypted store can not be decrypted with
+	if err := consAdminHandlers.CheckKeys(); err != nil {
+		panic(err)
+	}
+
+	if signerClient != nil {
+		consLSEngine.SetRemoteSigner(signerClient)
+		consAdminHandlers.SetRemoteSigner(signerClient)
+	}
+	consLSEngine.Init(consDB, consDlManager, app, consSigner, consAdminHandlers, publicKey, consReqClient, storage)
+
+	// Record consensus objects for offline replay
+	if dir := config.Configuration.Validator.RecorderDir; dir != "" {
+		fileSize := config.Configuration.Validator.RecorderFileSize
+		if fileSize <= 0 {
+			fileSize = 64
+		}
+		files := config.Configuration.Validator.RecorderFiles
+		if files <= 0 {
+			files = 16
+		}
+		recorder, err := lstate.NewRecorder(dir, int64(fileSize)*1024*1024, files)
+		if err != nil {
+			panic(err)
+		}
+		defer recorder.Close()
+		consLSEngine.SetRecorder(recorder)
+		consLSHandler.SetRecorder(recorder)
+		logger.Infof("Recording consensus to %v", dir)
+	}
 
 	// Setup monitor
 	monDB.Init(rawMonitorDb)
@@ -311,7 +416,9 @@ func validatorNode(cmd *cobra.Command, args []string) {
 
 	consSync.Init(consDB, mDB, tDB, consGossipClient, consGossipHandlers, consTxPool, consLSEngine, app, consAdminHandlers, peerManager, storage)
 	localStateHandler.Init(consDB, app, consGossipHandlers, publicKey, consSync.Safe)
+	localStateHandler.MerkleProofContract = config.Configuration.Ethereum.MerkleProofContract
 	statusLogger.Init(consLSEngine, peerManager, consAdminHandlers, mon)
+	localStateServer.RegisterHealth(initHealthChecker(consLSEngine, peerManager, mon, rawConsensusDb, rawTxPoolDb, rawMonitorDb))
 
 	//////////////////////////////////////////////////////////////////////////////
 	//LAUNCH ALL SERVICE GOROUTINES///////////////////////////////////////////////
@@ -333,6 +440,23 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	go ipcServer.Start()
 	defer ipcServer.Close()
 
+	if config.Configuration.Validator.Repl {
+		socket := config.Configuration.Validator.AdminSocket
+		if socket == "" {
+			panic("validator.adminSocket must be set to serve the admin API")
+		}
+		adminServer := initAdminServer(socket, consDB, app, peerManager, mon,
+			adminDatabase{"state", rawConsensusDb},
+			adminDatabase{"txpool", rawTxPoolDb},
+			adminDatabase{"monitor", rawMonitorDb})
+		go func() {
+			if err := adminServer.Start(); err != nil {
+				logger.Errorf("Admin server failed: %v", err)
+			}
+		}()
+		defer adminServer.Close()
+	}
+
 	go peerManager.Start()
 	defer peerManager.Close()
 
@@ -348,6 +472,16 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	go localStateHandler.Start()
 	defer localStateHandler.Stop()
 
+	if addr := config.Configuration.Transport.MetricsListeningAddress; addr != "" {
+		metricsServer := metrics.NewServer(addr, metrics.DefaultRegistry)
+		go func() {
+			if err := metricsServer.Start(); err != nil {
+				logger.Errorf("Metrics server failed: %v", err)
+			}
+		}()
+		defer metricsServer.Close()
+	}
+
 	go consGossipHandlers.Start()
 	defer consGossipHandlers.Close()
 
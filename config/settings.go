@@ -64,7 +64,9 @@ type transportConfig struct {
 	P2PListeningAddress        string
 	DiscoveryListeningAddress  string
 	LocalStateListeningAddress string
+	MetricsListeningAddress    string
 	UPnP                       bool
+	AllowUnknownGenesis        bool
 }
 
 type deployConfig struct {
@@ -77,10 +79,38 @@ type utilsConfig struct {
 }
 
 type validatorConfig struct {
-	Repl            bool
-	RewardAccount   string
-	RewardCurveSpec int
-	SymmetricKey    string
+	Repl             bool
+	AdminSocket      string
+	RewardAccount    string
+	RewardCurveSpec  int
+	SymmetricKey     string
+	SymmetricKeys    string
+	PrimaryKeyID     string
+	RecorderDir      string
+	RecorderFileSize int
+	RecorderFiles    int
+	SignerSocket     string
+}
+
+type signerConfig struct {
+	Socket string
+	Dir    string
+}
+
+type consoleConfig struct {
+	Socket string
+}
+
+type accountConfig struct {
+	Keystore   string
+	Passphrase string
+}
+
+type devnetConfig struct {
+	Validators         int
+	LocalStateHost     string
+	LocalStateBasePort int
+	Deposits           string
 }
 
 type loggingConfig struct {
@@ -130,6 +160,10 @@ type configuration struct {
 	Firewalld             firewalldConfig
 	Chain                 chainConfig
 	BootNode              bootnodeConfig
+	Signer                signerConfig
+	Devnet                devnetConfig
+	Console               consoleConfig
+	Account               accountConfig
 }
 
 // Configuration contains all active settings
@@ -180,3 +214,13 @@ func (t transportConfig) BootNodes() []string {
 	}
 	return bootNodeAddresses
 }
+
+func (d devnetConfig) DepositList() []string {
+	deposits := []string{}
+	for _, deposit := range strings.Split(d.Deposits, ",") {
+		if deposit = strings.TrimSpace(deposit); deposit != "" {
+			deposits = append(deposits, deposit)
+		}
+	}
+	return deposits
+}
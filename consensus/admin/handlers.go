@@ -10,6 +10,7 @@ import (
 	"github.com/MadBase/MadNet/consensus/appmock"
 	"github.com/MadBase/MadNet/consensus/db"
 	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/consensus/signer"
 	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/crypto"
 	"github.com/MadBase/MadNet/dynamics"
@@ -38,7 +39,7 @@ type Handlers struct {
 	database    *db.Database
 	isInit      bool
 	isSync      bool
-	secret      []byte
+	keyring     *Keyring
 	chainID     uint32
 	logger      *logrus.Logger
 	ethAcct     []byte
@@ -47,9 +48,15 @@ type Handlers struct {
 	storage     dynamics.StorageGetter
 	ReceiveLock chan interfaces.Lockable
 	ipcServer   *ipc.Server
+	// remoteSigner receives the private keys instead of the encrypted
+	// store if the keys are kept by a signer outside of the node
+	remoteSigner *signer.Client
+	// clock returns the time the round of a new validating state starts at
+	clock func() time.Time
 }
 
-// Init creates all fields and binds external services
+// Init creates all fields and binds external services. If ethPubk is empty
+// the account must be given to SetAccount.
 func (ah *Handlers) Init(chainID uint32, database *db.Database, secret []byte, appHandler appmock.Application, ethPubk []byte, storage dynamics.StorageGetter, ipcs *ipc.Server) {
 	ctx := context.Background()
 	subCtx, cancelFunc := context.WithCancel(ctx)
@@ -60,11 +67,43 @@ func (ah *Handlers) Init(chainID uint32, database *db.Database, secret []byte, a
 	ah.chainID = chainID
 	ah.appHandler = appHandler
 	ah.ethPubk = ethPubk
-	ah.secret = utils.CopySlice(secret)
-	ah.ethAcct = crypto.GetAccount(ethPubk)
+	ah.keyring = NewKeyring(secret)
+	if len(ethPubk) > 0 {
+		ah.ethAcct = crypto.GetAccount(ethPubk)
+	}
 	ah.ReceiveLock = make(chan interfaces.Lockable)
 	ah.storage = storage
 	ah.ipcServer = ipcs
+	ah.clock = time.Now
+}
+
+// SetKeyring replaces the keyring holding the secret passed to Init
+func (ah *Handlers) SetKeyring(kr *Keyring) {
+	ah.keyring = kr
+}
+
+// SetAccount replaces the account derived from the public key passed to
+// Init; it is used where only the address of the node is known
+func (ah *Handlers) SetAccount(acct []byte) {
+	ah.ethAcct = utils.CopySlice(acct)
+}
+
+// CheckKeys fails if an entry of the encrypted store was encrypted with a
+// key the keyring does not hold
+func (ah *Handlers) CheckKeys() error {
+	return ah.keyring.Check(ah.database)
+}
+
+// SetClock replaces the wall clock the round of a new validating state
+// starts at, so that a simulation can run validators on a virtual clock
+func (ah *Handlers) SetClock(now func() time.Time) {
+	ah.clock = now
+}
+
+// SetRemoteSigner makes AddPrivateKey hand private keys to the signer c
+// rather than storing them
+func (ah *Handlers) SetRemoteSigner(c *signer.Client) {
+	ah.remoteSigner = c
 }
 
 // Close shuts down all workers
@@ -171,7 +210,7 @@ func (ah *Handlers) AddValidatorSet(v *objs.ValidatorSet) error {
 				VAddr:    ah.ethAcct,
 				GroupKey: v.GroupKey,
 			}
-			ownValidatingState.SetRoundStarted()
+			ownValidatingState.SetRoundStarted(ah.clock())
 			if err := ah.database.SetOwnValidatingState(txn, ownValidatingState); err != nil {
 				utils.DebugTrace(ah.logger, err)
 				return err
@@ -370,8 +409,17 @@ func (ah *Handlers) RegisterSnapshotCallback(fn func(bh *objs.BlockHeader) error
 }
 
 // AddPrivateKey stores a private key from an EthDKG run into an encrypted
-// keystore in the DB
+// keystore in the DB, or hands it to the remote signer if one is set
 func (ah *Handlers) AddPrivateKey(pk []byte, curveSpec constants.CurveSpec) error {
+	if ah.remoteSigner != nil {
+		pubk, err := ah.remoteSigner.ImportKey(curveSpec, utils.CopySlice(pk))
+		if err != nil {
+			utils.DebugTrace(ah.logger, err)
+			return err
+		}
+		ah.logger.Infof("Handed the private key of %x to the signer", crypto.Hasher(pubk))
+		return nil
+	}
 	mutex, ok := ah.getLock()
 	if !ok {
 		return nil
@@ -398,7 +446,7 @@ func (ah *Handlers) AddPrivateKey(pk []byte, curveSpec constants.CurveSpec) erro
 			ec := &objs.EncryptedStore{
 				Name:      name,
 				ClearText: privk,
-				Kid:       constants.AdminHandlerKid(),
+				Kid:       ah.keyring.Primary(),
 			}
 			err = ec.Encrypt(ah)
 			if err != nil {
@@ -420,7 +468,7 @@ func (ah *Handlers) AddPrivateKey(pk []byte, curveSpec constants.CurveSpec) erro
 			ec := &objs.EncryptedStore{
 				Name:      pubkey,
 				ClearText: privk,
-				Kid:       constants.AdminHandlerKid(),
+				Kid:       ah.keyring.Primary(),
 			}
 			err = ec.Encrypt(ah)
 			if err != nil {
@@ -461,9 +509,7 @@ func (ah *Handlers) GetPrivK(name []byte) ([]byte, error) {
 // GetKey allows the admin handler to act as a key resolver for decrypting
 // stored private keys
 func (ah *Handlers) GetKey(kid []byte) ([]byte, error) {
-	out := make([]byte, len(ah.secret))
-	copy(out[:], ah.secret)
-	return out, nil
+	return ah.keyring.GetKey(kid)
 }
 
 // InitializationMonitor polls the database for the existence of a snapshot
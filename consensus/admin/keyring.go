@@ -0,0 +1,177 @@
+package admin
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+// Keyring holds the symmetric keys of the encrypted store by key ID. New
+// entries are encrypted with the primary key; an entry is decrypted with
+// the key matching its Kid.
+type Keyring struct {
+	sync.RWMutex
+	keys    map[string][]byte
+	primary []byte
+}
+
+// NewKeyring returns a keyring holding key under constants.AdminHandlerKid
+// as its primary key
+func NewKeyring(key []byte) *Keyring {
+	return &Keyring{
+		keys:    map[string][]byte{string(constants.AdminHandlerKid()): utils.CopySlice(key)},
+		primary: constants.AdminHandlerKid(),
+	}
+}
+
+// LoadKeyring returns a keyring holding key under constants.AdminHandlerKid
+// and the keys of the key file at path, if path is set. The key file
+// holds a <key id>=<secret> pair on every line; the key is the hash of the
+// secret. Lines starting with # are skipped. The key with ID primary is the
+// primary key, constants.AdminHandlerKid if primary is empty.
+func LoadKeyring(key []byte, path string, primary string) (*Keyring, error) {
+	kr := NewKeyring(key)
+	if path != "" {
+		if err := kr.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	if primary != "" {
+		if err := kr.SetPrimary([]byte(primary)); err != nil {
+			return nil, err
+		}
+	}
+	return kr, nil
+}
+
+func (kr *Keyring) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		components := strings.SplitN(line, "=", 2)
+		if len(components) != 2 {
+			return fmt.Errorf("%s:%d: expected <key id>=<secret>", path, n)
+		}
+		kid := strings.TrimSpace(components[0])
+		secret := strings.TrimSpace(components[1])
+		if kid == "" || secret == "" {
+			return fmt.Errorf("%s:%d: expected <key id>=<secret>", path, n)
+		}
+		if err := kr.AddKey([]byte(kid), crypto.Hasher([]byte(secret))); err != nil {
+			return fmt.Errorf("%s:%d: %v", path, n, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// AddKey registers key under kid. A kid can not be registered with two
+// different keys.
+func (kr *Keyring) AddKey(kid []byte, key []byte) error {
+	kr.Lock()
+	defer kr.Unlock()
+	if prev, ok := kr.keys[string(kid)]; ok && !bytes.Equal(prev, key) {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("key id %q is registered with another key", kid))
+	}
+	kr.keys[string(kid)] = utils.CopySlice(key)
+	return nil
+}
+
+// SetPrimary makes the key registered under kid the primary key
+func (kr *Keyring) SetPrimary(kid []byte) error {
+	kr.Lock()
+	defer kr.Unlock()
+	if _, ok := kr.keys[string(kid)]; !ok {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("unknown key id %q", kid))
+	}
+	kr.primary = utils.CopySlice(kid)
+	return nil
+}
+
+// Primary returns the ID of the primary key
+func (kr *Keyring) Primary() []byte {
+	kr.RLock()
+	defer kr.RUnlock()
+	return utils.CopySlice(kr.primary)
+}
+
+// GetKey returns the key registered under kid
+func (kr *Keyring) GetKey(kid []byte) ([]byte, error) {
+	kr.RLock()
+	defer kr.RUnlock()
+	key, ok := kr.keys[string(kid)]
+	if !ok {
+		return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("unknown key id %q", kid))
+	}
+	return utils.CopySlice(key), nil
+}
+
+// Check fails if an entry of the encrypted store was encrypted with a key
+// which is not registered
+func (kr *Keyring) Check(database *db.Database) error {
+	return database.View(func(txn *badger.Txn) error {
+		stores, err := database.GetEncryptedStores(txn)
+		if err != nil {
+			return err
+		}
+		for _, ec := range stores {
+			if _, err := kr.GetKey(ec.Kid); err != nil {
+				return errorz.ErrInvalid{}.New(fmt.Sprintf("the encrypted store entry %x uses the unknown key id %q", ec.Name, ec.Kid))
+			}
+		}
+		return nil
+	})
+}
+
+// Rotate encrypts every entry of the encrypted store which was encrypted
+// with another key than the primary key with the primary key. All entries
+// are rewritten in one transaction, so that none is if an entry can not be
+// decrypted. The number of entries rewritten is returned.
+func (kr *Keyring) Rotate(database *db.Database) (int, error) {
+	primary := kr.Primary()
+	count := 0
+	err := database.Update(func(txn *badger.Txn) error {
+		stores, err := database.GetEncryptedStores(txn)
+		if err != nil {
+			return err
+		}
+		for _, ec := range stores {
+			if bytes.Equal(ec.Kid, primary) {
+				continue
+			}
+			if err := ec.Decrypt(kr); err != nil {
+				return fmt.Errorf("could not decrypt the entry %x: %v", ec.Name, err)
+			}
+			ec.Kid = primary
+			if err := ec.Encrypt(kr); err != nil {
+				return err
+			}
+			if err := database.SetEncryptedStore(txn, ec); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
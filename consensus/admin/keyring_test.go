@@ -0,0 +1,161 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+func newDatabase(t *testing.T) *db.Database {
+	ctx, cf := context.WithCancel(context.Background())
+	t.Cleanup(cf)
+	rawDB, err := utils.OpenBadger(ctx.Done(), "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rawDB.Close() })
+	database := &db.Database{}
+	database.Init(rawDB)
+	return database
+}
+
+func writeKeyFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "keys")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func storeEntry(t *testing.T, database *db.Database, kr *Keyring, name string) {
+	ec := &objs.EncryptedStore{
+		Name:      []byte(name),
+		ClearText: []byte("secret of " + name),
+		Kid:       kr.Primary(),
+	}
+	if err := ec.Encrypt(kr); err != nil {
+		t.Fatal(err)
+	}
+	err := database.Update(func(txn *badger.Txn) error {
+		return database.SetEncryptedStore(txn, ec)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func loadEntry(t *testing.T, database *db.Database, name string) *objs.EncryptedStore {
+	var ec *objs.EncryptedStore
+	err := database.View(func(txn *badger.Txn) error {
+		var err error
+		ec, err = database.GetEncryptedStore(txn, []byte(name))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ec
+}
+
+func TestKeyringRotate(t *testing.T) {
+	database := newDatabase(t)
+	legacy := crypto.Hasher([]byte("legacy"))
+	old := NewKeyring(legacy)
+	storeEntry(t, database, old, "a")
+	storeEntry(t, database, old, "b")
+
+	kr, err := LoadKeyring(legacy, writeKeyFile(t, "# rotated keys\nnew = s3cret\n"), "new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := kr.Check(database); err != nil {
+		t.Fatal(err)
+	}
+	n, err := kr.Rotate(database)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("rotated %d entries", n)
+	}
+	// a second rotation has nothing to do
+	n, err = kr.Rotate(database)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Fatalf("rotated %d entries again", n)
+	}
+
+	// the entries are read with the new key alone
+	next, err := LoadKeyring(crypto.Hasher([]byte("other")), writeKeyFile(t, "new=s3cret\n"), "new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := next.Check(database); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a", "b"} {
+		ec := loadEntry(t, database, name)
+		if !bytes.Equal(ec.Kid, []byte("new")) {
+			t.Fatalf("entry %s has kid %q", name, ec.Kid)
+		}
+		if err := ec.Decrypt(next); err != nil {
+			t.Fatal(err)
+		}
+		if string(ec.ClearText) != "secret of "+name {
+			t.Fatalf("entry %s decrypted to %q", name, ec.ClearText)
+		}
+	}
+}
+
+func TestKeyringUnknownKid(t *testing.T) {
+	database := newDatabase(t)
+	kr, err := LoadKeyring(crypto.Hasher([]byte("legacy")), writeKeyFile(t, "gone=secret\n"), "gone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	storeEntry(t, database, kr, "a")
+	if err := NewKeyring(crypto.Hasher([]byte("legacy"))).Check(database); err == nil {
+		t.Fatal("store with an unknown key id accepted")
+	}
+}
+
+func TestKeyringRotateUndecryptable(t *testing.T) {
+	database := newDatabase(t)
+	storeEntry(t, database, NewKeyring(crypto.Hasher([]byte("legacy"))), "a")
+	kr, err := LoadKeyring(crypto.Hasher([]byte("wrong")), writeKeyFile(t, "new=secret\n"), "new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kr.Rotate(database); err == nil {
+		t.Fatal("entry rotated without its key")
+	}
+	if !bytes.Equal(loadEntry(t, database, "a").Kid, NewKeyring(nil).Primary()) {
+		t.Fatal("entry rewritten by a failed rotation")
+	}
+}
+
+func TestLoadKeyringErrors(t *testing.T) {
+	key := crypto.Hasher([]byte("legacy"))
+	if _, err := LoadKeyring(key, "", "missing"); err == nil {
+		t.Fatal("unknown primary key id accepted")
+	}
+	if _, err := LoadKeyring(key, writeKeyFile(t, "no separator\n"), ""); err == nil {
+		t.Fatal("malformed key file accepted")
+	}
+	if _, err := LoadKeyring(key, writeKeyFile(t, "k=one\nk=two\n"), ""); err == nil {
+		t.Fatal("key id registered twice")
+	}
+	if _, err := LoadKeyring(key, filepath.Join(t.TempDir(), "missing"), ""); err == nil {
+		t.Fatal("missing key file accepted")
+	}
+}
@@ -0,0 +1,307 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+	capnp "zombiezen.com/go/capnproto2"
+)
+
+// An archive holds a contiguous range of committed blocks of one chain. It
+// starts with a header
+//
+//	magic    "MNCHAIN\n"
+//	version  uint8
+//	chainID  uint32
+//
+// which is followed by one record per block
+//
+//	length   uint32 of the body, never zero
+//	checksum uint32 crc32 of the body
+//	body     capnp message holding a Block
+//
+// and ends with a trailer, so that a truncated archive is detected
+//
+//	zero     uint32
+//	count    uint32 number of records
+//	checksum uint32 crc32 of the bodies of all records
+//
+// All integers are big endian.
+
+// Version is the version of the archive format written by Writer
+const Version uint8 = 1
+
+const maxRecordSize = 64 * 1024 * 1024
+
+var archiveMagic = []byte("MNCHAIN\n")
+
+// Block is the record of one committed block. ValidatorSets holds the
+// validator set which became active at the height of the block, or the one
+// active at the height of the first block of an archive. An importing node
+// compares them to the validator sets it learned from Ethereum.
+type Block struct {
+	Header        *objs.BlockHeader
+	Txs           [][]byte
+	ValidatorSets []*objs.ValidatorSet
+}
+
+// Block records are capnp messages of the struct
+//
+//	struct Block {
+//	  blockHeader   @0 :Data;
+//	  txs           @1 :List(Data);
+//	  validatorSets @2 :List(Data);
+//	}
+//
+// where every element holds the capnp encoding of the object. The struct is
+// accessed through the untyped capnp API as it is private to this package.
+var blockSize = capnp.ObjectSize{DataSize: 0, PointerCount: 3}
+
+func (b *Block) marshal() ([]byte, error) {
+	if b.Header == nil || b.Header.BClaims == nil {
+		return nil, errorz.ErrInvalid{}.New("block header not initialized")
+	}
+	bh, err := b.Header.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	vss := make([][]byte, len(b.ValidatorSets))
+	for i, vs := range b.ValidatorSets {
+		vss[i], err = vs.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+	}
+	_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	st, err := capnp.NewRootStruct(seg, blockSize)
+	if err != nil {
+		return nil, err
+	}
+	if err := st.SetData(0, bh); err != nil {
+		return nil, err
+	}
+	for i, list := range [][][]byte{b.Txs, vss} {
+		dl, err := capnp.NewDataList(seg, int32(len(list)))
+		if err != nil {
+			return nil, err
+		}
+		for j, v := range list {
+			if err := dl.Set(j, v); err != nil {
+				return nil, err
+			}
+		}
+		if err := st.SetPtr(uint16(i+1), dl.List.ToPtr()); err != nil {
+			return nil, err
+		}
+	}
+	return capnp.Canonicalize(st)
+}
+
+func unmarshalBlock(data []byte) (*Block, error) {
+	var err error
+	fn := func() (*Block, error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = errorz.ErrInvalid{}.New("bad serialization")
+			}
+		}()
+		msg := &capnp.Message{Arena: capnp.SingleSegment(data)}
+		root, err := msg.RootPtr()
+		if err != nil {
+			return nil, err
+		}
+		st := root.Struct()
+		p, err := st.Ptr(0)
+		if err != nil {
+			return nil, err
+		}
+		b := &Block{Header: &objs.BlockHeader{}}
+		if err := b.Header.UnmarshalBinary(utils.CopySlice(p.Data())); err != nil {
+			return nil, err
+		}
+		lists := make([][][]byte, 2)
+		for i := range lists {
+			p, err := st.Ptr(uint16(i + 1))
+			if err != nil {
+				return nil, err
+			}
+			dl := capnp.DataList{List: p.List()}
+			for j := 0; j < dl.Len(); j++ {
+				v, err := dl.At(j)
+				if err != nil {
+					return nil, err
+				}
+				lists[i] = append(lists[i], utils.CopySlice(v))
+			}
+		}
+		b.Txs = lists[0]
+		for _, v := range lists[1] {
+			vs := &objs.ValidatorSet{}
+			if err := vs.UnmarshalBinary(v); err != nil {
+				return nil, err
+			}
+			b.ValidatorSets = append(b.ValidatorSets, vs)
+		}
+		return b, nil
+	}
+	b, fnErr := fn()
+	if err != nil {
+		return nil, err
+	}
+	if fnErr != nil {
+		return nil, fnErr
+	}
+	return b, nil
+}
+
+// Writer writes an archive
+type Writer struct {
+	w     *bufio.Writer
+	count uint32
+	crc   hash.Hash32
+}
+
+// NewWriter writes the header of an archive of the chain chainID to w
+func NewWriter(w io.Writer, chainID uint32) (*Writer, error) {
+	aw := &Writer{w: bufio.NewWriter(w), crc: crc32.NewIEEE()}
+	header := make([]byte, 0, len(archiveMagic)+5)
+	header = append(header, archiveMagic...)
+	header = append(header, Version)
+	header = append(header, utils.MarshalUint32(chainID)...)
+	if _, err := aw.w.Write(header); err != nil {
+		return nil, err
+	}
+	return aw, nil
+}
+
+// Write appends the record of a block
+func (aw *Writer) Write(b *Block) error {
+	body, err := b.marshal()
+	if err != nil {
+		return err
+	}
+	if len(body) > maxRecordSize {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("record of block %d has %d bytes", b.Header.BClaims.Height, len(body)))
+	}
+	frame := make([]byte, 8)
+	binary.BigEndian.PutUint32(frame[0:4], uint32(len(body)))
+	binary.BigEndian.PutUint32(frame[4:8], crc32.ChecksumIEEE(body))
+	if _, err := aw.w.Write(frame); err != nil {
+		return err
+	}
+	if _, err := aw.w.Write(body); err != nil {
+		return err
+	}
+	aw.crc.Write(body)
+	aw.count++
+	return nil
+}
+
+// Close writes the trailer and flushes the archive. It does not close the
+// underlying writer.
+func (aw *Writer) Close() error {
+	trailer := make([]byte, 12)
+	binary.BigEndian.PutUint32(trailer[4:8], aw.count)
+	binary.BigEndian.PutUint32(trailer[8:12], aw.crc.Sum32())
+	if _, err := aw.w.Write(trailer); err != nil {
+		return err
+	}
+	return aw.w.Flush()
+}
+
+// Reader reads an archive
+type Reader struct {
+	// Version is the format version of the archive
+	Version uint8
+	// ChainID is the chain the blocks of the archive belong to
+	ChainID uint32
+	r       *bufio.Reader
+	count   uint32
+	crc     hash.Hash32
+	done    bool
+}
+
+// NewReader reads the header of the archive in r
+func NewReader(r io.Reader) (*Reader, error) {
+	ar := &Reader{r: bufio.NewReader(r), crc: crc32.NewIEEE()}
+	header := make([]byte, len(archiveMagic)+5)
+	if _, err := io.ReadFull(ar.r, header); err != nil || !bytes.Equal(header[:len(archiveMagic)], archiveMagic) {
+		return nil, errorz.ErrInvalid{}.New("not a chain archive")
+	}
+	ar.Version = header[len(archiveMagic)]
+	if ar.Version != Version {
+		return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("unsupported archive version %d", ar.Version))
+	}
+	chainID, err := utils.UnmarshalUint32(header[len(archiveMagic)+1:])
+	if err != nil {
+		return nil, err
+	}
+	ar.ChainID = chainID
+	return ar, nil
+}
+
+// Next returns the next block of the archive or io.EOF once the trailer was
+// read and verified. An archive which ends before its trailer is reported
+// as truncated.
+func (ar *Reader) Next() (*Block, error) {
+	if ar.done {
+		return nil, io.EOF
+	}
+	frame := make([]byte, 8)
+	if _, err := io.ReadFull(ar.r, frame); err != nil {
+		return nil, ar.truncated(err)
+	}
+	size := binary.BigEndian.Uint32(frame[0:4])
+	if size == 0 {
+		return nil, ar.readTrailer(frame[4:8])
+	}
+	if size > maxRecordSize {
+		return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("record %d has %d bytes", ar.count, size))
+	}
+	body := make([]byte, size)
+	if _, err := io.ReadFull(ar.r, body); err != nil {
+		return nil, ar.truncated(err)
+	}
+	if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(frame[4:8]) {
+		return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("bad checksum of record %d", ar.count))
+	}
+	ar.crc.Write(body)
+	ar.count++
+	return unmarshalBlock(body)
+}
+
+func (ar *Reader) readTrailer(count []byte) error {
+	rest := make([]byte, 4)
+	if _, err := io.ReadFull(ar.r, rest); err != nil {
+		return ar.truncated(err)
+	}
+	if n := binary.BigEndian.Uint32(count); n != ar.count {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("the archive holds %d records, its trailer %d", ar.count, n))
+	}
+	if binary.BigEndian.Uint32(rest) != ar.crc.Sum32() {
+		return errorz.ErrInvalid{}.New("bad archive checksum")
+	}
+	if _, err := ar.r.ReadByte(); err != io.EOF {
+		return errorz.ErrInvalid{}.New("trailing bytes after the archive")
+	}
+	ar.done = true
+	return io.EOF
+}
+
+func (ar *Reader) truncated(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("archive truncated after %d records", ar.count))
+	}
+	return err
+}
@@ -0,0 +1,192 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/crypto"
+)
+
+func makeBlocks(t *testing.T, n int) []*Block {
+	gk := &crypto.BNGroupSigner{}
+	if err := gk.SetPrivk(crypto.Hasher([]byte("secret"))); err != nil {
+		t.Fatal(err)
+	}
+	groupKey, err := gk.PubkeyShare()
+	if err != nil {
+		t.Fatal(err)
+	}
+	secp := &crypto.Secp256k1Signer{}
+	if err := secp.SetPrivk(crypto.Hasher([]byte("validator"))); err != nil {
+		t.Fatal(err)
+	}
+	vaddr, err := secp.Pubkey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vs := &objs.ValidatorSet{
+		Validators: []*objs.Validator{{VAddr: vaddr, GroupShare: groupKey}},
+		GroupKey:   groupKey,
+		NotBefore:  1,
+	}
+
+	blocks := []*Block{}
+	prev := crypto.Hasher([]byte("genesis"))
+	for i := 1; i <= n; i++ {
+		tx := []byte("tx" + strconv.Itoa(i))
+		txHshLst := [][]byte{crypto.Hasher(tx)}
+		txRoot, err := objs.MakeTxRoot(txHshLst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bclaims := &objs.BClaims{
+			ChainID:    1,
+			Height:     uint32(i),
+			TxCount:    1,
+			PrevBlock:  prev,
+			TxRoot:     txRoot,
+			StateRoot:  crypto.Hasher([]byte("")),
+			HeaderRoot: crypto.Hasher([]byte("")),
+		}
+		bhsh, err := bclaims.BlockHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig, err := gk.Sign(bhsh)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := &Block{
+			Header: &objs.BlockHeader{BClaims: bclaims, SigGroup: sig, TxHshLst: txHshLst},
+			Txs:    [][]byte{tx},
+		}
+		if i == 1 {
+			b.ValidatorSets = []*objs.ValidatorSet{vs}
+		}
+		blocks = append(blocks, b)
+		prev = bhsh
+	}
+	return blocks
+}
+
+func writeArchive(t *testing.T, blocks []*Block) []byte {
+	buf := &bytes.Buffer{}
+	aw, err := NewWriter(buf, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range blocks {
+		if err := aw.Write(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := aw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func readArchive(data []byte) ([]*Block, error) {
+	ar, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	blocks := []*Block{}
+	for {
+		b, err := ar.Next()
+		if err == io.EOF {
+			return blocks, nil
+		}
+		if err != nil {
+			return blocks, err
+		}
+		blocks = append(blocks, b)
+	}
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	blocks := makeBlocks(t, 3)
+	data := writeArchive(t, blocks)
+	ar, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ar.Version != Version || ar.ChainID != 1 {
+		t.Fatalf("bad header: version %d chainID %d", ar.Version, ar.ChainID)
+	}
+	got, err := readArchive(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(blocks) {
+		t.Fatalf("read %d blocks, wrote %d", len(got), len(blocks))
+	}
+	for i, b := range got {
+		want, err := blocks[i].Header.BlockHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		bhsh, err := b.Header.BlockHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(bhsh, want) {
+			t.Fatalf("block %d: bad header", i)
+		}
+		if len(b.Txs) != 1 || !bytes.Equal(b.Txs[0], blocks[i].Txs[0]) {
+			t.Fatalf("block %d: bad transactions", i)
+		}
+		if len(b.ValidatorSets) != len(blocks[i].ValidatorSets) {
+			t.Fatalf("block %d: %d validator sets", i, len(b.ValidatorSets))
+		}
+		if err := b.Header.ValidateSignatures(&crypto.BNGroupValidator{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	vs := got[0].ValidatorSets[0]
+	if vs.NotBefore != 1 || !bytes.Equal(vs.GroupKey, blocks[0].ValidatorSets[0].GroupKey) {
+		t.Fatal("bad validator set")
+	}
+}
+
+func TestArchiveEmpty(t *testing.T) {
+	got, err := readArchive(writeArchive(t, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("read %d blocks", len(got))
+	}
+}
+
+func TestArchiveCorrupt(t *testing.T) {
+	data := writeArchive(t, makeBlocks(t, 2))
+	if _, err := readArchive(data[:len(data)-1]); err == nil {
+		t.Fatal("truncated trailer accepted")
+	}
+	if _, err := readArchive(data[:len(data)-12]); err == nil {
+		t.Fatal("archive without trailer accepted")
+	}
+	if _, err := readArchive(data[:len(data)-20]); err == nil {
+		t.Fatal("truncated record accepted")
+	}
+	if _, err := readArchive(append(append([]byte{}, data...), 0)); err == nil {
+		t.Fatal("trailing bytes accepted")
+	}
+	flipped := append([]byte{}, data...)
+	flipped[len(archiveMagic)+5+8+10] ^= 0xff
+	if _, err := readArchive(flipped); err == nil {
+		t.Fatal("bad record checksum accepted")
+	}
+	flipped = append([]byte{}, data...)
+	flipped[len(archiveMagic)] = Version + 1
+	if _, err := readArchive(flipped); err == nil {
+		t.Fatal("unknown version accepted")
+	}
+	if _, err := readArchive([]byte("not an archive")); err == nil {
+		t.Fatal("bad magic accepted")
+	}
+}
@@ -0,0 +1,348 @@
+package archive
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/MadBase/MadNet/consensus/appmock"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// Export writes the committed blocks from height from to height to of the
+// chain in database to w. A to of zero exports up to the most recent block.
+// The number of blocks written is returned.
+func Export(database *db.Database, app appmock.Application, w io.Writer, from, to uint32) (uint32, error) {
+	if from == 0 {
+		from = 1
+	}
+	var chainID uint32
+	err := database.View(func(txn *badger.Txn) error {
+		os, err := database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		tip := os.SyncToBH.BClaims.Height
+		if to == 0 || to > tip {
+			to = tip
+		}
+		chainID = os.SyncToBH.BClaims.ChainID
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if from > to {
+		return 0, errorz.ErrInvalid{}.New(fmt.Sprintf("no blocks from height %d to height %d", from, to))
+	}
+
+	aw, err := NewWriter(w, chainID)
+	if err != nil {
+		return 0, err
+	}
+	var lastNotBefore uint32
+	for height := from; height <= to; height++ {
+		var b *Block
+		err := database.View(func(txn *badger.Txn) error {
+			var err error
+			b, lastNotBefore, err = exportBlock(txn, database, app, height, lastNotBefore)
+			return err
+		})
+		if err != nil {
+			return height - from, fmt.Errorf("block %d: %v", height, err)
+		}
+		if err := aw.Write(b); err != nil {
+			return height - from, err
+		}
+	}
+	if err := aw.Close(); err != nil {
+		return to - from + 1, err
+	}
+	return to - from + 1, nil
+}
+
+// exportBlock reads the block at height with its transactions. The
+// validator set active at height is added to the block if it became active
+// after lastNotBefore.
+func exportBlock(txn *badger.Txn, database *db.Database, app appmock.Application, height uint32, lastNotBefore uint32) (*Block, uint32, error) {
+	bh, err := database.GetCommittedBlockHeader(txn, height)
+	if err != nil {
+		return nil, 0, err
+	}
+	txs, missing, err := app.MinedTxGet(txn, bh.TxHshLst)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(missing) > 0 {
+		return nil, 0, errorz.ErrInvalid{}.New(fmt.Sprintf("%d transactions are missing", len(missing)))
+	}
+	b := &Block{Header: bh}
+	for _, tx := range txs {
+		txb, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, 0, err
+		}
+		b.Txs = append(b.Txs, txb)
+	}
+	vs, err := database.GetValidatorSet(txn, height)
+	if err != nil {
+		return nil, 0, err
+	}
+	if lastNotBefore == 0 || vs.NotBefore != lastNotBefore {
+		b.ValidatorSets = append(b.ValidatorSets, vs)
+	}
+	return b, vs.NotBefore, nil
+}
+
+// ImportReport summarizes an import
+type ImportReport struct {
+	// Skipped counts the blocks of the archive which the chain already held
+	Skipped int
+	// Imported counts the blocks added to the chain
+	Imported int
+	// Height is the height of the chain after the import
+	Height uint32
+}
+
+// Importer adds the blocks of an archive to the chain of a node. The node
+// must hold the genesis block, the blocks are applied on top of its most
+// recent block. Blocks are verified against the validator sets the node
+// learned from Ethereum; the validator sets of the archive are only checked
+// against them and never stored.
+type Importer struct {
+	logger   *logrus.Logger
+	database *db.Database
+	app      appmock.Application
+	bnVal    *crypto.BNGroupValidator
+}
+
+// Init initializes the Importer
+func (im *Importer) Init(database *db.Database, app appmock.Application) {
+	im.logger = logging.GetLogger(constants.LoggerConsensus)
+	im.database = database
+	im.app = app
+	im.bnVal = &crypto.BNGroupValidator{}
+}
+
+// Import applies the blocks of ar. Blocks at or below the current height
+// must match the committed blocks and are skipped. Every other block is
+// verified and applied in a transaction of its own, so that an import which
+// fails leaves the chain at the last block applied.
+func (im *Importer) Import(ar *Reader) (*ImportReport, error) {
+	report := &ImportReport{}
+	var tip *objs.BlockHeader
+	err := im.database.View(func(txn *badger.Txn) error {
+		os, err := im.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		tip = os.SyncToBH
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tip.BClaims.ChainID != ar.ChainID {
+		return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("the archive holds chain %d, the node chain %d", ar.ChainID, tip.BClaims.ChainID))
+	}
+	report.Height = tip.BClaims.Height
+
+	for {
+		b, err := ar.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return report, err
+		}
+		height := b.Header.BClaims.Height
+		if height <= report.Height {
+			err := im.database.View(func(txn *badger.Txn) error {
+				return im.checkCommitted(txn, b.Header)
+			})
+			if err != nil {
+				return report, fmt.Errorf("block %d: %v", height, err)
+			}
+			report.Skipped++
+			continue
+		}
+		err = im.database.Update(func(txn *badger.Txn) error {
+			return im.importBlock(txn, b)
+		})
+		if err != nil {
+			return report, fmt.Errorf("block %d: %v", height, err)
+		}
+		report.Imported++
+		report.Height = height
+	}
+
+	if report.Imported > 0 {
+		err := im.database.Update(func(txn *badger.Txn) error {
+			os, err := im.database.GetOwnState(txn)
+			if err != nil {
+				return err
+			}
+			return im.database.ResetRoundStates(txn, os.SyncToBH)
+		})
+		if err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// checkCommitted verifies that bh is the committed block at its height.
+// Blocks below the snapshot of a node which fast synced are not stored and
+// can not be compared.
+func (im *Importer) checkCommitted(txn *badger.Txn, bh *objs.BlockHeader) error {
+	committed, err := im.database.GetCommittedBlockHeader(txn, bh.BClaims.Height)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil
+		}
+		return err
+	}
+	want, err := committed.BlockHash()
+	if err != nil {
+		return err
+	}
+	got, err := bh.BlockHash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(want, got) {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("the archive holds block %x, the chain block %x", got, want))
+	}
+	return nil
+}
+
+// importBlock verifies b against the most recent block and applies it
+func (im *Importer) importBlock(txn *badger.Txn, b *Block) error {
+	os, err := im.database.GetOwnState(txn)
+	if err != nil {
+		return err
+	}
+	prev := os.SyncToBH
+	bh := b.Header
+	height := prev.BClaims.Height + 1
+	if bh.BClaims.Height != height {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("the chain is at height %d", prev.BClaims.Height))
+	}
+	if bh.BClaims.ChainID != prev.BClaims.ChainID {
+		return errorz.ErrInvalid{}.New("wrong chainID")
+	}
+	prevHash, err := prev.BlockHash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(bh.BClaims.PrevBlock, prevHash) {
+		return errorz.ErrInvalid{}.New("the block does not follow the most recent block")
+	}
+	headerRoot, err := im.database.GetHeaderTrieRoot(txn, prev.BClaims.Height)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(bh.BClaims.HeaderRoot, headerRoot) {
+		return errorz.ErrInvalid{}.New("header root mismatch")
+	}
+
+	for _, vs := range b.ValidatorSets {
+		if err := im.checkValidatorSet(txn, vs); err != nil {
+			return err
+		}
+	}
+	vs, err := im.database.GetValidatorSet(txn, height)
+	if err != nil {
+		return err
+	}
+	if err := bh.ValidateSignatures(im.bnVal); err != nil {
+		utils.DebugTrace(im.logger, err)
+		return errorz.ErrInvalid{}.New(err.Error())
+	}
+	if !bytes.Equal(bh.GroupKey, vs.GroupKey) {
+		return errorz.ErrInvalid{}.New("group key does not match expected")
+	}
+
+	if len(b.Txs) != len(bh.TxHshLst) {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("the block has %d transactions, the archive %d", len(bh.TxHshLst), len(b.Txs)))
+	}
+	txs := make([]interfaces.Transaction, len(b.Txs))
+	for i, txb := range b.Txs {
+		tx, err := im.app.UnmarshalTx(txb)
+		if err != nil {
+			return err
+		}
+		txHash, err := tx.TxHash()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(txHash, bh.TxHshLst[i]) {
+			return errorz.ErrInvalid{}.New(fmt.Sprintf("transaction %d has hash %x, the block %x", i, txHash, bh.TxHshLst[i]))
+		}
+		txs[i] = tx
+	}
+	ok, err := im.app.IsValid(txn, bh.BClaims.ChainID, height, bh.BClaims.StateRoot, txs)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorz.ErrInvalid{}.New("the transactions are not valid")
+	}
+	stateRoot, err := im.app.ApplyState(txn, bh.BClaims.ChainID, height, txs)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(stateRoot, bh.BClaims.StateRoot) {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("the state root is %x, the block has %x", stateRoot, bh.BClaims.StateRoot))
+	}
+	if err := im.database.SetCommittedBlockHeader(txn, bh); err != nil {
+		return err
+	}
+
+	os.SyncToBH = bh
+	if os.MaxBHSeen.BClaims.Height < height {
+		os.MaxBHSeen = bh
+	}
+	if height%constants.EpochLength == 0 {
+		os.CanonicalSnapShot = os.PendingSnapShot
+		os.PendingSnapShot = bh
+	}
+	return im.database.SetOwnState(txn, os)
+}
+
+// checkValidatorSet verifies that a validator set of the archive is the one
+// the node learned from Ethereum. Validator sets are only stored by the
+// Ethereum event handlers, so a set the node does not know is rejected.
+func (im *Importer) checkValidatorSet(txn *badger.Txn, vs *objs.ValidatorSet) error {
+	known, err := im.database.GetValidatorSet(txn, vs.NotBefore)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return errorz.ErrInvalid{}.New(fmt.Sprintf("the validator set of height %d is not known", vs.NotBefore))
+		}
+		return err
+	}
+	if known.NotBefore != vs.NotBefore {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("the validator set of height %d is not known", vs.NotBefore))
+	}
+	want, err := known.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	got, err := vs.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(want, got) {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("the validator set of height %d differs from the one known", vs.NotBefore))
+	}
+	return nil
+}
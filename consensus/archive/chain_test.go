@@ -0,0 +1,330 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/MadBase/MadNet/consensus/appmock"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+var testStateRoot = crypto.Hasher([]byte("state"))
+
+// testApp applies every block to testStateRoot
+type testApp struct {
+	*appmock.MockApplication
+}
+
+func (a *testApp) ApplyState(*badger.Txn, uint32, uint32, []interfaces.Transaction) ([]byte, error) {
+	return testStateRoot, nil
+}
+
+func newDatabase(t *testing.T) *db.Database {
+	ctx, cf := context.WithCancel(context.Background())
+	t.Cleanup(cf)
+	rawDB, err := utils.OpenBadger(ctx.Done(), "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rawDB.Close() })
+	database := &db.Database{}
+	database.Init(rawDB)
+	return database
+}
+
+// initChain stores a genesis block and the validator set of gk
+func initChain(t *testing.T, database *db.Database, gk *crypto.BNGroupSigner) {
+	txRoot, err := objs.MakeTxRoot(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bh := &objs.BlockHeader{
+		BClaims: &objs.BClaims{
+			ChainID:    1,
+			Height:     1,
+			PrevBlock:  crypto.Hasher([]byte("genesis")),
+			TxRoot:     txRoot,
+			StateRoot:  make([]byte, constants.HashLen),
+			HeaderRoot: make([]byte, constants.HashLen),
+		},
+		SigGroup: make([]byte, constants.CurveBN256EthSigLen),
+		TxHshLst: [][]byte{},
+	}
+	err = database.Update(func(txn *badger.Txn) error {
+		if err := database.SetCommittedBlockHeader(txn, bh); err != nil {
+			return err
+		}
+		os := &objs.OwnState{
+			VAddr:             make([]byte, constants.OwnerLen),
+			SyncToBH:          bh,
+			MaxBHSeen:         bh,
+			CanonicalSnapShot: bh,
+			PendingSnapShot:   bh,
+		}
+		return database.SetOwnState(txn, os)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	setValidatorSet(t, database, gk, 1)
+}
+
+// setValidatorSet stores the validator set of gk active from notBefore, as
+// the Ethereum event handlers do
+func setValidatorSet(t *testing.T, database *db.Database, gk *crypto.BNGroupSigner, notBefore uint32) {
+	groupKey, err := gk.PubkeyShare()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vs := &objs.ValidatorSet{
+		Validators: []*objs.Validator{{VAddr: make([]byte, constants.OwnerLen), GroupShare: groupKey}},
+		GroupKey:   groupKey,
+		NotBefore:  notBefore,
+	}
+	err = database.Update(func(txn *badger.Txn) error {
+		return database.SetValidatorSet(txn, vs)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// extendChain commits n blocks signed by gk on top of the chain
+func extendChain(t *testing.T, database *db.Database, gk *crypto.BNGroupSigner, n int) {
+	err := database.Update(func(txn *badger.Txn) error {
+		os, err := database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			prev := os.SyncToBH
+			prevHash, err := prev.BlockHash()
+			if err != nil {
+				return err
+			}
+			headerRoot, err := database.GetHeaderTrieRoot(txn, prev.BClaims.Height)
+			if err != nil {
+				return err
+			}
+			txRoot, err := objs.MakeTxRoot(nil)
+			if err != nil {
+				return err
+			}
+			bclaims := &objs.BClaims{
+				ChainID:    1,
+				Height:     prev.BClaims.Height + 1,
+				PrevBlock:  prevHash,
+				TxRoot:     txRoot,
+				StateRoot:  testStateRoot,
+				HeaderRoot: headerRoot,
+			}
+			bhsh, err := bclaims.BlockHash()
+			if err != nil {
+				return err
+			}
+			sig, err := gk.Sign(bhsh)
+			if err != nil {
+				return err
+			}
+			bh := &objs.BlockHeader{BClaims: bclaims, SigGroup: sig, TxHshLst: [][]byte{}}
+			if err := database.SetCommittedBlockHeader(txn, bh); err != nil {
+				return err
+			}
+			os.SyncToBH = bh
+			os.MaxBHSeen = bh
+		}
+		return database.SetOwnState(txn, os)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newGroupSigner(t *testing.T, secret string) *crypto.BNGroupSigner {
+	gk := &crypto.BNGroupSigner{}
+	if err := gk.SetPrivk(crypto.Hasher([]byte(secret))); err != nil {
+		t.Fatal(err)
+	}
+	return gk
+}
+
+func tipHash(t *testing.T, database *db.Database) []byte {
+	var bhsh []byte
+	err := database.View(func(txn *badger.Txn) error {
+		os, err := database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		bhsh, err = os.SyncToBH.BlockHash()
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bhsh
+}
+
+func TestExportImport(t *testing.T) {
+	gk := newGroupSigner(t, "secret")
+	source := newDatabase(t)
+	initChain(t, source, gk)
+	extendChain(t, source, gk, 4)
+
+	buf := &bytes.Buffer{}
+	n, err := Export(source, appmock.New(), buf, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 5 {
+		t.Fatalf("exported %d blocks", n)
+	}
+
+	target := newDatabase(t)
+	initChain(t, target, gk)
+	ar, err := NewReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	im := &Importer{}
+	im.Init(target, &testApp{appmock.New()})
+	report, err := im.Import(ar)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Skipped != 1 || report.Imported != 4 || report.Height != 5 {
+		t.Fatalf("bad report: %+v", report)
+	}
+	if !bytes.Equal(tipHash(t, source), tipHash(t, target)) {
+		t.Fatal("the chains differ after the import")
+	}
+
+	// importing again skips every block
+	ar, err = NewReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err = im.Import(ar)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Skipped != 5 || report.Imported != 0 {
+		t.Fatalf("bad report: %+v", report)
+	}
+}
+
+func TestImportBadSignature(t *testing.T) {
+	source := newDatabase(t)
+	initChain(t, source, newGroupSigner(t, "secret"))
+	extendChain(t, source, newGroupSigner(t, "other"), 1)
+	buf := &bytes.Buffer{}
+	if _, err := Export(source, appmock.New(), buf, 2, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	target := newDatabase(t)
+	initChain(t, target, newGroupSigner(t, "secret"))
+	ar, err := NewReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	im := &Importer{}
+	im.Init(target, &testApp{appmock.New()})
+	report, err := im.Import(ar)
+	if err == nil {
+		t.Fatal("block of another group accepted")
+	}
+	if report.Imported != 0 || report.Height != 1 {
+		t.Fatalf("bad report: %+v", report)
+	}
+}
+
+// newTwoSetArchive returns an archive of a chain whose validator set changes
+// from the group "secret" to the group "other" with NotBefore 4, which signs
+// the blocks from height 3
+func newTwoSetArchive(t *testing.T) []byte {
+	source := newDatabase(t)
+	initChain(t, source, newGroupSigner(t, "secret"))
+	extendChain(t, source, newGroupSigner(t, "secret"), 1)
+	setValidatorSet(t, source, newGroupSigner(t, "other"), 4)
+	extendChain(t, source, newGroupSigner(t, "other"), 2)
+	buf := &bytes.Buffer{}
+	if _, err := Export(source, appmock.New(), buf, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func importArchive(t *testing.T, target *db.Database, data []byte) (*ImportReport, error) {
+	ar, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	im := &Importer{}
+	im.Init(target, &testApp{appmock.New()})
+	return im.Import(ar)
+}
+
+func TestImportValidatorSets(t *testing.T) {
+	data := newTwoSetArchive(t)
+
+	// the node learned the second validator set from Ethereum
+	target := newDatabase(t)
+	initChain(t, target, newGroupSigner(t, "secret"))
+	setValidatorSet(t, target, newGroupSigner(t, "other"), 4)
+	report, err := importArchive(t, target, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Imported != 3 || report.Height != 4 {
+		t.Fatalf("bad report: %+v", report)
+	}
+}
+
+func TestImportUnknownValidatorSet(t *testing.T) {
+	data := newTwoSetArchive(t)
+
+	target := newDatabase(t)
+	initChain(t, target, newGroupSigner(t, "secret"))
+	report, err := importArchive(t, target, data)
+	if err == nil {
+		t.Fatal("validator set unknown to the node accepted")
+	}
+	if report.Imported != 1 || report.Height != 2 {
+		t.Fatalf("bad report: %+v", report)
+	}
+	err = target.View(func(txn *badger.Txn) error {
+		vs, err := target.GetValidatorSet(txn, 3)
+		if err != nil {
+			return err
+		}
+		if vs.NotBefore != 1 {
+			t.Fatal("the validator set of the archive was stored")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestImportDifferentValidatorSet(t *testing.T) {
+	data := newTwoSetArchive(t)
+
+	target := newDatabase(t)
+	initChain(t, target, newGroupSigner(t, "secret"))
+	setValidatorSet(t, target, newGroupSigner(t, "third"), 4)
+	report, err := importArchive(t, target, data)
+	if err == nil {
+		t.Fatal("validator set which differs from the known one accepted")
+	}
+	if report.Imported != 1 || report.Height != 2 {
+		t.Fatalf("bad report: %+v", report)
+	}
+}
@@ -3,12 +3,14 @@ package db
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/MadBase/MadNet/constants/dbprefix"
 
 	trie "github.com/MadBase/MadNet/badgerTrie"
 	"github.com/MadBase/MadNet/consensus/objs"
 	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/errorz"
 	"github.com/MadBase/MadNet/logging"
 	"github.com/MadBase/MadNet/utils"
 	"github.com/dgraph-io/badger/v2"
@@ -96,6 +98,29 @@ func (db *Database) GetEncryptedStore(txn *badger.Txn, name []byte) (*objs.Encry
 	return result, nil
 }
 
+// GetEncryptedStores returns every entry of the encrypted store
+func (db *Database) GetEncryptedStores(txn *badger.Txn) ([]*objs.EncryptedStore, error) {
+	prefix := dbprefix.PrefixEncryptedStore()
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+	it := txn.NewIterator(opts)
+	defer it.Close()
+	keys := [][]byte{}
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		keys = append(keys, it.Item().KeyCopy(nil))
+	}
+	result := []*objs.EncryptedStore{}
+	for _, key := range keys {
+		ec, err := db.rawDB.GetEncryptedStore(txn, key)
+		if err != nil {
+			utils.DebugTrace(db.logger, err)
+			return nil, err
+		}
+		result = append(result, ec)
+	}
+	return result, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
@@ -182,6 +207,33 @@ func (db *Database) GetHistoricRoundState(txn *badger.Txn, vaddr []byte, height
 	return result, nil
 }
 
+// GetHistoricRoundStates returns the historic round states of every
+// validator in every round of height
+func (db *Database) GetHistoricRoundStates(txn *badger.Txn, height uint32) ([]*objs.RoundState, error) {
+	prefix, err := db.makeHistoricRoundStateIterKey(height)
+	if err != nil {
+		return nil, err
+	}
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+	it := txn.NewIterator(opts)
+	defer it.Close()
+	result := []*objs.RoundState{}
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		v, err := it.Item().ValueCopy(nil)
+		if err != nil {
+			return nil, err
+		}
+		rs := &objs.RoundState{}
+		if err := rs.UnmarshalBinary(v); err != nil {
+			utils.DebugTrace(db.logger, err)
+			return nil, err
+		}
+		result = append(result, rs)
+	}
+	return result, nil
+}
+
 func (db *Database) DeleteBeforeHistoricRoundState(txn *badger.Txn, height uint32, maxnum int) error {
 	prefix, err := db.makeHistoricRoundStateIterKey(height)
 	if err != nil {
@@ -302,6 +354,15 @@ func (db *Database) makeCommittedBlockHeaderHashIndexKey(blockHash []byte) ([]by
 	return key.MarshalBinary()
 }
 
+func (db *Database) makeCommittedBlockRoundKey(height uint32) ([]byte, error) {
+	prefix := dbprefix.PrefixCommittedBlockRound()
+	key := &objs.BlockHeaderHeightKey{
+		Prefix: prefix,
+		Height: height,
+	}
+	return key.MarshalBinary()
+}
+
 func (db *Database) makeHistoricHeaderRootKey(height uint32) ([]byte, error) {
 	prefix := dbprefix.PrefixBlockHeaderTrieRootHistoric()
 	key := &objs.BlockHeaderHeightKey{
@@ -323,6 +384,17 @@ func (db *Database) GetHeaderTrieRoot(txn *badger.Txn, height uint32) ([]byte, e
 	return rt, nil
 }
 
+// SetHeaderTrieRoot overwrites the header trie root of a height. It seeds a
+// database which does not hold the header trie, such as the one used to
+// replay a consensus recording.
+func (db *Database) SetHeaderTrieRoot(txn *badger.Txn, height uint32, root []byte) error {
+	key, err := db.makeHistoricHeaderRootKey(height)
+	if err != nil {
+		return err
+	}
+	return db.rawDB.SetValue(txn, key, root)
+}
+
 func (db *Database) UpdateHeaderTrieRootFastSync(txn *badger.Txn, v *objs.BlockHeader) error {
 	if err := db.finalizeSnapShotHdrRoot(txn, v.BClaims.HeaderRoot, v.BClaims.Height-1); err != nil {
 		utils.DebugTrace(db.logger, err)
@@ -449,6 +521,17 @@ func (db *Database) GetHeaderRootForProposal(txn *badger.Txn) ([]byte, error) {
 	return result, nil
 }
 
+// GetHeaderTrieHash returns the block hash stored for height in the header
+// trie with root rootHash, or nil when the trie does not hold height
+func (db *Database) GetHeaderTrieHash(txn *badger.Txn, rootHash []byte, height uint32) ([]byte, error) {
+	return db.trie.Get(txn, rootHash, height)
+}
+
+// DeleteCommittedBlockHeader removes the most recent committed block header
+// along with its round and, at an epoch boundary, its snapshot header, and
+// moves the header trie root back to the height before it. The header trie
+// root of height is set to the root of the height before it until a new
+// header is committed at height.
 func (db *Database) DeleteCommittedBlockHeader(txn *badger.Txn, height uint32) error {
 	headerRoot, err := db.trie.ApplyState(txn, nil, height)
 	if err != nil {
@@ -478,6 +561,22 @@ func (db *Database) DeleteCommittedBlockHeader(txn *badger.Txn, height uint32) e
 	if err != nil {
 		return err
 	}
+	roundKey, err := db.makeCommittedBlockRoundKey(height)
+	if err != nil {
+		return err
+	}
+	err = utils.DeleteValue(txn, roundKey)
+	if err != nil {
+		return err
+	}
+	if height == 1 || height%constants.EpochLength == 0 {
+		if err := db.DeleteSnapshotBlockHeader(txn, height); err != nil {
+			return err
+		}
+	}
+	if err := db.rawDB.decrementCounter(txn, dbprefix.PrefixCommittedBlockHeaderCount()); err != nil {
+		return err
+	}
 	headerRootKey := db.makeCurrentHeaderRootKey()
 	err = db.rawDB.SetValue(txn, headerRootKey, headerRoot)
 	if err != nil {
@@ -567,6 +666,31 @@ func (db *Database) GetCommittedBlockHeaderByHash(txn *badger.Txn, hash []byte)
 	return result, nil
 }
 
+// SetCommittedBlockRound records the round in which the block at height was
+// committed. The round is only known to a node which committed the block
+// through consensus; blocks which were downloaded have no record.
+func (db *Database) SetCommittedBlockRound(txn *badger.Txn, height uint32, round uint32) error {
+	key, err := db.makeCommittedBlockRoundKey(height)
+	if err != nil {
+		return err
+	}
+	return db.rawDB.SetValue(txn, key, utils.MarshalUint32(round))
+}
+
+// GetCommittedBlockRound returns the round in which the block at height was
+// committed
+func (db *Database) GetCommittedBlockRound(txn *badger.Txn, height uint32) (uint32, error) {
+	key, err := db.makeCommittedBlockRoundKey(height)
+	if err != nil {
+		return 0, err
+	}
+	v, err := db.rawDB.getValue(txn, key)
+	if err != nil {
+		return 0, err
+	}
+	return utils.UnmarshalUint32(v)
+}
+
 func (db *Database) GetMostRecentCommittedBlockHeaderFastSync(txn *badger.Txn) (*objs.BlockHeader, error) {
 	prefix := dbprefix.PrefixCommittedBlockHeader()
 	seek := []byte{}
@@ -654,6 +778,55 @@ func (db *Database) GetOwnValidatingState(txn *badger.Txn) (*objs.OwnValidatingS
 	return result, nil
 }
 
+// ResetRoundStates returns the validating state and the round state of
+// every validator to the first round of the height after prev. It is used
+// after the committed chain was changed outside of the consensus engine.
+func (db *Database) ResetRoundStates(txn *badger.Txn, prev *objs.BlockHeader) error {
+	ovs, err := db.GetOwnValidatingState(txn)
+	if err != nil && err != badger.ErrKeyNotFound {
+		return err
+	}
+	if err == nil {
+		ovs = &objs.OwnValidatingState{
+			VAddr:    ovs.VAddr,
+			GroupKey: ovs.GroupKey,
+		}
+		ovs.SetRoundStarted(time.Now())
+		if err := db.SetOwnValidatingState(txn, ovs); err != nil {
+			return err
+		}
+	}
+
+	rcert, err := prev.GetRCert()
+	if err != nil {
+		return err
+	}
+	vs, err := db.GetValidatorSet(txn, rcert.RClaims.Height)
+	if err != nil {
+		return err
+	}
+	for _, v := range vs.Validators {
+		rs, err := db.GetCurrentRoundState(txn, v.VAddr)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				continue
+			}
+			return err
+		}
+		rs = &objs.RoundState{
+			VAddr:      rs.VAddr,
+			GroupKey:   rs.GroupKey,
+			GroupShare: rs.GroupShare,
+			GroupIdx:   rs.GroupIdx,
+			RCert:      rcert,
+		}
+		if err := db.SetCurrentRoundState(txn, rs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
@@ -997,6 +1170,15 @@ func (db *Database) GetSnapshotBlockHeader(txn *badger.Txn, height uint32) (*obj
 	return result, nil
 }
 
+// DeleteSnapshotBlockHeader removes the snapshot block header of height
+func (db *Database) DeleteSnapshotBlockHeader(txn *badger.Txn, height uint32) error {
+	key, err := db.makeSnapshotBlockHeaderKey(height)
+	if err != nil {
+		return err
+	}
+	return utils.DeleteValue(txn, key)
+}
+
 func (db *Database) GetLastSnapshot(txn *badger.Txn) (*objs.BlockHeader, error) {
 	prefix := db.makeSnapshotBlockHeaderIterKey()
 	seek := []byte{}
@@ -1659,6 +1841,26 @@ func (db *Database) GetSafeToProceed(txn *badger.Txn, height uint32) (bool, erro
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 
+// SetGenesisHash stores the hash of the genesis file the chain was
+// initialized from
+func (db *Database) SetGenesisHash(txn *badger.Txn, hash []byte) error {
+	if len(hash) != constants.HashLen {
+		return errorz.ErrInvalid{}.New("genesis hash has the wrong length")
+	}
+	return db.rawDB.SetValue(txn, dbprefix.PrefixGenesisHash(), hash)
+}
+
+// GetGenesisHash returns the hash stored by SetGenesisHash. It returns
+// badger.ErrKeyNotFound if the chain was not initialized from a genesis
+// file.
+func (db *Database) GetGenesisHash(txn *badger.Txn) ([]byte, error) {
+	return db.rawDB.getValue(txn, dbprefix.PrefixGenesisHash())
+}
+
+////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////
+
 func (db *Database) ContainsSnapShotHdrNode(txn *badger.Txn, root []byte) (bool, error) {
 	node, err := db.GetSnapShotHdrNode(txn, root)
 	if err != nil {
@@ -242,10 +242,29 @@ func TestBlockHeader(t *testing.T) {
 		if bytes.Equal(hdrRootPendingDelete, hdrRootBeforeDelete) {
 			t.Fatal("hdrRoot should be different!")
 		}
+		err = db.SetCommittedBlockRound(txn, 3, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		round, err := db.GetCommittedBlockRound(txn, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if round != 2 {
+			t.Fatalf("Wrong round: %v", round)
+		}
+		_, err = db.GetCommittedBlockRound(txn, 2)
+		if err != badger.ErrKeyNotFound {
+			t.Fatal("Should have raised ErrKeyNotFound")
+		}
 		err = db.DeleteCommittedBlockHeader(txn, 3)
 		if err != nil {
 			t.Fatal("Failed to delete committed block header!")
 		}
+		_, err = db.GetCommittedBlockRound(txn, 3)
+		if err != badger.ErrKeyNotFound {
+			t.Fatal("Round should have been deleted with the block header")
+		}
 		_, _, err = db.GetCommittedBlockHeaderWithProof(txn, hdrRoot, 3)
 		if err != badger.ErrKeyNotFound && err != nil {
 			t.Fatal(err)
@@ -296,6 +315,110 @@ func TestBlockHeader(t *testing.T) {
 	}
 }
 
+func TestDeleteCommittedBlockHeaderAboveTarget(t *testing.T) {
+	groupSigner := &crypto.BNGroupSigner{}
+	err := groupSigner.SetPrivk(crypto.Hasher([]byte("secret")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tbd, db, p := newDB(t)
+	defer tbd.Close()
+	sig, err := groupSigner.Sign(p.PrevBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tip := constants.EpochLength + 1
+	target := constants.EpochLength - 1
+	hashes := make(map[uint32][]byte)
+	err = tbd.db.Update(func(txn *badger.Txn) error {
+		for h := uint32(1); h <= tip; h++ {
+			bh := &objs.BlockHeader{
+				SigGroup: sig,
+				BClaims: &objs.BClaims{
+					ChainID:    p.ChainID,
+					Height:     h,
+					PrevBlock:  p.PrevBlock,
+					HeaderRoot: p.HeaderRoot,
+					StateRoot:  p.StateRoot,
+					TxRoot:     p.TxRoot,
+				},
+			}
+			if err := db.SetCommittedBlockHeader(txn, bh); err != nil {
+				t.Fatal(err)
+			}
+			if err := db.SetCommittedBlockRound(txn, h, 1); err != nil {
+				t.Fatal(err)
+			}
+			hsh, err := bh.BlockHash()
+			if err != nil {
+				t.Fatal(err)
+			}
+			hashes[h] = hsh
+		}
+		if _, err := db.GetSnapshotBlockHeader(txn, constants.EpochLength); err != nil {
+			t.Fatal(err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// delete down to the target height one height at a time, as a rollback
+	for h := tip; h > target; h-- {
+		err := tbd.db.Update(func(txn *badger.Txn) error {
+			return db.DeleteCommittedBlockHeader(txn, h)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = tbd.db.View(func(txn *badger.Txn) error {
+		for h := target + 1; h <= tip; h++ {
+			if _, err := db.GetCommittedBlockHeader(txn, h); err != badger.ErrKeyNotFound {
+				t.Fatalf("block header %d remains: %v", h, err)
+			}
+			if _, err := db.GetCommittedBlockHeaderByHash(txn, hashes[h]); err != badger.ErrKeyNotFound {
+				t.Fatalf("hash index of %d remains: %v", h, err)
+			}
+			if _, err := db.GetCommittedBlockRound(txn, h); err != badger.ErrKeyNotFound {
+				t.Fatalf("round of %d remains: %v", h, err)
+			}
+			if _, err := db.GetSnapshotBlockHeader(txn, h); err != badger.ErrKeyNotFound {
+				t.Fatalf("snapshot header %d remains: %v", h, err)
+			}
+		}
+		if _, err := db.GetCommittedBlockHeader(txn, target); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.GetCommittedBlockRound(txn, target); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.GetSnapshotBlockHeader(txn, 1); err != nil {
+			t.Fatal(err)
+		}
+		last, err := db.GetLastSnapshot(txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if last.BClaims.Height != 1 {
+			t.Fatalf("Wrong last snapshot: %v", last.BClaims.Height)
+		}
+		count, err := db.CountCommittedBlockHeaders(txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != int(target) {
+			t.Fatalf("Wrong count: %v", count)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestEncryptedStore(t *testing.T) {
 	groupSigner := &crypto.BNGroupSigner{}
 	err := groupSigner.SetPrivk(crypto.Hasher([]byte("secret")))
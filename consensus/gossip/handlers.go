@@ -10,6 +10,7 @@ import (
 	"github.com/MadBase/MadNet/errorz"
 	"github.com/MadBase/MadNet/utils"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
 	"github.com/MadBase/MadNet/consensus/db"
@@ -61,6 +62,18 @@ type Handlers struct {
 	ReceiveLock chan interfaces.Lockable
 }
 
+// peerAddress returns the address of the peer which sent a gossip message
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if addr, ok := p.Addr.(interfaces.NodeAddr); ok {
+		return addr.P2PAddr()
+	}
+	return p.Addr.String()
+}
+
 func (mb *Handlers) getLock(ctx context.Context) (interfaces.Lockable, bool) {
 	select {
 	case <-ctx.Done():
@@ -229,6 +242,7 @@ func (mb *Handlers) HandleP2PGossipProposal(ctx context.Context, msg *pb.GossipP
 	}
 	mutex.Lock()
 	defer mutex.Unlock()
+	mb.shandlers.Received(peerAddress(ctx), obj)
 	if err := mb.shandlers.AddProposal(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
 		return ack, err
@@ -267,6 +281,7 @@ func (mb *Handlers) HandleP2PGossipPreVote(ctx context.Context, msg *pb.GossipPr
 	}
 	mutex.Lock()
 	defer mutex.Unlock()
+	mb.shandlers.Received(peerAddress(ctx), obj)
 	if err := mb.shandlers.AddPreVote(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -305,6 +320,7 @@ func (mb *Handlers) HandleP2PGossipPreVoteNil(ctx context.Context, msg *pb.Gossi
 	}
 	mutex.Lock()
 	defer mutex.Unlock()
+	mb.shandlers.Received(peerAddress(ctx), obj)
 	if err := mb.shandlers.AddPreVoteNil(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -343,6 +359,7 @@ func (mb *Handlers) HandleP2PGossipPreCommit(ctx context.Context, msg *pb.Gossip
 	}
 	mutex.Lock()
 	defer mutex.Unlock()
+	mb.shandlers.Received(peerAddress(ctx), obj)
 	if err := mb.shandlers.AddPreCommit(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -381,6 +398,7 @@ func (mb *Handlers) HandleP2PGossipPreCommitNil(ctx context.Context, msg *pb.Gos
 	}
 	mutex.Lock()
 	defer mutex.Unlock()
+	mb.shandlers.Received(peerAddress(ctx), obj)
 	if err := mb.shandlers.AddPreCommitNil(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -419,6 +437,7 @@ func (mb *Handlers) HandleP2PGossipNextRound(ctx context.Context, msg *pb.Gossip
 	}
 	mutex.Lock()
 	defer mutex.Unlock()
+	mb.shandlers.Received(peerAddress(ctx), obj)
 	if err := mb.shandlers.AddNextRound(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -457,6 +476,7 @@ func (mb *Handlers) HandleP2PGossipNextHeight(ctx context.Context, msg *pb.Gossi
 	}
 	mutex.Lock()
 	defer mutex.Unlock()
+	mb.shandlers.Received(peerAddress(ctx), obj)
 	if err := mb.shandlers.AddNextHeight(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -492,6 +512,7 @@ func (mb *Handlers) HandleP2PGossipBlockHeader(ctx context.Context, msg *pb.Goss
 	}
 	mutex.Lock()
 	defer mutex.Unlock()
+	mb.shandlers.Received(peerAddress(ctx), obj)
 	if err := mb.shandlers.AddBlockHeader(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/MadBase/MadNet/consensus/admin"
 	"github.com/MadBase/MadNet/consensus/appmock"
@@ -12,6 +14,7 @@ import (
 	"github.com/MadBase/MadNet/consensus/dman"
 	"github.com/MadBase/MadNet/consensus/objs"
 	"github.com/MadBase/MadNet/consensus/request"
+	"github.com/MadBase/MadNet/consensus/signer"
 	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/crypto"
 	"github.com/MadBase/MadNet/dynamics"
@@ -34,8 +37,11 @@ type Engine struct {
 	appHandler appmock.Application
 
 	logger     *logrus.Logger
-	secpSigner *crypto.Secp256k1Signer
-	bnSigner   *crypto.BNGroupSigner
+	secpSigner crypto.Signer
+	bnSigner   crypto.GroupSigner
+	// remoteSigner holds the group key shares if the keys are kept by a
+	// signer outside of the node
+	remoteSigner *signer.Client
 
 	AdminBus *admin.Handlers
 
@@ -44,18 +50,32 @@ type Engine struct {
 	ethAcct []byte
 	EthPubk []byte
 
-	storage dynamics.StorageGetter
+	storage  dynamics.StorageGetter
+	feeCache minTxFeeCache
 
 	dm *dman.DMan
+
+	rounds roundTracker
+
+	validatorKeyLoaded int32
+
+	// clock returns the time the step timers are started and expire at
+	clock func() time.Time
+
+	recorder *Recorder
+	// casts holds the records of the objects cast during the current update
+	// of the local state until the update is committed
+	casts  []*Record
+	replay *replayCasts
 }
 
 // Init will initialize the Consensus Engine and all sub modules
-func (ce *Engine) Init(database *db.Database, dm *dman.DMan, app appmock.Application, signer *crypto.Secp256k1Signer, adminHandlers *admin.Handlers, publicKey []byte, rbusClient *request.Client, storage dynamics.StorageGetter) {
+func (ce *Engine) Init(database *db.Database, dm *dman.DMan, app appmock.Application, secpSigner crypto.Signer, adminHandlers *admin.Handlers, publicKey []byte, rbusClient *request.Client, storage dynamics.StorageGetter) {
 	background := context.Background()
 	ctx, cf := context.WithCancel(background)
 	ce.cancelCtx = cf
 	ce.ctx = ctx
-	ce.secpSigner = signer
+	ce.secpSigner = secpSigner
 	ce.database = database
 	ce.AdminBus = adminHandlers
 	ce.EthPubk = publicKey
@@ -74,6 +94,7 @@ func (ce *Engine) Init(database *db.Database, dm *dman.DMan, app appmock.Applica
 	}
 	ce.storage = storage
 	ce.fastSync.Init(database, storage)
+	ce.clock = time.Now
 }
 
 // Status updates the status of the consensus engine
@@ -106,10 +127,59 @@ func (ce *Engine) Status(status map[string]interface{}) (map[string]interface{},
 	return status, nil
 }
 
+// SyncHealth reports whether the node has caught up with the highest block
+// header seen, which is the case once fast sync has completed
+func (ce *Engine) SyncHealth() (bool, string) {
+	var syncTo, maxSeen uint32
+	err := ce.database.View(func(txn *badger.Txn) error {
+		os, err := ce.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		syncTo = os.SyncToBH.BClaims.Height
+		maxSeen = os.MaxBHSeen.BClaims.Height
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Sprintf("unable to load own state: %v", err)
+	}
+	if maxSeen > syncTo+1 {
+		return false, fmt.Sprintf("syncing: at height %d of %d", syncTo, maxSeen)
+	}
+	return true, fmt.Sprintf("synchronized at height %d", syncTo)
+}
+
+// ValidatorKeyHealth reports whether the group signing key of the node is
+// loaded if the node is a member of the current validator set
+func (ce *Engine) ValidatorKeyHealth() (bool, string) {
+	var isValidator bool
+	err := ce.database.View(func(txn *badger.Txn) error {
+		rs, err := ce.sstore.LoadLocalState(txn)
+		if err != nil {
+			return err
+		}
+		isValidator = rs.IsCurrentValidator()
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Sprintf("unable to load local state: %v", err)
+	}
+	if !isValidator {
+		return true, "not a member of the current validator set"
+	}
+	if atomic.LoadInt32(&ce.validatorKeyLoaded) == 0 {
+		return false, "group signing key not loaded"
+	}
+	return true, "group signing key loaded"
+}
+
 // UpdateLocalState updates the local state of the consensus engine
 func (ce *Engine) UpdateLocalState() (bool, error) {
 	var isSync bool
+	var roundState *RoundStates
+	var records []*Record
 	updateLocalState := true
+	ce.casts = nil
 	// ce.logger.Error("!!! OPEN UpdateLocalState TXN")
 	// defer func() { ce.logger.Error("!!! CLOSE UpdateLocalState TXN") }()
 	err := ce.database.Update(func(txn *badger.Txn) error {
@@ -147,13 +217,13 @@ func (ce *Engine) UpdateLocalState() (bool, error) {
 				VAddr:    ownState.VAddr,
 				GroupKey: ownState.GroupKey,
 			}
-			ovs.SetRoundStarted()
+			ovs.SetRoundStarted(ce.clock())
 			err := ce.database.SetOwnValidatingState(txn, ovs)
 			if err != nil {
 				return err
 			}
 		}
-		roundState, err := ce.sstore.LoadLocalState(txn)
+		roundState, err = ce.sstore.LoadLocalState(txn)
 		if err != nil {
 			return err
 		}
@@ -163,6 +233,15 @@ func (ce *Engine) UpdateLocalState() (bool, error) {
 			utils.DebugTrace(ce.logger, err)
 			return err
 		}
+		err = ce.loadMinTxFee(txn, utils.Epoch(roundState.OwnState.SyncToBH.BClaims.Height+1))
+		if err != nil {
+			utils.DebugTrace(ce.logger, err)
+			if errors.Is(err, badger.ErrKeyNotFound) {
+				// retry once the headers which set the fee are present
+				return errorz.ErrInvalid{}.New("the minimum tx fee is not known")
+			}
+			return err
+		}
 		if roundState.OwnState.SyncToBH.BClaims.Height%constants.EpochLength == 0 {
 			safe, err := ce.database.GetSafeToProceed(txn, roundState.OwnState.SyncToBH.BClaims.Height)
 			if err != nil {
@@ -190,6 +269,9 @@ func (ce *Engine) UpdateLocalState() (bool, error) {
 			utils.DebugTrace(ce.logger, err)
 			return err
 		}
+		if ce.recorder != nil {
+			records = ce.recordUpdate(txn, roundState, updateLocalState)
+		}
 		if err := ce.dm.CleanCache(txn, height); err != nil {
 			utils.DebugTrace(ce.logger, err)
 			return err
@@ -207,6 +289,12 @@ func (ce *Engine) UpdateLocalState() (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	if len(records) > 0 {
+		if err := ce.recorder.Write(records...); err != nil {
+			utils.DebugTrace(ce.logger, err)
+		}
+	}
+	ce.rounds.observe(roundState)
 	return isSync, nil
 }
 
@@ -219,23 +307,23 @@ func (ce *Engine) UpdateLocalState() (bool, error) {
 // This changes state for local node
 // order of ops is as follows:
 //
-//  check for height jump
-//  check for dead block round round jump
-//  follow dead block round next round if signed by self
-//  follow f+1 other dead block round next round messages
-//  do own next height if in dead block round
-//  follow a next height from any round in the same height as us
-//      this is safe due to how we count next heights to filter
-//      dead block round
-//  follow a round jump to any non dead block round
-//  do a possible next round in same round
-//  do a possible precommit/pendingnext in same round
-//  do a possible precommit/pendingnext in same round
-//  do a possible prevote/pendingprecommit in same round
-//  do a possible prevotenil/pendingprecommit in same round
-//  do a possible pending prevote
-//  do a possible do a proposal if not already proposed and is proposer
-//  do nothing if not any of above is true
+//	check for height jump
+//	check for dead block round round jump
+//	follow dead block round next round if signed by self
+//	follow f+1 other dead block round next round messages
+//	do own next height if in dead block round
+//	follow a next height from any round in the same height as us
+//	    this is safe due to how we count next heights to filter
+//	    dead block round
+//	follow a round jump to any non dead block round
+//	do a possible next round in same round
+//	do a possible precommit/pendingnext in same round
+//	do a possible precommit/pendingnext in same round
+//	do a possible prevote/pendingprecommit in same round
+//	do a possible prevotenil/pendingprecommit in same round
+//	do a possible pending prevote
+//	do a possible do a proposal if not already proposed and is proposer
+//	do nothing if not any of above is true
 func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bool, error) {
 	os := rs.OwnRoundState()
 
@@ -401,9 +489,10 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 	PCCurrent := os.PCCurrent(rcert)
 	PCNCurrent := os.PCNCurrent(rcert)
 	NRCurrent := os.NRCurrent(rcert)
-	PTOExpired := rs.OwnValidatingState.PTOExpired(proposalStepTO)
-	PVTOExpired := rs.OwnValidatingState.PVTOExpired(preVoteStepTO)
-	PCTOExpired := rs.OwnValidatingState.PCTOExpired(preCommitStepTO)
+	now := ce.clock()
+	PTOExpired := rs.OwnValidatingState.PTOExpired(now, proposalStepTO)
+	PVTOExpired := rs.OwnValidatingState.PVTOExpired(now, preVoteStepTO)
+	PCTOExpired := rs.OwnValidatingState.PCTOExpired(now, preCommitStepTO)
 
 	// dispatch to handlers
 	if NRCurrent {
@@ -416,6 +505,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 	}
 	if PCCurrent {
 		if PCTOExpired {
+			ce.rounds.timeout(stepPreCommit)
 			err := ce.doPendingNext(txn, rs)
 			if err != nil {
 				utils.DebugTrace(ce.logger, err)
@@ -432,6 +522,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 	}
 	if PCNCurrent {
 		if PCTOExpired {
+			ce.rounds.timeout(stepPreCommit)
 			err := ce.doPendingNext(txn, rs)
 			if err != nil {
 				utils.DebugTrace(ce.logger, err)
@@ -449,6 +540,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 
 	if PVCurrent {
 		if PVTOExpired {
+			ce.rounds.timeout(stepPreVote)
 			err := ce.doPendingPreCommit(txn, rs)
 			if err != nil {
 				utils.DebugTrace(ce.logger, err)
@@ -465,6 +557,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 	}
 	if PVNCurrent {
 		if PVTOExpired {
+			ce.rounds.timeout(stepPreVote)
 			err := ce.doPendingPreCommit(txn, rs)
 			if err != nil {
 				utils.DebugTrace(ce.logger, err)
@@ -480,6 +573,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 		return true, nil
 	}
 	if PTOExpired {
+		ce.rounds.timeout(stepProposal)
 		err := ce.doPendingPreVoteStep(txn, rs)
 		if err != nil {
 			utils.DebugTrace(ce.logger, err)
@@ -560,6 +654,16 @@ func (ce *Engine) Sync() (bool, error) {
 				return nil
 			}
 		}
+		// fast sync does not validate txs, so the fee is only needed here
+		err = ce.loadMinTxFee(txn, utils.Epoch(rs.OwnState.SyncToBH.BClaims.Height+1))
+		if err != nil {
+			utils.DebugTrace(ce.logger, err)
+			if errors.Is(err, badger.ErrKeyNotFound) {
+				// retry once the headers which set the fee are present
+				return errorz.ErrInvalid{}.New("the minimum tx fee is not known")
+			}
+			return err
+		}
 		ce.logger.Debugf("SyncOneBH:  MBHS:%v  STBH:%v", rs.OwnState.MaxBHSeen.BClaims.Height, rs.OwnState.SyncToBH.BClaims.Height)
 		txs, bh, ok, err := ce.dm.SyncOneBH(txn, rs.OwnState.SyncToBH, rs.OwnState.MaxBHSeen, rs.ValidatorSet)
 		if err != nil {
@@ -609,29 +713,23 @@ func (ce *Engine) Sync() (bool, error) {
 }
 
 func (ce *Engine) loadValidationKey(rs *RoundStates) error {
+	if ce.replay != nil {
+		// a replay uses the objects signed by the recorded node
+		return nil
+	}
 	if rs.IsCurrentValidator() {
 		if !bytes.Equal(rs.ValidatorSet.GroupKey, rs.OwnValidatingState.GroupKey) || ce.bnSigner == nil {
+			atomic.StoreInt32(&ce.validatorKeyLoaded, 0)
 			for _, v := range rs.ValidatorSet.Validators {
 				if bytes.Equal(v.VAddr, rs.OwnState.VAddr) {
 					name := make([]byte, len(v.GroupShare))
 					copy(name[:], v.GroupShare)
-					pk, err := ce.AdminBus.GetPrivK(name)
-					if err != nil {
-						utils.DebugTrace(ce.logger, err)
-						return nil
-					}
-					signer := &crypto.BNGroupSigner{}
-					err = signer.SetPrivk(pk)
+					bnSigner, err := ce.loadGroupSigner(name, rs.ValidatorSet.GroupKey)
 					if err != nil {
 						utils.DebugTrace(ce.logger, err)
 						return nil
 					}
-					err = signer.SetGroupPubk(rs.ValidatorSet.GroupKey)
-					if err != nil {
-						utils.DebugTrace(ce.logger, err)
-						return err
-					}
-					ce.bnSigner = signer
+					ce.bnSigner = bnSigner
 					pubk, err := ce.bnSigner.PubkeyShare()
 					if err != nil {
 						return err
@@ -640,6 +738,7 @@ func (ce *Engine) loadValidationKey(rs *RoundStates) error {
 						utils.DebugTrace(ce.logger, err)
 						return err
 					}
+					atomic.StoreInt32(&ce.validatorKeyLoaded, 1)
 					break
 				}
 			}
@@ -648,3 +747,37 @@ func (ce *Engine) loadValidationKey(rs *RoundStates) error {
 	}
 	return nil
 }
+
+// loadGroupSigner returns the signer of the group key share with the public
+// key name, from the remote signer if one is set and from the encrypted
+// store otherwise
+func (ce *Engine) loadGroupSigner(name []byte, groupKey []byte) (crypto.GroupSigner, error) {
+	if ce.remoteSigner != nil {
+		return ce.remoteSigner.GroupSigner(name, groupKey)
+	}
+	pk, err := ce.AdminBus.GetPrivK(name)
+	if err != nil {
+		return nil, err
+	}
+	bnSigner := &crypto.BNGroupSigner{}
+	if err := bnSigner.SetPrivk(pk); err != nil {
+		return nil, err
+	}
+	if err := bnSigner.SetGroupPubk(groupKey); err != nil {
+		return nil, err
+	}
+	return bnSigner, nil
+}
+
+// SetRemoteSigner makes the engine sign with the group key shares held by
+// the signer c instead of the keys in the encrypted store. The secp256k1
+// signer of the signer is passed to Init.
+func (ce *Engine) SetRemoteSigner(c *signer.Client) {
+	ce.remoteSigner = c
+}
+
+// SetClock replaces the wall clock of the step timers, so that a simulation
+// can run the engine on a virtual clock
+func (ce *Engine) SetClock(now func() time.Time) {
+	ce.clock = now
+}
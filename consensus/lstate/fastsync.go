@@ -510,6 +510,8 @@ func (ssm *SnapShotManager) startFastSync(txn *badger.Txn, snapShotBlockHeader *
 	}
 
 	ssm.snapShotHeight.Set(snapShotBlockHeader.BClaims.Height)
+	fastSyncActive.Set(1)
+	fastSyncTargetHeight.Set(float64(snapShotBlockHeader.BClaims.Height))
 
 	// call dropBefore on the caches
 	ssm.stateNodeCache.dropBefore(snapShotBlockHeader.BClaims.Height)
@@ -586,6 +588,11 @@ func (ssm *SnapShotManager) Update(txn *badger.Txn, snapShotBlockHeader *objs.Bl
 	}
 	logMsg := fmt.Sprintf("FastSyncing@%v |HN:%v HL:%v CBH:%v |SN:%v SL:%v |Prct:%v", snapShotBlockHeader.BClaims.Height, hnCount, hlCount, bhCount, snCount, slCount, (bhCount*100)/int(snapShotBlockHeader.BClaims.Height))
 	ssm.status(logMsg)
+	fastSyncHeaders.Set(float64(bhCount))
+	fastSyncPending.WithLabelValues("header_node").Set(float64(hnCount))
+	fastSyncPending.WithLabelValues("header_leaf").Set(float64(hlCount))
+	fastSyncPending.WithLabelValues("state_node").Set(float64(snCount))
+	fastSyncPending.WithLabelValues("state_leaf").Set(float64(slCount))
 	if err := ssm.updateDls(txn, snapShotBlockHeader.BClaims.Height, bhCount, hlCount); err != nil {
 		utils.DebugTrace(ssm.logger, err)
 		return false, err
@@ -667,6 +674,7 @@ func (ssm *SnapShotManager) finalizeSync(txn *badger.Txn, snapShotBlockHeader *o
 		utils.DebugTrace(ssm.logger, err)
 		return err
 	}
+	fastSyncActive.Set(0)
 	return nil
 }
 
@@ -23,6 +23,7 @@ type Handlers struct {
 	bnVal    *crypto.BNGroupValidator
 	dm       *dman.DMan
 	logger   *logrus.Logger
+	recorder *Recorder
 }
 
 // Init initializes the Handlers object
@@ -12,7 +12,7 @@ import (
 // for votes on the local state.
 
 func (ce *Engine) setMostRecentRCert(rs *RoundStates, v *objs.RCert) error {
-	rs.OwnValidatingState.SetRoundStarted()
+	rs.OwnValidatingState.SetRoundStarted(ce.clock())
 	if err := rs.OwnRoundState().SetRCert(v); err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
@@ -33,7 +33,7 @@ func (ce *Engine) setMostRecentProposal(rs *RoundStates, v *objs.Proposal) error
 }
 
 func (ce *Engine) setMostRecentPreVote(rs *RoundStates, v *objs.PreVote) error {
-	rs.OwnValidatingState.SetPreVoteStepStarted()
+	rs.OwnValidatingState.SetPreVoteStepStarted(ce.clock())
 	ok, err := rs.OwnRoundState().SetPreVote(v)
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
@@ -46,7 +46,7 @@ func (ce *Engine) setMostRecentPreVote(rs *RoundStates, v *objs.PreVote) error {
 }
 
 func (ce *Engine) setMostRecentPreVoteNil(rs *RoundStates, v *objs.PreVoteNil) error {
-	rs.OwnValidatingState.SetPreVoteStepStarted()
+	rs.OwnValidatingState.SetPreVoteStepStarted(ce.clock())
 	ok, err := rs.OwnRoundState().SetPreVoteNil(v)
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
@@ -59,7 +59,7 @@ func (ce *Engine) setMostRecentPreVoteNil(rs *RoundStates, v *objs.PreVoteNil) e
 }
 
 func (ce *Engine) setMostRecentPreCommit(rs *RoundStates, v *objs.PreCommit) error {
-	rs.OwnValidatingState.SetPreCommitStepStarted()
+	rs.OwnValidatingState.SetPreCommitStepStarted(ce.clock())
 	ok, err := rs.OwnRoundState().SetPreCommit(v)
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
@@ -76,7 +76,7 @@ func (ce *Engine) setMostRecentPreCommit(rs *RoundStates, v *objs.PreCommit) err
 }
 
 func (ce *Engine) setMostRecentPreCommitNil(rs *RoundStates, v *objs.PreCommitNil) error {
-	rs.OwnValidatingState.SetPreCommitStepStarted()
+	rs.OwnValidatingState.SetPreCommitStepStarted(ce.clock())
 	ok, err := rs.OwnRoundState().SetPreCommitNil(v)
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
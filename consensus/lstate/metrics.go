@@ -0,0 +1,175 @@
+package lstate
+
+import (
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Step labels used by the consensus metrics
+const (
+	stepProposal  = "proposal"
+	stepPreVote   = "prevote"
+	stepPreCommit = "precommit"
+)
+
+var (
+	heightGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "consensus",
+		Name:      "height",
+		Help:      "Height of the round the consensus engine is in.",
+	})
+	roundGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "consensus",
+		Name:      "round",
+		Help:      "Round the consensus engine is in.",
+	})
+	stepTimeouts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "consensus",
+		Name:      "step_timeouts_total",
+		Help:      "Number of rounds in which the timeout of a step expired before the step completed.",
+	}, []string{"step"})
+	roundChanges = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "consensus",
+		Name:      "round_changes_total",
+		Help:      "Number of times the consensus engine moved to a later round of the same height.",
+	})
+	roundsPerHeight = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "consensus",
+		Name:      "rounds_per_height",
+		Help:      "Number of rounds needed to reach the next height.",
+		Buckets:   prometheus.LinearBuckets(1, 1, 5),
+	})
+	proposalLatency = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "consensus",
+		Name:      "proposal_latency_seconds",
+		Help:      "Time from the start of a round until the proposal of the round is known.",
+	})
+	voteLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "consensus",
+		Name:      "vote_latency_seconds",
+		Help:      "Time from the start of a round until the local node casts a vote.",
+	}, []string{"step"})
+
+	fastSyncActive = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "fastsync",
+		Name:      "active",
+		Help:      "Set to 1 while a fast sync is in progress.",
+	})
+	fastSyncTargetHeight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "fastsync",
+		Name:      "target_height",
+		Help:      "Height of the snapshot being synchronized.",
+	})
+	fastSyncHeaders = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "fastsync",
+		Name:      "committed_headers",
+		Help:      "Number of committed block headers stored during fast sync.",
+	})
+	fastSyncPending = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "fastsync",
+		Name:      "pending_keys",
+		Help:      "Number of trie keys waiting to be downloaded during fast sync.",
+	}, []string{"kind"})
+)
+
+func init() {
+	metrics.MustRegister(
+		heightGauge,
+		roundGauge,
+		stepTimeouts,
+		roundChanges,
+		roundsPerHeight,
+		proposalLatency,
+		voteLatency,
+		fastSyncActive,
+		fastSyncTargetHeight,
+		fastSyncHeaders,
+		fastSyncPending,
+	)
+}
+
+// roundTracker turns the round states observed after each update of the
+// local state into consensus metrics. Each event is recorded once per round.
+type roundTracker struct {
+	sync.Mutex
+	height       uint32
+	round        uint32
+	roundStarted time.Time
+	seen         map[string]bool
+}
+
+// timeout records that the timeout of step expired in the current round
+func (rt *roundTracker) timeout(step string) {
+	rt.Lock()
+	defer rt.Unlock()
+	if rt.once("timeout/" + step) {
+		stepTimeouts.WithLabelValues(step).Inc()
+	}
+}
+
+// observe records the progress visible in rs
+func (rt *roundTracker) observe(rs *RoundStates) {
+	rt.Lock()
+	defer rt.Unlock()
+	rcert := rs.OwnRoundState().RCert
+	height, round := rcert.RClaims.Height, rcert.RClaims.Round
+	switch {
+	case height > rt.height:
+		if rt.height != 0 {
+			roundsPerHeight.Observe(float64(rt.round))
+		}
+		rt.newRound(height, round)
+	case height == rt.height && round > rt.round:
+		roundChanges.Add(float64(round - rt.round))
+		rt.newRound(height, round)
+	}
+	heightGauge.Set(float64(height))
+	roundGauge.Set(float64(round))
+
+	if !rs.IsCurrentValidator() {
+		return
+	}
+	if rs.GetCurrentProposal() != nil && rt.once(stepProposal) {
+		proposalLatency.Observe(time.Since(rt.roundStarted).Seconds())
+	}
+	ownRS := rs.OwnRoundState()
+	if (ownRS.PVCurrent(rcert) || ownRS.PVNCurrent(rcert)) && rt.once(stepPreVote) {
+		voteLatency.WithLabelValues(stepPreVote).Observe(time.Since(rt.roundStarted).Seconds())
+	}
+	if (ownRS.PCCurrent(rcert) || ownRS.PCNCurrent(rcert)) && rt.once(stepPreCommit) {
+		voteLatency.WithLabelValues(stepPreCommit).Observe(time.Since(rt.roundStarted).Seconds())
+	}
+}
+
+func (rt *roundTracker) newRound(height, round uint32) {
+	rt.height = height
+	rt.round = round
+	rt.roundStarted = time.Now()
+	rt.seen = make(map[string]bool)
+}
+
+// once returns true the first time it is called for event in a round
+func (rt *roundTracker) once(event string) bool {
+	if rt.seen == nil {
+		rt.seen = make(map[string]bool)
+	}
+	if rt.seen[event] {
+		return false
+	}
+	rt.seen[event] = true
+	return true
+}
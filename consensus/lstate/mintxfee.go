@@ -0,0 +1,83 @@
+package lstate
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/dgraph-io/badger/v2"
+)
+
+// epochUsage is the EpochUsage of a complete epoch together with the hash
+// of its last block header
+type epochUsage struct {
+	usage *dynamics.EpochUsage
+	hash  []byte
+}
+
+// minTxFeeCache caches the usage of the epochs which determine the dynamic
+// minimum tx fee so that their headers are read once
+type minTxFeeCache struct {
+	sync.Mutex
+	epochs map[uint32]*epochUsage
+}
+
+// loadMinTxFee sets the minimum tx fee of epoch in storage from the
+// committed headers of the epochs before it. LoadStorage must have been
+// called for epoch. An error wrapping badger.ErrKeyNotFound is returned if
+// the headers are not all present, as while fast sync downloads them; the
+// fee is then unknown and txs must not be validated.
+func (ce *Engine) loadMinTxFee(txn *badger.Txn, epoch uint32) error {
+	ce.feeCache.Lock()
+	defer ce.feeCache.Unlock()
+	if ce.feeCache.epochs == nil {
+		ce.feeCache.epochs = make(map[uint32]*epochUsage)
+	}
+	err := ce.storage.LoadMinTxFee(epoch, func(e uint32) (*dynamics.EpochUsage, error) {
+		return ce.getEpochUsage(txn, e)
+	})
+	if err != nil {
+		return err
+	}
+	for e := range ce.feeCache.epochs {
+		if e+dynamics.MinTxFeeWindow < epoch {
+			delete(ce.feeCache.epochs, e)
+		}
+	}
+	return nil
+}
+
+// getEpochUsage returns the usage of the blocks of epoch. Each block counts
+// HashLen bytes per tx against MaxBytes, as proposals do when they fill a
+// block, rather than the size of its txs: MaxBytes bounds the number of txs
+// in a block, and the headers, which are all that fast sync keeps, hold the
+// tx count. A cached usage is dropped, along with every other, if the last
+// header of epoch has changed since it was cached.
+func (ce *Engine) getEpochUsage(txn *badger.Txn, epoch uint32) (*dynamics.EpochUsage, error) {
+	last, err := ce.database.GetCommittedBlockHeader(txn, epoch*constants.EpochLength)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := last.BlockHash()
+	if err != nil {
+		return nil, err
+	}
+	if cached, ok := ce.feeCache.epochs[epoch]; ok {
+		if bytes.Equal(cached.hash, hash) {
+			return cached.usage, nil
+		}
+		ce.feeCache.epochs = make(map[uint32]*epochUsage)
+	}
+	usage := &dynamics.EpochUsage{}
+	for h := (epoch-1)*constants.EpochLength + 1; h <= epoch*constants.EpochLength; h++ {
+		bh, err := ce.database.GetCommittedBlockHeader(txn, h)
+		if err != nil {
+			return nil, err
+		}
+		usage.Bytes += uint64(bh.BClaims.TxCount) * constants.HashLen
+		usage.Blocks++
+	}
+	ce.feeCache.epochs[epoch] = &epochUsage{usage: usage, hash: hash}
+	return usage, nil
+}
@@ -0,0 +1,97 @@
+package lstate
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/dgraph-io/badger/v2"
+)
+
+func TestLoadMinTxFeeMissingHeaders(t *testing.T) {
+	rawDB, err := badger.Open(badger.DefaultOptions("").WithInMemory(true).WithLogger(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rawDB.Close()
+	database := &db.Database{}
+	database.Init(rawDB)
+	storage := &dynamics.Storage{}
+	if err := storage.Init(database, logging.GetLogger(constants.LoggerDB)); err != nil {
+		t.Fatal(err)
+	}
+	storage.Start()
+	ce := &Engine{
+		database: database,
+		storage:  storage,
+		logger:   logging.GetLogger(constants.LoggerConsensus),
+	}
+
+	epoch := uint32(2)
+	err = database.Update(func(txn *badger.Txn) error {
+		for _, u := range [][2]string{{"maxBytes", "1024"}, {"minTxFee", "1000"}, {"minTxFeeMax", "4000"}, {dynamics.FeatureUpdatePrefix + string(dynamics.FeatureDynamicMinTxFee), "true"}} {
+			update, err := dynamics.NewUpdate(u[0], u[1], 1)
+			if err != nil {
+				return err
+			}
+			if err := storage.UpdateStorage(txn, update); err != nil {
+				return err
+			}
+		}
+		return storage.LoadStorage(txn, epoch)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the headers of the first epoch are missing
+	err = database.View(func(txn *badger.Txn) error {
+		return ce.loadMinTxFee(txn, epoch)
+	})
+	if !errors.Is(err, badger.ErrKeyNotFound) {
+		t.Fatalf("Should have raised ErrKeyNotFound: %v", err)
+	}
+
+	// full blocks raise the fee once the headers are present
+	txHashes := [][]byte{}
+	for i := uint32(0); i < storage.GetMaxBytes()/constants.HashLen; i++ {
+		txHashes = append(txHashes, crypto.Hasher([]byte{byte(i)}))
+	}
+	err = database.Update(func(txn *badger.Txn) error {
+		for h := uint32(1); h <= constants.EpochLength; h++ {
+			bh := &objs.BlockHeader{
+				BClaims: &objs.BClaims{
+					ChainID:    1,
+					Height:     h,
+					TxCount:    uint32(len(txHashes)),
+					PrevBlock:  crypto.Hasher([]byte("prev")),
+					TxRoot:     crypto.Hasher([]byte("txs")),
+					StateRoot:  crypto.Hasher([]byte("state")),
+					HeaderRoot: crypto.Hasher([]byte("header")),
+				},
+				SigGroup: make([]byte, constants.CurveBN256EthSigLen),
+				TxHshLst: txHashes,
+			}
+			if err := database.SetCommittedBlockHeaderFastSync(txn, bh); err != nil {
+				return err
+			}
+		}
+		return ce.loadMinTxFee(txn, epoch)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee, err := storage.GetMinTxFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Cmp(big.NewInt(1000)) <= 0 {
+		t.Fatalf("fee should have risen: %v", fee)
+	}
+}
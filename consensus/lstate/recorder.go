@@ -0,0 +1,669 @@
+package lstate
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+// RecordKind identifies the content of a Record
+type RecordKind uint8
+
+// The kinds of records written by the consensus flight recorder
+const (
+	// RecordState holds a snapshot of the local round states from which a
+	// replay can start. Its parts are the OwnState, the OwnValidatingState,
+	// the ValidatorSet, the header trie root at the height of the OwnState
+	// and the RoundState of every validator.
+	RecordState RecordKind = iota + 1
+	// RecordStep holds the state of the local node after an update of the
+	// local state that changed it. Its parts are the OwnState, the
+	// OwnValidatingState, the RoundState of the local node and the header
+	// trie root at the height of the OwnState.
+	RecordStep
+	// RecordValidatorSet holds a validator set which became active
+	RecordValidatorSet
+	RecordProposal
+	RecordPreVote
+	RecordPreVoteNil
+	RecordPreCommit
+	RecordPreCommitNil
+	RecordNextRound
+	RecordNextHeight
+	RecordBlockHeader
+	RecordRCert
+)
+
+var recordKindNames = map[RecordKind]string{
+	RecordState:        "State",
+	RecordStep:         "Step",
+	RecordValidatorSet: "ValidatorSet",
+	RecordProposal:     "Proposal",
+	RecordPreVote:      "PreVote",
+	RecordPreVoteNil:   "PreVoteNil",
+	RecordPreCommit:    "PreCommit",
+	RecordPreCommitNil: "PreCommitNil",
+	RecordNextRound:    "NextRound",
+	RecordNextHeight:   "NextHeight",
+	RecordBlockHeader:  "BlockHeader",
+	RecordRCert:        "RCert",
+}
+
+func (k RecordKind) String() string {
+	if name, ok := recordKindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("RecordKind(%d)", uint8(k))
+}
+
+// Record is a single entry of a consensus recording. Every part holds a
+// capnp encoded consensus object.
+type Record struct {
+	Kind RecordKind
+	// Cast is set if the object was cast by the local node
+	Cast bool
+	// Time is the time at which the object reached the local state
+	Time time.Time
+	// Peer is the address of the peer from which the object was received
+	Peer  string
+	Parts [][]byte
+}
+
+// Object decodes the consensus object held by a record of one of the
+// object kinds
+func (r *Record) Object() (interface{}, error) {
+	if len(r.Parts) != 1 {
+		return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("record of kind %v has %d parts", r.Kind, len(r.Parts)))
+	}
+	var obj interface {
+		UnmarshalBinary([]byte) error
+	}
+	switch r.Kind {
+	case RecordValidatorSet:
+		obj = &objs.ValidatorSet{}
+	case RecordProposal:
+		obj = &objs.Proposal{}
+	case RecordPreVote:
+		obj = &objs.PreVote{}
+	case RecordPreVoteNil:
+		obj = &objs.PreVoteNil{}
+	case RecordPreCommit:
+		obj = &objs.PreCommit{}
+	case RecordPreCommitNil:
+		obj = &objs.PreCommitNil{}
+	case RecordNextRound:
+		obj = &objs.NextRound{}
+	case RecordNextHeight:
+		obj = &objs.NextHeight{}
+	case RecordBlockHeader:
+		obj = &objs.BlockHeader{}
+	case RecordRCert:
+		obj = &objs.RCert{}
+	default:
+		return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("record of kind %v does not hold an object", r.Kind))
+	}
+	if err := obj.UnmarshalBinary(r.Parts[0]); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// recordKindOf returns the record kind of a consensus object
+func recordKindOf(v interface{}) (RecordKind, bool) {
+	switch v.(type) {
+	case *objs.ValidatorSet:
+		return RecordValidatorSet, true
+	case *objs.Proposal:
+		return RecordProposal, true
+	case *objs.PreVote:
+		return RecordPreVote, true
+	case *objs.PreVoteNil:
+		return RecordPreVoteNil, true
+	case *objs.PreCommit:
+		return RecordPreCommit, true
+	case *objs.PreCommitNil:
+		return RecordPreCommitNil, true
+	case *objs.NextRound:
+		return RecordNextRound, true
+	case *objs.NextHeight:
+		return RecordNextHeight, true
+	case *objs.BlockHeader:
+		return RecordBlockHeader, true
+	case *objs.RCert:
+		return RecordRCert, true
+	default:
+		return 0, false
+	}
+}
+
+type binaryMarshaler interface {
+	MarshalBinary() ([]byte, error)
+}
+
+func newObjectRecord(cast bool, peer string, v interface{}) (*Record, error) {
+	kind, ok := recordKindOf(v)
+	if !ok {
+		return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("can not record object of type %T", v))
+	}
+	m, ok := v.(binaryMarshaler)
+	if !ok {
+		return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("can not marshal object of type %T", v))
+	}
+	b, err := m.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &Record{Kind: kind, Cast: cast, Time: time.Now(), Peer: peer, Parts: [][]byte{b}}, nil
+}
+
+func marshalParts(vs ...binaryMarshaler) ([][]byte, error) {
+	parts := make([][]byte, len(vs))
+	for i, v := range vs {
+		b, err := v.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		parts[i] = b
+	}
+	return parts, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// A recording is a sequence of files. Each file starts with recordMagic and
+// holds records framed as
+//
+//	length   uint32 of the body
+//	checksum uint32 crc32 of the body
+//	body     kind uint8 | flags uint8 | unix nanos int64 |
+//	         peer length uint16 | peer | part count uint8 |
+//	         (part length uint32 | part)*
+var recordMagic = []byte("MNCR\x01")
+
+const (
+	recordFlagCast   = 1
+	recordFilePrefix = "consensus-"
+	recordFileSuffix = ".rec"
+	maxRecordSize    = 64 * 1024 * 1024
+)
+
+func (r *Record) marshal() ([]byte, error) {
+	if len(r.Peer) > 0xffff || len(r.Parts) > 0xff {
+		return nil, errorz.ErrInvalid{}.New("record too large")
+	}
+	body := &bytes.Buffer{}
+	var flags uint8
+	if r.Cast {
+		flags |= recordFlagCast
+	}
+	body.WriteByte(uint8(r.Kind))
+	body.WriteByte(flags)
+	_ = binary.Write(body, binary.BigEndian, r.Time.UnixNano())
+	_ = binary.Write(body, binary.BigEndian, uint16(len(r.Peer)))
+	body.WriteString(r.Peer)
+	body.WriteByte(uint8(len(r.Parts)))
+	for _, p := range r.Parts {
+		_ = binary.Write(body, binary.BigEndian, uint32(len(p)))
+		body.Write(p)
+	}
+	out := make([]byte, 8, 8+body.Len())
+	binary.BigEndian.PutUint32(out[0:4], uint32(body.Len()))
+	binary.BigEndian.PutUint32(out[4:8], crc32.ChecksumIEEE(body.Bytes()))
+	return append(out, body.Bytes()...), nil
+}
+
+func unmarshalRecord(body []byte) (*Record, error) {
+	buf := bytes.NewReader(body)
+	r := &Record{}
+	var kind, flags, count uint8
+	var nanos int64
+	var peerLen uint16
+	if err := binary.Read(buf, binary.BigEndian, &kind); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buf, binary.BigEndian, &flags); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buf, binary.BigEndian, &nanos); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buf, binary.BigEndian, &peerLen); err != nil {
+		return nil, err
+	}
+	peer := make([]byte, peerLen)
+	if _, err := io.ReadFull(buf, peer); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buf, binary.BigEndian, &count); err != nil {
+		return nil, err
+	}
+	r.Kind = RecordKind(kind)
+	r.Cast = flags&recordFlagCast != 0
+	r.Time = time.Unix(0, nanos)
+	r.Peer = string(peer)
+	for i := 0; i < int(count); i++ {
+		var n uint32
+		if err := binary.Read(buf, binary.BigEndian, &n); err != nil {
+			return nil, err
+		}
+		if int(n) > buf.Len() {
+			return nil, io.ErrUnexpectedEOF
+		}
+		p := make([]byte, n)
+		if _, err := io.ReadFull(buf, p); err != nil {
+			return nil, err
+		}
+		r.Parts = append(r.Parts, p)
+	}
+	if buf.Len() != 0 {
+		return nil, errorz.ErrInvalid{}.New("trailing bytes in record")
+	}
+	return r, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Recorder appends consensus records to a rotating set of files in a
+// directory. A new file is started once the current one grows beyond the
+// maximum file size and the oldest files are removed so that at most the
+// configured number of files is kept. Every file begins with a RecordState
+// once the engine has written one, so that a replay can start from the oldest
+// file which is kept.
+type Recorder struct {
+	sync.Mutex
+	dir      string
+	maxSize  int64
+	maxFiles int
+	seq      uint64
+	file     *os.File
+	w        *bufio.Writer
+	size     int64
+
+	needState     bool
+	lastStep      []byte
+	lastValidator []byte
+}
+
+// NewRecorder opens a recorder writing to dir. Files are rotated once they
+// exceed maxSize bytes and at most maxFiles files are kept.
+func NewRecorder(dir string, maxSize int64, maxFiles int) (*Recorder, error) {
+	if maxSize <= 0 || maxFiles <= 0 {
+		return nil, errorz.ErrInvalid{}.New("recorder file size and file count must be positive")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	files, err := recordingFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	r := &Recorder{
+		dir:      dir,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+	}
+	if len(files) > 0 {
+		last := filepath.Base(files[len(files)-1])
+		if _, err := fmt.Sscanf(last, recordFilePrefix+"%d"+recordFileSuffix, &r.seq); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.rotate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Close flushes and closes the current file of the recorder
+func (r *Recorder) Close() error {
+	r.Lock()
+	defer r.Unlock()
+	return r.closeFile()
+}
+
+func (r *Recorder) closeFile() error {
+	if r.file == nil {
+		return nil
+	}
+	err := r.w.Flush()
+	if cerr := r.file.Close(); err == nil {
+		err = cerr
+	}
+	r.file = nil
+	r.w = nil
+	return err
+}
+
+func (r *Recorder) rotate() error {
+	if err := r.closeFile(); err != nil {
+		return err
+	}
+	r.seq++
+	name := filepath.Join(r.dir, fmt.Sprintf("%s%08d%s", recordFilePrefix, r.seq, recordFileSuffix))
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	r.file = f
+	r.w = bufio.NewWriter(f)
+	if _, err := r.w.Write(recordMagic); err != nil {
+		return err
+	}
+	r.size = int64(len(recordMagic))
+	r.needState = true
+	r.lastStep = nil
+	r.lastValidator = nil
+	files, err := recordingFiles(r.dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > r.maxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// Write appends records to the recording and flushes them to the file
+func (r *Recorder) Write(recs ...*Record) error {
+	r.Lock()
+	defer r.Unlock()
+	if r.file == nil {
+		return errorz.ErrClosing
+	}
+	for _, rec := range recs {
+		if rec.Kind == RecordState {
+			r.needState = false
+		}
+		b, err := rec.marshal()
+		if err != nil {
+			return err
+		}
+		if _, err := r.w.Write(b); err != nil {
+			return err
+		}
+		r.size += int64(len(b))
+	}
+	if err := r.w.Flush(); err != nil {
+		return err
+	}
+	if r.size >= r.maxSize {
+		return r.rotate()
+	}
+	return nil
+}
+
+// requestState makes the recorder ask for a new RecordState before the next
+// step is recorded. It is called when the recording can no longer be
+// followed from the last state, e.g. after the node fell out of sync.
+func (r *Recorder) requestState() {
+	r.Lock()
+	defer r.Unlock()
+	r.needState = true
+}
+
+// wantsState reports whether the next update should be recorded as a
+// RecordState
+func (r *Recorder) wantsState() bool {
+	r.Lock()
+	defer r.Unlock()
+	return r.needState
+}
+
+// changed reports whether b differs from the value last seen for the step
+// or the validator set and remembers b
+func (r *Recorder) changed(kind RecordKind, b []byte) bool {
+	r.Lock()
+	defer r.Unlock()
+	last := &r.lastStep
+	if kind == RecordValidatorSet {
+		last = &r.lastValidator
+	}
+	if bytes.Equal(*last, b) {
+		return false
+	}
+	*last = utils.CopySlice(b)
+	return true
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func recordingFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, recordFilePrefix) || !strings.HasSuffix(name, recordFileSuffix) {
+			continue
+		}
+		files = append(files, filepath.Join(dir, name))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// RecordReader reads the records of a recording in the order in which they
+// were written
+type RecordReader struct {
+	files []string
+	file  *os.File
+	r     *bufio.Reader
+	// Truncated counts the files which ended in a partially written record,
+	// as happens when the node stops while writing
+	Truncated int
+}
+
+// OpenRecording returns a reader for the recording in dir
+func OpenRecording(dir string) (*RecordReader, error) {
+	files, err := recordingFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("no recording in %s", dir))
+	}
+	return &RecordReader{files: files}, nil
+}
+
+// Close closes the file being read
+func (rr *RecordReader) Close() error {
+	if rr.file == nil {
+		return nil
+	}
+	err := rr.file.Close()
+	rr.file = nil
+	return err
+}
+
+func (rr *RecordReader) nextFile() error {
+	if err := rr.Close(); err != nil {
+		return err
+	}
+	if len(rr.files) == 0 {
+		return io.EOF
+	}
+	f, err := os.Open(rr.files[0])
+	if err != nil {
+		return err
+	}
+	rr.files = rr.files[1:]
+	rr.file = f
+	rr.r = bufio.NewReader(f)
+	magic := make([]byte, len(recordMagic))
+	if _, err := io.ReadFull(rr.r, magic); err != nil || !bytes.Equal(magic, recordMagic) {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("%s is not a consensus recording", f.Name()))
+	}
+	return nil
+}
+
+// Next returns the next record of the recording or io.EOF once every record
+// has been read
+func (rr *RecordReader) Next() (*Record, error) {
+	for {
+		if rr.file == nil {
+			if err := rr.nextFile(); err != nil {
+				return nil, err
+			}
+		}
+		header := make([]byte, 8)
+		n, err := io.ReadFull(rr.r, header)
+		if err == io.EOF {
+			rr.file.Close()
+			rr.file = nil
+			continue
+		}
+		if err != nil {
+			if n > 0 {
+				rr.Truncated++
+			}
+			rr.file.Close()
+			rr.file = nil
+			continue
+		}
+		size := binary.BigEndian.Uint32(header[0:4])
+		if size > maxRecordSize {
+			return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("record of %d bytes in %s", size, rr.file.Name()))
+		}
+		body := make([]byte, size)
+		if _, err := io.ReadFull(rr.r, body); err != nil {
+			rr.Truncated++
+			rr.file.Close()
+			rr.file = nil
+			continue
+		}
+		if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(header[4:8]) {
+			return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("bad record checksum in %s", rr.file.Name()))
+		}
+		return unmarshalRecord(body)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// SetRecorder makes the engine record the objects it casts and every change
+// of its round state to r
+func (ce *Engine) SetRecorder(r *Recorder) {
+	ce.recorder = r
+}
+
+// cast returns the object signed by sign and records it. During a replay the
+// object cast by the recorded node is returned instead once it is checked to
+// be what the engine decided to cast.
+func (ce *Engine) cast(rs *RoundStates, kind RecordKind, sign func() (interface{}, error)) (interface{}, error) {
+	if ce.replay != nil {
+		return ce.replay.take(rs, kind)
+	}
+	obj, err := sign()
+	if err != nil {
+		return nil, err
+	}
+	if ce.recorder != nil {
+		rec, err := newObjectRecord(true, "", obj)
+		if err != nil {
+			utils.DebugTrace(ce.logger, err)
+			return obj, nil
+		}
+		ce.casts = append(ce.casts, rec)
+	}
+	return obj, nil
+}
+
+// recordUpdate returns the records describing an update of the local state.
+// A RecordState is returned in place of the update when the recorder asks for
+// one; the objects cast during the update are then part of the state.
+func (ce *Engine) recordUpdate(txn *badger.Txn, rs *RoundStates, updated bool) []*Record {
+	if !updated {
+		// the local state is moved forward by Sync while the node catches
+		// up, which is not recorded, so the recording has to start over
+		ce.recorder.requestState()
+		return nil
+	}
+	now := time.Now()
+	headerRoot, err := ce.database.GetHeaderTrieRoot(txn, rs.OwnState.SyncToBH.BClaims.Height)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			utils.DebugTrace(ce.logger, err)
+			return nil
+		}
+		headerRoot = make([]byte, constants.HashLen)
+	}
+	vs, err := rs.ValidatorSet.MarshalBinary()
+	if err != nil {
+		utils.DebugTrace(ce.logger, err)
+		return nil
+	}
+	step, err := marshalParts(rs.OwnState, rs.OwnValidatingState, rs.OwnRoundState())
+	if err != nil {
+		utils.DebugTrace(ce.logger, err)
+		return nil
+	}
+	step = append(step, headerRoot)
+	vsChanged := ce.recorder.changed(RecordValidatorSet, vs)
+	stepChanged := ce.recorder.changed(RecordStep, bytes.Join(step, nil))
+
+	if ce.recorder.wantsState() {
+		parts := [][]byte{step[0], step[1], vs, headerRoot, step[2]}
+		for _, v := range rs.ValidatorSet.Validators {
+			b, err := rs.PeerStateMap[string(v.VAddr)].MarshalBinary()
+			if err != nil {
+				utils.DebugTrace(ce.logger, err)
+				return nil
+			}
+			parts = append(parts, b)
+		}
+		return []*Record{{Kind: RecordState, Time: now, Parts: parts}}
+	}
+	records := []*Record{}
+	if vsChanged {
+		records = append(records, &Record{Kind: RecordValidatorSet, Time: now, Parts: [][]byte{vs}})
+	}
+	records = append(records, ce.casts...)
+	if stepChanged || len(ce.casts) > 0 {
+		records = append(records, &Record{Kind: RecordStep, Time: now, Parts: step})
+	}
+	return records
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// SetRecorder makes the handlers record the objects received from peers to r
+func (mb *Handlers) SetRecorder(r *Recorder) {
+	mb.recorder = r
+}
+
+// Received records an object received from peer. It is called while the
+// consensus lock is held just before the object is added, so that the
+// recording keeps the order in which objects reached the local state.
+func (mb *Handlers) Received(peer string, v interface{}) {
+	if mb.recorder == nil {
+		return
+	}
+	rec, err := newObjectRecord(false, peer, v)
+	if err != nil {
+		utils.DebugTrace(mb.logger, err)
+		return
+	}
+	if err := mb.recorder.Write(rec); err != nil {
+		utils.DebugTrace(mb.logger, err)
+	}
+}
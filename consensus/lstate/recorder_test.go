@@ -0,0 +1,132 @@
+package lstate
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func readRecording(t *testing.T, dir string) ([]*Record, *RecordReader) {
+	rr, err := OpenRecording(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rr.Close()
+	recs := []*Record{}
+	for {
+		rec, err := rr.Next()
+		if err == io.EOF {
+			return recs, rr
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		recs = append(recs, rec)
+	}
+}
+
+func TestRecorderRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	r, err := NewRecorder(dir, 1<<20, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(0, time.Now().UnixNano())
+	in := []*Record{
+		{Kind: RecordState, Time: now, Parts: [][]byte{{1, 2}, {}, {3}}},
+		{Kind: RecordPreVote, Time: now, Peer: "127.0.0.1:4242", Parts: [][]byte{{4, 5, 6}}},
+		{Kind: RecordPreCommit, Cast: true, Time: now, Parts: [][]byte{{7}}},
+	}
+	if err := r.Write(in...); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	out, _ := readRecording(t, dir)
+	if len(out) != len(in) {
+		t.Fatalf("read %d records, wrote %d", len(out), len(in))
+	}
+	for i := range in {
+		if out[i].Kind != in[i].Kind || out[i].Cast != in[i].Cast || out[i].Peer != in[i].Peer || !out[i].Time.Equal(in[i].Time) {
+			t.Fatalf("record %d: got %+v, want %+v", i, out[i], in[i])
+		}
+		if len(out[i].Parts) != len(in[i].Parts) {
+			t.Fatalf("record %d: got %d parts, want %d", i, len(out[i].Parts), len(in[i].Parts))
+		}
+		for j := range in[i].Parts {
+			if !bytes.Equal(out[i].Parts[j], in[i].Parts[j]) {
+				t.Fatalf("record %d part %d differs", i, j)
+			}
+		}
+	}
+}
+
+func TestRecorderRotationAndTruncation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	r, err := NewRecorder(dir, 64, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		rec := &Record{Kind: RecordState, Time: time.Now(), Parts: [][]byte{bytes.Repeat([]byte{byte(i)}, 64)}}
+		if err := r.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+		if !r.wantsState() {
+			t.Fatal("a rotated file must ask for a new state")
+		}
+	}
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	files, err := recordingFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("kept %d files, want 2", len(files))
+	}
+
+	// cut the last record of the older file in half
+	fi, err := os.Stat(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(files[0], fi.Size()-10); err != nil {
+		t.Fatal(err)
+	}
+	out, rr := readRecording(t, dir)
+	if len(out) != 0 || rr.Truncated != 1 {
+		t.Fatalf("read %d records with %d truncated files, want 0 and 1", len(out), rr.Truncated)
+	}
+
+	// a recorder reopened in the same directory continues the numbering
+	r, err = NewRecorder(dir, 64, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	files2, err := recordingFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Base(files2[len(files2)-1]) <= filepath.Base(files[len(files)-1]) {
+		t.Fatal("reopened recorder did not start a new file")
+	}
+}
@@ -0,0 +1,405 @@
+package lstate
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/MadBase/MadNet/consensus/appmock"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/dman"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// errReplayDiverged aborts an update of the local state during a replay once
+// the engine decided differently from the recorded node. It is an ErrInvalid
+// so that the engine drops the update.
+var errReplayDiverged = errorz.ErrInvalid{}.New("replay diverged from the recording")
+
+// Divergence is a point of a replay at which the replayed engine did not do
+// what the recorded node did
+type Divergence struct {
+	Time   time.Time
+	Height uint32
+	Round  uint32
+	Reason string
+}
+
+func (d *Divergence) String() string {
+	return fmt.Sprintf("%s h=%d r=%d: %s", d.Time.Format(time.RFC3339Nano), d.Height, d.Round, d.Reason)
+}
+
+// ReplayReport summarizes a replay
+type ReplayReport struct {
+	// Records is the number of records read
+	Records int
+	// Skipped is the number of records read before the first RecordState
+	Skipped int
+	// States is the number of snapshots the replay started from
+	States int
+	// Steps is the number of updates of the local state replayed
+	Steps int
+	// Received is the number of objects received from peers and Rejected
+	// the number of those the replayed state did not accept
+	Received int
+	Rejected int
+	// Casts is the number of objects cast by the recorded node
+	Casts int
+	// Truncated is the number of recording files which ended in a partial
+	// record
+	Truncated   int
+	Divergences []*Divergence
+}
+
+// replayCasts hands the objects cast by the recorded node to the replayed
+// engine in place of signing new ones
+type replayCasts struct {
+	queue       []*Record
+	stepTime    time.Time
+	divergences []*Divergence
+}
+
+func (rc *replayCasts) diverge(height, round uint32, format string, args ...interface{}) {
+	rc.divergences = append(rc.divergences, &Divergence{
+		Time:   rc.stepTime,
+		Height: height,
+		Round:  round,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+func (rc *replayCasts) take(rs *RoundStates, kind RecordKind) (interface{}, error) {
+	rcert := rs.OwnRoundState().RCert
+	height, round := rcert.RClaims.Height, rcert.RClaims.Round
+	if len(rc.queue) == 0 {
+		rc.diverge(height, round, "engine cast %v, node cast nothing", kind)
+		return nil, errReplayDiverged
+	}
+	rec := rc.queue[0]
+	if rec.Kind != kind {
+		rc.diverge(height, round, "engine cast %v, node cast %v", kind, rec.Kind)
+		return nil, errReplayDiverged
+	}
+	obj, err := rec.Object()
+	if err != nil {
+		return nil, err
+	}
+	if kind != RecordRCert && kind != RecordBlockHeader {
+		h, r := objs.ExtractHR(obj)
+		if kind == RecordNextHeight {
+			// a next height may be followed from any round of the height
+			r = round
+		}
+		if h != height || r != round {
+			rc.diverge(height, round, "engine cast %v, node cast %v for h=%d r=%d", kind, rec.Kind, h, r)
+			return nil, errReplayDiverged
+		}
+	}
+	rc.queue = rc.queue[1:]
+	return obj, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Replayer feeds a consensus recording into a fresh engine. The engine runs
+// on appmock, so every proposal is considered valid, and on a database which
+// holds nothing but the recorded state. At every recorded update of the local
+// state the engine is run with the clock shifted to the time of the update;
+// the objects it decides to cast are checked against the objects cast by the
+// recorded node and its resulting round state against the recorded one.
+// After each update the local state is reset to the recorded one so that a
+// single divergence does not hide later ones.
+type Replayer struct {
+	logger   *logrus.Logger
+	database *db.Database
+	app      *appmock.MockApplication
+	engine   *Engine
+	handlers *Handlers
+	casts    *replayCasts
+	ovs      []byte
+	report   *ReplayReport
+}
+
+// NewReplayer returns a Replayer using rawDB, which must be empty
+func NewReplayer(rawDB *badger.DB) (*Replayer, error) {
+	logger := logging.GetLogger(constants.LoggerConsensus)
+	database := &db.Database{}
+	database.Init(rawDB)
+	storage := &dynamics.Storage{}
+	if err := storage.Init(database, logger); err != nil {
+		return nil, err
+	}
+	storage.Start()
+	app := appmock.New()
+	dm := &dman.DMan{}
+	dm.Init(database, app, nil)
+	r := &Replayer{
+		logger:   logger,
+		database: database,
+		app:      app,
+		engine:   &Engine{},
+		handlers: &Handlers{},
+		casts:    &replayCasts{},
+		report:   &ReplayReport{},
+	}
+	r.engine.Init(database, dm, app, nil, nil, nil, nil, storage)
+	r.engine.replay = r.casts
+	r.handlers.Init(database, dm)
+	return r, nil
+}
+
+// Replay replays every record of rr
+func (r *Replayer) Replay(rr *RecordReader) (*ReplayReport, error) {
+	started := false
+	for {
+		rec, err := rr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		r.report.Records++
+		if rec.Kind == RecordState {
+			if err := r.loadState(rec); err != nil {
+				return nil, err
+			}
+			started = true
+			continue
+		}
+		if !started {
+			r.report.Skipped++
+			continue
+		}
+		switch {
+		case rec.Kind == RecordStep:
+			if err := r.step(rec); err != nil {
+				return nil, err
+			}
+		case rec.Kind == RecordValidatorSet:
+			if err := r.setValidatorSet(rec); err != nil {
+				return nil, err
+			}
+		case rec.Cast:
+			r.report.Casts++
+			r.casts.queue = append(r.casts.queue, rec)
+		default:
+			r.receive(rec)
+		}
+	}
+	if !started {
+		return nil, errorz.ErrInvalid{}.New("the recording does not hold a state to start from")
+	}
+	r.report.Truncated = rr.Truncated
+	r.report.Divergences = r.casts.divergences
+	return r.report, nil
+}
+
+func (r *Replayer) loadState(rec *Record) error {
+	if len(rec.Parts) < 5 {
+		return errorz.ErrInvalid{}.New("malformed state record")
+	}
+	r.report.States++
+	// objects cast before a snapshot are part of it
+	r.casts.queue = nil
+	os := &objs.OwnState{}
+	if err := os.UnmarshalBinary(rec.Parts[0]); err != nil {
+		return err
+	}
+	vs := &objs.ValidatorSet{}
+	if err := vs.UnmarshalBinary(rec.Parts[2]); err != nil {
+		return err
+	}
+	rss := []*objs.RoundState{}
+	for _, b := range rec.Parts[4:] {
+		rs := &objs.RoundState{}
+		if err := rs.UnmarshalBinary(b); err != nil {
+			return err
+		}
+		rss = append(rss, rs)
+	}
+	return r.database.Update(func(txn *badger.Txn) error {
+		if err := r.database.SetValidatorSet(txn, vs); err != nil {
+			return err
+		}
+		for _, rs := range rss {
+			if err := r.database.SetCurrentRoundState(txn, rs); err != nil {
+				return err
+			}
+		}
+		return r.setOwn(txn, os, rec.Parts[1], rec.Parts[3])
+	})
+}
+
+// setOwn resets the state of the local node to the recorded one
+func (r *Replayer) setOwn(txn *badger.Txn, os *objs.OwnState, ovsBytes []byte, headerRoot []byte) error {
+	ovs := &objs.OwnValidatingState{}
+	if err := ovs.UnmarshalBinary(ovsBytes); err != nil {
+		return err
+	}
+	if err := r.database.SetOwnState(txn, os); err != nil {
+		return err
+	}
+	if err := r.database.SetOwnValidatingState(txn, ovs); err != nil {
+		return err
+	}
+	if err := r.database.SetHeaderTrieRoot(txn, os.SyncToBH.BClaims.Height, headerRoot); err != nil {
+		return err
+	}
+	r.ovs = ovsBytes
+	return nil
+}
+
+func (r *Replayer) setValidatorSet(rec *Record) error {
+	obj, err := rec.Object()
+	if err != nil {
+		return err
+	}
+	return r.database.Update(func(txn *badger.Txn) error {
+		return r.database.SetValidatorSet(txn, obj.(*objs.ValidatorSet))
+	})
+}
+
+func (r *Replayer) receive(rec *Record) {
+	r.report.Received++
+	obj, err := rec.Object()
+	if err != nil {
+		utils.DebugTrace(r.logger, err)
+		r.report.Rejected++
+		return
+	}
+	if err := r.handlers.Store(obj); err != nil {
+		r.report.Rejected++
+	}
+}
+
+func (r *Replayer) step(rec *Record) error {
+	if len(rec.Parts) != 4 {
+		return errorz.ErrInvalid{}.New("malformed step record")
+	}
+	r.report.Steps++
+	os := &objs.OwnState{}
+	if err := os.UnmarshalBinary(rec.Parts[0]); err != nil {
+		return err
+	}
+	recorded := &objs.RoundState{}
+	if err := recorded.UnmarshalBinary(rec.Parts[2]); err != nil {
+		return err
+	}
+	r.casts.stepTime = rec.Time
+	if err := r.prepareStep(rec); err != nil {
+		return err
+	}
+
+	before := len(r.casts.divergences)
+	if _, err := r.engine.UpdateLocalState(); err != nil {
+		r.casts.diverge(recorded.RCert.RClaims.Height, recorded.RCert.RClaims.Round, "update of the local state failed: %v", err)
+	}
+	for _, left := range r.casts.queue {
+		r.casts.diverge(recorded.RCert.RClaims.Height, recorded.RCert.RClaims.Round, "node cast %v, engine did not", left.Kind)
+	}
+	r.casts.queue = nil
+
+	var replayed string
+	err := r.database.View(func(txn *badger.Txn) error {
+		ros, err := r.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		rs, err := r.database.GetCurrentRoundState(txn, ros.VAddr)
+		if err != nil {
+			return err
+		}
+		replayed = describeStep(ros, rs)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if want := describeStep(os, recorded); replayed != want && len(r.casts.divergences) == before {
+		r.casts.diverge(recorded.RCert.RClaims.Height, recorded.RCert.RClaims.Round, "engine moved to %s, node moved to %s", replayed, want)
+	}
+
+	return r.database.Update(func(txn *badger.Txn) error {
+		if err := r.database.SetCurrentRoundState(txn, recorded); err != nil {
+			return err
+		}
+		return r.setOwn(txn, os, rec.Parts[1], rec.Parts[3])
+	})
+}
+
+// prepareStep shifts the step timers of the local node by the time passed
+// since the recorded update, so that the engine sees the timeouts expire
+// exactly as the node did, and provides what the engine needs to run on
+// appmock
+func (r *Replayer) prepareStep(rec *Record) error {
+	ovs := &objs.OwnValidatingState{}
+	if err := ovs.UnmarshalBinary(r.ovs); err != nil {
+		return err
+	}
+	shift := int64(time.Since(rec.Time) / time.Second)
+	for _, t := range []*int64{&ovs.RoundStarted, &ovs.PreVoteStepStarted, &ovs.PreCommitStepStarted} {
+		if *t != 0 {
+			*t += shift
+		}
+	}
+	// appmock bases a new proposal on its valid value
+	vv := &objs.Proposal{PClaims: &objs.PClaims{BClaims: &objs.BClaims{}}}
+	for _, c := range r.casts.queue {
+		if c.Kind == RecordProposal {
+			obj, err := c.Object()
+			if err != nil {
+				return err
+			}
+			vv = obj.(*objs.Proposal)
+			break
+		}
+	}
+	r.app.SetNextValidValue(vv)
+	return r.database.Update(func(txn *badger.Txn) error {
+		if err := r.database.SetOwnValidatingState(txn, ovs); err != nil {
+			return err
+		}
+		os, err := r.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		// the admin handlers which mark the epoch boundaries as safe are not
+		// part of a replay
+		height := os.SyncToBH.BClaims.Height
+		if height%constants.EpochLength == 0 {
+			return r.database.SetSafeToProceed(txn, height, true)
+		}
+		return nil
+	})
+}
+
+// describeStep describes the consensus step of a round state
+func describeStep(os *objs.OwnState, rs *objs.RoundState) string {
+	rc := rs.RCert
+	step := "none"
+	switch {
+	case rs.NHCurrent(rc):
+		step = "NextHeight"
+	case rs.NRCurrent(rc):
+		step = "NextRound"
+	case rs.PCCurrent(rc):
+		step = "PreCommit"
+	case rs.PCNCurrent(rc):
+		step = "PreCommitNil"
+	case rs.PVCurrent(rc):
+		step = "PreVote"
+	case rs.PVNCurrent(rc):
+		step = "PreVoteNil"
+	case rs.PCurrent(rc):
+		step = "Proposal"
+	}
+	return fmt.Sprintf("h=%d r=%d step=%s synced to %d", rc.RClaims.Height, rc.RClaims.Round, step, os.SyncToBH.BClaims.Height)
+}
@@ -3,6 +3,7 @@ package lstate
 import (
 	"bytes"
 	"errors"
+	"fmt"
 
 	"github.com/MadBase/MadNet/consensus/objs"
 	"github.com/MadBase/MadNet/crypto"
@@ -301,3 +302,73 @@ func (r *RoundStates) LocalPreVoteCurrent() bool {
 func (r *RoundStates) LocalPreCommitCurrent() bool {
 	return r.OwnRoundState().PCCurrent(r.OwnRoundState().RCert)
 }
+
+// RoundStateSummary summarizes the round state of a validator. The flags
+// tell which objects of the validator are current to the round of the
+// local node.
+type RoundStateSummary struct {
+	VAddr        string
+	Height       uint32
+	Round        uint32
+	Proposal     bool
+	PreVote      bool
+	PreVoteNil   bool
+	ImplicitPVN  bool
+	PreCommit    bool
+	PreCommitNil bool
+	ImplicitPCN  bool
+	NextRound    bool
+	NextHeight   bool
+}
+
+// RoundStatesSummary summarizes the round states of the local node for
+// display to an operator
+type RoundStatesSummary struct {
+	Height       uint32
+	Round        uint32
+	SyncToHeight uint32
+	MaxHeight    uint32
+	IsValidator  bool
+	// Proposer is the validator proposing in the round
+	Proposer   string
+	Validators []*RoundStateSummary
+}
+
+// Summary summarizes the round states, the validators are listed in the
+// order of the validator set
+func (r *RoundStates) Summary() *RoundStatesSummary {
+	rcert := r.RCert()
+	s := &RoundStatesSummary{
+		Height:       r.height,
+		Round:        r.round,
+		SyncToHeight: r.OwnState.SyncToBH.BClaims.Height,
+		MaxHeight:    r.OwnState.MaxBHSeen.BClaims.Height,
+		IsValidator:  r.IsCurrentValidator(),
+		Validators:   []*RoundStateSummary{},
+	}
+	if len(r.ValidatorSet.Validators) > 0 {
+		idx := objs.GetProposerIdx(len(r.ValidatorSet.Validators), r.height, r.round)
+		s.Proposer = fmt.Sprintf("%x", r.ValidatorSet.Validators[idx].VAddr)
+	}
+	for _, v := range r.ValidatorSet.Validators {
+		rs := r.GetRoundState(v.VAddr)
+		if rs == nil {
+			continue
+		}
+		s.Validators = append(s.Validators, &RoundStateSummary{
+			VAddr:        fmt.Sprintf("%x", v.VAddr),
+			Height:       rs.RCert.RClaims.Height,
+			Round:        rs.RCert.RClaims.Round,
+			Proposal:     rs.PCurrent(rcert),
+			PreVote:      rs.PVCurrent(rcert),
+			PreVoteNil:   rs.PVNCurrent(rcert),
+			ImplicitPVN:  rs.ImplicitPVN,
+			PreCommit:    rs.PCCurrent(rcert),
+			PreCommitNil: rs.PCNCurrent(rcert),
+			ImplicitPCN:  rs.ImplicitPCN,
+			NextRound:    rs.NRCurrent(rcert),
+			NextHeight:   rs.NHCurrent(rcert),
+		})
+	}
+	return s
+}
@@ -71,11 +71,14 @@ func (ce *Engine) castNewProposalValue(txn *badger.Txn, rs *RoundStates) error {
       }
       TxHshLst:         %x
     }`, rs.OwnState.SyncToBH.BClaims.ChainID, rs.OwnState.SyncToBH.BClaims.Height+1, rs.PrevBlock(), headerRoot, stateRoot, txRoot, len(txList), rs.OwnRoundState().RCert.SigGroup[0:16], rs.OwnRoundState().RCert.SigGroup[len(rs.OwnRoundState().RCert.SigGroup)-11:], rs.OwnState.SyncToBH.BClaims.ChainID, rs.OwnState.SyncToBH.BClaims.Height+1, rs.PrevBlock(), rs.Round(), txList)
-	err = p.Sign(ce.secpSigner)
+	obj, err := ce.cast(rs, RecordProposal, func() (interface{}, error) {
+		return p, p.Sign(ce.secpSigner)
+	})
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
+	p = obj.(*objs.Proposal)
 	if err := p.ValidateSignatures(&crypto.Secp256k1Validator{}, &crypto.BNGroupValidator{}); err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
@@ -102,11 +105,14 @@ func (ce *Engine) castProposalFromValue(txn *badger.Txn, rs *RoundStates, prop *
 	}
 	// we have either a locked or valid value
 	rcert := rs.OwnRoundState().RCert
-	p, err := prop.RePropose(ce.secpSigner, rcert)
+	obj, err := ce.cast(rs, RecordProposal, func() (interface{}, error) {
+		return prop.RePropose(ce.secpSigner, rcert)
+	})
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
+	p := obj.(*objs.Proposal)
 	if err := p.ValidateSignatures(&crypto.Secp256k1Validator{}, &crypto.BNGroupValidator{}); err != nil {
 		ce.logger.Debugf("Error in castProposalFromValue at ValidateSignatures: %v", err)
 		return err
@@ -153,11 +159,14 @@ func (ce *Engine) castPreVote(txn *badger.Txn, rs *RoundStates, p *objs.Proposal
 	if !rs.IsCurrentValidator() {
 		return nil
 	}
-	pv, err := p.PreVote(ce.secpSigner)
+	obj, err := ce.cast(rs, RecordPreVote, func() (interface{}, error) {
+		return p.PreVote(ce.secpSigner)
+	})
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
+	pv := obj.(*objs.PreVote)
 	if err := pv.ValidateSignatures(&crypto.Secp256k1Validator{}, &crypto.BNGroupValidator{}); err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
@@ -186,11 +195,14 @@ func (ce *Engine) castPreVoteNil(txn *badger.Txn, rs *RoundStates) error {
 		return nil
 	}
 	rcert := rs.OwnRoundState().RCert
-	pvn, err := rcert.PreVoteNil(ce.secpSigner)
+	obj, err := ce.cast(rs, RecordPreVoteNil, func() (interface{}, error) {
+		return rcert.PreVoteNil(ce.secpSigner)
+	})
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
+	pvn := obj.(*objs.PreVoteNil)
 	if err := pvn.ValidateSignatures(&crypto.Secp256k1Validator{}, &crypto.BNGroupValidator{}); err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
@@ -215,11 +227,14 @@ func (ce *Engine) castPreCommit(txn *badger.Txn, rs *RoundStates, preVotes []*ob
 		return nil
 	}
 	pvl := objs.PreVoteList(preVotes)
-	pc, err := pvl.MakePreCommit(ce.secpSigner)
+	obj, err := ce.cast(rs, RecordPreCommit, func() (interface{}, error) {
+		return pvl.MakePreCommit(ce.secpSigner)
+	})
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
+	pc := obj.(*objs.PreCommit)
 	if err := pc.ValidateSignatures(&crypto.Secp256k1Validator{}, &crypto.BNGroupValidator{}); err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
@@ -244,11 +259,14 @@ func (ce *Engine) castPreCommitNil(txn *badger.Txn, rs *RoundStates) error {
 		return nil
 	}
 	rcert := rs.OwnRoundState().RCert
-	pcn, err := rcert.PreCommitNil(ce.secpSigner)
+	obj, err := ce.cast(rs, RecordPreCommitNil, func() (interface{}, error) {
+		return rcert.PreCommitNil(ce.secpSigner)
+	})
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
+	pcn := obj.(*objs.PreCommitNil)
 	if err := pcn.ValidateSignatures(&crypto.Secp256k1Validator{}, &crypto.BNGroupValidator{}); err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
@@ -284,11 +302,14 @@ func (ce *Engine) castNextRound(txn *badger.Txn, rs *RoundStates) error {
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
-	nr, err := rc.NextRound(ce.secpSigner, ce.bnSigner)
+	obj, err := ce.cast(rs, RecordNextRound, func() (interface{}, error) {
+		return rc.NextRound(ce.secpSigner, ce.bnSigner)
+	})
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
+	nr := obj.(*objs.NextRound)
 	if err := nr.ValidateSignatures(&crypto.Secp256k1Validator{}, &crypto.BNGroupValidator{}); err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
@@ -317,11 +338,14 @@ func (ce *Engine) castNextRoundRCert(txn *badger.Txn, rs *RoundStates, NextRound
 	for _, val := range rs.ValidatorSet.Validators {
 		shares = append(shares, val.GroupShare)
 	}
-	rc, err := nrl.MakeRoundCert(ce.bnSigner, shares)
+	obj, err := ce.cast(rs, RecordRCert, func() (interface{}, error) {
+		return nrl.MakeRoundCert(ce.bnSigner, shares)
+	})
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
+	rc := obj.(*objs.RCert)
 	if err := rc.ValidateSignature(&crypto.BNGroupValidator{}); err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
@@ -345,11 +369,14 @@ func (ce *Engine) castNextHeightFromNextHeight(txn *badger.Txn, rs *RoundStates,
 	if !rs.IsCurrentValidator() {
 		return nil
 	}
-	newNh, err := nh.Plagiarize(ce.secpSigner, ce.bnSigner)
+	obj, err := ce.cast(rs, RecordNextHeight, func() (interface{}, error) {
+		return nh.Plagiarize(ce.secpSigner, ce.bnSigner)
+	})
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
+	newNh := obj.(*objs.NextHeight)
 	if err := newNh.ValidateSignatures(&crypto.Secp256k1Validator{}, &crypto.BNGroupValidator{}); err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
@@ -370,11 +397,14 @@ func (ce *Engine) castNextHeightFromPreCommits(txn *badger.Txn, rs *RoundStates,
 		return nil
 	}
 	pcl := objs.PreCommitList(preCommits)
-	nh, err := pcl.MakeNextHeight(ce.secpSigner, ce.bnSigner)
+	obj, err := ce.cast(rs, RecordNextHeight, func() (interface{}, error) {
+		return pcl.MakeNextHeight(ce.secpSigner, ce.bnSigner)
+	})
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
+	nh := obj.(*objs.NextHeight)
 	if err := nh.ValidateSignatures(&crypto.Secp256k1Validator{}, &crypto.BNGroupValidator{}); err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
@@ -401,7 +431,16 @@ func (ce *Engine) castNewCommittedBlockHeader(txn *badger.Txn, rs *RoundStates,
 		vobj := rs.ValidatorSet.Validators[i]
 		shares[i] = vobj.GroupShare
 	}
-	bh, rc, err := nhl.MakeBlockHeader(ce.bnSigner, shares)
+	obj, err := ce.cast(rs, RecordBlockHeader, func() (interface{}, error) {
+		bh, _, err := nhl.MakeBlockHeader(ce.bnSigner, shares)
+		return bh, err
+	})
+	if err != nil {
+		utils.DebugTrace(ce.logger, err)
+		return err
+	}
+	bh := obj.(*objs.BlockHeader)
+	rc, err := bh.GetRCert()
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return err
@@ -418,6 +457,11 @@ func (ce *Engine) castNewCommittedBlockHeader(txn *badger.Txn, rs *RoundStates,
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
+	round := nextHeights[0].NHClaims.Proposal.PClaims.RCert.RClaims.Round
+	if err := ce.database.SetCommittedBlockRound(txn, bh.BClaims.Height, round); err != nil {
+		utils.DebugTrace(ce.logger, err)
+		return err
+	}
 	return nil
 }
 
@@ -435,5 +479,9 @@ func (ce *Engine) castNewCommittedBlockFromProposalAndRCert(txn *badger.Txn, rs
 		utils.DebugTrace(ce.logger, err)
 		return err
 	}
+	if err := ce.database.SetCommittedBlockRound(txn, bh.BClaims.Height, p.PClaims.RCert.RClaims.Round); err != nil {
+		utils.DebugTrace(ce.logger, err)
+		return err
+	}
 	return nil
 }
@@ -431,7 +431,7 @@ func (ce *Engine) doPendingNext(txn *badger.Txn, rs *RoundStates) error {
 	if rcert.RClaims.Round != constants.DEADBLOCKROUND {
 		if rcert.RClaims.Round == constants.DEADBLOCKROUNDNR {
 			dbrnrTO := ce.storage.GetDeadBlockRoundNextRoundTimeout()
-			if rs.OwnValidatingState.DBRNRExpired(dbrnrTO) {
+			if rs.OwnValidatingState.DBRNRExpired(ce.clock(), dbrnrTO) {
 				// Wait a long time before moving into Dead Block Round
 				if len(pcl)+len(pcnl) >= rs.GetCurrentThreshold() {
 					if err := ce.castNextRound(txn, rs); err != nil {
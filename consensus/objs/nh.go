@@ -146,7 +146,7 @@ func (b *NextHeight) ValidateSignatures(secpVal *crypto.Secp256k1Validator, bnVa
 	return nil
 }
 
-func (b *NextHeight) Plagiarize(secpSigner *crypto.Secp256k1Signer, bnSigner *crypto.BNGroupSigner) (*NextHeight, error) {
+func (b *NextHeight) Plagiarize(secpSigner crypto.Signer, bnSigner crypto.GroupSigner) (*NextHeight, error) {
 	nhb, err := b.MarshalBinary()
 	if err != nil {
 		return nil, err
@@ -161,7 +161,7 @@ func (b *NextHeight) Plagiarize(secpSigner *crypto.Secp256k1Signer, bnSigner *cr
 	return nh, nil
 }
 
-func (b *NextHeight) Sign(secpSigner *crypto.Secp256k1Signer, bnSigner *crypto.BNGroupSigner) error {
+func (b *NextHeight) Sign(secpSigner crypto.Signer, bnSigner crypto.GroupSigner) error {
 	if b == nil || b.NHClaims == nil || b.NHClaims.Proposal == nil || b.NHClaims.Proposal.PClaims == nil {
 		return errorz.ErrInvalid{}.New("not initialized")
 	}
@@ -172,6 +172,9 @@ func (b *NextHeight) Sign(secpSigner *crypto.Secp256k1Signer, bnSigner *crypto.B
 	CE := []byte{}
 	CE = append(CE, NextHeightSigDesignator()...)
 	CE = append(CE, canonicalEncoding...)
+	if secpSigner == nil {
+		return errorz.ErrInvalid{}.New("signer not initialized")
+	}
 	sig, err := secpSigner.Sign(CE)
 	if err != nil {
 		return err
@@ -181,6 +184,9 @@ func (b *NextHeight) Sign(secpSigner *crypto.Secp256k1Signer, bnSigner *crypto.B
 	if err != nil {
 		return err
 	}
+	if bnSigner == nil {
+		return errorz.ErrInvalid{}.New("signer not initialized")
+	}
 	SigShare, err := bnSigner.Sign(bclaimsCanEnc)
 	if err != nil {
 		return err
@@ -2,17 +2,21 @@ package objs
 
 import (
 	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
 	"github.com/MadBase/MadNet/utils"
 )
 
 // NextHeightList ...
 type NextHeightList []*NextHeight
 
-func (nhl NextHeightList) MakeBlockHeader(bns *crypto.BNGroupSigner, groupShares [][]byte) (*BlockHeader, *RCert, error) {
+func (nhl NextHeightList) MakeBlockHeader(bns crypto.GroupSigner, groupShares [][]byte) (*BlockHeader, *RCert, error) {
 	sigs := [][]byte{}
 	for _, nh := range nhl {
 		sigs = append(sigs, utils.CopySlice(nh.NHClaims.SigShare))
 	}
+	if bns == nil {
+		return nil, nil, errorz.ErrInvalid{}.New("signer not initialized")
+	}
 	SigGroup, err := bns.Aggregate(sigs, groupShares)
 	if err != nil {
 		return nil, nil, err
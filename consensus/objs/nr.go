@@ -96,7 +96,7 @@ func (b *NextRound) MarshalCapn(seg *capnp.Segment) (mdefs.NextRound, error) {
 	return bh, nil
 }
 
-func (b *NextRound) Sign(secpSigner *crypto.Secp256k1Signer, bnSigner *crypto.BNGroupSigner) error {
+func (b *NextRound) Sign(secpSigner crypto.Signer, bnSigner crypto.GroupSigner) error {
 	if b == nil {
 		return errorz.ErrInvalid{}.New("not initialized")
 	}
@@ -111,6 +111,9 @@ func (b *NextRound) Sign(secpSigner *crypto.Secp256k1Signer, bnSigner *crypto.BN
 	NextRoundCE := []byte{}
 	NextRoundCE = append(NextRoundCE, NextRoundSigDesignator()...)
 	NextRoundCE = append(NextRoundCE, canonicalEncoding...)
+	if secpSigner == nil {
+		return errorz.ErrInvalid{}.New("signer not initialized")
+	}
 	sig, err := secpSigner.Sign(NextRoundCE)
 	if err != nil {
 		return err
@@ -142,7 +142,7 @@ func (b *NRClaims) ValidateSignatures(bnVal *crypto.BNGroupValidator) error {
 	return nil
 }
 
-func (b *NRClaims) Sign(bnSigner *crypto.BNGroupSigner) error {
+func (b *NRClaims) Sign(bnSigner crypto.GroupSigner) error {
 	if b == nil {
 		return errorz.ErrInvalid{}.New("not initialized")
 	}
@@ -150,6 +150,9 @@ func (b *NRClaims) Sign(bnSigner *crypto.BNGroupSigner) error {
 	if err != nil {
 		return err
 	}
+	if bnSigner == nil {
+		return errorz.ErrInvalid{}.New("signer not initialized")
+	}
 	sig, err := bnSigner.Sign(canonicalEncoding)
 	if err != nil {
 		return err
@@ -2,17 +2,21 @@ package objs
 
 import (
 	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
 	"github.com/MadBase/MadNet/utils"
 )
 
 // NextRoundList ...
 type NextRoundList []*NextRound
 
-func (nrl NextRoundList) MakeRoundCert(bns *crypto.BNGroupSigner, groupShares [][]byte) (*RCert, error) {
+func (nrl NextRoundList) MakeRoundCert(bns crypto.GroupSigner, groupShares [][]byte) (*RCert, error) {
 	sigs := [][]byte{}
 	for _, nr := range nrl {
 		sigs = append(sigs, utils.CopySlice(nr.NRClaims.SigShare))
 	}
+	if bns == nil {
+		return nil, errorz.ErrInvalid{}.New("signer not initialized")
+	}
 	SigGroup, err := bns.Aggregate(sigs, groupShares)
 	if err != nil {
 		return nil, err
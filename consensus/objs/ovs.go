@@ -130,36 +130,37 @@ func (b *OwnValidatingState) MarshalCapn(seg *capnp.Segment) (mdefs.OwnValidatin
 	return bh, nil
 }
 
-func (b *OwnValidatingState) PTOExpired(proposalStepTO time.Duration) bool {
-	return time.Unix(b.RoundStarted, 0).Add(proposalStepTO).Before(time.Now())
+// The step timers are started and checked at the time now of the clock of
+// the caller, which is the wall clock unless a simulation runs validators on
+// a virtual clock.
+
+func (b *OwnValidatingState) PTOExpired(now time.Time, proposalStepTO time.Duration) bool {
+	return time.Unix(b.RoundStarted, 0).Add(proposalStepTO).Before(now)
 }
 
-func (b *OwnValidatingState) PVTOExpired(preVoteStepTO time.Duration) bool {
-	return time.Unix(b.PreVoteStepStarted, 0).Add(preVoteStepTO).Before(time.Now())
+func (b *OwnValidatingState) PVTOExpired(now time.Time, preVoteStepTO time.Duration) bool {
+	return time.Unix(b.PreVoteStepStarted, 0).Add(preVoteStepTO).Before(now)
 }
 
-func (b *OwnValidatingState) PCTOExpired(preCommitStepTO time.Duration) bool {
-	return time.Unix(b.PreCommitStepStarted, 0).Add(preCommitStepTO).Before(time.Now())
+func (b *OwnValidatingState) PCTOExpired(now time.Time, preCommitStepTO time.Duration) bool {
+	return time.Unix(b.PreCommitStepStarted, 0).Add(preCommitStepTO).Before(now)
 }
 
-func (b *OwnValidatingState) DBRNRExpired(dbrnrTO time.Duration) bool {
-	return time.Unix(b.PreCommitStepStarted, 0).Add(dbrnrTO).Before(time.Now())
+func (b *OwnValidatingState) DBRNRExpired(now time.Time, dbrnrTO time.Duration) bool {
+	return time.Unix(b.PreCommitStepStarted, 0).Add(dbrnrTO).Before(now)
 }
 
-func (b *OwnValidatingState) SetRoundStarted() {
-	now := time.Now()
+func (b *OwnValidatingState) SetRoundStarted(now time.Time) {
 	b.RoundStarted = now.Unix()
 	b.PreVoteStepStarted = 0
 	b.PreCommitStepStarted = 0
 }
 
-func (b *OwnValidatingState) SetPreVoteStepStarted() {
-	now := time.Now()
+func (b *OwnValidatingState) SetPreVoteStepStarted(now time.Time) {
 	b.PreVoteStepStarted = now.Unix()
 	b.PreCommitStepStarted = 0
 }
 
-func (b *OwnValidatingState) SetPreCommitStepStarted() {
-	now := time.Now()
+func (b *OwnValidatingState) SetPreCommitStepStarted(now time.Time) {
 	b.PreCommitStepStarted = now.Unix()
 }
@@ -180,7 +180,7 @@ func (b *PreCommit) MakeImplPreVotes() (PreVoteList, error) {
 	return pvl, nil
 }
 
-func (b *PreCommit) Sign(secpSigner *crypto.Secp256k1Signer) error {
+func (b *PreCommit) Sign(secpSigner crypto.Signer) error {
 	if b == nil || b.Proposal == nil {
 		return errorz.ErrInvalid{}.New("not initialized")
 	}
@@ -191,6 +191,9 @@ func (b *PreCommit) Sign(secpSigner *crypto.Secp256k1Signer) error {
 	CE := []byte{}
 	CE = append(CE, PreCommitSigDesignator()...)
 	CE = append(CE, canonicalEncoding...)
+	if secpSigner == nil {
+		return errorz.ErrInvalid{}.New("signer not initialized")
+	}
 	sig, err := secpSigner.Sign(CE)
 	if err != nil {
 		return err
@@ -8,7 +8,7 @@ import (
 type PreCommitList []*PreCommit
 type PreCommitNilList []bool
 
-func (pcl PreCommitList) MakeNextHeight(secpSigner *crypto.Secp256k1Signer, bnSigner *crypto.BNGroupSigner) (*NextHeight, error) {
+func (pcl PreCommitList) MakeNextHeight(secpSigner crypto.Signer, bnSigner crypto.GroupSigner) (*NextHeight, error) {
 	propBytes, err := pcl[0].Proposal.MarshalBinary()
 	if err != nil {
 		return nil, err
@@ -115,7 +115,7 @@ func (b *Proposal) MarshalCapn(seg *capnp.Segment) (mdefs.Proposal, error) {
 	return bh, nil
 }
 
-func (b *Proposal) RePropose(secpSigner *crypto.Secp256k1Signer, rc *RCert) (*Proposal, error) {
+func (b *Proposal) RePropose(secpSigner crypto.Signer, rc *RCert) (*Proposal, error) {
 	pce, err := b.MarshalBinary()
 	if err != nil {
 		return nil, err
@@ -140,7 +140,7 @@ func (b *Proposal) RePropose(secpSigner *crypto.Secp256k1Signer, rc *RCert) (*Pr
 	return p, nil
 }
 
-func (b *Proposal) Sign(secpSigner *crypto.Secp256k1Signer) error {
+func (b *Proposal) Sign(secpSigner crypto.Signer) error {
 	if b == nil || b.PClaims == nil || b.PClaims.RCert == nil || b.PClaims.RCert.RClaims == nil {
 		return errorz.ErrInvalid{}.New("not initialized")
 	}
@@ -154,6 +154,9 @@ func (b *Proposal) Sign(secpSigner *crypto.Secp256k1Signer) error {
 	ProposalCE := []byte{}
 	ProposalCE = append(ProposalCE, ProposalSigDesignator()...)
 	ProposalCE = append(ProposalCE, canonicalEncoding...)
+	if secpSigner == nil {
+		return errorz.ErrInvalid{}.New("signer not initialized")
+	}
 	sig, err := secpSigner.Sign(ProposalCE)
 	if err != nil {
 		return err
@@ -196,7 +199,7 @@ func (b *Proposal) ValidateSignatures(val *crypto.Secp256k1Validator, bnVal *cry
 	return nil
 }
 
-func (b *Proposal) PreVote(secpSigner *crypto.Secp256k1Signer) (*PreVote, error) {
+func (b *Proposal) PreVote(secpSigner crypto.Signer) (*PreVote, error) {
 	pcb, err := b.MarshalBinary()
 	if err != nil {
 		return nil, err
@@ -120,7 +120,7 @@ func (b *PreVote) ValidateSignatures(secpVal *crypto.Secp256k1Validator, bnVal *
 	return nil
 }
 
-func (b *PreVote) Sign(secpSigner *crypto.Secp256k1Signer) error {
+func (b *PreVote) Sign(secpSigner crypto.Signer) error {
 	if b == nil {
 		return errorz.ErrInvalid{}.New("not initialized")
 	}
@@ -131,6 +131,9 @@ func (b *PreVote) Sign(secpSigner *crypto.Secp256k1Signer) error {
 	CE := []byte{}
 	CE = append(CE, PreVoteSigDesignator()...)
 	CE = append(CE, canonicalEncoding...)
+	if secpSigner == nil {
+		return errorz.ErrInvalid{}.New("signer not initialized")
+	}
 	sig, err := secpSigner.Sign(CE)
 	if err != nil {
 		return err
@@ -8,7 +8,7 @@ import (
 type PreVoteList []*PreVote
 type PreVoteNilList []bool
 
-func (pvl PreVoteList) MakePreCommit(secpSigner *crypto.Secp256k1Signer) (*PreCommit, error) {
+func (pvl PreVoteList) MakePreCommit(secpSigner crypto.Signer) (*PreCommit, error) {
 	sigs := [][]byte{}
 	for _, pv := range pvl {
 		s := utils.CopySlice(pv.Signature)
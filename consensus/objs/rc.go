@@ -137,7 +137,7 @@ func (b *RCert) ValidateSignature(bnVal *crypto.BNGroupValidator) error {
 }
 
 // PreVoteNil constructs a PreVoteNil object from RCert
-func (b *RCert) PreVoteNil(secpSigner *crypto.Secp256k1Signer) (*PreVoteNil, error) {
+func (b *RCert) PreVoteNil(secpSigner crypto.Signer) (*PreVoteNil, error) {
 	rce, err := b.MarshalBinary()
 	if err != nil {
 		return nil, err
@@ -155,6 +155,9 @@ func (b *RCert) PreVoteNil(secpSigner *crypto.Secp256k1Signer) (*PreVoteNil, err
 	PreVoteNilCE := []byte{}
 	PreVoteNilCE = append(PreVoteNilCE, PreVoteNilSigDesignator()...)
 	PreVoteNilCE = append(PreVoteNilCE, canonicalEncoding...)
+	if secpSigner == nil {
+		return nil, errorz.ErrInvalid{}.New("signer not initialized")
+	}
 	sig, err := secpSigner.Sign(PreVoteNilCE)
 	if err != nil {
 		return nil, err
@@ -164,7 +167,7 @@ func (b *RCert) PreVoteNil(secpSigner *crypto.Secp256k1Signer) (*PreVoteNil, err
 }
 
 // PreCommitNil constructs a PreCommitNil object from RCert
-func (b *RCert) PreCommitNil(secpSigner *crypto.Secp256k1Signer) (*PreCommitNil, error) {
+func (b *RCert) PreCommitNil(secpSigner crypto.Signer) (*PreCommitNil, error) {
 	rce, err := b.MarshalBinary()
 	if err != nil {
 		return nil, err
@@ -182,6 +185,9 @@ func (b *RCert) PreCommitNil(secpSigner *crypto.Secp256k1Signer) (*PreCommitNil,
 	PreCommitNilCE := []byte{}
 	PreCommitNilCE = append(PreCommitNilCE, PreCommitNilSigDesignator()...)
 	PreCommitNilCE = append(PreCommitNilCE, canonicalEncoding...)
+	if secpSigner == nil {
+		return nil, errorz.ErrInvalid{}.New("signer not initialized")
+	}
 	sig, err := secpSigner.Sign(PreCommitNilCE)
 	if err != nil {
 		return nil, err
@@ -191,7 +197,7 @@ func (b *RCert) PreCommitNil(secpSigner *crypto.Secp256k1Signer) (*PreCommitNil,
 }
 
 // NextRound constructs a NextRound object from RCert
-func (b *RCert) NextRound(secpSigner *crypto.Secp256k1Signer, bnSigner *crypto.BNGroupSigner) (*NextRound, error) {
+func (b *RCert) NextRound(secpSigner crypto.Signer, bnSigner crypto.GroupSigner) (*NextRound, error) {
 	if b == nil {
 		return nil, errorz.ErrInvalid{}.New("not initialized")
 	}
@@ -0,0 +1,151 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/utils"
+	"google.golang.org/grpc"
+)
+
+// callTimeout bounds every call to the signer
+const callTimeout = 5 * time.Second
+
+// Client connects to a signer service listening on a Unix socket
+type Client struct {
+	conn   *grpc.ClientConn
+	client pb.SignerClient
+}
+
+// Dial connects to the signer listening on socket
+func Dial(socket string) (*Client, error) {
+	dialer := func(ctx context.Context, addr string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, "unix", addr)
+	}
+	conn, err := grpc.Dial(socket,
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(dialer))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, client: pb.NewSignerClient(conn)}, nil
+}
+
+// Close closes the connection to the signer
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// PublicKeys lists the public keys held by the signer
+func (c *Client) PublicKeys() (*pb.SignerPublicKeysResponse, error) {
+	ctx, cf := context.WithTimeout(context.Background(), callTimeout)
+	defer cf()
+	return c.client.PublicKeys(ctx, &pb.SignerPublicKeysRequest{})
+}
+
+// ImportKey hands a private key to the signer and returns its public key,
+// the public key share for a bn256 key. The signer only accepts group key
+// shares.
+func (c *Client) ImportKey(curve constants.CurveSpec, privk []byte) ([]byte, error) {
+	ctx, cf := context.WithTimeout(context.Background(), callTimeout)
+	defer cf()
+	resp, err := c.client.ImportKey(ctx, &pb.SignerImportKeyRequest{Curve: uint32(curve), PrivateKey: privk})
+	if err != nil {
+		return nil, err
+	}
+	return resp.PublicKey, nil
+}
+
+// Secp256k1Signer returns a signer which signs with the secp256k1 key of
+// the signer
+func (c *Client) Secp256k1Signer() (crypto.Signer, error) {
+	keys, err := c.PublicKeys()
+	if err != nil {
+		return nil, err
+	}
+	if len(keys.Secp256K1) == 0 {
+		return nil, errorz.ErrInvalid{}.New("the signer holds no secp256k1 key")
+	}
+	return &remoteSigner{client: c, pubk: keys.Secp256K1}, nil
+}
+
+// GroupSigner returns a signer which signs with the group key share whose
+// public key is share. Signature shares are aggregated locally with the
+// group key groupKey.
+func (c *Client) GroupSigner(share []byte, groupKey []byte) (crypto.GroupSigner, error) {
+	keys, err := c.PublicKeys()
+	if err != nil {
+		return nil, err
+	}
+	held := false
+	for _, s := range keys.GroupShares {
+		if bytes.Equal(s, share) {
+			held = true
+			break
+		}
+	}
+	if !held {
+		return nil, errorz.ErrInvalid{}.New("the signer does not hold the group key share")
+	}
+	aggregator := &crypto.BNGroupSigner{}
+	if err := aggregator.SetGroupPubk(groupKey); err != nil {
+		return nil, err
+	}
+	return &remoteGroupSigner{client: c, share: utils.CopySlice(share), aggregator: aggregator}, nil
+}
+
+// remoteSigner implements crypto.Signer with the secp256k1 key of a signer
+type remoteSigner struct {
+	client *Client
+	pubk   []byte
+}
+
+func (rs *remoteSigner) Sign(msg []byte) ([]byte, error) {
+	ctx, cf := context.WithTimeout(context.Background(), callTimeout)
+	defer cf()
+	resp, err := rs.client.client.Sign(ctx, &pb.SignerSignRequest{Message: msg})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Signature, nil
+}
+
+func (rs *remoteSigner) Pubkey() ([]byte, error) {
+	return utils.CopySlice(rs.pubk), nil
+}
+
+// remoteGroupSigner implements crypto.GroupSigner with a group key share of
+// a signer. Aggregation needs no private key and is done locally.
+type remoteGroupSigner struct {
+	client     *Client
+	share      []byte
+	aggregator *crypto.BNGroupSigner
+}
+
+func (rgs *remoteGroupSigner) Sign(msg []byte) ([]byte, error) {
+	ctx, cf := context.WithTimeout(context.Background(), callTimeout)
+	defer cf()
+	resp, err := rgs.client.client.SignGroup(ctx, &pb.SignerSignGroupRequest{GroupShare: rgs.share, Message: msg})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Signature, nil
+}
+
+func (rgs *remoteGroupSigner) PubkeyShare() ([]byte, error) {
+	return utils.CopySlice(rgs.share), nil
+}
+
+func (rgs *remoteGroupSigner) PubkeyGroup() ([]byte, error) {
+	return rgs.aggregator.PubkeyGroup()
+}
+
+func (rgs *remoteGroupSigner) Aggregate(sigs [][]byte, groupShares [][]byte) ([]byte, error) {
+	return rgs.aggregator.Aggregate(sigs, groupShares)
+}
@@ -0,0 +1,294 @@
+package signer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+)
+
+// voteKind groups the consensus messages which conflict with each other
+// when signed for the same height and round
+type voteKind uint8
+
+const (
+	kindProposal voteKind = iota + 1
+	// PreVotes and PreVoteNils
+	kindPreVote
+	// PreCommits and PreCommitNils
+	kindPreCommit
+	kindNextRound
+	kindNextHeight
+	// the group signature share of the round claims of a NextRound
+	kindRoundShare
+)
+
+// designators maps the prefixes of the messages signed with the secp256k1
+// key to the kind of the message. Longer designators come first as
+// PreVote is a prefix of PreVoteNil.
+var designators = []struct {
+	prefix []byte
+	kind   voteKind
+	nilRC  bool
+}{
+	{objs.PreVoteNilSigDesignator(), kindPreVote, true},
+	{objs.PreCommitNilSigDesignator(), kindPreCommit, true},
+	{objs.PreVoteSigDesignator(), kindPreVote, false},
+	{objs.PreCommitSigDesignator(), kindPreCommit, false},
+	{objs.ProposalSigDesignator(), kindProposal, false},
+	{objs.NextHeightSigDesignator(), kindNextHeight, false},
+	{objs.NextRoundSigDesignator(), kindNextRound, false},
+}
+
+// keepHeights is the number of heights below the highest height signed for
+// which the signed messages are remembered. Messages for older heights are
+// refused.
+const keepHeights = constants.EpochLength
+
+type vote struct {
+	Kind   voteKind `json:"kind"`
+	Height uint32   `json:"height"`
+	Round  uint32   `json:"round"`
+	Digest []byte   `json:"digest"`
+}
+
+type voteKey struct {
+	kind   voteKind
+	height uint32
+	round  uint32
+}
+
+type guardState struct {
+	Votes []*vote `json:"votes"`
+	// Blocks holds the hashes of the blocks a NextHeight was signed for.
+	// Only those are signed with a group key share.
+	Blocks []*vote `json:"blocks"`
+}
+
+// Guard refuses to sign a consensus message which conflicts with a message
+// signed before for the same height and round, so that a validator can not
+// be made to double sign. The messages signed are stored in a file, which
+// is written before a signature is released.
+type Guard struct {
+	sync.Mutex
+	path      string
+	maxHeight uint32
+	votes     map[voteKey]*vote
+	blocks    map[string]*vote
+}
+
+// NewGuard loads the guard state stored at path. A missing file starts an
+// empty state.
+func NewGuard(path string) (*Guard, error) {
+	g := &Guard{
+		path:   path,
+		votes:  make(map[voteKey]*vote),
+		blocks: make(map[string]*vote),
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return g, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := &guardState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("guard state %s: %v", path, err)
+	}
+	for _, v := range state.Votes {
+		g.votes[voteKey{v.Kind, v.Height, v.Round}] = v
+		g.raise(v.Height)
+	}
+	for _, v := range state.Blocks {
+		g.blocks[string(v.Digest)] = v
+	}
+	return g, nil
+}
+
+func (g *Guard) raise(height uint32) {
+	if height > g.maxHeight {
+		g.maxHeight = height
+	}
+}
+
+// CheckSign records msg as signed with the secp256k1 key. It fails if msg
+// is not a consensus message or conflicts with a message signed before.
+func (g *Guard) CheckSign(msg []byte) error {
+	v, blockHash, err := decodeSigned(msg)
+	if err != nil {
+		return err
+	}
+	g.Lock()
+	defer g.Unlock()
+	var block *vote
+	if blockHash != nil {
+		block = &vote{Kind: kindNextHeight, Height: v.Height, Round: v.Round, Digest: blockHash}
+	}
+	return g.record(v, block)
+}
+
+// CheckSignGroup records msg as signed with a group key share. Group
+// signature shares are given for the round claims of a NextRound and for
+// the hash of a block a NextHeight was signed for.
+func (g *Guard) CheckSignGroup(msg []byte) error {
+	g.Lock()
+	defer g.Unlock()
+	if len(msg) == constants.HashLen {
+		if _, ok := g.blocks[string(msg)]; !ok {
+			return errorz.ErrInvalid{}.New("no NextHeight was signed for the block")
+		}
+		return nil
+	}
+	rc := &objs.RClaims{}
+	if err := unmarshal(rc, msg); err != nil {
+		return errorz.ErrInvalid{}.New("not a consensus message")
+	}
+	return g.record(&vote{Kind: kindRoundShare, Height: rc.Height, Round: rc.Round, Digest: crypto.Hasher(msg)}, nil)
+}
+
+func (g *Guard) record(v *vote, block *vote) error {
+	if v.Height+keepHeights < g.maxHeight {
+		return errorz.ErrInvalid{}.New(fmt.Sprintf("height %d is below the signed height %d", v.Height, g.maxHeight))
+	}
+	key := voteKey{v.Kind, v.Height, v.Round}
+	if prev, ok := g.votes[key]; ok {
+		if !bytes.Equal(prev.Digest, v.Digest) {
+			return errorz.ErrInvalid{}.New(fmt.Sprintf("conflicts with a message signed for height %d round %d", v.Height, v.Round))
+		}
+		return nil
+	}
+	g.votes[key] = v
+	if block != nil {
+		g.blocks[string(block.Digest)] = block
+	}
+	g.raise(v.Height)
+	g.prune()
+	if err := g.save(); err != nil {
+		delete(g.votes, key)
+		if block != nil {
+			delete(g.blocks, string(block.Digest))
+		}
+		return err
+	}
+	return nil
+}
+
+func (g *Guard) prune() {
+	if g.maxHeight <= keepHeights {
+		return
+	}
+	low := g.maxHeight - keepHeights
+	for key, v := range g.votes {
+		if v.Height < low {
+			delete(g.votes, key)
+		}
+	}
+	for key, v := range g.blocks {
+		if v.Height < low {
+			delete(g.blocks, key)
+		}
+	}
+}
+
+// save writes the state to a temporary file which replaces the state file
+func (g *Guard) save() error {
+	state := &guardState{}
+	for _, v := range g.votes {
+		state.Votes = append(state.Votes, v)
+	}
+	for _, v := range g.blocks {
+		state.Blocks = append(state.Blocks, v)
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(g.path), filepath.Base(g.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), g.path)
+}
+
+// decodeSigned decodes a message signed with the secp256k1 key. The hash of
+// the block is returned for a NextHeight.
+func decodeSigned(msg []byte) (*vote, []byte, error) {
+	for _, d := range designators {
+		if !bytes.HasPrefix(msg, d.prefix) {
+			continue
+		}
+		body := msg[len(d.prefix):]
+		v := &vote{Kind: d.kind, Digest: crypto.Hasher(msg)}
+		var rc *objs.RClaims
+		var blockHash []byte
+		switch {
+		case d.nilRC:
+			rcert := &objs.RCert{}
+			if err := unmarshal(rcert, body); err != nil {
+				return nil, nil, err
+			}
+			rc = rcert.RClaims
+		case d.kind == kindNextRound:
+			nrc := &objs.NRClaims{}
+			if err := unmarshal(nrc, body); err != nil {
+				return nil, nil, err
+			}
+			rc = nrc.RClaims
+		default:
+			pc := &objs.PClaims{}
+			if err := unmarshal(pc, body); err != nil {
+				return nil, nil, err
+			}
+			if pc.RCert == nil || pc.BClaims == nil {
+				return nil, nil, errorz.ErrInvalid{}.New("bad serialization")
+			}
+			rc = pc.RCert.RClaims
+			if d.kind == kindNextHeight {
+				bh, err := pc.BClaims.BlockHash()
+				if err != nil {
+					return nil, nil, err
+				}
+				blockHash = bh
+			}
+		}
+		if rc == nil {
+			return nil, nil, errorz.ErrInvalid{}.New("bad serialization")
+		}
+		v.Height, v.Round = rc.Height, rc.Round
+		return v, blockHash, nil
+	}
+	return nil, nil, errorz.ErrInvalid{}.New("not a consensus message")
+}
+
+// unmarshal decodes data into obj. Some objects panic rather than fail on
+// malformed data, which is recovered from.
+func unmarshal(obj interface{ UnmarshalBinary([]byte) error }, data []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errorz.ErrInvalid{}.New("bad serialization")
+		}
+	}()
+	return obj.UnmarshalBinary(utils.CopySlice(data))
+}
@@ -0,0 +1,170 @@
+package signer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+)
+
+// testBClaims returns the claims of a block at height. Blocks with another
+// seed differ.
+func testBClaims(t *testing.T, height uint32, seed string) *objs.BClaims {
+	txRoot, err := objs.MakeTxRoot(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &objs.BClaims{
+		ChainID:    1,
+		Height:     height,
+		PrevBlock:  crypto.Hasher([]byte("prev")),
+		TxRoot:     txRoot,
+		StateRoot:  crypto.Hasher([]byte(seed)),
+		HeaderRoot: make([]byte, constants.HashLen),
+	}
+}
+
+// testRCert returns the round certificate of the first round of height
+func testRCert(t *testing.T, height uint32) *objs.RCert {
+	gk := &crypto.BNGroupSigner{}
+	if err := gk.SetPrivk(crypto.Hasher([]byte("secret"))); err != nil {
+		t.Fatal(err)
+	}
+	bclaims := testBClaims(t, height-1, "prev")
+	bhsh, err := bclaims.BlockHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := gk.Sign(bhsh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bh := &objs.BlockHeader{BClaims: bclaims, SigGroup: sig, TxHshLst: [][]byte{}}
+	rcert, err := bh.GetRCert()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rcert
+}
+
+// testPClaims returns the claims of a proposal of the block seed at height
+func testPClaims(t *testing.T, height uint32, seed string) *objs.PClaims {
+	rcert := testRCert(t, height)
+	bclaims := testBClaims(t, height, seed)
+	bclaims.PrevBlock = rcert.RClaims.PrevBlock
+	return &objs.PClaims{BClaims: bclaims, RCert: rcert}
+}
+
+// testPClaimsMsg returns the message signed for a proposal of the block
+// seed at height, prefixed with designator
+func testPClaimsMsg(t *testing.T, designator []byte, height uint32, seed string) []byte {
+	pcBytes, err := testPClaims(t, height, seed).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(designator, pcBytes...)
+}
+
+func testNilMsg(t *testing.T, designator []byte, height uint32) []byte {
+	rcBytes, err := testRCert(t, height).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(designator, rcBytes...)
+}
+
+func newTestGuard(t *testing.T) (*Guard, string) {
+	path := filepath.Join(t.TempDir(), guardStateFile)
+	g, err := NewGuard(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g, path
+}
+
+func TestGuardConflict(t *testing.T) {
+	g, _ := newTestGuard(t)
+	pvA := testPClaimsMsg(t, objs.PreVoteSigDesignator(), 10, "a")
+	pvB := testPClaimsMsg(t, objs.PreVoteSigDesignator(), 10, "b")
+	if err := g.CheckSign(pvA); err != nil {
+		t.Fatal(err)
+	}
+	// signing the same message again is allowed
+	if err := g.CheckSign(pvA); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.CheckSign(pvB); err == nil {
+		t.Fatal("conflicting PreVote signed")
+	}
+	if err := g.CheckSign(testNilMsg(t, objs.PreVoteNilSigDesignator(), 10)); err == nil {
+		t.Fatal("PreVoteNil signed after a PreVote")
+	}
+	// a PreCommit does not conflict with a PreVote
+	if err := g.CheckSign(testPClaimsMsg(t, objs.PreCommitSigDesignator(), 10, "a")); err != nil {
+		t.Fatal(err)
+	}
+	// nor does a PreVote of the next height
+	if err := g.CheckSign(testPClaimsMsg(t, objs.PreVoteSigDesignator(), 11, "b")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGuardPersistence(t *testing.T) {
+	g, path := newTestGuard(t)
+	if err := g.CheckSign(testNilMsg(t, objs.PreCommitNilSigDesignator(), 10)); err != nil {
+		t.Fatal(err)
+	}
+	g, err := NewGuard(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.CheckSign(testPClaimsMsg(t, objs.PreCommitSigDesignator(), 10, "a")); err == nil {
+		t.Fatal("PreCommit signed after a PreCommitNil stored before")
+	}
+}
+
+func TestGuardOldHeight(t *testing.T) {
+	g, _ := newTestGuard(t)
+	if err := g.CheckSign(testPClaimsMsg(t, objs.ProposalSigDesignator(), keepHeights+10, "a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.CheckSign(testPClaimsMsg(t, objs.ProposalSigDesignator(), 5, "a")); err == nil {
+		t.Fatal("message of a forgotten height signed")
+	}
+}
+
+func TestGuardGroupShare(t *testing.T) {
+	g, _ := newTestGuard(t)
+	bhsh, err := testPClaims(t, 10, "a").BClaims.BlockHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.CheckSignGroup(bhsh); err == nil {
+		t.Fatal("block hash signed without a NextHeight")
+	}
+	if err := g.CheckSign(testPClaimsMsg(t, objs.NextHeightSigDesignator(), 10, "a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.CheckSignGroup(bhsh); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGuardMalformed(t *testing.T) {
+	g, _ := newTestGuard(t)
+	msgs := [][]byte{
+		nil,
+		[]byte("garbage"),
+		append(objs.PreVoteSigDesignator(), []byte("garbage")...),
+	}
+	for _, msg := range msgs {
+		if err := g.CheckSign(msg); err == nil {
+			t.Fatalf("malformed message %x signed", msg)
+		}
+	}
+	if err := g.CheckSignGroup([]byte("garbage")); err == nil {
+		t.Fatal("malformed message signed with a group key share")
+	}
+}
@@ -0,0 +1,201 @@
+package signer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/logging"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The files of a signer directory. Keys are stored hex encoded, the group
+// key shares under the hash of their public key.
+const (
+	secpKeyFile     = "secp256k1.key"
+	groupKeyPrefix  = "bn256-"
+	keyFileSuffix   = ".key"
+	guardStateFile  = "guard.json"
+	keyFilePerm     = 0600
+	signerDirPerm   = 0700
+	maxKeyFileBytes = 1024
+)
+
+// Server is a reference signer which keeps its keys and the state of its
+// double sign guard in files of a directory. The secp256k1 key is stored in
+// the directory by the operator; only group key shares are imported.
+type Server struct {
+	sync.Mutex
+	logger *logrus.Logger
+	dir    string
+	secp   *crypto.Secp256k1Signer
+	shares map[string]*crypto.BNGroupSigner
+	guard  *Guard
+}
+
+var _ pb.SignerServer = &Server{}
+
+// NewServer loads the keys and the guard state stored in dir. The
+// directory is created if it does not exist.
+func NewServer(dir string) (*Server, error) {
+	if err := os.MkdirAll(dir, signerDirPerm); err != nil {
+		return nil, err
+	}
+	s := &Server{
+		logger: logging.GetLogger(constants.LoggerSigner),
+		dir:    dir,
+		shares: make(map[string]*crypto.BNGroupSigner),
+	}
+	guard, err := NewGuard(filepath.Join(dir, guardStateFile))
+	if err != nil {
+		return nil, err
+	}
+	s.guard = guard
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasSuffix(name, keyFileSuffix) {
+			continue
+		}
+		privk, err := readKeyFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case name == secpKeyFile:
+			s.secp = &crypto.Secp256k1Signer{}
+			if err := s.secp.SetPrivk(privk); err != nil {
+				return nil, fmt.Errorf("%s: %v", name, err)
+			}
+		case strings.HasPrefix(name, groupKeyPrefix):
+			signer := &crypto.BNGroupSigner{}
+			if err := signer.SetPrivk(privk); err != nil {
+				return nil, fmt.Errorf("%s: %v", name, err)
+			}
+			share, err := signer.PubkeyShare()
+			if err != nil {
+				return nil, err
+			}
+			s.shares[string(share)] = signer
+		}
+	}
+	s.logger.Infof("Loaded %d group key shares, secp256k1 key present: %v", len(s.shares), s.secp != nil)
+	return s, nil
+}
+
+func readKeyFile(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxKeyFileBytes {
+		return nil, fmt.Errorf("%s is not a key file", path)
+	}
+	privk, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return privk, nil
+}
+
+func (s *Server) writeKeyFile(name string, privk []byte) error {
+	path := filepath.Join(s.dir, name)
+	return ioutil.WriteFile(path, []byte(hex.EncodeToString(privk)+"\n"), keyFilePerm)
+}
+
+// PublicKeys lists the public keys held by the signer
+func (s *Server) PublicKeys(ctx context.Context, req *pb.SignerPublicKeysRequest) (*pb.SignerPublicKeysResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	resp := &pb.SignerPublicKeysResponse{}
+	if s.secp != nil {
+		pubk, err := s.secp.Pubkey()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Secp256K1 = pubk
+	}
+	for share := range s.shares {
+		resp.GroupShares = append(resp.GroupShares, []byte(share))
+	}
+	return resp, nil
+}
+
+// Sign signs a consensus message with the secp256k1 key
+func (s *Server) Sign(ctx context.Context, req *pb.SignerSignRequest) (*pb.SignerSignResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.secp == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no secp256k1 key")
+	}
+	if err := s.guard.CheckSign(req.Message); err != nil {
+		s.logger.Warnf("Refused to sign: %v", err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	sig, err := s.secp.Sign(req.Message)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.SignerSignResponse{Signature: sig}, nil
+}
+
+// SignGroup signs a consensus message with a group key share
+func (s *Server) SignGroup(ctx context.Context, req *pb.SignerSignGroupRequest) (*pb.SignerSignResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	signer, ok := s.shares[string(req.GroupShare)]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown group key share")
+	}
+	if err := s.guard.CheckSignGroup(req.Message); err != nil {
+		s.logger.Warnf("Refused to sign with the group key share: %v", err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	sig, err := signer.Sign(req.Message)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.SignerSignResponse{Signature: sig}, nil
+}
+
+// ImportKey stores a group key share. The secp256k1 key never leaves the
+// signer directory and can not be imported.
+func (s *Server) ImportKey(ctx context.Context, req *pb.SignerImportKeyRequest) (*pb.SignerImportKeyResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	switch constants.CurveSpec(req.Curve) {
+	case constants.CurveSecp256k1:
+		return nil, status.Error(codes.InvalidArgument, "the secp256k1 key must be stored in the signer directory")
+	case constants.CurveBN256Eth:
+		signer := &crypto.BNGroupSigner{}
+		if err := signer.SetPrivk(req.PrivateKey); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		share, err := signer.PubkeyShare()
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		name := groupKeyPrefix + hex.EncodeToString(crypto.Hasher(share)) + keyFileSuffix
+		if err := s.writeKeyFile(name, req.PrivateKey); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		s.shares[string(share)] = signer
+		s.logger.Infof("Imported group key share %x", crypto.Hasher(share))
+		return &pb.SignerImportKeyResponse{PublicKey: share}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown curve %d", req.Curve))
+	}
+}
@@ -0,0 +1,147 @@
+package signer
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	pb "github.com/MadBase/MadNet/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// startServer serves the signer of dir and returns a client connected to it
+func startServer(t *testing.T, dir string) *Client {
+	srv, err := NewServer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// socket paths are limited in length, so the socket is not put in dir
+	sockDir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(sockDir) })
+	socket := filepath.Join(sockDir, "s.sock")
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	pb.RegisterSignerServer(grpcServer, srv)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+	client, err := Dial(socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestServer(t *testing.T) {
+	dir := t.TempDir()
+	client := startServer(t, dir)
+
+	if _, err := client.Secp256k1Signer(); err == nil {
+		t.Fatal("signer without a secp256k1 key")
+	}
+	// the secp256k1 key can not be imported, it is stored by the operator
+	secpKey := crypto.Hasher([]byte("secp"))
+	_, err := client.ImportKey(constants.CurveSecp256k1, secpKey)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("secp256k1 key imported: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, secpKeyFile), []byte(hex.EncodeToString(secpKey)+"\n"), keyFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	client = startServer(t, dir)
+	ref := &crypto.Secp256k1Signer{}
+	if err := ref.SetPrivk(secpKey); err != nil {
+		t.Fatal(err)
+	}
+	pubk, err := ref.Pubkey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secp, err := client.Secp256k1Signer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signerPubk, err := secp.Pubkey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(signerPubk, pubk) {
+		t.Fatal("public keys differ")
+	}
+	msg := testPClaimsMsg(t, objs.PreVoteSigDesignator(), 10, "a")
+	sig, err := secp.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&crypto.Secp256k1Validator{}).Validate(msg, sig); err != nil {
+		t.Fatal(err)
+	}
+	_, err = secp.Sign(testPClaimsMsg(t, objs.PreVoteSigDesignator(), 10, "b"))
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("conflicting PreVote signed: %v", err)
+	}
+
+	share, err := client.ImportKey(constants.CurveBN256Eth, crypto.Hasher([]byte("share")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GroupSigner(crypto.Hasher([]byte("unknown")), share); err == nil {
+		t.Fatal("group signer for an unknown share")
+	}
+	gs, err := client.GroupSigner(share, share)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pc := testPClaims(t, 11, "a")
+	bhsh, err := pc.BClaims.BlockHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gs.Sign(bhsh); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("block hash signed without a NextHeight: %v", err)
+	}
+	if _, err := secp.Sign(testPClaimsMsg(t, objs.NextHeightSigDesignator(), 11, "a")); err != nil {
+		t.Fatal(err)
+	}
+	sig, err = gs.Sign(bhsh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&crypto.BNGroupValidator{}).Validate(bhsh, sig); err != nil {
+		t.Fatal(err)
+	}
+
+	// the keys and the guard state survive a restart
+	client = startServer(t, dir)
+	keys, err := client.PublicKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(keys.Secp256K1, pubk) || len(keys.GroupShares) != 1 || !bytes.Equal(keys.GroupShares[0], share) {
+		t.Fatalf("keys not restored: %+v", keys)
+	}
+	secp, err = client.Secp256k1Signer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = secp.Sign(testPClaimsMsg(t, objs.PreVoteSigDesignator(), 10, "b"))
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("conflicting PreVote signed after a restart: %v", err)
+	}
+}
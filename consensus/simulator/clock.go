@@ -0,0 +1,33 @@
+package simulator
+
+import (
+	"time"
+)
+
+// clockStart is the time every simulation starts at
+var clockStart = time.Unix(1600000000, 0)
+
+// Clock is the virtual clock of a simulation. It only moves when the
+// simulation advances it, so a simulation does not depend on how fast the
+// host runs it.
+type Clock struct {
+	now time.Time
+}
+
+func newClock() *Clock {
+	return &Clock{now: clockStart}
+}
+
+// Now returns the virtual time
+func (c *Clock) Now() time.Time {
+	return c.now
+}
+
+// Elapsed returns the virtual time passed since the start of the simulation
+func (c *Clock) Elapsed() time.Duration {
+	return c.now.Sub(clockStart)
+}
+
+func (c *Clock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
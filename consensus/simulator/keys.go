@@ -0,0 +1,71 @@
+package simulator
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/crypto"
+	bn256 "github.com/MadBase/MadNet/crypto/bn256/cloudflare"
+)
+
+// validatorKeys holds the keys of a simulated validator
+type validatorKeys struct {
+	secpSigner  *crypto.Secp256k1Signer
+	publicKey   []byte
+	account     []byte
+	groupPrivk  []byte
+	groupShare  []byte
+	secpPrivKey []byte
+}
+
+// makeKeys derives the keys of n validators and the validator set they form
+// from seed. The group key is split with the polynomial a DKG run would
+// agree on, so the group signatures of the set are valid.
+func makeKeys(n int, seed int64) ([]*validatorKeys, *objs.ValidatorSet, error) {
+	threshold := crypto.CalcThreshold(n)
+	coefs := make([]*big.Int, threshold+1)
+	for i := range coefs {
+		h := crypto.Hasher([]byte(fmt.Sprintf("simulator %d group coefficient %d", seed, i)))
+		coefs[i] = new(big.Int).Mod(new(big.Int).SetBytes(h), bn256.Order)
+	}
+	vs := &objs.ValidatorSet{
+		GroupKey:   new(bn256.G2).ScalarBaseMult(coefs[0]).Marshal(),
+		Validators: make([]*objs.Validator, n),
+		NotBefore:  1,
+	}
+	keys := make([]*validatorKeys, n)
+	for i := 0; i < n; i++ {
+		privk := crypto.Hasher([]byte(fmt.Sprintf("simulator %d validator %d", seed, i)))
+		secpSigner := &crypto.Secp256k1Signer{}
+		if err := secpSigner.SetPrivk(privk); err != nil {
+			return nil, nil, err
+		}
+		pubk, err := secpSigner.Pubkey()
+		if err != nil {
+			return nil, nil, err
+		}
+		groupPrivk := bn256.PrivatePolyEval(coefs, i+1).Bytes()
+		bnSigner := &crypto.BNGroupSigner{}
+		if err := bnSigner.SetPrivk(groupPrivk); err != nil {
+			return nil, nil, err
+		}
+		groupShare, err := bnSigner.PubkeyShare()
+		if err != nil {
+			return nil, nil, err
+		}
+		keys[i] = &validatorKeys{
+			secpSigner:  secpSigner,
+			publicKey:   pubk,
+			account:     crypto.GetAccount(pubk),
+			groupPrivk:  groupPrivk,
+			groupShare:  groupShare,
+			secpPrivKey: privk,
+		}
+		vs.Validators[i] = &objs.Validator{
+			VAddr:      keys[i].account,
+			GroupShare: groupShare,
+		}
+	}
+	return keys, vs, nil
+}
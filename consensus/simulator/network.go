@@ -0,0 +1,150 @@
+package simulator
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Message is a consensus object sent from one validator to another
+type Message struct {
+	From   int
+	To     int
+	Object interface{}
+	// Sent is the virtual time the message was sent at
+	Sent time.Time
+}
+
+// Scheduler decides the fate of every message sent in a simulation. A
+// message is dropped if drop is true and delivered delay after it was sent
+// otherwise. The random source is the one of the simulation, a scheduler
+// using it keeps the simulation deterministic.
+type Scheduler interface {
+	Schedule(msg *Message, rng *rand.Rand) (delay time.Duration, drop bool)
+}
+
+// Delay delivers every message after the same delay
+type Delay time.Duration
+
+// Schedule implements Scheduler
+func (d Delay) Schedule(*Message, *rand.Rand) (time.Duration, bool) {
+	return time.Duration(d), false
+}
+
+// Reorder delivers every message after a random delay below Max, so that
+// messages overtake each other
+type Reorder struct {
+	Max time.Duration
+}
+
+// Schedule implements Scheduler
+func (r *Reorder) Schedule(_ *Message, rng *rand.Rand) (time.Duration, bool) {
+	if r.Max <= 0 {
+		return 0, false
+	}
+	return time.Duration(rng.Int63n(int64(r.Max))), false
+}
+
+// Drop drops every message with the probability Rate
+type Drop struct {
+	Rate float64
+}
+
+// Schedule implements Scheduler
+func (d *Drop) Schedule(_ *Message, rng *rand.Rand) (time.Duration, bool) {
+	return 0, rng.Float64() < d.Rate
+}
+
+// Partition drops the messages sent between different groups of validators
+// from From until Until, both measured from the start of the simulation. A
+// validator in no group is cut off from every other validator. An Until of
+// zero keeps the partition for good.
+type Partition struct {
+	Groups [][]int
+	From   time.Duration
+	Until  time.Duration
+}
+
+// Schedule implements Scheduler
+func (p *Partition) Schedule(msg *Message, _ *rand.Rand) (time.Duration, bool) {
+	elapsed := msg.Sent.Sub(clockStart)
+	if elapsed < p.From || (p.Until != 0 && elapsed >= p.Until) {
+		return 0, false
+	}
+	from, to := p.group(msg.From), p.group(msg.To)
+	return 0, from < 0 || from != to
+}
+
+func (p *Partition) group(validator int) int {
+	for i, g := range p.Groups {
+		for _, v := range g {
+			if v == validator {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// Schedulers applies several schedulers to every message. A message is
+// dropped if any of them drops it and is delayed by the sum of their delays
+// otherwise.
+type Schedulers []Scheduler
+
+// Schedule implements Scheduler
+func (s Schedulers) Schedule(msg *Message, rng *rand.Rand) (time.Duration, bool) {
+	total := time.Duration(0)
+	for _, scheduler := range s {
+		delay, drop := scheduler.Schedule(msg, rng)
+		if drop {
+			return 0, true
+		}
+		total += delay
+	}
+	return total, false
+}
+
+// delivery is a message in flight
+type delivery struct {
+	msg *Message
+	at  time.Time
+}
+
+// network holds the messages in flight, ordered by the time they arrive at
+// and then by the order they were sent in
+type network struct {
+	scheduler Scheduler
+	rng       *rand.Rand
+	queue     []*delivery
+}
+
+// send schedules msg and reports whether it was dropped
+func (n *network) send(msg *Message) bool {
+	delay := time.Duration(0)
+	if n.scheduler != nil {
+		var drop bool
+		delay, drop = n.scheduler.Schedule(msg, n.rng)
+		if drop {
+			return true
+		}
+	}
+	d := &delivery{msg: msg, at: msg.Sent.Add(delay)}
+	// insert after the messages arriving at the same time
+	i := len(n.queue)
+	for i > 0 && n.queue[i-1].at.After(d.at) {
+		i--
+	}
+	n.queue = append(n.queue, nil)
+	copy(n.queue[i+1:], n.queue[i:])
+	n.queue[i] = d
+	return false
+}
+
+// due removes and returns the messages which arrive until now
+func (n *network) due(now time.Time) []*Message {
+	msgs := []*Message{}
+	for len(n.queue) > 0 && !n.queue[0].at.After(now) {
+		msgs = append(msgs, n.queue[0].msg)
+		n.queue = n.queue[1:]
+	}
+	return msgs
+}
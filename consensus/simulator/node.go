@@ -0,0 +1,286 @@
+package simulator
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/MadBase/MadNet/consensus/admin"
+	"github.com/MadBase/MadNet/consensus/appmock"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/dman"
+	"github.com/MadBase/MadNet/consensus/lstate"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/ipc"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// binaryMarshaler is implemented by every consensus object
+type binaryMarshaler interface {
+	MarshalBinary() ([]byte, error)
+}
+
+// application is appmock with the state root of the genesis block, which
+// appmock leaves empty
+type application struct {
+	*appmock.MockApplication
+}
+
+// ApplyState returns an empty state root
+func (a *application) ApplyState(*badger.Txn, uint32, uint32, []interfaces.Transaction) ([]byte, error) {
+	return make([]byte, constants.HashLen), nil
+}
+
+// node is a validator of a simulation. It runs an engine on appmock and an
+// in memory database; the objects of the other validators only reach it
+// through the simulated network.
+type node struct {
+	sync.Mutex
+	index      int
+	keys       *validatorKeys
+	logger     *logrus.Logger
+	closeChan  chan struct{}
+	closeOnce  sync.Once
+	database   *db.Database
+	engine     *lstate.Engine
+	handlers   *lstate.Handlers
+	admin      *admin.Handlers
+	sstore     *lstate.Store
+	equivocate bool
+	// gossip holds the value last cast for each kind of gossiped object
+	// and the messages which carried it, which are sent again on every
+	// regossip
+	gossip [][]byte
+	sent   [][]*Message
+	// alts are the conflicting proposals of an equivocating validator
+	alts []*objs.Proposal
+	// checked is the last height checked for safety
+	checked uint32
+}
+
+func newNode(chainID uint32, index int, keys *validatorKeys, vs *objs.ValidatorSet, equivocate bool, clock *Clock) (*node, error) {
+	n := &node{
+		index:      index,
+		keys:       keys,
+		logger:     logging.GetLogger(constants.LoggerConsensus),
+		closeChan:  make(chan struct{}),
+		database:   &db.Database{},
+		engine:     &lstate.Engine{},
+		handlers:   &lstate.Handlers{},
+		admin:      &admin.Handlers{},
+		sstore:     &lstate.Store{},
+		equivocate: equivocate,
+		gossip:     make([][]byte, gossipKinds),
+		sent:       make([][]*Message, gossipKinds),
+	}
+	rawDB, err := utils.OpenBadger(n.closeChan, "", true)
+	if err != nil {
+		return nil, err
+	}
+	n.database.Init(rawDB)
+	n.sstore.Init(n.database)
+	storage := &dynamics.Storage{}
+	if err := storage.Init(n.database, n.logger); err != nil {
+		n.close()
+		return nil, err
+	}
+	storage.Start()
+	// appmock proposes the state root of its valid value, every validator
+	// proposes a different one so that the blocks of the rounds differ
+	app := &application{appmock.New()}
+	app.SetNextValidValue(&objs.Proposal{PClaims: &objs.PClaims{BClaims: &objs.BClaims{
+		StateRoot: crypto.Hasher([]byte(fmt.Sprintf("simulator state %d", index))),
+	}}})
+	dm := &dman.DMan{}
+	dm.Init(n.database, app, nil)
+	n.admin.Init(chainID, n.database, crypto.Hasher(keys.secpPrivKey), app, keys.publicKey, storage, ipc.NewServer(""))
+	n.engine.Init(n.database, dm, app, keys.secpSigner, n.admin, keys.publicKey, nil, storage)
+	// the step timers of the validator run on the virtual clock
+	n.admin.SetClock(clock.Now)
+	n.engine.SetClock(clock.Now)
+	n.handlers.Init(n.database, dm)
+
+	// the admin handlers take the lock the synchronizer would hand them
+	go func() {
+		for {
+			select {
+			case n.admin.ReceiveLock <- n:
+			case <-n.closeChan:
+				return
+			}
+		}
+	}()
+	if err := n.admin.AddPrivateKey(keys.groupPrivk, constants.CurveBN256Eth); err != nil {
+		n.close()
+		return nil, err
+	}
+	if err := n.admin.AddValidatorSet(vs); err != nil {
+		n.close()
+		return nil, err
+	}
+	return n, nil
+}
+
+func (n *node) close() {
+	n.closeOnce.Do(func() {
+		n.admin.Close()
+		close(n.closeChan)
+	})
+}
+
+// step runs the engine once at the virtual now of the simulation
+func (n *node) step() {
+	n.Lock()
+	defer n.Unlock()
+	if _, err := n.engine.UpdateLocalState(); err != nil {
+		utils.DebugTrace(n.logger, err)
+	}
+}
+
+// receive hands an object of another validator to the node, like the
+// gossip handlers do. It reports whether the object was accepted.
+func (n *node) receive(v interface{}) bool {
+	if err := n.handlers.PreValidate(v); err != nil {
+		return false
+	}
+	n.Lock()
+	defer n.Unlock()
+	if err := n.handlers.Store(v); err != nil {
+		return false
+	}
+	return true
+}
+
+// gossipKinds is the number of kinds of objects a validator gossips
+const gossipKinds = 7
+
+// gossipValues returns the objects last cast by the node, indexed by kind
+func (n *node) gossipValues() ([]binaryMarshaler, error) {
+	var values []binaryMarshaler
+	err := n.database.View(func(txn *badger.Txn) error {
+		p, pv, pvn, pc, pcn, nr, nh, err := n.sstore.GetGossipValues(txn)
+		if err != nil {
+			return err
+		}
+		values = make([]binaryMarshaler, gossipKinds)
+		// typed nil pointers are kept out of the interfaces
+		if p != nil {
+			values[0] = p
+		}
+		if pv != nil {
+			values[1] = pv
+		}
+		if pvn != nil {
+			values[2] = pvn
+		}
+		if pc != nil {
+			values[3] = pc
+		}
+		if pcn != nil {
+			values[4] = pcn
+		}
+		if nr != nil {
+			values[5] = nr
+		}
+		if nh != nil {
+			values[6] = nh
+		}
+		return nil
+	})
+	return values, err
+}
+
+// newCasts returns the objects the node cast since it was last asked
+func (n *node) newCasts() ([]int, []binaryMarshaler, error) {
+	values, err := n.gossipValues()
+	if err != nil {
+		return nil, nil, err
+	}
+	kinds := []int{}
+	casts := []binaryMarshaler{}
+	for kind, v := range values {
+		if v == nil {
+			continue
+		}
+		b, err := v.MarshalBinary()
+		if err != nil {
+			return nil, nil, err
+		}
+		if bytes.Equal(b, n.gossip[kind]) {
+			continue
+		}
+		n.gossip[kind] = b
+		kinds = append(kinds, kind)
+		casts = append(casts, v)
+	}
+	return kinds, casts, nil
+}
+
+// height returns the height the node is synchronized to
+func (n *node) height() (uint32, error) {
+	var height uint32
+	err := n.database.View(func(txn *badger.Txn) error {
+		os, err := n.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		height = os.SyncToBH.BClaims.Height
+		return nil
+	})
+	return height, err
+}
+
+// committed returns the hashes of the blocks the node committed above the
+// last height checked for safety, indexed from that height plus one
+func (n *node) committed() (uint32, [][]byte, error) {
+	from := n.checked + 1
+	hashes := [][]byte{}
+	err := n.database.View(func(txn *badger.Txn) error {
+		os, err := n.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		for height := from; height <= os.SyncToBH.BClaims.Height; height++ {
+			bh, err := n.database.GetCommittedBlockHeader(txn, height)
+			if err != nil {
+				return err
+			}
+			hash, err := bh.BlockHash()
+			if err != nil {
+				return err
+			}
+			hashes = append(hashes, hash)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	n.checked += uint32(len(hashes))
+	return from, hashes, nil
+}
+
+// conflicting returns a proposal for the round of p with another block,
+// signed by the node
+func (n *node) conflicting(p *objs.Proposal) (*objs.Proposal, error) {
+	b, err := p.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	alt := &objs.Proposal{}
+	if err := alt.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	alt.PClaims.BClaims.StateRoot = crypto.Hasher(alt.PClaims.BClaims.StateRoot, []byte("equivocation"))
+	if err := alt.Sign(n.keys.secpSigner); err != nil {
+		return nil, err
+	}
+	return alt, nil
+}
@@ -0,0 +1,335 @@
+// Package simulator runs several consensus engines in a single process on a
+// virtual clock and a virtual network. The network delivers the objects the
+// engines cast as a scheduler decides, which may delay, drop, reorder or
+// partition them, and validators may be made to equivocate. Every run with
+// the same configuration behaves the same, so safety and liveness can be
+// asserted from go test.
+//
+// The engines run on appmock and only exchange the objects of the gossip
+// protocol. A validator more than one height behind needs the block
+// download of the request protocol to catch up, which is not simulated, so
+// it stays behind.
+package simulator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/errorz"
+)
+
+var (
+	// ErrSafety is returned once two validators committed different blocks
+	// at the same height
+	ErrSafety = errors.New("different blocks were committed at the same height")
+
+	// ErrLiveness is returned when the validators did not reach a height
+	// within the time allowed
+	ErrLiveness = errors.New("the height was not reached in time")
+)
+
+// Default settings of a simulation, those of the synchronizer of a node
+const (
+	DefaultTick     = 200 * time.Millisecond
+	DefaultReGossip = 9 * constants.MsgTimeout
+)
+
+// Config holds the settings of a simulation
+type Config struct {
+	// Validators is the number of validators
+	Validators int
+	// ChainID is the id of the chain
+	ChainID uint32
+	// Seed seeds the keys of the validators and the random source of the
+	// scheduler
+	Seed int64
+	// Scheduler decides the fate of the messages, all messages are
+	// delivered at the next tick if nil
+	Scheduler Scheduler
+	// Equivocators are the validators which send a proposal to half of the
+	// other validators and a conflicting one, prevoted as well, to the other
+	// half
+	Equivocators []int
+	// Tick is the interval the engines are run at
+	Tick time.Duration
+	// ReGossip is the interval every validator sends its last objects again
+	// at, zero disables it
+	ReGossip time.Duration
+}
+
+// Stats counts the messages of a simulation
+type Stats struct {
+	// Sent counts the messages sent, including the ones dropped
+	Sent int
+	// Dropped counts the messages the scheduler dropped
+	Dropped int
+	// Delivered counts the messages the receiving validator accepted and
+	// Rejected the ones it did not, such as objects of a past round
+	Delivered int
+	Rejected  int
+}
+
+// Simulator runs a simulation
+type Simulator struct {
+	cfg          Config
+	clock        *Clock
+	network      *network
+	nodes        []*node
+	committed    map[uint32][]byte
+	nextReGossip time.Time
+	stats        Stats
+}
+
+// New sets up the validators of a simulation
+func New(cfg Config) (*Simulator, error) {
+	if cfg.Validators < 1 {
+		return nil, errorz.ErrInvalid{}.New("a simulation needs at least one validator")
+	}
+	if cfg.Tick <= 0 {
+		return nil, errorz.ErrInvalid{}.New("the tick of a simulation must be positive")
+	}
+	equivocators := make(map[int]bool)
+	for _, i := range cfg.Equivocators {
+		if i < 0 || i >= cfg.Validators {
+			return nil, errorz.ErrInvalid{}.New(fmt.Sprintf("no validator %d to equivocate", i))
+		}
+		equivocators[i] = true
+	}
+	keys, vs, err := makeKeys(cfg.Validators, cfg.Seed)
+	if err != nil {
+		return nil, err
+	}
+	s := &Simulator{
+		cfg:   cfg,
+		clock: newClock(),
+		network: &network{
+			scheduler: cfg.Scheduler,
+			rng:       rand.New(rand.NewSource(cfg.Seed)),
+		},
+		committed: make(map[uint32][]byte),
+	}
+	s.nextReGossip = s.clock.Now().Add(cfg.ReGossip)
+	for i := range keys {
+		// AddValidatorSet updates the set it is given
+		vsCopy := &objs.ValidatorSet{}
+		vsBytes, err := vs.MarshalBinary()
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+		if err := vsCopy.UnmarshalBinary(vsBytes); err != nil {
+			s.Close()
+			return nil, err
+		}
+		n, err := newNode(cfg.ChainID, i, keys[i], vsCopy, equivocators[i], s.clock)
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+		s.nodes = append(s.nodes, n)
+	}
+	return s, nil
+}
+
+// Close stops the validators
+func (s *Simulator) Close() {
+	for _, n := range s.nodes {
+		n.close()
+	}
+}
+
+// Clock returns the virtual clock of the simulation
+func (s *Simulator) Clock() *Clock {
+	return s.clock
+}
+
+// Stats returns the message counts of the simulation
+func (s *Simulator) Stats() Stats {
+	return s.stats
+}
+
+// Height returns the height validator i is synchronized to
+func (s *Simulator) Height(i int) (uint32, error) {
+	return s.nodes[i].height()
+}
+
+// Run runs the simulation for d of virtual time. ErrSafety is returned as
+// soon as two validators committed different blocks at a height.
+func (s *Simulator) Run(d time.Duration) error {
+	end := s.clock.Now().Add(d)
+	for s.clock.Now().Before(end) {
+		if err := s.tick(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunUntilHeight runs the simulation until the validators reached height
+// and returns the virtual time it took. All validators but the
+// equivocators are waited for if none are given. ErrLiveness is returned
+// if the height is not reached within limit.
+func (s *Simulator) RunUntilHeight(height uint32, limit time.Duration, validators ...int) (time.Duration, error) {
+	if len(validators) == 0 {
+		for _, n := range s.nodes {
+			if !n.equivocate {
+				validators = append(validators, n.index)
+			}
+		}
+	}
+	start := s.clock.Now()
+	for {
+		reached := true
+		for _, i := range validators {
+			h, err := s.Height(i)
+			if err != nil {
+				return 0, err
+			}
+			if h < height {
+				reached = false
+				break
+			}
+		}
+		elapsed := s.clock.Now().Sub(start)
+		if reached {
+			return elapsed, nil
+		}
+		if elapsed >= limit {
+			return elapsed, fmt.Errorf("%w: height %d not reached after %v", ErrLiveness, height, limit)
+		}
+		if err := s.tick(); err != nil {
+			return elapsed, err
+		}
+	}
+}
+
+// tick delivers the messages which arrived, then runs every engine once
+// and sends what it cast
+func (s *Simulator) tick() error {
+	for _, msg := range s.network.due(s.clock.Now()) {
+		if s.nodes[msg.To].receive(msg.Object) {
+			s.stats.Delivered++
+		} else {
+			s.stats.Rejected++
+		}
+	}
+	for _, n := range s.nodes {
+		n.step()
+		kinds, casts, err := n.newCasts()
+		if err != nil {
+			return err
+		}
+		for i, v := range casts {
+			if err := s.cast(n, kinds[i], v); err != nil {
+				return err
+			}
+		}
+		if err := s.checkSafety(n); err != nil {
+			return err
+		}
+	}
+	if s.cfg.ReGossip > 0 && !s.clock.Now().Before(s.nextReGossip) {
+		s.reGossip()
+		s.nextReGossip = s.clock.Now().Add(s.cfg.ReGossip)
+	}
+	s.clock.advance(s.cfg.Tick)
+	return nil
+}
+
+// cast sends an object cast by n to every other validator
+func (s *Simulator) cast(n *node, kind int, v binaryMarshaler) error {
+	alt, err := s.equivocation(n, v)
+	if err != nil {
+		return err
+	}
+	msgs := []*Message{}
+	for _, peer := range s.nodes {
+		if peer == n {
+			continue
+		}
+		var obj interface{} = v
+		if alt != nil && peer.index%2 == 1 {
+			obj = alt
+		}
+		msgs = append(msgs, &Message{From: n.index, To: peer.index, Object: obj})
+	}
+	n.sent[kind] = msgs
+	s.send(msgs)
+	return nil
+}
+
+// equivocation returns the object an equivocating validator sends in place
+// of v to half of the validators, nil if it sends v to all of them
+func (s *Simulator) equivocation(n *node, v binaryMarshaler) (interface{}, error) {
+	if !n.equivocate {
+		return nil, nil
+	}
+	switch obj := v.(type) {
+	case *objs.Proposal:
+		alt, err := n.conflicting(obj)
+		if err != nil {
+			return nil, err
+		}
+		n.alts = append(n.alts, alt)
+		return alt, nil
+	case *objs.PreVote:
+		rc := obj.Proposal.PClaims.RCert.RClaims
+		for _, alt := range n.alts {
+			altRC := alt.PClaims.RCert.RClaims
+			if altRC.Height == rc.Height && altRC.Round == rc.Round {
+				return alt.PreVote(n.keys.secpSigner)
+			}
+		}
+	}
+	return nil, nil
+}
+
+// reGossip sends the last objects of every validator again, like the
+// regossip of the gossip client does
+func (s *Simulator) reGossip() {
+	for _, n := range s.nodes {
+		for _, msgs := range n.sent {
+			again := []*Message{}
+			for _, msg := range msgs {
+				again = append(again, &Message{From: msg.From, To: msg.To, Object: msg.Object})
+			}
+			s.send(again)
+		}
+	}
+}
+
+func (s *Simulator) send(msgs []*Message) {
+	for _, msg := range msgs {
+		msg.Sent = s.clock.Now()
+		s.stats.Sent++
+		if s.network.send(msg) {
+			s.stats.Dropped++
+		}
+	}
+}
+
+// checkSafety compares the blocks n committed since it was last checked
+// with the blocks the other validators committed at the same heights
+func (s *Simulator) checkSafety(n *node) error {
+	from, hashes, err := n.committed()
+	if err != nil {
+		return err
+	}
+	for i, hash := range hashes {
+		height := from + uint32(i)
+		first, ok := s.committed[height]
+		if !ok {
+			s.committed[height] = hash
+			continue
+		}
+		if !bytes.Equal(first, hash) {
+			return fmt.Errorf("%w: validator %d committed %x at height %d, %x was committed before", ErrSafety, n.index, hash, height, first)
+		}
+	}
+	return nil
+}
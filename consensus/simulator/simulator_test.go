@@ -0,0 +1,217 @@
+package simulator
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/consensus/lstate"
+	"github.com/dgraph-io/badger/v2"
+)
+
+func newSimulator(t *testing.T, cfg Config) *Simulator {
+	t.Helper()
+	if cfg.Validators == 0 {
+		cfg.Validators = 4
+	}
+	if cfg.ChainID == 0 {
+		cfg.ChainID = 42
+	}
+	if cfg.Tick == 0 {
+		cfg.Tick = DefaultTick
+	}
+	s, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestSimulatorLiveness(t *testing.T) {
+	s := newSimulator(t, Config{Seed: 1})
+	elapsed, err := s.RunUntilHeight(6, 2*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Stats().Dropped != 0 {
+		t.Fatal("messages dropped without a scheduler")
+	}
+	t.Logf("height 6 after %v, %+v", elapsed, s.Stats())
+}
+
+func TestSimulatorDeterministic(t *testing.T) {
+	cfg := Config{Seed: 2, Scheduler: &Reorder{Max: 2 * time.Second}}
+	run := func() (time.Duration, Stats, map[uint32][]byte) {
+		s := newSimulator(t, cfg)
+		elapsed, err := s.RunUntilHeight(4, 2*time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return elapsed, s.Stats(), s.committed
+	}
+	elapsed1, stats1, committed1 := run()
+	elapsed2, stats2, committed2 := run()
+	if elapsed1 != elapsed2 || stats1 != stats2 {
+		t.Fatalf("runs differ: %v %+v and %v %+v", elapsed1, stats1, elapsed2, stats2)
+	}
+	for height, hash := range committed1 {
+		if !bytes.Equal(hash, committed2[height]) {
+			t.Fatalf("runs committed different blocks at height %d", height)
+		}
+	}
+}
+
+func TestSimulatorDelay(t *testing.T) {
+	s := newSimulator(t, Config{Seed: 3, Scheduler: Delay(time.Second)})
+	if _, err := s.RunUntilHeight(4, 2*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSimulatorDropAndReorder(t *testing.T) {
+	s := newSimulator(t, Config{
+		Seed:      4,
+		Scheduler: Schedulers{&Drop{Rate: 0.2}, &Reorder{Max: 3 * time.Second}},
+		ReGossip:  5 * time.Second,
+	})
+	if _, err := s.RunUntilHeight(4, 5*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if s.Stats().Dropped == 0 {
+		t.Fatal("no message dropped")
+	}
+}
+
+func TestSimulatorPartition(t *testing.T) {
+	// two halves of two validators, neither can make blocks
+	s := newSimulator(t, Config{
+		Seed: 5,
+		Scheduler: &Partition{
+			Groups: [][]int{{0, 1}, {2, 3}},
+			From:   0,
+			Until:  time.Minute,
+		},
+		ReGossip: DefaultReGossip,
+	})
+	if err := s.Run(time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 4; i++ {
+		h, err := s.Height(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h != 1 {
+			t.Fatalf("validator %d made a block during the partition", i)
+		}
+	}
+	// once healed the validators carry on
+	if _, err := s.RunUntilHeight(3, 3*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSimulatorMinorityPartition(t *testing.T) {
+	// the three validators of the majority carry on without the fourth
+	s := newSimulator(t, Config{
+		Seed:      6,
+		Scheduler: &Partition{Groups: [][]int{{0, 1, 2}}},
+	})
+	if _, err := s.RunUntilHeight(5, 3*time.Minute, 0, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	h, err := s.Height(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h != 1 {
+		t.Fatal("the isolated validator made a block")
+	}
+}
+
+func TestSimulatorEquivocation(t *testing.T) {
+	for _, seed := range []int64{7, 8} {
+		s := newSimulator(t, Config{
+			Seed:         seed,
+			Equivocators: []int{1},
+			Scheduler:    &Reorder{Max: time.Second},
+			ReGossip:     DefaultReGossip,
+		})
+		// RunUntilHeight fails with ErrSafety once two blocks are committed
+		// at a height, and with ErrLiveness if the honest validators do not
+		// get past the conflicting proposals in time
+		if _, err := s.RunUntilHeight(4, 2*time.Minute, 0, 2, 3); err != nil {
+			t.Fatalf("seed %d: %v", seed, err)
+		}
+		if len(s.nodes[1].alts) == 0 {
+			t.Fatalf("seed %d: validator 1 cast no conflicting proposal", seed)
+		}
+	}
+}
+
+func TestSimulatorSafetyCheck(t *testing.T) {
+	s := newSimulator(t, Config{Seed: 11})
+	if _, err := s.RunUntilHeight(3, 2*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	// pretend another block was committed at height 2 first
+	s.committed[2] = make([]byte, len(s.committed[2]))
+	s.nodes[0].checked = 1
+	if err := s.checkSafety(s.nodes[0]); !errors.Is(err, ErrSafety) {
+		t.Fatalf("expected ErrSafety, got %v", err)
+	}
+}
+
+func TestSimulatorLivenessBound(t *testing.T) {
+	s := newSimulator(t, Config{Seed: 10, Scheduler: &Partition{Groups: [][]int{{0, 1}, {2, 3}}}})
+	_, err := s.RunUntilHeight(2, 30*time.Second)
+	if !errors.Is(err, ErrLiveness) {
+		t.Fatalf("expected ErrLiveness, got %v", err)
+	}
+}
+
+func TestSimulatorConfig(t *testing.T) {
+	if _, err := New(Config{Tick: DefaultTick}); err == nil {
+		t.Fatal("expected an error without validators")
+	}
+	if _, err := New(Config{Validators: 4}); err == nil {
+		t.Fatal("expected an error without a tick")
+	}
+	if _, err := New(Config{Validators: 4, Tick: DefaultTick, Equivocators: []int{4}}); err == nil {
+		t.Fatal("expected an error for an unknown equivocator")
+	}
+}
+
+func TestSimulatorRoundStatesSummary(t *testing.T) {
+	s := newSimulator(t, Config{Seed: 12})
+	if _, err := s.RunUntilHeight(2, 2*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	n := s.nodes[0]
+	var summary *lstate.RoundStatesSummary
+	err := n.database.View(func(txn *badger.Txn) error {
+		rs, err := n.sstore.LoadLocalState(txn)
+		if err != nil {
+			return err
+		}
+		summary = rs.Summary()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !summary.IsValidator || summary.SyncToHeight < 2 || summary.Proposer == "" {
+		t.Fatalf("unexpected summary %+v", summary)
+	}
+	if len(summary.Validators) != 4 {
+		t.Fatalf("expected 4 validators, got %d", len(summary.Validators))
+	}
+	// the other validators may be a height behind
+	for _, v := range summary.Validators {
+		if v.Height > summary.Height || v.Height+1 < summary.Height {
+			t.Fatalf("validator %v at height %d, the node is at %d", v.VAddr, v.Height, summary.Height)
+		}
+	}
+}
@@ -0,0 +1,280 @@
+package stats
+
+import (
+	"encoding/hex"
+
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/constants/dbprefix"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/metrics"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// maxHeightsPerRun is the most heights indexed by one call of Index
+const maxHeightsPerRun = 1024
+
+var (
+	epochHeights = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "validator_stats",
+		Name:      "epoch_heights",
+		Help:      "Number of heights of the current epoch which were indexed.",
+	})
+	epochRounds = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "validator_stats",
+		Name:      "epoch_rounds",
+		Help:      "Number of rounds the indexed heights of the current epoch took.",
+	})
+	validatorRounds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "validator_stats",
+		Name:      "rounds",
+		Help:      "Number of rounds of the current epoch a validator was expected to vote in.",
+	}, []string{"validator"})
+	validatorProposerRounds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "validator_stats",
+		Name:      "proposer_rounds",
+		Help:      "Number of rounds of the current epoch in which a validator was the proposer.",
+	}, []string{"validator"})
+	validatorProposals = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "validator_stats",
+		Name:      "proposals",
+		Help:      "Number of proposer rounds of the current epoch in which the proposal of a validator was seen.",
+	}, []string{"validator"})
+	validatorPreVotes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "validator_stats",
+		Name:      "prevotes",
+		Help:      "Number of rounds of the current epoch in which a PreVote or PreVoteNil of a validator was seen.",
+	}, []string{"validator"})
+	validatorPreCommits = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "validator_stats",
+		Name:      "precommits",
+		Help:      "Number of rounds of the current epoch in which a PreCommit or PreCommitNil of a validator was seen.",
+	}, []string{"validator"})
+)
+
+func init() {
+	metrics.MustRegister(
+		epochHeights,
+		epochRounds,
+		validatorRounds,
+		validatorProposerRounds,
+		validatorProposals,
+		validatorPreVotes,
+		validatorPreCommits,
+	)
+}
+
+// Indexer records the statistics of each committed height from the historic
+// round states of the height. It must index a height before the evidence
+// pool drops its round states.
+type Indexer struct {
+	database *db.Database
+	logger   *logrus.Logger
+}
+
+// Init will initialize the Indexer
+func (ix *Indexer) Init(database *db.Database) {
+	ix.logger = logging.GetLogger(constants.LoggerConsensus)
+	ix.database = database
+}
+
+// Index records the statistics of the committed heights which have not been
+// indexed yet. The first call starts at the most recently committed height,
+// as the round states of older heights are not known to a node which has
+// just joined. Errors are logged so that the caller keeps running.
+func (ix *Indexer) Index() error {
+	var current *EpochStats
+	err := ix.database.Update(func(txn *badger.Txn) error {
+		os, err := ix.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		committed := os.SyncToBH.BClaims.Height
+		last, err := getLastHeight(txn)
+		if err != nil {
+			if err != badger.ErrKeyNotFound {
+				return err
+			}
+			last = committed - 1
+		}
+		if last >= committed {
+			return nil
+		}
+		end := committed
+		if end-last > maxHeightsPerRun {
+			end = last + maxHeightsPerRun
+		}
+		for height := last + 1; height <= end; height++ {
+			if err := ix.indexHeight(txn, height); err != nil {
+				return err
+			}
+		}
+		if err := setLastHeight(txn, end); err != nil {
+			return err
+		}
+		current, err = GetEpochStats(txn, utils.Epoch(end))
+		if err == badger.ErrKeyNotFound {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		ix.logger.Warnf("Could not index the validator statistics: %v", err)
+		return nil
+	}
+	if current != nil {
+		updateMetrics(current)
+	}
+	return nil
+}
+
+// Rollback removes the statistics of the heights above height when the
+// chain is rolled back to height. The statistics of the epoch of height are
+// rebuilt from the historic round states of its heights up to height; the
+// heights whose round states the evidence pool has dropped since they were
+// indexed are no longer counted. Nothing is done if no height above height
+// was indexed.
+func (ix *Indexer) Rollback(txn *badger.Txn, height uint32) error {
+	last, err := getLastHeight(txn)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil
+		}
+		return err
+	}
+	if last <= height {
+		return nil
+	}
+	epoch := utils.Epoch(height)
+	for e := epoch; e <= utils.Epoch(last); e++ {
+		if err := utils.DeleteValue(txn, makeEpochKey(e)); err != nil {
+			return err
+		}
+	}
+	for h := (epoch-1)*constants.EpochLength + 1; h <= height; h++ {
+		if err := ix.indexHeight(txn, h); err != nil {
+			return err
+		}
+	}
+	return setLastHeight(txn, height)
+}
+
+// indexHeight adds the statistics of height to the statistics of its epoch.
+// A height without any round states was not seen being decided and is
+// skipped.
+func (ix *Indexer) indexHeight(txn *badger.Txn, height uint32) error {
+	states, err := ix.database.GetHistoricRoundStates(txn, height)
+	if err != nil {
+		return err
+	}
+	if len(states) == 0 {
+		return nil
+	}
+	vs, err := ix.database.GetValidatorSet(txn, height)
+	if err != nil {
+		return err
+	}
+	byVAddr := make(map[string]map[uint32]*objs.RoundState)
+	maxRound := uint32(0)
+	for _, rs := range states {
+		if rs.RCert == nil || rs.RCert.RClaims == nil {
+			continue
+		}
+		round := rs.RCert.RClaims.Round
+		if byVAddr[string(rs.VAddr)] == nil {
+			byVAddr[string(rs.VAddr)] = make(map[uint32]*objs.RoundState)
+		}
+		byVAddr[string(rs.VAddr)][round] = rs
+		if round > maxRound {
+			maxRound = round
+		}
+	}
+	rounds, err := ix.database.GetCommittedBlockRound(txn, height)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			return err
+		}
+		rounds = maxRound
+	}
+	if rounds == 0 {
+		return nil
+	}
+	epoch := utils.Epoch(height)
+	es, err := GetEpochStats(txn, epoch)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			return err
+		}
+		es = &EpochStats{Epoch: epoch}
+	}
+	es.recordHeight(vs, height, rounds, byVAddr)
+	return setEpochStats(txn, es)
+}
+
+// GetEpochStats returns the statistics of epoch
+func GetEpochStats(txn *badger.Txn, epoch uint32) (*EpochStats, error) {
+	v, err := utils.GetValue(txn, makeEpochKey(epoch))
+	if err != nil {
+		return nil, err
+	}
+	es := &EpochStats{}
+	if err := es.UnmarshalBinary(v); err != nil {
+		return nil, err
+	}
+	return es, nil
+}
+
+func setEpochStats(txn *badger.Txn, es *EpochStats) error {
+	v, err := es.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return utils.SetValue(txn, makeEpochKey(es.Epoch), v)
+}
+
+func getLastHeight(txn *badger.Txn) (uint32, error) {
+	v, err := utils.GetValue(txn, dbprefix.PrefixValidatorStatsHeight())
+	if err != nil {
+		return 0, err
+	}
+	return utils.UnmarshalUint32(v)
+}
+
+func setLastHeight(txn *badger.Txn, height uint32) error {
+	return utils.SetValue(txn, dbprefix.PrefixValidatorStatsHeight(), utils.MarshalUint32(height))
+}
+
+func makeEpochKey(epoch uint32) []byte {
+	key := []byte{}
+	key = append(key, dbprefix.PrefixValidatorStatsEpoch()...)
+	key = append(key, utils.MarshalUint32(epoch)...)
+	return key
+}
+
+// updateMetrics exports the statistics of the current epoch
+func updateMetrics(es *EpochStats) {
+	epochHeights.Set(float64(es.Heights))
+	epochRounds.Set(float64(es.Rounds))
+	for _, gv := range []*prometheus.GaugeVec{validatorRounds, validatorProposerRounds, validatorProposals, validatorPreVotes, validatorPreCommits} {
+		gv.Reset()
+	}
+	for _, v := range es.Validators {
+		vAddr := hex.EncodeToString(v.VAddr)
+		validatorRounds.WithLabelValues(vAddr).Set(float64(v.Rounds))
+		validatorProposerRounds.WithLabelValues(vAddr).Set(float64(v.ProposerRounds))
+		validatorProposals.WithLabelValues(vAddr).Set(float64(v.Proposals))
+		validatorPreVotes.WithLabelValues(vAddr).Set(float64(v.PreVotes))
+		validatorPreCommits.WithLabelValues(vAddr).Set(float64(v.PreCommits))
+	}
+}
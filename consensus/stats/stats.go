@@ -0,0 +1,156 @@
+// Package stats keeps statistics of how the validators take part in
+// consensus. The statistics are computed from the historic round states of
+// each committed height and rolled up per epoch.
+package stats
+
+import (
+	"bytes"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/utils"
+)
+
+// ValidatorStats counts the rounds in which a validator took part during an
+// epoch. A round counts towards Rounds for every validator of the validator
+// set of its height; the proposer of a round is given by objs.GetProposerIdx.
+type ValidatorStats struct {
+	VAddr []byte
+	// Heights is the number of indexed heights at which the validator was
+	// in the validator set
+	Heights uint32
+	// Rounds is the number of rounds the validator was expected to vote in
+	Rounds uint32
+	// ProposerRounds is the number of rounds in which the validator was the
+	// proposer
+	ProposerRounds uint32
+	// Proposals is the number of ProposerRounds in which its proposal was
+	// seen
+	Proposals uint32
+	// PreVotes is the number of rounds in which its PreVote or PreVoteNil
+	// was seen
+	PreVotes uint32
+	// PreCommits is the number of rounds in which its PreCommit or
+	// PreCommitNil was seen
+	PreCommits uint32
+}
+
+const validatorStatsLen = constants.OwnerLen + 6*4
+
+// MissedProposals returns the number of rounds in which the validator was
+// the proposer but no proposal of it was seen
+func (vs *ValidatorStats) MissedProposals() uint32 {
+	return vs.ProposerRounds - vs.Proposals
+}
+
+// EpochStats holds the statistics of the indexed heights of an epoch
+type EpochStats struct {
+	Epoch uint32
+	// Heights is the number of heights of the epoch which were indexed.
+	// Heights which the node did not see being decided, such as the ones
+	// it downloaded while syncing, are not indexed.
+	Heights uint32
+	// Rounds is the number of rounds the indexed heights took
+	Rounds     uint32
+	Validators []*ValidatorStats
+}
+
+// RoundChanges returns the number of times the indexed heights moved to a
+// later round
+func (es *EpochStats) RoundChanges() uint32 {
+	return es.Rounds - es.Heights
+}
+
+// MarshalBinary returns the byte slice for the EpochStats object
+func (es *EpochStats) MarshalBinary() ([]byte, error) {
+	if es == nil {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	buf := make([]byte, 0, 12+len(es.Validators)*validatorStatsLen)
+	buf = append(buf, utils.MarshalUint32(es.Epoch)...)
+	buf = append(buf, utils.MarshalUint32(es.Heights)...)
+	buf = append(buf, utils.MarshalUint32(es.Rounds)...)
+	for _, v := range es.Validators {
+		if len(v.VAddr) != constants.OwnerLen {
+			return nil, errorz.ErrInvalid{}.New("invalid VAddr length")
+		}
+		buf = append(buf, v.VAddr...)
+		for _, n := range []uint32{v.Heights, v.Rounds, v.ProposerRounds, v.Proposals, v.PreVotes, v.PreCommits} {
+			buf = append(buf, utils.MarshalUint32(n)...)
+		}
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary takes a byte slice and sets the EpochStats object
+func (es *EpochStats) UnmarshalBinary(data []byte) error {
+	if es == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	if len(data) < 12 || (len(data)-12)%validatorStatsLen != 0 {
+		return errorz.ErrInvalid{}.New("invalid byte length for EpochStats")
+	}
+	// No errors are checked because every slice has length 4
+	es.Epoch, _ = utils.UnmarshalUint32(data[0:4])
+	es.Heights, _ = utils.UnmarshalUint32(data[4:8])
+	es.Rounds, _ = utils.UnmarshalUint32(data[8:12])
+	es.Validators = []*ValidatorStats{}
+	for data = data[12:]; len(data) > 0; data = data[validatorStatsLen:] {
+		v := &ValidatorStats{VAddr: utils.CopySlice(data[:constants.OwnerLen])}
+		n := data[constants.OwnerLen:validatorStatsLen]
+		v.Heights, _ = utils.UnmarshalUint32(n[0:4])
+		v.Rounds, _ = utils.UnmarshalUint32(n[4:8])
+		v.ProposerRounds, _ = utils.UnmarshalUint32(n[8:12])
+		v.Proposals, _ = utils.UnmarshalUint32(n[12:16])
+		v.PreVotes, _ = utils.UnmarshalUint32(n[16:20])
+		v.PreCommits, _ = utils.UnmarshalUint32(n[20:24])
+		es.Validators = append(es.Validators, v)
+	}
+	return nil
+}
+
+// validator returns the statistics of vAddr, adding them if they do not
+// exist yet
+func (es *EpochStats) validator(vAddr []byte) *ValidatorStats {
+	for _, v := range es.Validators {
+		if bytes.Equal(v.VAddr, vAddr) {
+			return v
+		}
+	}
+	v := &ValidatorStats{VAddr: utils.CopySlice(vAddr)}
+	es.Validators = append(es.Validators, v)
+	return v
+}
+
+// recordHeight adds a height which took rounds rounds to the statistics.
+// states holds the historic round state of each validator by VAddr and
+// round; a validator without a state in a round took no part in it.
+func (es *EpochStats) recordHeight(vs *objs.ValidatorSet, height uint32, rounds uint32, states map[string]map[uint32]*objs.RoundState) {
+	es.Heights++
+	es.Rounds += rounds
+	for i, val := range vs.Validators {
+		v := es.validator(val.VAddr)
+		v.Heights++
+		for round := uint32(1); round <= rounds; round++ {
+			v.Rounds++
+			rs := states[string(val.VAddr)][round]
+			isProposer := int(objs.GetProposerIdx(len(vs.Validators), height, round)) == i
+			if isProposer {
+				v.ProposerRounds++
+			}
+			if rs == nil || rs.RCert == nil {
+				continue
+			}
+			if isProposer && rs.PCurrent(rs.RCert) {
+				v.Proposals++
+			}
+			if rs.PVCurrent(rs.RCert) || rs.PVNCurrent(rs.RCert) {
+				v.PreVotes++
+			}
+			if rs.PCCurrent(rs.RCert) || rs.PCNCurrent(rs.RCert) {
+				v.PreCommits++
+			}
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package stats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/dgraph-io/badger/v2"
+)
+
+func makeVAddr(i int) []byte {
+	return bytes.Repeat([]byte{byte(i + 1)}, constants.OwnerLen)
+}
+
+func makeRCert(height, round uint32) *objs.RCert {
+	return &objs.RCert{
+		RClaims: &objs.RClaims{ChainID: 1, Height: height, Round: round},
+	}
+}
+
+func makeProposal(height, round uint32) *objs.Proposal {
+	return &objs.Proposal{
+		PClaims: &objs.PClaims{RCert: makeRCert(height, round)},
+	}
+}
+
+func validatorStatsEqual(t *testing.T, a, b *ValidatorStats) {
+	t.Helper()
+	if !bytes.Equal(a.VAddr, b.VAddr) {
+		t.Fatalf("VAddr does not match: %x %x", a.VAddr, b.VAddr)
+	}
+	if a.Heights != b.Heights || a.Rounds != b.Rounds || a.ProposerRounds != b.ProposerRounds ||
+		a.Proposals != b.Proposals || a.PreVotes != b.PreVotes || a.PreCommits != b.PreCommits {
+		t.Fatalf("Validator stats do not match: %+v %+v", a, b)
+	}
+}
+
+func TestEpochStatsMarshal(t *testing.T) {
+	es := &EpochStats{Epoch: 3, Heights: 10, Rounds: 12}
+	for i := 0; i < 3; i++ {
+		v := es.validator(makeVAddr(i))
+		v.Heights = 10
+		v.Rounds = 12
+		v.ProposerRounds = uint32(4 - i)
+		v.Proposals = uint32(3 - i)
+		v.PreVotes = uint32(12 - i)
+		v.PreCommits = uint32(11 - i)
+	}
+	data, err := es.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	es2 := &EpochStats{}
+	if err := es2.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if es2.Epoch != 3 || es2.Heights != 10 || es2.Rounds != 12 || es2.RoundChanges() != 2 {
+		t.Fatalf("Wrong epoch stats: %+v", es2)
+	}
+	if len(es2.Validators) != 3 {
+		t.Fatalf("Wrong number of validators: %v", len(es2.Validators))
+	}
+	for i, v := range es2.Validators {
+		validatorStatsEqual(t, v, es.Validators[i])
+		if v.MissedProposals() != 1 {
+			t.Fatalf("Wrong missed proposals: %v", v.MissedProposals())
+		}
+	}
+	if err := es2.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Fatal("Should have raised error")
+	}
+	es.Validators[0].VAddr = es.Validators[0].VAddr[1:]
+	if _, err := es.MarshalBinary(); err == nil {
+		t.Fatal("Should have raised error")
+	}
+}
+
+func TestEpochStatsRecordHeight(t *testing.T) {
+	vs := &objs.ValidatorSet{}
+	for i := 0; i < 4; i++ {
+		vs.Validators = append(vs.Validators, &objs.Validator{VAddr: makeVAddr(i)})
+	}
+	height := uint32(5)
+	p1 := int(objs.GetProposerIdx(4, height, 1))
+	p2 := int(objs.GetProposerIdx(4, height, 2))
+	states := make(map[string]map[uint32]*objs.RoundState)
+	for i, v := range vs.Validators {
+		states[string(v.VAddr)] = make(map[uint32]*objs.RoundState)
+		// the last validator took no part in the first round
+		if i != 3 {
+			rs := &objs.RoundState{VAddr: v.VAddr, RCert: makeRCert(height, 1)}
+			// the proposer of the first round did not propose
+			rs.PreVoteNil = &objs.PreVoteNil{RCert: makeRCert(height, 1)}
+			rs.PreCommitNil = &objs.PreCommitNil{RCert: makeRCert(height, 1)}
+			states[string(v.VAddr)][1] = rs
+		}
+		rs := &objs.RoundState{VAddr: v.VAddr, RCert: makeRCert(height, 2)}
+		if i == p2 {
+			rs.Proposal = makeProposal(height, 2)
+		}
+		rs.PreVote = &objs.PreVote{Proposal: makeProposal(height, 2)}
+		// votes of an earlier round do not count
+		rs.PreCommit = &objs.PreCommit{Proposal: makeProposal(height, 1)}
+		states[string(v.VAddr)][2] = rs
+	}
+	es := &EpochStats{Epoch: 1}
+	es.recordHeight(vs, height, 2, states)
+	if es.Heights != 1 || es.Rounds != 2 || es.RoundChanges() != 1 {
+		t.Fatalf("Wrong epoch stats: %+v", es)
+	}
+	if len(es.Validators) != 4 {
+		t.Fatalf("Wrong number of validators: %v", len(es.Validators))
+	}
+	for i, v := range es.Validators {
+		expected := &ValidatorStats{VAddr: makeVAddr(i), Heights: 1, Rounds: 2, PreVotes: 2, PreCommits: 1}
+		if i == 3 {
+			expected.PreVotes = 1
+			expected.PreCommits = 0
+		}
+		if i == p1 {
+			expected.ProposerRounds++
+		}
+		if i == p2 {
+			expected.ProposerRounds++
+			expected.Proposals++
+		}
+		validatorStatsEqual(t, v, expected)
+	}
+	es.recordHeight(vs, height+1, 1, nil)
+	if es.Heights != 2 || es.Rounds != 3 || es.Validators[0].Heights != 2 || es.Validators[0].Rounds != 3 {
+		t.Fatalf("Wrong epoch stats: %+v", es)
+	}
+}
+
+func TestIndexerRollback(t *testing.T) {
+	rawDB, err := badger.Open(badger.DefaultOptions("").WithInMemory(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rawDB.Close()
+	database := &db.Database{}
+	database.Init(rawDB)
+	ix := &Indexer{}
+	ix.Init(database)
+
+	vs := &objs.ValidatorSet{
+		GroupKey:  make([]byte, constants.CurveBN256EthPubkeyLen),
+		NotBefore: 1,
+	}
+	for i := 0; i < 4; i++ {
+		vs.Validators = append(vs.Validators, &objs.Validator{
+			VAddr:      makeVAddr(i),
+			GroupShare: make([]byte, constants.CurveBN256EthPubkeyLen),
+		})
+	}
+	err = database.Update(func(txn *badger.Txn) error {
+		if err := database.SetValidatorSet(txn, vs); err != nil {
+			return err
+		}
+		for height := uint32(2); height <= 5; height++ {
+			for i, v := range vs.Validators {
+				rs := &objs.RoundState{
+					VAddr:      v.VAddr,
+					GroupKey:   vs.GroupKey,
+					GroupShare: v.GroupShare,
+					GroupIdx:   uint8(i),
+					RCert: &objs.RCert{
+						RClaims: &objs.RClaims{
+							ChainID:   1,
+							Height:    height,
+							Round:     1,
+							PrevBlock: crypto.Hasher([]byte("prev")),
+						},
+						SigGroup: make([]byte, constants.CurveBN256EthSigLen),
+					},
+				}
+				if err := database.SetHistoricRoundState(txn, rs); err != nil {
+					return err
+				}
+			}
+			if err := database.SetCommittedBlockRound(txn, height, 1); err != nil {
+				return err
+			}
+			if err := ix.indexHeight(txn, height); err != nil {
+				return err
+			}
+		}
+		// statistics of a later epoch which the rollback removes
+		if err := setEpochStats(txn, &EpochStats{Epoch: 2, Heights: 1, Rounds: 1}); err != nil {
+			return err
+		}
+		return setLastHeight(txn, constants.EpochLength+1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = database.Update(func(txn *badger.Txn) error {
+		return ix.Rollback(txn, 3)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = database.View(func(txn *badger.Txn) error {
+		last, err := getLastHeight(txn)
+		if err != nil {
+			return err
+		}
+		if last != 3 {
+			t.Fatalf("Wrong last height: %v", last)
+		}
+		es, err := GetEpochStats(txn, 1)
+		if err != nil {
+			return err
+		}
+		if es.Heights != 2 || es.Rounds != 2 {
+			t.Fatalf("Wrong epoch stats: %+v", es)
+		}
+		for _, v := range es.Validators {
+			if v.Heights != 2 || v.Rounds != 2 {
+				t.Fatalf("Wrong validator stats: %+v", v)
+			}
+		}
+		if _, err := GetEpochStats(txn, 2); err != badger.ErrKeyNotFound {
+			t.Fatalf("Statistics of epoch 2 should be removed: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// rolling back to a height above the last indexed one does nothing
+	err = database.Update(func(txn *badger.Txn) error {
+		if err := ix.Rollback(txn, 5); err != nil {
+			return err
+		}
+		es, err := GetEpochStats(txn, 1)
+		if err != nil {
+			return err
+		}
+		if es.Heights != 2 {
+			t.Fatalf("Wrong epoch stats: %+v", es)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/MadBase/MadNet/consensus/evidence"
 	"github.com/MadBase/MadNet/consensus/gossip"
 	"github.com/MadBase/MadNet/consensus/lstate"
+	"github.com/MadBase/MadNet/consensus/stats"
 	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/dynamics"
 	"github.com/MadBase/MadNet/errorz"
@@ -203,6 +204,7 @@ type Synchronizer struct {
 	gossipClient    *gossip.Client
 	gossipHandler   *gossip.Handlers
 	evidenceHandler *evidence.Pool
+	statsIndexer    *stats.Indexer
 	stateHandler    *lstate.Engine
 	appHandler      *application.Application
 	adminHandler    *admin.Handlers
@@ -225,6 +227,8 @@ func (s *Synchronizer) Init(cdb *db.Database, mdb *badger.DB, tdb *badger.DB, gc
 	s.gossipClient = gc
 	s.gossipHandler = gh
 	s.evidenceHandler = ep
+	s.statsIndexer = &stats.Indexer{}
+	s.statsIndexer.Init(cdb)
 	s.stateHandler = eng
 	s.appHandler = app
 	s.adminHandler = ah
@@ -387,6 +391,22 @@ func (s *Synchronizer) Safe() bool {
 	return true
 }
 
+// checkSupply verifies the supply ledger. Walking the state trie takes a
+// while, so it is done without the lock in a read-only transaction; only the
+// outcome is stored under the lock.
+func (s *Synchronizer) checkSupply() error {
+	r := s.appHandler.CheckSupply()
+	if r == nil {
+		return nil
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.isClosing() {
+		return nil
+	}
+	return s.appHandler.StoreSupplyCheck(r)
+}
+
 func (s *Synchronizer) gossipInteruptLoop() {
 	defer s.wg.Done()
 	defer func() { s.logger.Warn("Stopping Gossip loop") }()
@@ -489,6 +509,30 @@ func (s *Synchronizer) setupLoops() {
 	s.wg.Add(1)
 	go s.loop(evidenceLoopConfig)
 
+	statsLoopConfig := newLoopConfig().
+		withName("StatsLoop").
+		withFn(s.statsIndexer.Index).
+		withFreq(11 * time.Second).
+		withDelayOnConditionFailure(11 * time.Second).
+		withLockFreeCondition(s.isNotClosing).
+		withLockFreeCondition(s.initialized.isSet).
+		withLockFreeCondition(s.madSyncDone.isSet).
+		withLock().
+		withLockedCondition(s.isNotClosing)
+	s.wg.Add(1)
+	go s.loop(statsLoopConfig)
+
+	supplyLoopConfig := newLoopConfig().
+		withName("SupplyLoop").
+		withFn(s.checkSupply).
+		withFreq(61 * time.Second).
+		withDelayOnConditionFailure(61 * time.Second).
+		withLockFreeCondition(s.isNotClosing).
+		withLockFreeCondition(s.initialized.isSet).
+		withLockFreeCondition(s.madSyncDone.isSet)
+	s.wg.Add(1)
+	go s.loop(supplyLoopConfig)
+
 	cdbgcLoopConfig := newLoopConfig().
 		withName("CDB-GCLoop").
 		withFn(s.cdb.GarbageCollect).
@@ -113,3 +113,27 @@ func PrefixDepositValueKey() []byte {
 func PrefixPendingTxCooldownKey() []byte {
 	return []byte("n7")
 }
+
+func PrefixMinedUTXOTimelockKey() []byte {
+	return []byte("n8")
+}
+
+func PrefixMinedUTXOWithdrawalKey() []byte {
+	return []byte("n9")
+}
+
+func PrefixMinedUTXOWithdrawalRefKey() []byte {
+	return []byte("ne")
+}
+
+func PrefixMinedTxSpentByKey() []byte {
+	return []byte("nf")
+}
+
+func PrefixSupplyLedgerKey() []byte {
+	return []byte("ng")
+}
+
+func PrefixSupplyCheckedKey() []byte {
+	return []byte("nh")
+}
@@ -149,3 +149,19 @@ func PrefixPendingHdrLeafKeyCount() []byte {
 func PrefixCommittedBlockHeaderCount() []byte {
 	return []byte("A3")
 }
+
+func PrefixCommittedBlockRound() []byte {
+	return []byte("A4")
+}
+
+func PrefixValidatorStatsEpoch() []byte {
+	return []byte("A5")
+}
+
+func PrefixValidatorStatsHeight() []byte {
+	return []byte("A6")
+}
+
+func PrefixGenesisHash() []byte {
+	return []byte("A7")
+}
@@ -77,6 +77,8 @@ const (
 	LoggerPeer      = "peer"
 	LoggerYamux     = "yamux"
 	LoggerUPnP      = "upnp"
+	LoggerSigner    = "signer"
+	LoggerDevnet    = "devnet"
 )
 
 // Badger VLog GC ratio
@@ -90,4 +92,4 @@ var ValidLoggers []string = []string{"madnet", "consensus", "transport", "app",
 	"gossipbus", "badger", "peerman", "localrpc", "dman", "peer", "yamux",
 	"ethereum", "main", "deploy", "utils", "monitor", "dkg",
 	"services", "settings", "validator", "muxhandler", "bootnode", "p2pmux",
-	"status", "test", "ipc", "firewalld"}
+	"status", "test", "ipc", "firewalld", "replay", "mockapp", "signer", "devnet"}
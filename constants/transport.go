@@ -8,6 +8,7 @@ const (
 	TxMsgQSize              = 1024
 	TxMsgQWorkers           = 4
 	LocalRPCMaxWorkers      = 2
+	LocalRPCMaxBatchSize    = 256
 	MaxConcurrentStreams    = 1
 	P2PMaxConcurrentStreams = 4
 	ReadBufferSize          = 0
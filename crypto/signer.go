@@ -0,0 +1,22 @@
+package crypto
+
+// Signer creates secp256k1 signatures. It is implemented by Secp256k1Signer
+// and by signers which hold the key outside of the node process.
+type Signer interface {
+	Sign(msg []byte) ([]byte, error)
+	Pubkey() ([]byte, error)
+}
+
+// GroupSigner creates signature shares with a share of a bn256 group key
+// and aggregates shares into group signatures. It is implemented by
+// BNGroupSigner and by signers which hold the key share outside of the node
+// process.
+type GroupSigner interface {
+	Sign(msg []byte) ([]byte, error)
+	PubkeyShare() ([]byte, error)
+	PubkeyGroup() ([]byte, error)
+	Aggregate(sigs [][]byte, groupShares [][]byte) ([]byte, error)
+}
+
+var _ Signer = &Secp256k1Signer{}
+var _ GroupSigner = &BNGroupSigner{}
@@ -0,0 +1,341 @@
+package devnet
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/blockchain/interfaces"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/bridge/bindings"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+)
+
+var _ interfaces.Ethereum = (*Ethereum)(nil)
+var _ interfaces.Contracts = (*contracts)(nil)
+
+// ErrNotSupported is returned by the methods of the fake Ethereum that
+// would need a real endpoint.
+var ErrNotSupported = errors.New("not supported by the devnet ethereum")
+
+// DepositAddress is the address the deposit logs of the fake Ethereum
+// are emitted from.
+var DepositAddress = common.HexToAddress("0x0000000000000000000000000000000000de9051")
+
+// Ethereum is an in process stand in for the Ethereum endpoint of a
+// devnet. Every call to Deposit mines a block holding one DepositReceived
+// log, which the monitors of the validators pick up like a real deposit.
+// Snapshots are not submitted to a contract, they are handed straight to
+// the admin handlers added with AddSnapshotHandler.
+type Ethereum struct {
+	sync.Mutex
+	logger        *logrus.Logger
+	chainID       *big.Int
+	account       accounts.Account
+	contracts     *contracts
+	depositEvent  abi.Event
+	height        uint64
+	logs          map[uint64][]types.Log
+	depositID     *big.Int
+	handlers      []interfaces.AdminHandler
+	snapshotEpoch uint32
+}
+
+// NewEthereum returns a fake Ethereum for the chain with the given id.
+func NewEthereum(chainID uint32) (*Ethereum, error) {
+	deposit, err := bindings.NewDeposit(DepositAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := bindings.DepositMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	event, ok := parsed.Events["DepositReceived"]
+	if !ok {
+		return nil, errors.New("deposit abi has no DepositReceived event")
+	}
+	return &Ethereum{
+		logger:       logging.GetLogger(constants.LoggerDevnet),
+		chainID:      new(big.Int).SetUint64(uint64(chainID)),
+		contracts:    &contracts{deposit: deposit},
+		depositEvent: event,
+		logs:         make(map[uint64][]types.Log),
+		depositID:    new(big.Int),
+	}, nil
+}
+
+// Deposit mines a block with a deposit of amount to depositor and returns
+// the id of the deposit.
+func (eth *Ethereum) Deposit(depositor common.Address, amount *big.Int) (*big.Int, error) {
+	eth.Lock()
+	defer eth.Unlock()
+	depositID := new(big.Int).Add(eth.depositID, big.NewInt(1))
+	data, err := eth.depositEvent.Inputs.Pack(depositID, depositor, amount)
+	if err != nil {
+		return nil, err
+	}
+	eth.depositID = depositID
+	eth.height++
+	eth.logs[eth.height] = append(eth.logs[eth.height], types.Log{
+		Address:     DepositAddress,
+		Topics:      []common.Hash{eth.depositEvent.ID},
+		Data:        data,
+		BlockNumber: eth.height,
+	})
+	eth.logger.WithFields(logrus.Fields{
+		"DepositID": depositID,
+		"Depositor": depositor.Hex(),
+		"Amount":    amount,
+		"Block":     eth.height,
+	}).Info("Deposit emitted")
+	return new(big.Int).Set(depositID), nil
+}
+
+// AddSnapshotHandler adds an admin handler that is given the snapshots
+// passed to Snapshot.
+func (eth *Ethereum) AddSnapshotHandler(ah interfaces.AdminHandler) {
+	eth.Lock()
+	defer eth.Unlock()
+	eth.handlers = append(eth.handlers, ah)
+}
+
+// Snapshot records the snapshot block header bh. Every validator calls it
+// for the same header, only the first call of an epoch is passed on.
+func (eth *Ethereum) Snapshot(bh *objs.BlockHeader) error {
+	epoch := bh.BClaims.Height / constants.EpochLength
+	eth.Lock()
+	if epoch <= eth.snapshotEpoch {
+		eth.Unlock()
+		return nil
+	}
+	eth.snapshotEpoch = epoch
+	handlers := append([]interfaces.AdminHandler{}, eth.handlers...)
+	eth.Unlock()
+	eth.logger.WithField("Height", bh.BClaims.Height).Info("Snapshot taken")
+	// AddSnapshot waits for the synchronizer, the caller may be holding it
+	for _, ah := range handlers {
+		go func(ah interfaces.AdminHandler) {
+			if err := ah.AddSnapshot(bh, false); err != nil {
+				eth.logger.Errorf("Could not add snapshot: %v", err)
+			}
+		}(ah)
+	}
+	return nil
+}
+
+// ChainID returns the id of the chain.
+func (eth *Ethereum) ChainID() *big.Int {
+	return new(big.Int).Set(eth.chainID)
+}
+
+// Close does nothing.
+func (eth *Ethereum) Close() error {
+	return nil
+}
+
+// Commit does nothing, blocks are mined by Deposit.
+func (eth *Ethereum) Commit() {}
+
+// IsEthereumAccessible always returns true.
+func (eth *Ethereum) IsEthereumAccessible() bool {
+	return true
+}
+
+// GetCallOpts returns call options for acct.
+func (eth *Ethereum) GetCallOpts(ctx context.Context, acct accounts.Account) *bind.CallOpts {
+	return &bind.CallOpts{Context: ctx, From: acct.Address}
+}
+
+// GetTransactionOpts is not supported.
+func (eth *Ethereum) GetTransactionOpts(context.Context, accounts.Account) (*bind.TransactOpts, error) {
+	return nil, ErrNotSupported
+}
+
+// UnlockAccount does nothing.
+func (eth *Ethereum) UnlockAccount(accounts.Account) error {
+	return nil
+}
+
+// UnlockAccountWithPasscode does nothing.
+func (eth *Ethereum) UnlockAccountWithPasscode(accounts.Account, string) error {
+	return nil
+}
+
+// TransferEther is not supported.
+func (eth *Ethereum) TransferEther(common.Address, common.Address, *big.Int) (*types.Transaction, error) {
+	return nil, ErrNotSupported
+}
+
+// GetAccount returns an account with the address addr.
+func (eth *Ethereum) GetAccount(addr common.Address) (accounts.Account, error) {
+	return accounts.Account{Address: addr}, nil
+}
+
+// GetAccountKeys is not supported.
+func (eth *Ethereum) GetAccountKeys(addr common.Address) (*keystore.Key, error) {
+	return nil, ErrNotSupported
+}
+
+// GetBalance returns zero.
+func (eth *Ethereum) GetBalance(common.Address) (*big.Int, error) {
+	return new(big.Int), nil
+}
+
+// GetGethClient returns nil, there is no geth client.
+func (eth *Ethereum) GetGethClient() interfaces.GethClient {
+	return nil
+}
+
+// GetCoinbaseAddress returns the zero address.
+func (eth *Ethereum) GetCoinbaseAddress() common.Address {
+	return common.Address{}
+}
+
+// GetCurrentHeight returns the number of the last block mined.
+func (eth *Ethereum) GetCurrentHeight(context.Context) (uint64, error) {
+	eth.Lock()
+	defer eth.Unlock()
+	return eth.height, nil
+}
+
+// GetDefaultAccount returns the zero account.
+func (eth *Ethereum) GetDefaultAccount() accounts.Account {
+	return eth.account
+}
+
+// GetEndpoint returns a name for the fake endpoint.
+func (eth *Ethereum) GetEndpoint() string {
+	return "devnet"
+}
+
+// GetEvents returns the logs of the blocks firstBlock to lastBlock emitted
+// from one of addresses.
+func (eth *Ethereum) GetEvents(ctx context.Context, firstBlock uint64, lastBlock uint64, addresses []common.Address) ([]types.Log, error) {
+	eth.Lock()
+	defer eth.Unlock()
+	logs := []types.Log{}
+	for height := firstBlock; height <= lastBlock; height++ {
+		for _, log := range eth.logs[height] {
+			for _, addr := range addresses {
+				if log.Address == addr {
+					logs = append(logs, log)
+					break
+				}
+			}
+		}
+	}
+	return logs, nil
+}
+
+// GetFinalizedHeight returns the number of the last block mined, the
+// blocks of the fake Ethereum are final at once.
+func (eth *Ethereum) GetFinalizedHeight(ctx context.Context) (uint64, error) {
+	return eth.GetCurrentHeight(ctx)
+}
+
+// GetKnownAccounts returns no accounts.
+func (eth *Ethereum) GetKnownAccounts() []accounts.Account {
+	return []accounts.Account{}
+}
+
+// GetPeerCount returns a count above any minimum.
+func (eth *Ethereum) GetPeerCount(context.Context) (uint64, error) {
+	return math.MaxUint32, nil
+}
+
+// GetSnapshot is not supported.
+func (eth *Ethereum) GetSnapshot() ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// GetSyncProgress reports the endpoint as synchronized.
+func (eth *Ethereum) GetSyncProgress() (bool, *ethereum.SyncProgress, error) {
+	return false, nil, nil
+}
+
+// GetTimeoutContext returns a context that expires after Timeout.
+func (eth *Ethereum) GetTimeoutContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), eth.Timeout())
+}
+
+// GetValidators is not supported.
+func (eth *Ethereum) GetValidators(context.Context) ([]common.Address, error) {
+	return nil, ErrNotSupported
+}
+
+// KnownSelectors returns nil.
+func (eth *Ethereum) KnownSelectors() interfaces.SelectorMap {
+	return nil
+}
+
+// Queue returns nil, no transaction is ever sent.
+func (eth *Ethereum) Queue() interfaces.TxnQueue {
+	return nil
+}
+
+// RetryCount returns 1.
+func (eth *Ethereum) RetryCount() int {
+	return 1
+}
+
+// RetryDelay returns one second.
+func (eth *Ethereum) RetryDelay() time.Duration {
+	return time.Second
+}
+
+// Timeout returns five seconds.
+func (eth *Ethereum) Timeout() time.Duration {
+	return 5 * time.Second
+}
+
+// Contracts returns the contracts, only Deposit is bound.
+func (eth *Ethereum) Contracts() interfaces.Contracts {
+	return eth.contracts
+}
+
+// contracts binds the deposit contract for the parsing of its logs, the
+// other contracts are nil.
+type contracts struct {
+	deposit *bindings.Deposit
+}
+
+func (c *contracts) LookupContracts(ctx context.Context, registryAddress common.Address) error {
+	return ErrNotSupported
+}
+
+func (c *contracts) DeployContracts(ctx context.Context, account accounts.Account) (*bindings.Registry, common.Address, error) {
+	return nil, common.Address{}, ErrNotSupported
+}
+
+func (c *contracts) Crypto() *bindings.Crypto             { return nil }
+func (c *contracts) CryptoAddress() common.Address        { return common.Address{} }
+func (c *contracts) Deposit() *bindings.Deposit           { return c.deposit }
+func (c *contracts) DepositAddress() common.Address       { return DepositAddress }
+func (c *contracts) Ethdkg() *bindings.ETHDKG             { return nil }
+func (c *contracts) EthdkgAddress() common.Address        { return common.Address{} }
+func (c *contracts) Governor() *bindings.Governor         { return nil }
+func (c *contracts) GovernorAddress() common.Address      { return common.Address{} }
+func (c *contracts) Participants() *bindings.Participants { return nil }
+func (c *contracts) Registry() *bindings.Registry         { return nil }
+func (c *contracts) RegistryAddress() common.Address      { return common.Address{} }
+func (c *contracts) Snapshots() *bindings.Snapshots       { return nil }
+func (c *contracts) Staking() *bindings.Staking           { return nil }
+func (c *contracts) StakingToken() *bindings.Token        { return nil }
+func (c *contracts) StakingTokenAddress() common.Address  { return common.Address{} }
+func (c *contracts) UtilityToken() *bindings.Token        { return nil }
+func (c *contracts) UtilityTokenAddress() common.Address  { return common.Address{} }
+func (c *contracts) Validators() *bindings.Validators     { return nil }
+func (c *contracts) ValidatorsAddress() common.Address    { return common.Address{} }
@@ -0,0 +1,109 @@
+package devnet
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/crypto"
+	bn256 "github.com/MadBase/MadNet/crypto/bn256/cloudflare"
+)
+
+// MaxValidators is the largest validator set a devnet is started with.
+const MaxValidators = 64
+
+// ErrValidatorCount is returned for a validator count below one or above
+// MaxValidators.
+var ErrValidatorCount = errors.New("invalid number of devnet validators")
+
+// Validator holds the keys of a devnet validator. The keys only depend on
+// the index of the validator, so a devnet started twice has the same
+// accounts and group key.
+type Validator struct {
+	// Index is the position of the validator in the validator set
+	Index int
+	// PrivateKey is the secp256k1 key the validator signs with
+	PrivateKey []byte
+	// PublicKey is the public key of PrivateKey
+	PublicKey []byte
+	// Account is the address of the validator, its VAddr
+	Account []byte
+	// GroupPrivateKey is the bn256 share of the group key
+	GroupPrivateKey []byte
+	// GroupShare is the public key of GroupPrivateKey
+	GroupShare []byte
+	// TransportKey is the hex private key of the peer to peer transport
+	TransportKey string
+}
+
+// NewValidators returns the keys of n validators and the validator set
+// they form. The group key is split with the polynomial a DKG run would
+// agree on, so the group signatures of the set are valid.
+func NewValidators(n int) ([]*Validator, *objs.ValidatorSet, error) {
+	if n < 1 || n > MaxValidators {
+		return nil, nil, ErrValidatorCount
+	}
+	threshold := crypto.CalcThreshold(n)
+	coefs := make([]*big.Int, threshold+1)
+	for i := range coefs {
+		seed := crypto.Hasher([]byte(fmt.Sprintf("devnet group coefficient %d", i)))
+		coefs[i] = new(big.Int).Mod(new(big.Int).SetBytes(seed), bn256.Order)
+	}
+	groupKey := new(bn256.G2).ScalarBaseMult(coefs[0]).Marshal()
+
+	vs := &objs.ValidatorSet{
+		GroupKey:   groupKey,
+		Validators: make([]*objs.Validator, n),
+		NotBefore:  1,
+	}
+	validators := make([]*Validator, n)
+	for i := 0; i < n; i++ {
+		secpSigner := &crypto.Secp256k1Signer{}
+		privk := crypto.Hasher([]byte(fmt.Sprintf("devnet validator %d", i)))
+		if err := secpSigner.SetPrivk(privk); err != nil {
+			return nil, nil, err
+		}
+		pubk, err := secpSigner.Pubkey()
+		if err != nil {
+			return nil, nil, err
+		}
+		groupPrivk := bn256.PrivatePolyEval(coefs, i+1)
+		bnSigner := &crypto.BNGroupSigner{}
+		if err := bnSigner.SetPrivk(groupPrivk.Bytes()); err != nil {
+			return nil, nil, err
+		}
+		groupShare, err := bnSigner.PubkeyShare()
+		if err != nil {
+			return nil, nil, err
+		}
+		transportKey := crypto.Hasher([]byte(fmt.Sprintf("devnet transport %d", i)))
+		validators[i] = &Validator{
+			Index:           i,
+			PrivateKey:      privk,
+			PublicKey:       pubk,
+			Account:         crypto.GetAccount(pubk),
+			GroupPrivateKey: groupPrivk.Bytes(),
+			GroupShare:      groupShare,
+			TransportKey:    hex.EncodeToString(transportKey),
+		}
+		vs.Validators[i] = &objs.Validator{
+			VAddr:      validators[i].Account,
+			GroupShare: groupShare,
+		}
+	}
+	return validators, vs, nil
+}
+
+func copyValidatorSet(vs *objs.ValidatorSet) (*objs.ValidatorSet, error) {
+	vsBytes, err := vs.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	vsCopy := &objs.ValidatorSet{}
+	if err := vsCopy.UnmarshalBinary(vsBytes); err != nil {
+		return nil, err
+	}
+	return vsCopy, nil
+}
@@ -0,0 +1,76 @@
+package devnet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MadBase/MadNet/crypto"
+)
+
+func TestNewValidators(t *testing.T) {
+	n := 4
+	validators, vs, err := NewValidators(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(validators) != n || len(vs.Validators) != n {
+		t.Fatalf("expected %v validators", n)
+	}
+	again, _, err := NewValidators(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range validators {
+		if !bytes.Equal(validators[i].Account, again[i].Account) {
+			t.Fatal("validator keys are not deterministic")
+		}
+	}
+
+	// the group signature of a threshold+1 subset is valid for the group key
+	msg := []byte("devnet")
+	groupShares := make([][]byte, n)
+	for i, v := range vs.Validators {
+		groupShares[i] = v.GroupShare
+	}
+	sigs := [][]byte{}
+	for _, v := range validators[:crypto.CalcThreshold(n)+1] {
+		signer := &crypto.BNGroupSigner{}
+		if err := signer.SetPrivk(v.GroupPrivateKey); err != nil {
+			t.Fatal(err)
+		}
+		if err := signer.SetGroupPubk(vs.GroupKey); err != nil {
+			t.Fatal(err)
+		}
+		sig, err := signer.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sigs = append(sigs, sig)
+	}
+	signer := &crypto.BNGroupSigner{}
+	if err := signer.SetPrivk(validators[0].GroupPrivateKey); err != nil {
+		t.Fatal(err)
+	}
+	if err := signer.SetGroupPubk(vs.GroupKey); err != nil {
+		t.Fatal(err)
+	}
+	groupSig, err := signer.Aggregate(sigs, groupShares)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator := &crypto.BNGroupValidator{}
+	groupKey, err := validator.Validate(msg, groupSig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(groupKey, vs.GroupKey) {
+		t.Fatal("group signature is not from the group key")
+	}
+
+	if _, _, err := NewValidators(0); err != ErrValidatorCount {
+		t.Fatal("expected ErrValidatorCount")
+	}
+	if _, _, err := NewValidators(MaxValidators + 1); err != ErrValidatorCount {
+		t.Fatal("expected ErrValidatorCount")
+	}
+}
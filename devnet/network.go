@@ -0,0 +1,91 @@
+package devnet
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/transport"
+	"github.com/sirupsen/logrus"
+)
+
+// Config holds the settings of a devnet.
+type Config struct {
+	// Validators is the number of validators started
+	Validators int
+	// ChainID is the id of the chain
+	ChainID uint32
+	// LocalStateHost is the host the localrpc servers listen on
+	LocalStateHost string
+	// LocalStateBasePort is the localrpc port of the first validator,
+	// validator i listens on LocalStateBasePort+i
+	LocalStateBasePort int
+}
+
+// Network is a devnet: validators running in this process, connected
+// through a MemoryNetwork and watching a fake Ethereum.
+type Network struct {
+	Ethereum *Ethereum
+	Nodes    []*Node
+	logger   *logrus.Logger
+}
+
+// New builds the validators of a devnet. Start must be called to run them.
+func New(cfg Config) (*Network, error) {
+	validators, vs, err := NewValidators(cfg.Validators)
+	if err != nil {
+		return nil, err
+	}
+	eth, err := NewEthereum(cfg.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	memoryNetwork := transport.NewMemoryNetwork()
+	network := &Network{
+		Ethereum: eth,
+		logger:   logging.GetLogger(constants.LoggerDevnet),
+	}
+	for _, v := range validators {
+		// AddValidatorSet updates the set it is given
+		vsCopy, err := copyValidatorSet(vs)
+		if err != nil {
+			network.Close()
+			return nil, err
+		}
+		addr := net.JoinHostPort(cfg.LocalStateHost, strconv.Itoa(cfg.LocalStateBasePort+v.Index))
+		node, err := newNode(cfg.ChainID, v, vsCopy, eth, memoryNetwork, len(validators)-1, addr)
+		if err != nil {
+			network.Close()
+			return nil, err
+		}
+		network.Nodes = append(network.Nodes, node)
+	}
+	// every validator knows the others from the start
+	for _, node := range network.Nodes {
+		for _, peer := range network.Nodes {
+			node.peerManager.AddPeer(peer.peerManager.NodeAddr())
+		}
+	}
+	return network, nil
+}
+
+// Start runs the validators.
+func (n *Network) Start() {
+	for _, node := range n.Nodes {
+		n.logger.WithFields(logrus.Fields{
+			"Validator":  node.Validator.Index,
+			"Account":    fmt.Sprintf("0x%x", node.Validator.Account),
+			"LocalState": node.LocalStateAddress,
+		}).Info("Starting validator")
+		node.start()
+	}
+}
+
+// Close stops the validators.
+func (n *Network) Close() {
+	for _, node := range n.Nodes {
+		node.Close()
+	}
+}
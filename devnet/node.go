@@ -0,0 +1,284 @@
+package devnet
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/application"
+	"github.com/MadBase/MadNet/application/deposit"
+	"github.com/MadBase/MadNet/blockchain/monitor"
+	"github.com/MadBase/MadNet/blockchain/objects"
+	"github.com/MadBase/MadNet/consensus"
+	"github.com/MadBase/MadNet/consensus/admin"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/dman"
+	"github.com/MadBase/MadNet/consensus/evidence"
+	"github.com/MadBase/MadNet/consensus/gossip"
+	"github.com/MadBase/MadNet/consensus/lstate"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/consensus/request"
+	"github.com/MadBase/MadNet/constants"
+	mncrypto "github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/ipc"
+	"github.com/MadBase/MadNet/localrpc"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/peering"
+	"github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/transport"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// monitorBatchSize is the number of Ethereum blocks a monitor tick of a
+// devnet node processes at most.
+const monitorBatchSize = 16
+
+// Node is one validator of a devnet. It runs the same services as the
+// validator command, with in memory databases and transport.
+type Node struct {
+	Validator         *Validator
+	LocalStateAddress string
+
+	logger    *logrus.Entry
+	eth       *Ethereum
+	vs        *objs.ValidatorSet
+	ctx       context.Context
+	cf        func()
+	wg        sync.WaitGroup
+	closeChan chan struct{}
+	closeOnce sync.Once
+
+	rawConsensusDb *badger.DB
+	rawTxPoolDb    *badger.DB
+	rawMonitorDb   *badger.DB
+
+	consDB             *db.Database
+	app                *application.Application
+	appDepositHandler  *deposit.Handler
+	consDlManager      *dman.DMan
+	consGossipHandlers *gossip.Handlers
+	consGossipClient   *gossip.Client
+	consAdminHandlers  *admin.Handlers
+	consSync           *consensus.Synchronizer
+	storage            *dynamics.Storage
+	localStateHandler  *localrpc.Handlers
+	localStateServer   *localrpc.Handler
+	peerManager        *peering.PeerManager
+}
+
+func newNode(chainID uint32, v *Validator, vs *objs.ValidatorSet, eth *Ethereum, network *transport.MemoryNetwork, peerLimit int, localStateAddress string) (*Node, error) {
+	ctx, cf := context.WithCancel(context.Background())
+	n := &Node{
+		Validator:         v,
+		LocalStateAddress: localStateAddress,
+		logger:            logging.GetLogger(constants.LoggerDevnet).WithField("Validator", v.Index),
+		eth:               eth,
+		vs:                vs,
+		ctx:               ctx,
+		cf:                cf,
+		closeChan:         make(chan struct{}),
+	}
+	if err := n.init(chainID, network, peerLimit); err != nil {
+		// cancelling the context closes the databases
+		cf()
+		return nil, err
+	}
+	return n, nil
+}
+
+func (n *Node) init(chainID uint32, network *transport.MemoryNetwork, peerLimit int) error {
+	var err error
+	n.rawConsensusDb, err = utils.OpenBadger(n.ctx.Done(), "", true)
+	if err != nil {
+		return err
+	}
+	n.rawTxPoolDb, err = utils.OpenBadger(n.ctx.Done(), "", true)
+	if err != nil {
+		return err
+	}
+	n.rawMonitorDb, err = utils.OpenBadger(n.ctx.Done(), "", true)
+	if err != nil {
+		return err
+	}
+
+	n.consDB = &db.Database{}
+	n.app = &application.Application{}
+	n.appDepositHandler = &deposit.Handler{}
+	n.consDlManager = &dman.DMan{}
+	n.consGossipHandlers = &gossip.Handlers{}
+	n.consGossipClient = &gossip.Client{}
+	n.consAdminHandlers = &admin.Handlers{}
+	n.consSync = &consensus.Synchronizer{}
+	n.storage = &dynamics.Storage{}
+	n.localStateHandler = &localrpc.Handlers{}
+	consTxPool := &evidence.Pool{}
+	consReqClient := &request.Client{}
+	consReqHandler := &request.Handler{}
+	consLSEngine := &lstate.Engine{}
+	consLSHandler := &lstate.Handlers{}
+	secp256k1Signer := &mncrypto.Secp256k1Signer{}
+
+	if err := secp256k1Signer.SetPrivk(n.Validator.PrivateKey); err != nil {
+		return err
+	}
+	n.localStateServer, err = newLocalStateServer(n.localStateHandler, n.LocalStateAddress)
+	if err != nil {
+		return err
+	}
+	n.peerManager, err = newPeerManager(network, chainID, peerLimit, n.Validator, n.consGossipHandlers, consReqHandler)
+	if err != nil {
+		n.localStateServer.Close()
+		return err
+	}
+
+	logger := logging.GetLogger(constants.LoggerDevnet)
+	n.consDB.Init(n.rawConsensusDb)
+	consTxPool.Init(n.consDB)
+	n.appDepositHandler.Init()
+	if err := n.app.Init(n.consDB, n.rawTxPoolDb, n.appDepositHandler, n.storage); err != nil {
+		return err
+	}
+	if err := n.storage.Init(n.consDB, logger); err != nil {
+		return err
+	}
+	consReqClient.Init(n.peerManager.P2PClient(), n.storage)
+	consReqHandler.Init(n.consDB, n.app, n.storage)
+	n.consDlManager.Init(n.consDB, n.app, consReqClient)
+	consLSHandler.Init(n.consDB, n.consDlManager)
+	n.consGossipHandlers.Init(n.consDB, n.peerManager.P2PClient(), n.app, consLSHandler, n.storage)
+	n.consGossipClient.Init(n.consDB, n.peerManager.P2PClient(), n.app, n.storage)
+	n.consAdminHandlers.Init(chainID, n.consDB, mncrypto.Hasher(n.Validator.PrivateKey), n.app, n.Validator.PublicKey, n.storage, ipc.NewServer(""))
+	consLSEngine.Init(n.consDB, n.consDlManager, n.app, secp256k1Signer, n.consAdminHandlers, n.Validator.PublicKey, consReqClient, n.storage)
+
+	// snapshots go to the fake Ethereum, which hands them to every node
+	n.consAdminHandlers.RegisterSnapshotCallback(n.eth.Snapshot)
+	n.eth.AddSnapshotHandler(n.consAdminHandlers)
+
+	// the transaction pool is in memory, a nil database skips its value log gc
+	n.consSync.Init(n.consDB, n.rawMonitorDb, nil, n.consGossipClient, n.consGossipHandlers, consTxPool, consLSEngine, n.app, n.consAdminHandlers, n.peerManager, n.storage)
+	n.localStateHandler.Init(n.consDB, n.app, n.consGossipHandlers, n.Validator.PublicKey, n.consSync.Safe)
+	return nil
+}
+
+// start launches the services of the node, then hands the group key share
+// and the validator set to the admin handlers as the monitor would once
+// the DKG completes.
+func (n *Node) start() {
+	go n.storage.Start()
+	go n.peerManager.Start()
+	go n.consGossipClient.Start()
+	go n.consDlManager.Start()
+	go n.localStateServer.Serve()
+	go n.localStateHandler.Start()
+	go n.consGossipHandlers.Start()
+	n.consSync.Start()
+
+	n.wg.Add(2)
+	go func() {
+		defer n.wg.Done()
+		// the admin handlers wait for the synchronizer to hand them its lock
+		if err := n.consAdminHandlers.AddPrivateKey(n.Validator.GroupPrivateKey, constants.CurveBN256Eth); err != nil {
+			n.logger.Errorf("Could not add the group key share: %v", err)
+			return
+		}
+		if err := n.consAdminHandlers.AddValidatorSet(n.vs); err != nil {
+			n.logger.Errorf("Could not add the validator set: %v", err)
+		}
+	}()
+	go n.monitorLoop()
+}
+
+// monitorLoop runs the monitor ticks of the validator command against the
+// fake Ethereum. Only the deposit logs are ever emitted, so no DKG task is
+// scheduled.
+func (n *Node) monitorLoop() {
+	defer n.wg.Done()
+	eventMap := objects.NewEventMap()
+	if err := monitor.SetupEventMap(eventMap, n.consDB, n.consAdminHandlers, n.appDepositHandler); err != nil {
+		n.logger.Errorf("Could not setup the event map: %v", err)
+		return
+	}
+	schedule := objects.NewSequentialSchedule(&objects.TypeRegistry{}, n.consAdminHandlers)
+	state := objects.NewMonitorState(objects.NewDkgState(n.eth.GetDefaultAccount()), schedule)
+	logger := n.logger.WithField("Component", "monitor")
+	tasks := &sync.WaitGroup{}
+	defer tasks.Wait()
+	for {
+		select {
+		case <-n.closeChan:
+			return
+		case <-time.After(time.Second):
+		}
+		ctx, cf := context.WithTimeout(n.ctx, n.eth.Timeout())
+		if err := monitor.MonitorTick(ctx, cf, tasks, n.eth, state, logger, eventMap, n.consAdminHandlers, monitorBatchSize); err != nil {
+			logger.Errorf("Failed MonitorTick(...): %v", err)
+		}
+	}
+}
+
+// Close stops the services of the node and closes its databases.
+func (n *Node) Close() {
+	n.closeOnce.Do(func() {
+		n.consSync.Stop()
+		n.consGossipHandlers.Close()
+		n.localStateHandler.Stop()
+		n.localStateServer.Close()
+		n.consDlManager.Close()
+		n.consGossipClient.Close()
+		n.peerManager.Close()
+		n.consAdminHandlers.Close()
+		close(n.closeChan)
+		n.wg.Wait()
+		// cancelling the context closes the databases
+		n.cf()
+	})
+}
+
+func newPeerManager(network *transport.MemoryNetwork, chainID uint32, peerLimit int, v *Validator, consGossipHandlers *gossip.Handlers, consReqHandler *request.Handler) (*peering.PeerManager, error) {
+	p2pDispatch := proto.NewP2PDispatch()
+	peerManager, err := peering.NewMemoryPeerManager(
+		proto.NewGeneratedP2PServer(p2pDispatch),
+		network,
+		chainID,
+		peerLimit,
+		peerLimit+2,
+		v.TransportKey,
+		v.Index+1)
+	if err != nil {
+		return nil, err
+	}
+	p2pDispatch.RegisterP2PGetPeers(peerManager)
+	p2pDispatch.RegisterP2PGossipTransaction(consGossipHandlers)
+	p2pDispatch.RegisterP2PGossipProposal(consGossipHandlers)
+	p2pDispatch.RegisterP2PGossipPreVote(consGossipHandlers)
+	p2pDispatch.RegisterP2PGossipPreVoteNil(consGossipHandlers)
+	p2pDispatch.RegisterP2PGossipPreCommit(consGossipHandlers)
+	p2pDispatch.RegisterP2PGossipPreCommitNil(consGossipHandlers)
+	p2pDispatch.RegisterP2PGossipNextRound(consGossipHandlers)
+	p2pDispatch.RegisterP2PGossipNextHeight(consGossipHandlers)
+	p2pDispatch.RegisterP2PGossipBlockHeader(consGossipHandlers)
+	p2pDispatch.RegisterP2PGetBlockHeaders(consReqHandler)
+	p2pDispatch.RegisterP2PGetMinedTxs(consReqHandler)
+	p2pDispatch.RegisterP2PGetPendingTxs(consReqHandler)
+	p2pDispatch.RegisterP2PGetSnapShotNode(consReqHandler)
+	p2pDispatch.RegisterP2PGetSnapShotStateData(consReqHandler)
+	p2pDispatch.RegisterP2PGetSnapShotHdrNode(consReqHandler)
+	return peerManager, nil
+}
+
+func newLocalStateServer(localStateHandler *localrpc.Handlers, addr string) (*localrpc.Handler, error) {
+	localStateDispatch := proto.NewLocalStateDispatch()
+	localStateServer, err := localrpc.NewStateServerHandler(
+		logging.GetLogger(constants.LoggerTransport),
+		addr,
+		proto.NewGeneratedLocalStateServer(localStateDispatch),
+	)
+	if err != nil {
+		return nil, err
+	}
+	localStateHandler.Register(localStateDispatch)
+	return localStateServer, nil
+}
@@ -38,4 +38,8 @@ var (
 
 	// ErrInvalidNode is an error which occurs when a Node is invalid
 	ErrInvalidNode = errors.New("invalid Node")
+
+	// ErrMinTxFeeNotLoaded is an error which is returned when the dynamic
+	// minimum tx fee of the loaded epoch has not been computed.
+	ErrMinTxFeeNotLoaded = errors.New("the minimum tx fee of the epoch is not loaded")
 )
@@ -0,0 +1,177 @@
+package dynamics
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Features are protocol changes which governance activates from an epoch.
+//
+// A feature is scheduled by an update of the field
+//
+//	feature.<name>
+//
+// whose value is "true" to activate the feature from the epoch of the update
+// or "false" to deactivate it from that epoch. The schedule is stored with
+// the other values in RawStorage, so it is persisted in the LinkedList and
+// carried forward to the nodes after the update.
+//
+// Code which changes behaviour registers its Feature in an init function
+// and asks Storage.IsActive whether it applies at an epoch:
+//
+//	var FeatureX = dynamics.RegisterFeature("x", "does X")
+//
+//	if storage.IsActive(FeatureX, epoch) { ... }
+//
+// Updates for features which are not registered are accepted and stored so
+// that a node which is upgraded later agrees with the others.
+
+// FeatureUpdatePrefix is the prefix of the fields of feature updates
+const FeatureUpdatePrefix = "feature."
+
+// Feature is the name of a protocol change
+type Feature string
+
+// FeatureInfo describes a Feature and its schedule
+type FeatureInfo struct {
+	Name        Feature
+	Description string
+	// Registered is true if this node knows the feature
+	Registered bool
+	// Scheduled is true if governance has activated the feature
+	Scheduled bool
+	// ActivationEpoch is the first epoch at which the feature is active
+	ActivationEpoch uint32
+}
+
+var (
+	featureMu       sync.RWMutex
+	featureRegistry = make(map[Feature]string)
+)
+
+// RegisterFeature adds a feature to the registry and returns it. It panics
+// if the name is invalid or already registered, so it should be called
+// when a package is initialized.
+func RegisterFeature(name string, description string) Feature {
+	if !validFeatureName(name) {
+		panic("dynamics: invalid feature name " + strconv.Quote(name))
+	}
+	featureMu.Lock()
+	defer featureMu.Unlock()
+	f := Feature(name)
+	if _, ok := featureRegistry[f]; ok {
+		panic("dynamics: feature registered twice: " + name)
+	}
+	featureRegistry[f] = description
+	return f
+}
+
+// RegisteredFeatures returns the registered features in order of name
+func RegisteredFeatures() []Feature {
+	featureMu.RLock()
+	defer featureMu.RUnlock()
+	out := make([]Feature, 0, len(featureRegistry))
+	for f := range featureRegistry {
+		out = append(out, f)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// featureDescription returns the description of a registered feature
+func featureDescription(f Feature) (string, bool) {
+	featureMu.RLock()
+	defer featureMu.RUnlock()
+	d, ok := featureRegistry[f]
+	return d, ok
+}
+
+// validFeatureName returns true if name is made of letters, digits, '-'
+// and '_'
+func validFeatureName(name string) bool {
+	if len(name) == 0 || len(name) > 64 {
+		return false
+	}
+	for _, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case c >= 'A' && c <= 'Z':
+		case c >= '0' && c <= '9':
+		case c == '-' || c == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// featureFromField returns the feature named by the field of an update
+func featureFromField(field string) (Feature, error) {
+	if !strings.HasPrefix(field, FeatureUpdatePrefix) {
+		return "", ErrInvalid
+	}
+	name := strings.TrimPrefix(field, FeatureUpdatePrefix)
+	if !validFeatureName(name) {
+		return "", ErrInvalid
+	}
+	return Feature(name), nil
+}
+
+// updateFeature applies a feature update to RawStorage
+func (rs *RawStorage) updateFeature(update Updater) error {
+	f, err := featureFromField(update.Name())
+	if err != nil {
+		return err
+	}
+	active, err := strconv.ParseBool(update.Value())
+	if err != nil {
+		return ErrInvalid
+	}
+	if !active {
+		delete(rs.Features, string(f))
+		return nil
+	}
+	if rs.Features == nil {
+		rs.Features = make(map[string]uint32)
+	}
+	if activation, ok := rs.Features[string(f)]; ok && activation <= update.Epoch() {
+		// the feature is already active
+		return nil
+	}
+	rs.Features[string(f)] = update.Epoch()
+	return nil
+}
+
+// IsActive returns true if feature is active at epoch
+func (rs *RawStorage) IsActive(feature Feature, epoch uint32) bool {
+	activation, ok := rs.Features[string(feature)]
+	return ok && epoch >= activation
+}
+
+// GetFeatures returns the registered features and the scheduled ones, in
+// order of name
+func (rs *RawStorage) GetFeatures() []*FeatureInfo {
+	infos := make(map[Feature]*FeatureInfo)
+	for _, f := range RegisteredFeatures() {
+		infos[f] = &FeatureInfo{Name: f}
+	}
+	for name, epoch := range rs.Features {
+		f := Feature(name)
+		info, ok := infos[f]
+		if !ok {
+			info = &FeatureInfo{Name: f}
+			infos[f] = info
+		}
+		info.Scheduled = true
+		info.ActivationEpoch = epoch
+	}
+	out := make([]*FeatureInfo, 0, len(infos))
+	for f, info := range infos {
+		info.Description, info.Registered = featureDescription(f)
+		out = append(out, info)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
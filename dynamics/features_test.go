@@ -0,0 +1,168 @@
+package dynamics
+
+import (
+	"errors"
+	"testing"
+)
+
+var testFeature = RegisterFeature("test-feature", "feature used by the tests")
+
+func TestRegisterFeature(t *testing.T) {
+	mustPanic := func(name string) {
+		t.Helper()
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("Should have panicked: %q", name)
+			}
+		}()
+		RegisterFeature(name, "")
+	}
+	mustPanic("")
+	mustPanic("bad.name")
+	mustPanic(string(testFeature))
+
+	found := false
+	for _, f := range RegisteredFeatures() {
+		if f == testFeature {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("testFeature should be registered")
+	}
+}
+
+func TestConvertFieldToTypeFeature(t *testing.T) {
+	uType, err := convertFieldToType("feature.anything")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uType != FeatureType {
+		t.Fatal("Incorrect UpdateType")
+	}
+	for _, field := range []string{"feature.", "feature.a.b", "features.x"} {
+		if _, err := convertFieldToType(field); !errors.Is(err, ErrInvalid) {
+			t.Fatalf("Should have raised ErrInvalid: %q", field)
+		}
+	}
+}
+
+func TestRawStorageUpdateFeature(t *testing.T) {
+	rs := &RawStorage{}
+	if rs.IsActive(testFeature, 100) {
+		t.Fatal("Should not be active")
+	}
+
+	update, err := NewUpdate(FeatureUpdatePrefix+string(testFeature), "maybe", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.UpdateValue(update); !errors.Is(err, ErrInvalid) {
+		t.Fatal("Should have raised ErrInvalid")
+	}
+
+	update, err = NewUpdate(FeatureUpdatePrefix+string(testFeature), "true", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.UpdateValue(update); err != nil {
+		t.Fatal(err)
+	}
+	if rs.IsActive(testFeature, 9) || !rs.IsActive(testFeature, 10) {
+		t.Fatal("Should be active from epoch 10")
+	}
+
+	// activating again later keeps the first activation
+	update, err = NewUpdate(FeatureUpdatePrefix+string(testFeature), "true", 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.UpdateValue(update); err != nil {
+		t.Fatal(err)
+	}
+	if !rs.IsActive(testFeature, 10) {
+		t.Fatal("Should be active from epoch 10")
+	}
+
+	// the schedule survives a copy
+	rs2, err := rs.Copy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rs2.IsActive(testFeature, 10) {
+		t.Fatal("Copy should be active from epoch 10")
+	}
+
+	update, err = NewUpdate(FeatureUpdatePrefix+string(testFeature), "false", 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.UpdateValue(update); err != nil {
+		t.Fatal(err)
+	}
+	if rs.IsActive(testFeature, 30) {
+		t.Fatal("Should not be active")
+	}
+}
+
+func TestStorageFeatures(t *testing.T) {
+	s := initializeStorageWithFirstNode()
+
+	updates := []struct {
+		field string
+		value string
+		epoch uint32
+	}{
+		{FeatureUpdatePrefix + string(testFeature), "true", 25},
+		{FeatureUpdatePrefix + "unknown", "true", 5},
+		{"maxBytes", "1000", 50},
+		{FeatureUpdatePrefix + string(testFeature), "false", 100},
+	}
+	for _, u := range updates {
+		update, err := NewUpdate(u.field, u.value, u.epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.UpdateStorage(nil, update); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		epoch  uint32
+		active bool
+	}{
+		{1, false},
+		{24, false},
+		{25, true},
+		{60, true},
+		{100, false},
+	}
+	for _, tt := range tests {
+		if err := s.LoadStorage(nil, tt.epoch); err != nil {
+			t.Fatal(err)
+		}
+		if s.IsActive(testFeature, tt.epoch) != tt.active {
+			t.Fatalf("Incorrect IsActive at epoch %v", tt.epoch)
+		}
+	}
+
+	if err := s.LoadStorage(nil, 60); err != nil {
+		t.Fatal(err)
+	}
+	var known, unknown *FeatureInfo
+	for _, info := range s.GetFeatures() {
+		switch info.Name {
+		case testFeature:
+			known = info
+		case "unknown":
+			unknown = info
+		}
+	}
+	if known == nil || !known.Registered || !known.Scheduled || known.ActivationEpoch != 25 || known.Description == "" {
+		t.Fatalf("Incorrect FeatureInfo: %+v", known)
+	}
+	if unknown == nil || unknown.Registered || !unknown.Scheduled || unknown.ActivationEpoch != 5 {
+		t.Fatalf("Incorrect FeatureInfo: %+v", unknown)
+	}
+}
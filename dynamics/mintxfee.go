@@ -0,0 +1,110 @@
+package dynamics
+
+import (
+	"math/big"
+)
+
+// The minimum tx fee may follow the fullness of recent blocks, in the spirit
+// of EIP-1559. When FeatureDynamicMinTxFee is active and governance sets
+// MinTxFeeMax above MinTxFee, the fee of an epoch starts at MinTxFee
+// MinTxFeeWindow epochs before and moves once for each epoch since: up when
+// its blocks used more than half of MaxBytes and down when they used less,
+// by at most 1/8 each time. The fee always stays between MinTxFee and
+// MinTxFeeMax.
+//
+// Only committed headers are used, so every node computes the same fee.
+
+// FeatureDynamicMinTxFee is the minimum tx fee which follows the fullness
+// of recent blocks
+var FeatureDynamicMinTxFee = RegisterFeature("dynamicMinTxFee", "A minimum tx fee which follows the fullness of recent blocks")
+
+const (
+	// MinTxFeeWindow is the number of epochs whose blocks determine the
+	// minimum tx fee of the epoch after them
+	MinTxFeeWindow = 16
+
+	// minTxFeeTargetDenominator sets the fullness of blocks at which the
+	// fee does not change to 1/2
+	minTxFeeTargetDenominator = 2
+
+	// minTxFeeChangeDenominator bounds the change of the fee from one epoch
+	// to the next to 1/8
+	minTxFeeChangeDenominator = 8
+)
+
+// EpochUsage is the space used by the blocks of one epoch
+type EpochUsage struct {
+	// Bytes is the sum over the blocks of the bytes counted against
+	// MaxBytes. Proposals count HashLen bytes for each tx, so this is not
+	// the size of the txs.
+	Bytes uint64
+	// Blocks is the number of blocks in the epoch
+	Blocks uint32
+}
+
+// EpochUsageFunc returns the EpochUsage of an epoch
+type EpochUsageFunc func(epoch uint32) (*EpochUsage, error)
+
+// nextMinTxFee returns the minimum tx fee of the epoch after the one which
+// had fee and usage; it is not bounded.
+func nextMinTxFee(fee *big.Int, usage *EpochUsage, maxBytes uint32) *big.Int {
+	next := new(big.Int).Set(fee)
+	target := uint64(maxBytes/minTxFeeTargetDenominator) * uint64(usage.Blocks)
+	if target == 0 || usage.Bytes == target {
+		return next
+	}
+	var diff uint64
+	if usage.Bytes > target {
+		diff = usage.Bytes - target
+	} else {
+		diff = target - usage.Bytes
+	}
+	delta := new(big.Int).Mul(fee, new(big.Int).SetUint64(diff))
+	delta.Div(delta, new(big.Int).SetUint64(target*minTxFeeChangeDenominator))
+	if usage.Bytes < target {
+		return next.Sub(next, delta)
+	}
+	if delta.Sign() == 0 {
+		// the fee must be able to rise from small values
+		delta.SetInt64(1)
+	}
+	return next.Add(next, delta)
+}
+
+// dynamicMinTxFeeEnabled returns true if the minimum tx fee of epoch
+// follows the usage of the epochs before it
+func (rs *RawStorage) dynamicMinTxFeeEnabled(epoch uint32) bool {
+	return rs.IsActive(FeatureDynamicMinTxFee, epoch) && rs.GetMinTxFeeMax().Cmp(rs.GetMinTxFee()) > 0
+}
+
+// dynamicMinTxFee returns the minimum tx fee of epoch given the usage of
+// the epochs before it. This is MinTxFee unless the dynamic fee is enabled.
+func (rs *RawStorage) dynamicMinTxFee(epoch uint32, usage EpochUsageFunc) (*big.Int, error) {
+	if epoch == 0 {
+		return nil, ErrZeroEpoch
+	}
+	floor := rs.GetMinTxFee()
+	ceiling := rs.GetMinTxFeeMax()
+	fee := new(big.Int).Set(floor)
+	if !rs.dynamicMinTxFeeEnabled(epoch) {
+		return fee, nil
+	}
+	start := uint32(1)
+	if epoch > MinTxFeeWindow {
+		start = epoch - MinTxFeeWindow
+	}
+	for e := start; e < epoch; e++ {
+		u, err := usage(e)
+		if err != nil {
+			return nil, err
+		}
+		fee = nextMinTxFee(fee, u, rs.GetMaxBytes())
+		if fee.Cmp(floor) < 0 {
+			fee.Set(floor)
+		}
+		if fee.Cmp(ceiling) > 0 {
+			fee.Set(ceiling)
+		}
+	}
+	return fee, nil
+}
@@ -0,0 +1,212 @@
+package dynamics
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestNextMinTxFee(t *testing.T) {
+	maxBytes := uint32(1000)
+	fee := big.NewInt(800)
+
+	// blocks at the target leave the fee unchanged
+	next := nextMinTxFee(fee, &EpochUsage{Bytes: 5000, Blocks: 10}, maxBytes)
+	if next.Cmp(fee) != 0 {
+		t.Fatalf("Incorrect fee (1): %v", next)
+	}
+
+	// full blocks raise the fee by 1/8
+	next = nextMinTxFee(fee, &EpochUsage{Bytes: 10000, Blocks: 10}, maxBytes)
+	if next.Cmp(big.NewInt(900)) != 0 {
+		t.Fatalf("Incorrect fee (2): %v", next)
+	}
+
+	// empty blocks lower the fee by 1/8
+	next = nextMinTxFee(fee, &EpochUsage{Bytes: 0, Blocks: 10}, maxBytes)
+	if next.Cmp(big.NewInt(700)) != 0 {
+		t.Fatalf("Incorrect fee (3): %v", next)
+	}
+
+	// a zero fee can rise
+	next = nextMinTxFee(new(big.Int), &EpochUsage{Bytes: 10000, Blocks: 10}, maxBytes)
+	if next.Cmp(big.NewInt(1)) != 0 {
+		t.Fatalf("Incorrect fee (4): %v", next)
+	}
+
+	// an epoch without blocks leaves the fee unchanged
+	next = nextMinTxFee(fee, &EpochUsage{}, maxBytes)
+	if next.Cmp(fee) != 0 {
+		t.Fatalf("Incorrect fee (5): %v", next)
+	}
+	if fee.Cmp(big.NewInt(800)) != 0 {
+		t.Fatal("fee should not be modified")
+	}
+}
+
+func TestRawStorageDynamicMinTxFee(t *testing.T) {
+	rs := &RawStorage{}
+	rs.standardParameters()
+	if err := rs.SetMinTxFee(big.NewInt(1000)); err != nil {
+		t.Fatal(err)
+	}
+	full := func(epoch uint32) (*EpochUsage, error) {
+		return &EpochUsage{Bytes: uint64(rs.GetMaxBytes()) * 1024, Blocks: 1024}, nil
+	}
+	empty := func(epoch uint32) (*EpochUsage, error) {
+		return &EpochUsage{Bytes: 0, Blocks: 1024}, nil
+	}
+	calls := 0
+	counted := func(epoch uint32) (*EpochUsage, error) {
+		calls++
+		return full(epoch)
+	}
+
+	// disabled while MinTxFeeMax is not above MinTxFee
+	fee, err := rs.dynamicMinTxFee(100, counted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Cmp(big.NewInt(1000)) != 0 || calls != 0 {
+		t.Fatalf("Incorrect fee (1): %v %v", fee, calls)
+	}
+
+	if err := rs.SetMinTxFeeMax(big.NewInt(2000)); err != nil {
+		t.Fatal(err)
+	}
+
+	// disabled while FeatureDynamicMinTxFee is not active
+	fee, err = rs.dynamicMinTxFee(100, counted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Cmp(big.NewInt(1000)) != 0 || calls != 0 {
+		t.Fatalf("Incorrect fee (1b): %v %v", fee, calls)
+	}
+	update, err := NewUpdate(FeatureUpdatePrefix+string(FeatureDynamicMinTxFee), "true", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.UpdateValue(update); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = rs.dynamicMinTxFee(0, counted)
+	if !errors.Is(err, ErrZeroEpoch) {
+		t.Fatal("Should have raised ErrZeroEpoch")
+	}
+
+	// the first epoch has no epochs before it
+	fee, err = rs.dynamicMinTxFee(1, counted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Cmp(big.NewInt(1000)) != 0 || calls != 0 {
+		t.Fatalf("Incorrect fee (2): %v %v", fee, calls)
+	}
+
+	// 1000 * 9/8 * 9/8 = 1265
+	fee, err = rs.dynamicMinTxFee(3, counted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Cmp(big.NewInt(1265)) != 0 || calls != 2 {
+		t.Fatalf("Incorrect fee (3): %v %v", fee, calls)
+	}
+
+	// full blocks stop at MinTxFeeMax; only the window is used
+	calls = 0
+	fee, err = rs.dynamicMinTxFee(1000, counted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Cmp(big.NewInt(2000)) != 0 || calls != MinTxFeeWindow {
+		t.Fatalf("Incorrect fee (4): %v %v", fee, calls)
+	}
+
+	// empty blocks stop at MinTxFee
+	fee, err = rs.dynamicMinTxFee(1000, empty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Cmp(big.NewInt(1000)) != 0 {
+		t.Fatalf("Incorrect fee (5): %v", fee)
+	}
+
+	// errors are returned
+	_, err = rs.dynamicMinTxFee(1000, func(epoch uint32) (*EpochUsage, error) {
+		return nil, ErrKeyNotPresent
+	})
+	if !errors.Is(err, ErrKeyNotPresent) {
+		t.Fatal("Should have raised ErrKeyNotPresent")
+	}
+}
+
+func TestStorageLoadMinTxFee(t *testing.T) {
+	s := initializeStorageWithFirstNode()
+	epoch := uint32(10)
+	update, err := NewUpdate("minTxFee", "1000", epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateStorage(nil, update); err != nil {
+		t.Fatal(err)
+	}
+	update, err = NewUpdate("minTxFeeMax", "4000", epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateStorage(nil, update); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.LoadStorage(nil, epoch); err != nil {
+		t.Fatal(err)
+	}
+
+	// MinTxFee is used while FeatureDynamicMinTxFee is not active
+	fee, err := s.GetMinTxFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Cmp(big.NewInt(1000)) != 0 {
+		t.Fatalf("Incorrect fee: %v", fee)
+	}
+
+	update, err = NewUpdate(FeatureUpdatePrefix+string(FeatureDynamicMinTxFee), "true", epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateStorage(nil, update); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.LoadStorage(nil, epoch); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetMinTxFee(); !errors.Is(err, ErrMinTxFeeNotLoaded) {
+		t.Fatalf("Should have raised ErrMinTxFeeNotLoaded: %v", err)
+	}
+	full := func(epoch uint32) (*EpochUsage, error) {
+		return &EpochUsage{Bytes: uint64(s.rawStorage.GetMaxBytes()), Blocks: 1}, nil
+	}
+	if err := s.LoadMinTxFee(epoch+1, full); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("Should have raised ErrInvalid: %v", err)
+	}
+	if err := s.LoadMinTxFee(epoch, full); err != nil {
+		t.Fatal(err)
+	}
+	fee, err = s.GetMinTxFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Cmp(big.NewInt(1000)) <= 0 {
+		t.Fatalf("fee should have risen: %v", fee)
+	}
+
+	// LoadStorage drops the fee until it is computed again
+	if err := s.LoadStorage(nil, epoch); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetMinTxFee(); !errors.Is(err, ErrMinTxFeeNotLoaded) {
+		t.Fatalf("Should have raised ErrMinTxFeeNotLoaded: %v", err)
+	}
+}
@@ -22,6 +22,7 @@ type RawStorage struct {
 	MsgTimeout                     time.Duration `json:"msgTimeout,omitempty"`
 
 	MinTxFee       *big.Int `json:"minTxFee,omitempty"`
+	MinTxFeeMax    *big.Int `json:"minTxFeeMax,omitempty"`
 	TxValidVersion uint32   `json:"txValidVersion,omitempty"`
 
 	ValueStoreFee          *big.Int `json:"valueStoreFee,omitempty"`
@@ -32,6 +33,10 @@ type RawStorage struct {
 
 	DataStoreEpochFee     *big.Int `json:"dataStoreEpochFee,omitempty"`
 	DataStoreValidVersion uint32   `json:"dataStoreValidVersion,omitempty"`
+
+	// Features maps the name of each active or scheduled Feature to the
+	// epoch from which it is active
+	Features map[string]uint32 `json:"features,omitempty"`
 }
 
 // Marshal performs json.Marshal on the RawStorage struct.
@@ -125,6 +130,16 @@ func (rs *RawStorage) UpdateValue(update Updater) error {
 		if err != nil {
 			return err
 		}
+	case MinTxFeeMaxType:
+		// *big.Int
+		v, err := stringToBigInt(value)
+		if err != nil {
+			return err
+		}
+		err = rs.SetMinTxFeeMax(v)
+		if err != nil {
+			return err
+		}
 	case TxValidVersionType:
 		// uint32
 		v, err := stringToUint32(value)
@@ -183,12 +198,24 @@ func (rs *RawStorage) UpdateValue(update Updater) error {
 			return err
 		}
 		rs.SetDataStoreValidVersion(v)
+	case FeatureType:
+		err := rs.updateFeature(update)
+		if err != nil {
+			return err
+		}
 	default:
 		return ErrInvalidUpdateValue
 	}
 	return nil
 }
 
+// StandardRawStorage returns the values used when none are stored
+func StandardRawStorage() *RawStorage {
+	rs := &RawStorage{}
+	rs.standardParameters()
+	return rs
+}
+
 // standardParameters initializes RawStorage with the standard (original)
 // parameters for the system.
 func (rs *RawStorage) standardParameters() {
@@ -308,6 +335,30 @@ func (rs *RawStorage) SetMinTxFee(value *big.Int) error {
 	return nil
 }
 
+// GetMinTxFeeMax returns the maximum which the dynamic minimum tx fee may
+// reach; the dynamic fee is only used when this is above MinTxFee
+func (rs *RawStorage) GetMinTxFeeMax() *big.Int {
+	if rs.MinTxFeeMax == nil {
+		rs.MinTxFeeMax = new(big.Int)
+	}
+	return rs.MinTxFeeMax
+}
+
+// SetMinTxFeeMax sets the maximum of the dynamic minimum tx fee
+func (rs *RawStorage) SetMinTxFeeMax(value *big.Int) error {
+	if value == nil {
+		return ErrInvalidValue
+	}
+	if rs.MinTxFeeMax == nil {
+		rs.MinTxFeeMax = new(big.Int)
+	}
+	if value.Sign() < 0 {
+		return ErrInvalidValue
+	}
+	rs.MinTxFeeMax.Set(value)
+	return nil
+}
+
 // GetTxValidVersion returns the valid version of tx
 func (rs *RawStorage) GetTxValidVersion() uint32 {
 	return rs.TxValidVersion
@@ -861,6 +861,46 @@ func TestRawStorageUpdateMinTxBurnedFee(t *testing.T) {
 	}
 }
 
+func TestRawStorageUpdateMinTxFeeMax(t *testing.T) {
+	rs := &RawStorage{}
+
+	retMinTxFeeMax := rs.GetMinTxFeeMax()
+	if retMinTxFeeMax.Sign() != 0 {
+		t.Fatal("Incorrect MinTxFeeMax (1)")
+	}
+
+	field := "minTxFeeMax"
+	valueBad := "-1"
+	epoch := uint32(1)
+	update, err := NewUpdate(field, valueBad, epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.UpdateValue(update)
+	if !errors.Is(err, ErrInvalid) {
+		t.Fatal("Should have raised ErrInvalid error")
+	}
+
+	valueGood := "5000000000"
+	update, err = NewUpdate(field, valueGood, epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.UpdateValue(update)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valueTrue, ok := new(big.Int).SetString(valueGood, 10)
+	if !ok {
+		t.Fatal("SetString failed")
+	}
+	retMinTxFeeMax = rs.GetMinTxFeeMax()
+	if retMinTxFeeMax.Cmp(valueTrue) != 0 {
+		t.Fatal("Incorrect MinTxFeeMax (2)")
+	}
+}
+
 func TestRawStorageUpdateTxValidVersion(t *testing.T) {
 	rs := &RawStorage{}
 
@@ -47,6 +47,7 @@ type StorageGetter interface {
 
 	UpdateStorage(*badger.Txn, Updater) error
 	LoadStorage(*badger.Txn, uint32) error
+	LoadMinTxFee(uint32, EpochUsageFunc) error
 
 	GetDataStoreEpochFee() *big.Int
 	GetDataStoreValidVersion() uint32
@@ -57,8 +58,11 @@ type StorageGetter interface {
 	GetAtomicSwapFee() *big.Int
 	GetAtomicSwapValidStopEpoch() uint32
 
-	GetMinTxFee() *big.Int
+	GetMinTxFee() (*big.Int, error)
 	GetTxValidVersion() uint32
+
+	IsActive(Feature, uint32) bool
+	GetFeatures() []*FeatureInfo
 }
 
 // Storage is the struct which will implement the StorageGetter interface.
@@ -68,6 +72,8 @@ type Storage struct {
 	startChan  chan struct{}
 	startOnce  sync.Once
 	rawStorage *RawStorage
+	epoch      uint32
+	minTxFee   *big.Int
 	logger     *logrus.Logger
 }
 
@@ -132,17 +138,7 @@ func (s *Storage) UpdateStorage(txn *badger.Txn, update Updater) error {
 		}
 		rs := &RawStorage{}
 		rs.standardParameters()
-		node, ll, err := CreateLinkedList(1, rs)
-		if err != nil {
-			utils.DebugTrace(s.logger, err)
-			return err
-		}
-		err = s.database.SetLinkedList(txn, ll)
-		if err != nil {
-			utils.DebugTrace(s.logger, err)
-			return err
-		}
-		err = s.database.SetNode(txn, node)
+		err = s.createLinkedList(txn, rs)
 		if err != nil {
 			utils.DebugTrace(s.logger, err)
 			return err
@@ -157,6 +153,49 @@ func (s *Storage) UpdateStorage(txn *badger.Txn, update Updater) error {
 	return nil
 }
 
+// InitStorage stores rs as the values from the first epoch. It is used to
+// initialize a chain from a genesis file and fails with ErrInvalid if values
+// are already stored.
+func (s *Storage) InitStorage(txn *badger.Txn, rs *RawStorage) error {
+	select {
+	case <-s.startChan:
+	}
+	s.Lock()
+	defer s.Unlock()
+
+	if !rs.IsValid() {
+		return ErrInvalid
+	}
+	_, err := s.database.GetLinkedList(txn)
+	if err == nil {
+		return ErrInvalid
+	}
+	if !errors.Is(err, ErrKeyNotPresent) {
+		utils.DebugTrace(s.logger, err)
+		return err
+	}
+	rsCopy, err := rs.Copy()
+	if err != nil {
+		utils.DebugTrace(s.logger, err)
+		return err
+	}
+	return s.createLinkedList(txn, rsCopy)
+}
+
+// createLinkedList stores a LinkedList whose only node holds rs from the
+// first epoch
+func (s *Storage) createLinkedList(txn *badger.Txn, rs *RawStorage) error {
+	node, ll, err := CreateLinkedList(1, rs)
+	if err != nil {
+		return err
+	}
+	err = s.database.SetLinkedList(txn, ll)
+	if err != nil {
+		return err
+	}
+	return s.database.SetNode(txn, node)
+}
+
 // updateStorageValue updates the stored RawStorage values.
 //
 // We start at the Head of LinkedList and find the farthest point
@@ -366,6 +405,29 @@ func (s *Storage) LoadStorage(txn *badger.Txn, epoch uint32) error {
 		utils.DebugTrace(s.logger, err)
 		return err
 	}
+	s.epoch = epoch
+	s.minTxFee = nil
+	return nil
+}
+
+// LoadMinTxFee sets the minimum tx fee of epoch, which must be the epoch
+// last given to LoadStorage. If the dynamic fee is enabled, usage is
+// called for the epochs which determine it; until LoadMinTxFee succeeds,
+// GetMinTxFee returns ErrMinTxFeeNotLoaded.
+func (s *Storage) LoadMinTxFee(epoch uint32, usage EpochUsageFunc) error {
+	select {
+	case <-s.startChan:
+	}
+	s.Lock()
+	defer s.Unlock()
+	if epoch != s.epoch {
+		return ErrInvalid
+	}
+	fee, err := s.rawStorage.dynamicMinTxFee(epoch, usage)
+	if err != nil {
+		return err
+	}
+	s.minTxFee = fee
 	return nil
 }
 
@@ -688,14 +750,22 @@ func (s *Storage) GetDownloadTimeout() time.Duration {
 	return s.rawStorage.GetDownloadTimeout()
 }
 
-// GetMinTxFee returns the minimum transaction fee.
-func (s *Storage) GetMinTxFee() *big.Int {
+// GetMinTxFee returns the minimum transaction fee of the loaded epoch.
+// ErrMinTxFeeNotLoaded is returned if the fee follows the fullness of
+// recent blocks and LoadMinTxFee has not computed it.
+func (s *Storage) GetMinTxFee() (*big.Int, error) {
 	select {
 	case <-s.startChan:
 	}
 	s.RLock()
 	defer s.RUnlock()
-	return s.rawStorage.GetMinTxFee()
+	if s.minTxFee != nil {
+		return s.minTxFee, nil
+	}
+	if s.epoch != 0 && s.rawStorage.dynamicMinTxFeeEnabled(s.epoch) {
+		return nil, ErrMinTxFeeNotLoaded
+	}
+	return s.rawStorage.GetMinTxFee(), nil
 }
 
 // GetTxValidVersion returns the transaction valid version
@@ -767,3 +837,25 @@ func (s *Storage) GetDataStoreValidVersion() uint32 {
 	defer s.RUnlock()
 	return s.rawStorage.GetDataStoreValidVersion()
 }
+
+// IsActive returns true if feature is active at epoch. The schedule is the
+// one known at the epoch last given to LoadStorage; later updates are not
+// seen until their epoch is loaded.
+func (s *Storage) IsActive(feature Feature, epoch uint32) bool {
+	select {
+	case <-s.startChan:
+	}
+	s.RLock()
+	defer s.RUnlock()
+	return s.rawStorage.IsActive(feature, epoch)
+}
+
+// GetFeatures returns the registered and the scheduled features
+func (s *Storage) GetFeatures() []*FeatureInfo {
+	select {
+	case <-s.startChan:
+	}
+	s.RLock()
+	defer s.RUnlock()
+	return s.rawStorage.GetFeatures()
+}
@@ -142,7 +142,10 @@ func TestStorageInitialized(t *testing.T) {
 		t.Fatal("Incorrect downloadTimeout")
 	}
 
-	minTxFee := s.GetMinTxFee()
+	minTxFee, err := s.GetMinTxFee()
+	if err != nil {
+		t.Fatal(err)
+	}
 	if minTxFee.Sign() != 0 {
 		t.Fatal("Incorrect minTxFee")
 	}
@@ -183,6 +186,45 @@ func TestStorageInitialized(t *testing.T) {
 	}
 }
 
+func TestStorageInitStorage(t *testing.T) {
+	s := initializeStorage()
+	rs := &RawStorage{}
+	rs.standardParameters()
+	rs.MaxBytes = 12345
+	rs.Features = map[string]uint32{"x": 3}
+	if err := s.InitStorage(nil, rs); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.LoadStorage(nil, 1); err != nil {
+		t.Fatal(err)
+	}
+	if s.GetMaxBytes() != 12345 {
+		t.Fatal("Incorrect MaxBytes")
+	}
+	if !s.IsActive("x", 3) {
+		t.Fatal("Feature should be active")
+	}
+
+	// later updates apply on top of the initial values
+	update, err := NewUpdate("maxBytes", "3000000", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateStorage(nil, update); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.LoadStorage(nil, 9); err != nil {
+		t.Fatal(err)
+	}
+	if s.GetMaxBytes() != 12345 {
+		t.Fatal("Incorrect MaxBytes at epoch 9")
+	}
+
+	if err := s.InitStorage(nil, rs); !errors.Is(err, ErrInvalid) {
+		t.Fatal("Should have raised ErrInvalid")
+	}
+}
+
 func TestStorageCheckUpdate(t *testing.T) {
 	fieldBad := "invalid"
 	valueBad := "invalid"
@@ -1335,7 +1377,10 @@ func TestStorageUpdateStorageValueBad4(t *testing.T) {
 
 func TestStorageGetMinTxFee(t *testing.T) {
 	s := initializeStorageWithFirstNode()
-	txFee := s.GetMinTxFee()
+	txFee, err := s.GetMinTxFee()
+	if err != nil {
+		t.Fatal(err)
+	}
 	if txFee.Sign() != 0 {
 		t.Fatal("txFee should be zero")
 	}
@@ -1361,7 +1406,7 @@ func TestStorageGetMinTxFee(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	txFee = s.GetMinTxFee()
+	txFee, err = s.GetMinTxFee()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -55,6 +55,13 @@ const (
 
 	// DataStoreValidVersionType is the UpdateType for updating DataStoreValidVersion
 	DataStoreValidVersionType
+
+	// MinTxFeeMaxType is the UpdateType for updating MinTxFeeMax
+	MinTxFeeMaxType
+
+	// FeatureType is the UpdateType for activating or deactivating a Feature;
+	// the field names the Feature
+	FeatureType
 )
 
 // Updater specifies the interface we use for updating Storage
@@ -172,6 +179,8 @@ func convertFieldToType(field string) (UpdateType, error) {
 		return MsgTimeoutType, nil
 	case "minTxFee":
 		return MinTxFeeType, nil
+	case "minTxFeeMax":
+		return MinTxFeeMaxType, nil
 	case "txValidVersion":
 		return TxValidVersionType, nil
 	case "valueStoreFee":
@@ -187,6 +196,9 @@ func convertFieldToType(field string) (UpdateType, error) {
 	case "dataStoreValidVersion":
 		return DataStoreValidVersionType, nil
 	default:
+		if _, err := featureFromField(field); err == nil {
+			return FeatureType, nil
+		}
 		return UpdateType(0), ErrInvalid
 	}
 }
@@ -375,4 +375,13 @@ func TestConvertFieldToType(t *testing.T) {
 	if uType != DataStoreValidVersionType {
 		t.Fatal("Incorrect UpdateType (13)")
 	}
+
+	field = "minTxFeeMax"
+	uType, err = convertFieldToType(field)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uType != MinTxFeeMaxType {
+		t.Fatal("Incorrect UpdateType (14)")
+	}
 }
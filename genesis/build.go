@@ -0,0 +1,140 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sync"
+
+	aobjs "github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/consensus/admin"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/dgraph-io/badger/v2"
+)
+
+// DepositAdder adds deposits to the state, as deposit.Handler does
+type DepositAdder interface {
+	Add(txn *badger.Txn, chainID uint32, utxoID []byte, biValue *big.Int, owner *aobjs.Owner) error
+}
+
+// Build initializes an empty database with the state of the height 1 block
+// of g. The dynamics values and the allocations are stored first; then
+// ah.AddValidatorSet applies the state of height 1 and stores the block,
+// the validator set and the own state of the node. ah must have been
+// initialized with database and the chainID of g, and firewalld must be
+// disabled. If groupPrivk is not empty it is stored through ah as the
+// private group share of the node, which must be one of the validators.
+//
+// Build returns the header of the height 1 block and the genesis hash, which
+// is stored in database.
+func Build(g *Genesis, database *db.Database, ah *admin.Handlers, storage *dynamics.Storage, depositHandler DepositAdder, groupPrivk []byte) (*objs.BlockHeader, []byte, error) {
+	vs, err := g.ValidatorSet()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(groupPrivk) > 0 {
+		if err := checkGroupShare(vs, groupPrivk); err != nil {
+			return nil, nil, err
+		}
+	}
+	err = database.Update(func(txn *badger.Txn) error {
+		if _, err := database.GetLastSnapshot(txn); err != badger.ErrKeyNotFound {
+			if err != nil {
+				return err
+			}
+			return errorz.ErrInvalid{}.New("genesis: the database already holds a chain")
+		}
+		if err := storage.InitStorage(txn, g.Dynamics); err != nil {
+			return err
+		}
+		for i, a := range g.Allocations {
+			acct, err := hex.DecodeString(a.Owner)
+			if err != nil {
+				return err
+			}
+			owner := &aobjs.Owner{}
+			if err := owner.New(acct, a.CurveSpec); err != nil {
+				return err
+			}
+			value, ok := new(big.Int).SetString(a.Value, 10)
+			if !ok {
+				return errorz.ErrInvalid{}.New("genesis: invalid value of " + a.Owner)
+			}
+			if err := depositHandler.Add(txn, g.ChainID, AllocationID(i), value, owner); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the admin handlers take a lock from the synchronizer for each call
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		lock := &sync.Mutex{}
+		for {
+			select {
+			case ah.ReceiveLock <- lock:
+			case <-done:
+				return
+			}
+		}
+	}()
+	if err := ah.AddValidatorSet(vs); err != nil {
+		return nil, nil, err
+	}
+	if len(groupPrivk) > 0 {
+		if err := ah.AddPrivateKey(groupPrivk, constants.CurveBN256Eth); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var bh *objs.BlockHeader
+	var hash []byte
+	err = database.Update(func(txn *badger.Txn) error {
+		bh, err = database.GetCommittedBlockHeader(txn, 1)
+		if err != nil {
+			return err
+		}
+		blockHash, err := bh.BlockHash()
+		if err != nil {
+			return err
+		}
+		hash, err = g.Hash(blockHash)
+		if err != nil {
+			return err
+		}
+		return database.SetGenesisHash(txn, hash)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return bh, hash, nil
+}
+
+// checkGroupShare fails if the public share of groupPrivk is not the group
+// share of a validator of vs
+func checkGroupShare(vs *objs.ValidatorSet, groupPrivk []byte) error {
+	signer := &crypto.BNGroupSigner{}
+	if err := signer.SetPrivk(groupPrivk); err != nil {
+		return err
+	}
+	share, err := signer.PubkeyShare()
+	if err != nil {
+		return err
+	}
+	for _, v := range vs.Validators {
+		if bytes.Equal(v.GroupShare, share) {
+			return nil
+		}
+	}
+	return errorz.ErrInvalid{}.New("genesis: the group key is not the share of a validator")
+}
@@ -0,0 +1,217 @@
+// Package genesis defines the genesis file of a chain and builds the state of
+// its first block from it.
+//
+// A genesis file is JSON:
+//
+//	{
+//	  "chainID": 42,
+//	  "groupKey": "<hex of the 128 byte group public key>",
+//	  "validators": [
+//	    {"vAddr": "<hex of the 20 byte address>", "groupShare": "<hex of the 128 byte share>"}
+//	  ],
+//	  "dynamics": {"maxBytes": 3000000, "minTxFee": 4},
+//	  "allocations": [
+//	    {"owner": "<hex of the 20 byte account>", "value": "1000000"}
+//	  ]
+//	}
+//
+// The dynamics values are those of dynamics.RawStorage; the values which are
+// left out keep their standard value. Every allocation becomes a deposit, as
+// the deposits made on Ethereum do, owned by a secp256k1 account unless its
+// curveSpec says otherwise.
+//
+// The hash of a genesis file covers its canonical encoding and the hash of
+// the height 1 block built from it, so two nodes which agree on the hash
+// start from the same chain.
+package genesis
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/errorz"
+)
+
+// Genesis is the content of a genesis file
+type Genesis struct {
+	ChainID     uint32               `json:"chainID"`
+	GroupKey    string               `json:"groupKey"`
+	Validators  []*Validator         `json:"validators"`
+	Dynamics    *dynamics.RawStorage `json:"dynamics"`
+	Allocations []*Allocation        `json:"allocations,omitempty"`
+}
+
+// Validator is a member of the initial validator set
+type Validator struct {
+	VAddr      string `json:"vAddr"`
+	GroupShare string `json:"groupShare"`
+}
+
+// Allocation is a ValueStore which exists from the first block
+type Allocation struct {
+	Owner     string              `json:"owner"`
+	CurveSpec constants.CurveSpec `json:"curveSpec,omitempty"`
+	Value     string              `json:"value"`
+}
+
+// Load reads and parses the genesis file at path
+func Load(path string) (*Genesis, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Parse decodes a genesis file, fills in the standard dynamics values and
+// validates it. Hex strings are lower cased and stripped of 0x and values
+// are written in base 10, so equivalent files have the same canonical
+// encoding.
+func Parse(data []byte) (*Genesis, error) {
+	g := &Genesis{Dynamics: dynamics.StandardRawStorage()}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(g); err != nil {
+		return nil, errorz.ErrInvalid{}.New("genesis: " + err.Error())
+	}
+	if dec.More() {
+		return nil, errorz.ErrInvalid{}.New("genesis: data after the genesis object")
+	}
+	if g.Dynamics == nil {
+		g.Dynamics = dynamics.StandardRawStorage()
+	}
+	if err := g.normalize(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// normalize validates g and rewrites its strings in canonical form
+func (g *Genesis) normalize() error {
+	if g.ChainID == 0 {
+		return errorz.ErrInvalid{}.New("genesis: chainID must not be zero")
+	}
+	groupKey, err := decodeHex(g.GroupKey, constants.CurveBN256EthPubkeyLen, "groupKey")
+	if err != nil {
+		return err
+	}
+	g.GroupKey = hex.EncodeToString(groupKey)
+	if len(g.Validators) == 0 {
+		return errorz.ErrInvalid{}.New("genesis: no validators")
+	}
+	// round states index validators by a uint8
+	if len(g.Validators) > 256 {
+		return errorz.ErrInvalid{}.New("genesis: too many validators")
+	}
+	seen := make(map[string]bool)
+	for _, v := range g.Validators {
+		if v == nil {
+			return errorz.ErrInvalid{}.New("genesis: empty validator")
+		}
+		vAddr, err := decodeHex(v.VAddr, constants.OwnerLen, "vAddr")
+		if err != nil {
+			return err
+		}
+		share, err := decodeHex(v.GroupShare, constants.CurveBN256EthPubkeyLen, "groupShare")
+		if err != nil {
+			return err
+		}
+		v.VAddr = hex.EncodeToString(vAddr)
+		v.GroupShare = hex.EncodeToString(share)
+		if seen[v.VAddr] || seen[v.GroupShare] {
+			return errorz.ErrInvalid{}.New("genesis: duplicate validator " + v.VAddr)
+		}
+		seen[v.VAddr] = true
+		seen[v.GroupShare] = true
+	}
+	if !g.Dynamics.IsValid() || g.Dynamics.GetMaxBytes() == 0 {
+		return errorz.ErrInvalid{}.New("genesis: invalid dynamics")
+	}
+	for _, a := range g.Allocations {
+		if a == nil {
+			return errorz.ErrInvalid{}.New("genesis: empty allocation")
+		}
+		owner, err := decodeHex(a.Owner, constants.OwnerLen, "owner")
+		if err != nil {
+			return err
+		}
+		a.Owner = hex.EncodeToString(owner)
+		switch a.CurveSpec {
+		case 0:
+			a.CurveSpec = constants.CurveSecp256k1
+		case constants.CurveSecp256k1, constants.CurveBN256Eth:
+		default:
+			return errorz.ErrInvalid{}.New("genesis: invalid curveSpec of " + a.Owner)
+		}
+		value, ok := new(big.Int).SetString(a.Value, 10)
+		if !ok || value.Sign() <= 0 || value.BitLen() > 256 {
+			return errorz.ErrInvalid{}.New("genesis: invalid value of " + a.Owner)
+		}
+		a.Value = value.String()
+	}
+	return nil
+}
+
+// decodeHex decodes the field name of a genesis file, which must hold n
+// bytes
+func decodeHex(s string, n int, name string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+	if err != nil || len(b) != n {
+		return nil, errorz.ErrInvalid{}.New("genesis: invalid " + name + " " + s)
+	}
+	return b, nil
+}
+
+// Marshal returns the canonical encoding of a parsed genesis file
+func (g *Genesis) Marshal() ([]byte, error) {
+	return json.Marshal(g)
+}
+
+// Hash returns the genesis hash of g given the hash of the height 1 block
+// built from it
+func (g *Genesis) Hash(blockHash []byte) ([]byte, error) {
+	data, err := g.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Hasher(data, blockHash), nil
+}
+
+// ValidatorSet returns the initial validator set
+func (g *Genesis) ValidatorSet() (*objs.ValidatorSet, error) {
+	groupKey, err := hex.DecodeString(g.GroupKey)
+	if err != nil {
+		return nil, err
+	}
+	vs := &objs.ValidatorSet{GroupKey: groupKey, NotBefore: 1}
+	for _, v := range g.Validators {
+		vAddr, err := hex.DecodeString(v.VAddr)
+		if err != nil {
+			return nil, err
+		}
+		share, err := hex.DecodeString(v.GroupShare)
+		if err != nil {
+			return nil, err
+		}
+		vs.Validators = append(vs.Validators, &objs.Validator{VAddr: vAddr, GroupShare: share})
+	}
+	return vs, nil
+}
+
+// AllocationID returns the deposit ID of the i-th allocation. It is a hash,
+// so it does not collide with the IDs of deposits made on Ethereum, which
+// count up from one.
+func AllocationID(i int) []byte {
+	var idx [4]byte
+	binary.BigEndian.PutUint32(idx[:], uint32(i))
+	return crypto.Hasher([]byte("genesis allocation"), idx[:])
+}
@@ -0,0 +1,256 @@
+package genesis
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/MadBase/MadNet/application"
+	"github.com/MadBase/MadNet/application/deposit"
+	"github.com/MadBase/MadNet/consensus/admin"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/ipc"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+// testGenesis returns a genesis file of two validators and the private
+// group share of the first
+func testGenesis(t *testing.T) (string, []byte) {
+	t.Helper()
+	var shares []string
+	var privks [][]byte
+	for i := 1; i <= 2; i++ {
+		privk := crypto.Hasher([]byte{byte(i)})
+		signer := &crypto.BNGroupSigner{}
+		if err := signer.SetPrivk(privk); err != nil {
+			t.Fatal(err)
+		}
+		share, err := signer.PubkeyShare()
+		if err != nil {
+			t.Fatal(err)
+		}
+		shares = append(shares, hex.EncodeToString(share))
+		privks = append(privks, privk)
+	}
+	groupKey := strings.Repeat("ab", constants.CurveBN256EthPubkeyLen)
+	return `{
+  "chainID": 42,
+  "groupKey": "0x` + strings.ToUpper(groupKey) + `",
+  "validators": [
+    {"vAddr": "0x` + strings.Repeat("11", 20) + `", "groupShare": "` + shares[0] + `"},
+    {"vAddr": "` + strings.Repeat("22", 20) + `", "groupShare": "` + shares[1] + `"}
+  ],
+  "dynamics": {"maxBytes": 1000, "minTxFee": 5, "features": {"x": 3}},
+  "allocations": [
+    {"owner": "` + strings.Repeat("33", 20) + `", "value": "0100"},
+    {"owner": "` + strings.Repeat("44", 20) + `", "curveSpec": 2, "value": "7"}
+  ]
+}`, privks[0]
+}
+
+func TestParse(t *testing.T) {
+	data, _ := testGenesis(t)
+	g, err := Parse([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.GroupKey != strings.Repeat("ab", constants.CurveBN256EthPubkeyLen) {
+		t.Fatal("groupKey should be normalized")
+	}
+	if g.Validators[0].VAddr != strings.Repeat("11", 20) {
+		t.Fatal("vAddr should be normalized")
+	}
+	if g.Allocations[0].Value != "100" || g.Allocations[0].CurveSpec != constants.CurveSecp256k1 {
+		t.Fatalf("Incorrect allocation: %+v", g.Allocations[0])
+	}
+	if g.Dynamics.GetMaxBytes() != 1000 || g.Dynamics.GetMinTxFee().Cmp(big.NewInt(5)) != 0 {
+		t.Fatal("Incorrect dynamics")
+	}
+	if g.Dynamics.GetMsgTimeout() != dynamics.StandardRawStorage().GetMsgTimeout() {
+		t.Fatal("dynamics should default to the standard values")
+	}
+
+	// the canonical encoding parses to itself
+	canonical, err := g.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	g2, err := Parse(canonical)
+	if err != nil {
+		t.Fatal(err)
+	}
+	canonical2, err := g2.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(canonical, canonical2) {
+		t.Fatal("canonical encoding is not stable")
+	}
+
+	bad := []string{
+		strings.Replace(data, `"chainID": 42`, `"chainID": 0`, 1),
+		strings.Replace(data, `"groupKey": "0x`, `"groupKey": "0xab`, 1),
+		strings.Replace(data, strings.Repeat("22", 20), strings.Repeat("11", 20), 1),
+		strings.Replace(data, `"0100"`, `"-1"`, 1),
+		strings.Replace(data, `"curveSpec": 2`, `"curveSpec": 9`, 1),
+		strings.Replace(data, `"chainID": 42`, `"chainID": 42, "extra": 1`, 1),
+		strings.Replace(data, `"maxBytes": 1000`, `"maxBytes": 0`, 1),
+		data + "{}",
+	}
+	for i, b := range bad {
+		_, err := Parse([]byte(b))
+		var e *errorz.ErrInvalid
+		if !errors.As(err, &e) {
+			t.Fatalf("Should have raised ErrInvalid (%v): %v", i, err)
+		}
+	}
+}
+
+type testNode struct {
+	database *db.Database
+	ah       *admin.Handlers
+	storage  *dynamics.Storage
+	dph      *deposit.Handler
+}
+
+func newTestNode(t *testing.T, chainID uint32, vAddr []byte) *testNode {
+	t.Helper()
+	ctx, cf := context.WithCancel(context.Background())
+	t.Cleanup(cf)
+	rawDB, err := utils.OpenBadger(ctx.Done(), "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rawDB.Close() })
+	txPoolDB, err := utils.OpenBadger(ctx.Done(), "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { txPoolDB.Close() })
+	n := &testNode{
+		database: &db.Database{},
+		ah:       &admin.Handlers{},
+		storage:  &dynamics.Storage{},
+		dph:      &deposit.Handler{},
+	}
+	n.database.Init(rawDB)
+	if err := n.storage.Init(n.database, logging.GetLogger(constants.LoggerDB)); err != nil {
+		t.Fatal(err)
+	}
+	n.storage.Start()
+	n.dph.Init()
+	app := &application.Application{}
+	if err := app.Init(n.database, txPoolDB, n.dph, n.storage); err != nil {
+		t.Fatal(err)
+	}
+	n.ah.Init(chainID, n.database, crypto.Hasher([]byte("secret")), app, nil, n.storage, ipc.NewServer(""))
+	n.ah.SetAccount(vAddr)
+	t.Cleanup(n.ah.Close)
+	return n
+}
+
+func TestBuild(t *testing.T) {
+	data, privk := testGenesis(t)
+	g, err := Parse([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n1 := newTestNode(t, g.ChainID, bytes.Repeat([]byte{0x11}, 20))
+	bh1, hash1, err := Build(g, n1.database, n1.ah, n1.storage, n1.dph, privk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bh1.BClaims.Height != 1 || bh1.BClaims.ChainID != 42 {
+		t.Fatalf("Incorrect header: %+v", bh1.BClaims)
+	}
+
+	// a node which is not a validator builds the same chain
+	n2 := newTestNode(t, g.ChainID, bytes.Repeat([]byte{0x55}, 20))
+	bh2, hash2, err := Build(g, n2.database, n2.ah, n2.storage, n2.dph, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(hash1, hash2) || !bytes.Equal(bh1.BClaims.StateRoot, bh2.BClaims.StateRoot) {
+		t.Fatal("genesis hashes should be equal")
+	}
+
+	err = n1.database.View(func(txn *badger.Txn) error {
+		stored, err := n1.database.GetGenesisHash(txn)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(stored, hash1) {
+			t.Fatal("Incorrect stored genesis hash")
+		}
+		vs, err := n1.database.GetValidatorSet(txn, 1)
+		if err != nil {
+			return err
+		}
+		if len(vs.Validators) != 2 {
+			t.Fatal("Incorrect validator set")
+		}
+		utxos, missing, _, err := n1.dph.Get(txn, [][]byte{AllocationID(0), AllocationID(1)})
+		if err != nil {
+			return err
+		}
+		if len(utxos) != 2 || len(missing) != 0 {
+			t.Fatal("allocations should be deposits")
+		}
+		return n1.storage.LoadStorage(txn, 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n1.storage.GetMaxBytes() != 1000 || !n1.storage.IsActive("x", 3) {
+		t.Fatal("Incorrect dynamics")
+	}
+	if _, err := n1.ah.GetPrivK(mustShare(t, privk)); err != nil {
+		t.Fatal(err)
+	}
+
+	// a changed file has another hash
+	g.Allocations[1].Value = "8"
+	n3 := newTestNode(t, g.ChainID, bytes.Repeat([]byte{0x11}, 20))
+	_, hash3, err := Build(g, n3.database, n3.ah, n3.storage, n3.dph, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(hash1, hash3) {
+		t.Fatal("genesis hashes should differ")
+	}
+
+	// a chain is built once
+	if _, _, err := Build(g, n1.database, n1.ah, n1.storage, n1.dph, nil); err == nil {
+		t.Fatal("Should have raised error")
+	}
+
+	// the group key must belong to a validator
+	n4 := newTestNode(t, g.ChainID, bytes.Repeat([]byte{0x11}, 20))
+	if _, _, err := Build(g, n4.database, n4.ah, n4.storage, n4.dph, crypto.Hasher([]byte("other"))); err == nil {
+		t.Fatal("Should have raised error")
+	}
+}
+
+func mustShare(t *testing.T, privk []byte) []byte {
+	t.Helper()
+	signer := &crypto.BNGroupSigner{}
+	if err := signer.SetPrivk(privk); err != nil {
+		t.Fatal(err)
+	}
+	share, err := signer.PubkeyShare()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return share
+}
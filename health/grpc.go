@@ -0,0 +1,87 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// Service names understood by the gRPC health service in addition to the
+// component names. The empty service name reports readiness as required by
+// the gRPC health checking protocol.
+const (
+	ServiceReadiness = ""
+	ServiceLiveness  = "liveness"
+)
+
+// watchInterval is how often the checks are rerun for a Watch call
+const watchInterval = time.Second
+
+// GRPCServer implements the standard gRPC health service on top of a Checker
+type GRPCServer struct {
+	checker *Checker
+}
+
+// NewGRPCServer returns the gRPC health service for checker
+func NewGRPCServer(checker *Checker) *GRPCServer {
+	return &GRPCServer{checker: checker}
+}
+
+// Register adds the health service to a gRPC server
+func (s *GRPCServer) Register(grpcServer *grpc.Server) {
+	healthpb.RegisterHealthServer(grpcServer, s)
+}
+
+func (s *GRPCServer) servingStatus(service string) (healthpb.HealthCheckResponse_ServingStatus, bool) {
+	var healthy bool
+	switch service {
+	case ServiceReadiness:
+		healthy = s.checker.Readiness().Healthy
+	case ServiceLiveness:
+		healthy = s.checker.Liveness().Healthy
+	default:
+		st, ok := s.checker.Component(service)
+		if !ok {
+			return healthpb.HealthCheckResponse_SERVICE_UNKNOWN, false
+		}
+		healthy = st.Healthy
+	}
+	if healthy {
+		return healthpb.HealthCheckResponse_SERVING, true
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING, true
+}
+
+// Check reports the serving status of a service. Unknown services fail with
+// NotFound.
+func (s *GRPCServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	st, ok := s.servingStatus(req.Service)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.Service)
+	}
+	return &healthpb.HealthCheckResponse{Status: st}, nil
+}
+
+// Watch sends the serving status of a service and then every change of the
+// status until the client goes away
+func (s *GRPCServer) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	last := healthpb.HealthCheckResponse_ServingStatus(-1)
+	for {
+		st, _ := s.servingStatus(req.Service)
+		if st != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: st}); err != nil {
+				return err
+			}
+			last = st
+		}
+		select {
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "stream has ended")
+		case <-time.After(watchInterval):
+		}
+	}
+}
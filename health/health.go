@@ -0,0 +1,140 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+// Component names of the checks registered by the validator
+const (
+	ComponentDatabase     = "database"
+	ComponentPeering      = "peering"
+	ComponentEthereum     = "ethereum"
+	ComponentMonitor      = "monitor"
+	ComponentFastSync     = "fastsync"
+	ComponentValidatorKey = "validator_key"
+)
+
+// CheckFunc reports whether a component is healthy along with a human
+// readable reason. Checks must be cheap and safe to call concurrently since
+// they are run on every probe.
+type CheckFunc func() (bool, string)
+
+// Status is the outcome of the check of a single component
+type Status struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Reason  string `json:"reason"`
+}
+
+// Report is the outcome of a set of checks. The report is healthy only if
+// every component is healthy.
+type Report struct {
+	Healthy    bool      `json:"healthy"`
+	Components []*Status `json:"components"`
+}
+
+type check struct {
+	name     string
+	liveness bool
+	fn       CheckFunc
+}
+
+// Checker holds the checks of the components of the node. Liveness checks
+// report whether the process is able to make progress at all and are used
+// for /healthz; every check must pass for the node to be ready to serve
+// requests at /readyz.
+type Checker struct {
+	sync.RWMutex
+	checks []*check
+}
+
+// NewChecker returns a Checker without any checks
+func NewChecker() *Checker {
+	return &Checker{}
+}
+
+// Register adds the check of a component. Registering a name twice replaces
+// the earlier check.
+func (c *Checker) Register(name string, liveness bool, fn CheckFunc) {
+	c.Lock()
+	defer c.Unlock()
+	for i, chk := range c.checks {
+		if chk.name == name {
+			c.checks[i] = &check{name, liveness, fn}
+			return
+		}
+	}
+	c.checks = append(c.checks, &check{name, liveness, fn})
+}
+
+// Liveness runs the liveness checks
+func (c *Checker) Liveness() *Report {
+	return c.run(func(chk *check) bool { return chk.liveness })
+}
+
+// Readiness runs every check
+func (c *Checker) Readiness() *Report {
+	return c.run(func(chk *check) bool { return true })
+}
+
+// Component runs the check of a single component. False is returned if no
+// check is registered under name.
+func (c *Checker) Component(name string) (*Status, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	for _, chk := range c.checks {
+		if chk.name == name {
+			return chk.run(), true
+		}
+	}
+	return nil, false
+}
+
+func (c *Checker) run(filter func(*check) bool) *Report {
+	c.RLock()
+	defer c.RUnlock()
+	r := &Report{Healthy: true, Components: []*Status{}}
+	for _, chk := range c.checks {
+		if !filter(chk) {
+			continue
+		}
+		s := chk.run()
+		r.Healthy = r.Healthy && s.Healthy
+		r.Components = append(r.Components, s)
+	}
+	return r
+}
+
+func (chk *check) run() *Status {
+	ok, reason := chk.fn()
+	return &Status{Name: chk.name, Healthy: ok, Reason: reason}
+}
+
+// HealthzHandler serves the liveness report. The status is 200 if the node
+// is alive and 503 otherwise.
+func (c *Checker) HealthzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, c.Liveness())
+	})
+}
+
+// ReadyzHandler serves the readiness report. The status is 200 if the node
+// is ready and 503 otherwise.
+func (c *Checker) ReadyzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, c.Readiness())
+	})
+}
+
+func writeReport(w http.ResponseWriter, r *Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if r.Healthy {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(r)
+}
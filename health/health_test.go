@@ -0,0 +1,96 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func newTestChecker(peersOK *bool) *Checker {
+	c := NewChecker()
+	c.Register(ComponentDatabase, true, func() (bool, string) { return true, "databases open" })
+	c.Register(ComponentPeering, false, func() (bool, string) {
+		if *peersOK {
+			return true, "4 peers active"
+		}
+		return false, "1 of 3 required peers active"
+	})
+	return c
+}
+
+func TestCheckerHTTP(t *testing.T) {
+	peersOK := false
+	c := newTestChecker(&peersOK)
+
+	rec := httptest.NewRecorder()
+	c.HealthzHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("healthz: expected %v; got %v", http.StatusOK, rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	c.ReadyzHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("readyz: expected %v; got %v", http.StatusServiceUnavailable, rec.Code)
+	}
+	r := &Report{}
+	if err := json.Unmarshal(rec.Body.Bytes(), r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Healthy || len(r.Components) != 2 {
+		t.Fatalf("unexpected report: %s", rec.Body.String())
+	}
+	if r.Components[1].Name != ComponentPeering || r.Components[1].Healthy || r.Components[1].Reason == "" {
+		t.Fatalf("unexpected component: %+v", r.Components[1])
+	}
+
+	peersOK = true
+	rec = httptest.NewRecorder()
+	c.ReadyzHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("readyz: expected %v; got %v", http.StatusOK, rec.Code)
+	}
+
+	// replacing a check keeps a single entry
+	c.Register(ComponentPeering, false, func() (bool, string) { return false, "replaced" })
+	r = c.Readiness()
+	if r.Healthy || len(r.Components) != 2 || r.Components[1].Reason != "replaced" {
+		t.Fatalf("unexpected report: %+v", r)
+	}
+}
+
+func TestCheckerGRPC(t *testing.T) {
+	peersOK := false
+	s := NewGRPCServer(newTestChecker(&peersOK))
+	ctx := context.Background()
+
+	tests := []struct {
+		service  string
+		expected healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{ServiceReadiness, healthpb.HealthCheckResponse_NOT_SERVING},
+		{ServiceLiveness, healthpb.HealthCheckResponse_SERVING},
+		{ComponentDatabase, healthpb.HealthCheckResponse_SERVING},
+		{ComponentPeering, healthpb.HealthCheckResponse_NOT_SERVING},
+	}
+	for _, tt := range tests {
+		resp, err := s.Check(ctx, &healthpb.HealthCheckRequest{Service: tt.service})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Status != tt.expected {
+			t.Fatalf("%q: expected %v; got %v", tt.service, tt.expected, resp.Status)
+		}
+	}
+
+	_, err := s.Check(ctx, &healthpb.HealthCheckRequest{Service: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound; got %v", err)
+	}
+}
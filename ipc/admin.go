@@ -0,0 +1,206 @@
+package ipc
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// AdminMethod handles a call of the admin API. The arguments are the
+// positional string parameters of the call, the result is sent back as
+// JSON.
+type AdminMethod func(args []string) (interface{}, error)
+
+// AdminMethodInfo describes a method of the admin API
+type AdminMethodInfo struct {
+	Name  string
+	Usage string
+}
+
+type adminMethod struct {
+	info AdminMethodInfo
+	fn   AdminMethod
+}
+
+type adminRequest struct {
+	JsonRpc string      `json:"jsonrpc"`
+	Id      interface{} `json:"id"`
+	Params  []string    `json:"params"`
+	Method  string      `json:"method"`
+}
+
+// AdminServer serves the admin API of a node over a Unix socket. It speaks
+// JSON-RPC 2.0 like Server but accepts any number of clients, such as
+// madnet console, and serves the methods registered with Handle.
+type AdminServer struct {
+	sync.RWMutex
+	address   string
+	logger    *logrus.Logger
+	methods   map[string]*adminMethod
+	lis       *net.UnixListener
+	conns     map[net.Conn]bool
+	closeChan chan struct{}
+	closeOnce sync.Once
+}
+
+// NewAdminServer creates an admin server listening on the Unix socket at
+// address once started
+func NewAdminServer(address string) *AdminServer {
+	s := &AdminServer{
+		address:   address,
+		logger:    logging.GetLogger(constants.LoggerIPC),
+		methods:   make(map[string]*adminMethod),
+		conns:     make(map[net.Conn]bool),
+		closeChan: make(chan struct{}),
+	}
+	s.Handle("methods", "lists the methods of the admin API", func([]string) (interface{}, error) {
+		return s.Methods(), nil
+	})
+	return s
+}
+
+// Handle registers the method name. The usage describes the arguments of
+// the method and what it does.
+func (s *AdminServer) Handle(name string, usage string, fn AdminMethod) {
+	s.Lock()
+	defer s.Unlock()
+	s.methods[name] = &adminMethod{AdminMethodInfo{name, usage}, fn}
+}
+
+// Methods lists the registered methods sorted by name
+func (s *AdminServer) Methods() []AdminMethodInfo {
+	s.RLock()
+	defer s.RUnlock()
+	infos := make([]AdminMethodInfo, 0, len(s.methods))
+	for _, m := range s.methods {
+		infos = append(infos, m.info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// Start listens on the socket and serves clients until the server is
+// closed. Only the user running the node may connect.
+func (s *AdminServer) Start() error {
+	if err := os.Remove(s.address); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	lis, err := net.ListenUnix("unix", &net.UnixAddr{Name: s.address, Net: "unix"})
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(s.address, 0600); err != nil {
+		lis.Close()
+		return err
+	}
+	s.Lock()
+	select {
+	case <-s.closeChan:
+		s.Unlock()
+		return lis.Close()
+	default:
+	}
+	s.lis = lis
+	s.Unlock()
+	s.logger.Infof("admin server listening on %v", s.address)
+	for {
+		conn, err := lis.AcceptUnix()
+		if err != nil {
+			select {
+			case <-s.closeChan:
+				return nil
+			default:
+				return err
+			}
+		}
+		go s.serve(conn)
+	}
+}
+
+// Close stops the server and disconnects its clients
+func (s *AdminServer) Close() {
+	s.closeOnce.Do(func() {
+		s.Lock()
+		defer s.Unlock()
+		close(s.closeChan)
+		if s.lis != nil {
+			s.lis.Close()
+		}
+		for conn := range s.conns {
+			conn.Close()
+		}
+	})
+}
+
+// serve answers the requests of a client, one at a time
+func (s *AdminServer) serve(conn net.Conn) {
+	s.Lock()
+	select {
+	case <-s.closeChan:
+		s.Unlock()
+		conn.Close()
+		return
+	default:
+	}
+	s.conns[conn] = true
+	s.Unlock()
+	defer func() {
+		s.Lock()
+		delete(s.conns, conn)
+		s.Unlock()
+		conn.Close()
+	}()
+	dec := json.NewDecoder(conn)
+	enc := json.NewEncoder(conn)
+	for {
+		var req adminRequest
+		err := dec.Decode(&req)
+		switch err.(type) {
+		case nil:
+		case *json.UnmarshalTypeError:
+			// the request was read, it is answered like an invalid one
+			if err := enc.Encode(newInvalidRequestResponse(req.Id)); err != nil {
+				return
+			}
+			continue
+		case *json.SyntaxError:
+			// the stream can not be resynchronized
+			enc.Encode(newParseErrorResponse(err.Error()))
+			return
+		default:
+			if err != io.EOF {
+				s.logger.Debugf("admin client error: %v", err)
+			}
+			return
+		}
+		if err := enc.Encode(s.call(&req)); err != nil {
+			s.logger.Debugf("admin client error: %v", err)
+			return
+		}
+	}
+}
+
+func (s *AdminServer) call(req *adminRequest) response {
+	if req.JsonRpc != "2.0" || req.Method == "" {
+		return newInvalidRequestResponse(req.Id)
+	}
+	s.RLock()
+	m, ok := s.methods[req.Method]
+	s.RUnlock()
+	if !ok {
+		return newMethodNotFoundResponse(req.Id)
+	}
+	s.logger.Infof("admin call %v %v", req.Method, req.Params)
+	result, err := m.fn(req.Params)
+	if err != nil {
+		return newServerErrorResponse(req.Id, err.Error())
+	}
+	return response{"2.0", req.Id, result, nil}
+}
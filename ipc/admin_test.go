@@ -0,0 +1,154 @@
+package ipc
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/MadBase/MadNet/utils/testutils"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func newTestAdminServer(t *testing.T) (*AdminServer, string) {
+	socketFile := testutils.SocketFileName()
+	s := NewAdminServer(socketFile)
+	l, _ := test.NewNullLogger()
+	s.logger = l
+	s.Handle("echo", "<args...> returns the arguments", func(args []string) (interface{}, error) {
+		return args, nil
+	})
+	s.Handle("fail", "always fails", func([]string) (interface{}, error) {
+		return nil, errors.New("failed on purpose")
+	})
+	errchan := testutils.TestAsync(s.Start)
+	t.Cleanup(func() {
+		s.Close()
+		if err := <-errchan; err != nil {
+			t.Error(err)
+		}
+		os.Remove(socketFile)
+	})
+	testutils.WaitUntil(func() bool {
+		_, err := os.Stat(socketFile)
+		return err == nil
+	})
+	return s, socketFile
+}
+
+func dialTestAdmin(t *testing.T, socketFile string) *AdminClient {
+	c, err := DialAdmin(socketFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestAdminServer(t *testing.T) {
+	_, socketFile := newTestAdminServer(t)
+	c1 := dialTestAdmin(t, socketFile)
+	c2 := dialTestAdmin(t, socketFile)
+
+	for _, c := range []*AdminClient{c1, c2} {
+		result, err := c.Call("echo", "a", "b")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var args []string
+		if err := json.Unmarshal(result, &args); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(args, ",") != "a,b" {
+			t.Fatalf("unexpected result %s", result)
+		}
+	}
+
+	result, err := c1.Call("methods")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var methods []AdminMethodInfo
+	if err := json.Unmarshal(result, &methods); err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, m := range methods {
+		names = append(names, m.Name)
+	}
+	if strings.Join(names, ",") != "echo,fail,methods" {
+		t.Fatalf("unexpected methods %v", names)
+	}
+
+	_, err = c1.Call("fail")
+	adminErr := &AdminError{}
+	if !errors.As(err, &adminErr) || adminErr.Message != "failed on purpose" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	_, err = c1.Call("missing")
+	if !errors.As(err, &adminErr) || adminErr.Code != -32601 {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// the connection is still usable after errors
+	if _, err := c1.Call("echo"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdminServerInvalidRequests(t *testing.T) {
+	_, socketFile := newTestAdminServer(t)
+	conn, err := net.Dial("unix", socketFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	dec := json.NewDecoder(conn)
+
+	for _, req := range []string{
+		`{"jsonrpc":"1.0","id":1,"method":"echo"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"echo","params":{"a":1}}`,
+	} {
+		if _, err := conn.Write([]byte(req)); err != nil {
+			t.Fatal(err)
+		}
+		resp := &adminResponse{}
+		if err := dec.Decode(resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.Error == nil || resp.Error.Code != -32600 {
+			t.Fatalf("expected an invalid request error for %v", req)
+		}
+	}
+
+	// a syntax error closes the connection
+	if _, err := conn.Write([]byte(`{"jsonrpc":`)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.Write([]byte(`}`)); err != nil {
+		t.Fatal(err)
+	}
+	resp := &adminResponse{}
+	if err := dec.Decode(resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error == nil || resp.Error.Code != -32700 {
+		t.Fatal("expected a parse error")
+	}
+	if err := dec.Decode(resp); err == nil {
+		t.Fatal("expected the connection to be closed")
+	}
+}
+
+func TestAdminServerClose(t *testing.T) {
+	s, socketFile := newTestAdminServer(t)
+	c := dialTestAdmin(t, socketFile)
+	if _, err := c.Call("echo"); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+	if _, err := c.Call("echo"); err == nil {
+		t.Fatal("expected an error once the server closed")
+	}
+}
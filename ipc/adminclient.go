@@ -0,0 +1,87 @@
+package ipc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// adminCallTimeout bounds a call of the admin API, a garbage collection of
+// the databases may take a while
+const adminCallTimeout = 5 * time.Minute
+
+type adminResponse struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Id      uint64          `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *errorObj       `json:"error"`
+}
+
+// AdminError is an error returned by a method of the admin API
+type AdminError struct {
+	Code    int
+	Message string
+}
+
+func (e *AdminError) Error() string {
+	return e.Message
+}
+
+// AdminClient calls the admin API of a node
+type AdminClient struct {
+	sync.Mutex
+	conn   net.Conn
+	dec    *json.Decoder
+	enc    *json.Encoder
+	nextID uint64
+}
+
+// DialAdmin connects to the admin server listening on the Unix socket at
+// address
+func DialAdmin(address string) (*AdminClient, error) {
+	conn, err := net.Dial("unix", address)
+	if err != nil {
+		return nil, err
+	}
+	return &AdminClient{
+		conn: conn,
+		dec:  json.NewDecoder(conn),
+		enc:  json.NewEncoder(conn),
+	}, nil
+}
+
+// Close closes the connection to the server
+func (c *AdminClient) Close() error {
+	return c.conn.Close()
+}
+
+// Call calls method with args and returns its raw JSON result, which is
+// empty for a method without one
+func (c *AdminClient) Call(method string, args ...string) (json.RawMessage, error) {
+	c.Lock()
+	defer c.Unlock()
+	if args == nil {
+		args = []string{}
+	}
+	c.nextID++
+	req := &adminRequest{JsonRpc: "2.0", Id: c.nextID, Method: method, Params: args}
+	if err := c.conn.SetDeadline(time.Now().Add(adminCallTimeout)); err != nil {
+		return nil, err
+	}
+	if err := c.enc.Encode(req); err != nil {
+		return nil, err
+	}
+	resp := &adminResponse{}
+	if err := c.dec.Decode(resp); err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		return nil, &AdminError{Code: resp.Error.Code, Message: resp.Error.Message}
+	}
+	if resp.Id != req.Id {
+		return nil, fmt.Errorf("response to call %d for call %d", resp.Id, req.Id)
+	}
+	return resp.Result, nil
+}
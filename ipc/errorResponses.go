@@ -11,3 +11,7 @@ func newMethodNotFoundResponse(id interface{}) response {
 func newParseErrorResponse(details string) response {
 	return response{"2.0", nil, nil, &errorObj{Code: -32700, Message: "Parse error", Data: details}}
 }
+
+func newServerErrorResponse(id interface{}, message string) response {
+	return response{"2.0", id, nil, &errorObj{Code: -32000, Message: message}}
+}
@@ -54,3 +54,28 @@ func TestTXFeeTranslation(t *testing.T) {
 		t.Fatal("should have same fields after serialization")
 	}
 }
+
+func TestWithdrawalTranslation(t *testing.T) {
+	value := &uint256.Uint256{}
+	value.FromUint64(12349872)
+	acct := testHash()[:20]
+	obj1 := &objs.Withdrawal{WDPreImage: &objs.WDPreImage{ChainID: 42, TXOutIdx: 77, Value: value, Account: acct, Fee: uint256.One()}, TxHash: testHash()}
+	proto1, err := ForwardTranslateWithdrawal(obj1)
+	if err != nil {
+		t.Fatal("Failed to serialize withdrawal", err)
+	}
+	obj2, err := ReverseTranslateWithdrawal(proto1)
+	if err != nil {
+		t.Fatal("Failed to deserialize withdrawal", err)
+	}
+
+	// test transitivity
+	if !obj1.WDPreImage.Value.Eq(obj2.WDPreImage.Value) ||
+		!obj1.WDPreImage.Fee.Eq(obj2.WDPreImage.Fee) ||
+		obj1.WDPreImage.TXOutIdx != obj2.WDPreImage.TXOutIdx ||
+		obj1.WDPreImage.ChainID != obj2.WDPreImage.ChainID ||
+		!bytes.Equal(obj1.WDPreImage.Account, obj2.WDPreImage.Account) ||
+		!bytes.Equal(obj1.TxHash, obj2.TxHash) {
+		t.Fatal("back and forth serialization should yield the same obj:", obj1, obj2)
+	}
+}
@@ -10,7 +10,9 @@ import (
 
 	aobjs "github.com/MadBase/MadNet/application/objs"
 	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/application/supply"
 	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/consensus/stats"
 	"github.com/MadBase/MadNet/constants"
 	pb "github.com/MadBase/MadNet/proto"
 	"google.golang.org/grpc"
@@ -137,6 +139,115 @@ func (lrpc *Client) GetBlockHeader(ctx context.Context, height uint32) (*objs.Bl
 	return bh, nil
 }
 
+// Block is a committed block with the validator set which signed it.
+// RoundKnown is false if the node downloaded the block rather than committed
+// it, in which case Round is zero and Proposer is nil. Txs is only set if it
+// was requested.
+type Block struct {
+	BlockHeader *objs.BlockHeader
+	BlockHash   []byte
+	Txs         []*aobjs.Tx
+	RoundKnown  bool
+	Round       uint32
+	Proposer    []byte
+	Validators  [][]byte
+	GroupKey    []byte
+}
+
+// GetBlock returns the block at height
+func (lrpc *Client) GetBlock(ctx context.Context, height uint32, includeTxs bool) (*Block, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	request := &pb.BlockRequest{Height: height, IncludeTxs: includeTxs}
+	resp, err := lrpc.client.GetBlock(subCtx, request)
+	if err != nil {
+		return nil, err
+	}
+	return reverseTranslateBlock(resp.Block)
+}
+
+// GetBlockByHash returns the block with blockHash
+func (lrpc *Client) GetBlockByHash(ctx context.Context, blockHash []byte, includeTxs bool) (*Block, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	request := &pb.BlockByHashRequest{BlockHash: ForwardTranslateByte(blockHash), IncludeTxs: includeTxs}
+	resp, err := lrpc.client.GetBlockByHash(subCtx, request)
+	if err != nil {
+		return nil, err
+	}
+	return reverseTranslateBlock(resp.Block)
+}
+
+// GetBlockRange returns up to count consecutive blocks starting at
+// startHeight. The server may return fewer blocks; the height to continue
+// from is returned, or zero if there are no more blocks.
+func (lrpc *Client) GetBlockRange(ctx context.Context, startHeight uint32, count uint32, includeTxs bool) ([]*Block, uint32, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, 0, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	request := &pb.BlockRangeRequest{StartHeight: startHeight, Count: count, IncludeTxs: includeTxs}
+	resp, err := lrpc.client.GetBlockRange(subCtx, request)
+	if err != nil {
+		return nil, 0, err
+	}
+	blocks := []*Block{}
+	for _, b := range resp.Blocks {
+		block, err := reverseTranslateBlock(b)
+		if err != nil {
+			return nil, 0, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, resp.NextHeight, nil
+}
+
+func reverseTranslateBlock(b *pb.Block) (*Block, error) {
+	if b == nil {
+		return nil, errors.New("invalid block: nil")
+	}
+	bh, err := ReverseTranslateBlockHeader(b.BlockHeader)
+	if err != nil {
+		return nil, err
+	}
+	block := &Block{BlockHeader: bh, RoundKnown: b.RoundKnown, Round: b.Round}
+	if block.BlockHash, err = ReverseTranslateByte(b.BlockHash); err != nil {
+		return nil, err
+	}
+	if b.RoundKnown {
+		if block.Proposer, err = ReverseTranslateByte(b.Proposer); err != nil {
+			return nil, err
+		}
+	}
+	if block.Validators, err = ReverseTranslateByteSlice(b.Validators); err != nil {
+		return nil, err
+	}
+	if block.GroupKey, err = ReverseTranslateByte(b.GroupKey); err != nil {
+		return nil, err
+	}
+	for _, t := range b.Txs {
+		tx, err := ReverseTranslateTx(t)
+		if err != nil {
+			return nil, err
+		}
+		block.Txs = append(block.Txs, tx)
+	}
+	return block, nil
+}
+
 // GetBlockNumber returns the current block number
 func (lrpc *Client) GetBlockNumber(ctx context.Context) (uint32, error) {
 	if err := lrpc.entrancyGuard(); err != nil {
@@ -173,6 +284,49 @@ func (lrpc *Client) GetEpochNumber(ctx context.Context) (uint32, error) {
 	return data, nil
 }
 
+// Fees holds the fees currently set in dynamics
+type Fees struct {
+	MinTxFee          *uint256.Uint256
+	ValueStoreFee     *uint256.Uint256
+	DataStoreEpochFee *uint256.Uint256
+	AtomicSwapFee     *uint256.Uint256
+}
+
+// GetFees returns the fees currently set in dynamics
+func (lrpc *Client) GetFees(ctx context.Context) (*Fees, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	request := &pb.FeesRequest{}
+	resp, err := lrpc.client.GetFees(subCtx, request)
+	if err != nil {
+		return nil, err
+	}
+	fees := &Fees{
+		MinTxFee:          &uint256.Uint256{},
+		ValueStoreFee:     &uint256.Uint256{},
+		DataStoreEpochFee: &uint256.Uint256{},
+		AtomicSwapFee:     &uint256.Uint256{},
+	}
+	if err := fees.MinTxFee.UnmarshalString(resp.MinTxFee); err != nil {
+		return nil, err
+	}
+	if err := fees.ValueStoreFee.UnmarshalString(resp.ValueStoreFee); err != nil {
+		return nil, err
+	}
+	if err := fees.DataStoreEpochFee.UnmarshalString(resp.DataStoreEpochFee); err != nil {
+		return nil, err
+	}
+	if err := fees.AtomicSwapFee.UnmarshalString(resp.AtomicSwapFee); err != nil {
+		return nil, err
+	}
+	return fees, nil
+}
+
 // SendTransaction allows the caller to inject a tx into the pending tx pool
 func (lrpc *Client) SendTransaction(ctx context.Context, tx *aobjs.Tx) ([]byte, error) {
 	if err := lrpc.entrancyGuard(); err != nil {
@@ -195,6 +349,49 @@ func (lrpc *Client) SendTransaction(ctx context.Context, tx *aobjs.Tx) ([]byte,
 	return hex.DecodeString(data)
 }
 
+// SendTransactions injects a batch of txs into the pending tx pool. A result
+// is returned for every tx in the order given.
+func (lrpc *Client) SendTransactions(ctx context.Context, txs []*aobjs.Tx) ([]*pb.TransactionResult, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	request := &pb.TransactionsData{}
+	for _, tx := range txs {
+		txb, err := ForwardTranslateTx(tx)
+		if err != nil {
+			return nil, err
+		}
+		request.Txs = append(request.Txs, txb)
+	}
+	resp, err := lrpc.client.SendTransactions(subCtx, request)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// ValidateTransaction validates a tx against the current state of the node
+// without injecting it into the pending tx pool
+func (lrpc *Client) ValidateTransaction(ctx context.Context, tx *aobjs.Tx) (*pb.ValidateTransactionResponse, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	txb, err := ForwardTranslateTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	request := &pb.TransactionData{Tx: txb}
+	return lrpc.client.ValidateTransaction(subCtx, request)
+}
+
 // GetValueForOwner allows a caller to receive a list of UTXOs that are
 // controlled by the named account
 func (lrpc *Client) GetValueForOwner(ctx context.Context, curveSpec constants.CurveSpec, account []byte, minValue *uint256.Uint256) ([][]byte, *uint256.Uint256, error) {
@@ -392,3 +589,171 @@ func (lrpc *Client) GetBlockHeightForTx(ctx context.Context, txHash []byte) (uin
 	}
 	return resp.BlockHeight, nil
 }
+
+// GetWithdrawalProof returns a withdrawal with a proof of its inclusion in
+// the state of the last snapshot
+func (lrpc *Client) GetWithdrawalProof(ctx context.Context, utxoID []byte) (*pb.GetWithdrawalProofResponse, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	request := &pb.GetWithdrawalProofRequest{UTXOID: ForwardTranslateByte(utxoID)}
+	resp, err := lrpc.client.GetWithdrawalProof(subCtx, request)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetWithdrawalsForAccount returns the utxoIDs of all withdrawals to an
+// Ethereum account
+func (lrpc *Client) GetWithdrawalsForAccount(ctx context.Context, account []byte) ([][]byte, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	acct := ForwardTranslateByte(account)
+	result := [][]byte{}
+	startKey := ""
+	for {
+		request := &pb.GetWithdrawalsForAccountRequest{Account: acct, StartKey: startKey}
+		resp, err := lrpc.client.GetWithdrawalsForAccount(subCtx, request)
+		if err != nil {
+			return nil, err
+		}
+		utxoIDs, err := ReverseTranslateByteSlice(resp.UTXOIDs)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, utxoIDs...)
+		if resp.StartKey == "" {
+			return result, nil
+		}
+		startKey = resp.StartKey
+	}
+}
+
+// GetSpendingTransaction returns the hash of the mined transaction which
+// consumed the UTXO with utxoID and the height at which it was mined
+func (lrpc *Client) GetSpendingTransaction(ctx context.Context, utxoID []byte) ([]byte, uint32, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, 0, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	request := &pb.GetSpendingTransactionRequest{UTXOID: ForwardTranslateByte(utxoID)}
+	resp, err := lrpc.client.GetSpendingTransaction(subCtx, request)
+	if err != nil {
+		return nil, 0, err
+	}
+	txHash, err := ReverseTranslateByte(resp.TxHash)
+	if err != nil {
+		return nil, 0, err
+	}
+	return txHash, resp.Height, nil
+}
+
+// GetValidatorStats returns the validator statistics of the epochs from
+// startEpoch to endEpoch. Epochs without indexed heights are left out.
+func (lrpc *Client) GetValidatorStats(ctx context.Context, startEpoch uint32, endEpoch uint32) ([]*stats.EpochStats, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	request := &pb.ValidatorStatsRequest{StartEpoch: startEpoch, EndEpoch: endEpoch}
+	resp, err := lrpc.client.GetValidatorStats(subCtx, request)
+	if err != nil {
+		return nil, err
+	}
+	result := []*stats.EpochStats{}
+	for _, e := range resp.Epochs {
+		es := &stats.EpochStats{
+			Epoch:      e.Epoch,
+			Heights:    e.Heights,
+			Rounds:     e.Rounds,
+			Validators: []*stats.ValidatorStats{},
+		}
+		for _, v := range e.Validators {
+			vAddr, err := ReverseTranslateByte(v.VAddr)
+			if err != nil {
+				return nil, err
+			}
+			es.Validators = append(es.Validators, &stats.ValidatorStats{
+				VAddr:          vAddr,
+				Heights:        v.Heights,
+				Rounds:         v.Rounds,
+				ProposerRounds: v.ProposerRounds,
+				Proposals:      v.Proposals,
+				PreVotes:       v.PreVotes,
+				PreCommits:     v.PreCommits,
+			})
+		}
+		result = append(result, es)
+	}
+	return result, nil
+}
+
+// GetSupply returns the supply ledger entry of height. A height of zero
+// returns the entry of the latest height.
+func (lrpc *Client) GetSupply(ctx context.Context, height uint32) (*supply.Supply, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	request := &pb.SupplyRequest{Height: height}
+	resp, err := lrpc.client.GetSupply(subCtx, request)
+	if err != nil {
+		return nil, err
+	}
+	s := supply.New(resp.Height, uint256.Zero())
+	fields := []struct {
+		out *uint256.Uint256
+		in  string
+	}{
+		{s.Deposits, resp.Deposits},
+		{s.Fees, resp.Fees},
+		{s.DataStoreDeposits, resp.DataStoreDeposits},
+		{s.DataStoreRefunds, resp.DataStoreRefunds},
+		{s.DataStoreBurned, resp.DataStoreBurned},
+		{s.Withdrawals, resp.Withdrawals},
+		{s.ActiveValue, resp.ActiveValue},
+	}
+	for _, f := range fields {
+		if err := f.out.UnmarshalString(f.in); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// GetFeatures returns the registered and the scheduled features; the
+// epoch at which Active is computed is returned with them
+func (lrpc *Client) GetFeatures(ctx context.Context, epoch uint32) ([]*pb.Feature, uint32, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, 0, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	request := &pb.FeaturesRequest{Epoch: epoch}
+	resp, err := lrpc.client.GetFeatures(subCtx, request)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp.Features, resp.Epoch, nil
+}
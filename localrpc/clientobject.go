@@ -0,0 +1,300 @@
+package localrpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	aobjs "github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	pb "github.com/MadBase/MadNet/proto"
+)
+
+// ObjectFees holds the fees charged when writing a large object. These must
+// match the fees currently set in dynamics; nil values are treated as zero.
+type ObjectFees struct {
+	DataStoreEpochFee *uint256.Uint256
+	ValueStoreFee     *uint256.Uint256
+	MinTxFee          *uint256.Uint256
+}
+
+// GetChainID returns the chain id of the node
+func (lrpc *Client) GetChainID(ctx context.Context) (uint32, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return 0, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	request := &pb.ChainIDRequest{}
+	resp, err := lrpc.client.GetChainID(subCtx, request)
+	if err != nil {
+		return 0, err
+	}
+	return resp.ChainID, nil
+}
+
+// GetObject returns a large object reassembled by the node from its manifest
+// and chunk datastores
+func (lrpc *Client) GetObject(ctx context.Context, curveSpec constants.CurveSpec, account []byte, index []byte) ([]byte, error) {
+	if err := lrpc.entrancyGuard(); err != nil {
+		return nil, err
+	}
+	defer lrpc.wg.Done()
+	subCtx, cleanup := lrpc.contextGuard(ctx)
+	defer cleanup()
+
+	o := ForwardTranslateByte(account)
+
+	i := ForwardTranslateByte(index)
+
+	request := &pb.GetObjectRequest{Account: o, CurveSpec: uint32(curveSpec), Index: i}
+	resp, err := lrpc.client.GetObject(subCtx, request)
+	if err != nil {
+		return nil, err
+	}
+	return ReverseTranslateByte(resp.Rawdata)
+}
+
+// ReadObject reads a large object by fetching its manifest and each chunk
+// datastore with GetData. Every chunk and the whole object are verified
+// against the manifest.
+func (lrpc *Client) ReadObject(ctx context.Context, curveSpec constants.CurveSpec, account []byte, index []byte) ([]byte, error) {
+	manifest, err := lrpc.GetData(ctx, curveSpec, account, index)
+	if err != nil {
+		return nil, err
+	}
+	getData := func(chunkIndex []byte) ([]byte, error) {
+		return lrpc.GetData(ctx, curveSpec, account, chunkIndex)
+	}
+	return aobjs.ReadObject(manifest, 0, getData)
+}
+
+// WriteObject stores data as a large object owned by signer. The data is
+// split into chunks of at most chunkSize bytes; each chunk is written to its
+// own datastore and the manifest is written last at index. maxBytes must be
+// the MaxBytes value currently set in dynamics; a chunkSize of zero uses the
+// largest chunks which fit in a block. Each datastore is funded from the
+// value of the signer and is mined before the next one is sent. The hashes of
+// the transactions are returned in order.
+func (lrpc *Client) WriteObject(ctx context.Context, signer aobjs.Signer, index []byte, data []byte, numEpochs uint32, chunkSize uint32, maxBytes uint32, fees *ObjectFees) ([][]byte, error) {
+	if chunkSize == 0 {
+		chunkSize = aobjs.MaxChunkSize(maxBytes)
+	}
+	m, chunks, err := aobjs.SplitObject(index, data, chunkSize, maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := m.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := lrpc.GetChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txHashes := [][]byte{}
+	for i, chunk := range chunks {
+		txHash, err := lrpc.writeDataStore(ctx, signer, chainID, m.Chunks[i].Index, chunk, numEpochs, fees)
+		if err != nil {
+			return nil, err
+		}
+		txHashes = append(txHashes, txHash)
+	}
+	txHash, err := lrpc.writeDataStore(ctx, signer, chainID, index, manifest, numEpochs, fees)
+	if err != nil {
+		return nil, err
+	}
+	txHashes = append(txHashes, txHash)
+	return txHashes, nil
+}
+
+// writeDataStore sends a tx which creates a single datastore and blocks until
+// the tx has been mined
+func (lrpc *Client) writeDataStore(ctx context.Context, signer aobjs.Signer, chainID uint32, index []byte, rawData []byte, numEpochs uint32, fees *ObjectFees) ([]byte, error) {
+	if fees == nil {
+		fees = &ObjectFees{}
+	}
+	dsEpochFee := uint256.Zero()
+	if fees.DataStoreEpochFee != nil {
+		dsEpochFee = fees.DataStoreEpochFee.Clone()
+	}
+	vsFee := uint256.Zero()
+	if fees.ValueStoreFee != nil {
+		vsFee = fees.ValueStoreFee.Clone()
+	}
+	txFee := uint256.Zero()
+	if fees.MinTxFee != nil {
+		txFee = fees.MinTxFee.Clone()
+	}
+	curveSpec, acct, err := signerAccount(signer)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := lrpc.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deposit, err := aobjs.BaseDepositEquation(uint32(len(rawData)), numEpochs)
+	if err != nil {
+		return nil, err
+	}
+	totalEpochs, err := new(uint256.Uint256).FromUint64(uint64(numEpochs) + 2)
+	if err != nil {
+		return nil, err
+	}
+	dsFee, err := new(uint256.Uint256).Mul(dsEpochFee, totalEpochs)
+	if err != nil {
+		return nil, err
+	}
+	valueOut, err := new(uint256.Uint256).Add(deposit, dsFee)
+	if err != nil {
+		return nil, err
+	}
+	valueOut, err = new(uint256.Uint256).Add(valueOut, txFee)
+	if err != nil {
+		return nil, err
+	}
+
+	utxoIDs, valueIn, err := lrpc.GetValueForOwner(ctx, curveSpec, acct, valueOut)
+	if err != nil {
+		return nil, err
+	}
+	if valueIn.Lt(valueOut) {
+		return nil, errors.New("insufficient funds to write datastore")
+	}
+	consumed, err := lrpc.GetUTXO(ctx, utxoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &aobjs.Tx{Vin: aobjs.Vin{}, Vout: aobjs.Vout{}}
+	for _, utxo := range consumed {
+		txIn, err := utxo.MakeTxIn()
+		if err != nil {
+			return nil, err
+		}
+		tx.Vin = append(tx.Vin, txIn)
+	}
+
+	owner := &aobjs.DataStoreOwner{}
+	owner.New(acct, curveSpec)
+	ds := &aobjs.DataStore{
+		DSLinker: &aobjs.DSLinker{
+			DSPreImage: &aobjs.DSPreImage{
+				ChainID:  chainID,
+				Index:    index,
+				IssuedAt: epoch,
+				Deposit:  deposit,
+				RawData:  rawData,
+				Owner:    owner,
+				Fee:      dsFee,
+			},
+			TxHash: make([]byte, constants.HashLen),
+		},
+	}
+	dsUTXO := &aobjs.TXOut{}
+	if err := dsUTXO.NewDataStore(ds); err != nil {
+		return nil, err
+	}
+	tx.Vout = append(tx.Vout, dsUTXO)
+
+	// return the change to the signer; change too small to pay for its own
+	// value store is added to the tx fee instead
+	change, err := new(uint256.Uint256).Sub(valueIn, valueOut)
+	if err != nil {
+		return nil, err
+	}
+	if change.Gt(vsFee) {
+		changeValue, err := new(uint256.Uint256).Sub(change, vsFee)
+		if err != nil {
+			return nil, err
+		}
+		vs := &aobjs.ValueStore{}
+		if err := vs.New(chainID, changeValue, vsFee, acct, curveSpec, make([]byte, constants.HashLen)); err != nil {
+			return nil, err
+		}
+		vsUTXO := &aobjs.TXOut{}
+		if err := vsUTXO.NewValueStore(vs); err != nil {
+			return nil, err
+		}
+		tx.Vout = append(tx.Vout, vsUTXO)
+	} else {
+		txFee, err = new(uint256.Uint256).Add(txFee, change)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !txFee.IsZero() {
+		tf := &aobjs.TxFee{}
+		if err := tf.New(chainID, txFee); err != nil {
+			return nil, err
+		}
+		tfUTXO := &aobjs.TXOut{}
+		if err := tfUTXO.NewTxFee(tf); err != nil {
+			return nil, err
+		}
+		tx.Vout = append(tx.Vout, tfUTXO)
+	}
+
+	if err := tx.Vout.SetTxOutIdx(); err != nil {
+		return nil, err
+	}
+	if err := tx.SetTxHash(); err != nil {
+		return nil, err
+	}
+	if err := ds.PreSign(signer); err != nil {
+		return nil, err
+	}
+	for i, utxo := range consumed {
+		vs, err := utxo.ValueStore()
+		if err != nil {
+			return nil, err
+		}
+		if err := vs.Sign(tx.Vin[i], signer); err != nil {
+			return nil, err
+		}
+	}
+
+	txHash, err := lrpc.SendTransaction(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := lrpc.waitForMined(ctx, txHash); err != nil {
+		return nil, err
+	}
+	return txHash, nil
+}
+
+// waitForMined blocks until the tx with txHash has been mined or ctx is done
+func (lrpc *Client) waitForMined(ctx context.Context, txHash []byte) error {
+	for {
+		if _, err := lrpc.GetMinedTransaction(ctx, txHash); err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// signerAccount returns the curve and account of a signer
+func signerAccount(signer aobjs.Signer) (constants.CurveSpec, []byte, error) {
+	pubk, err := signer.Pubkey()
+	if err != nil {
+		return 0, nil, err
+	}
+	switch signer.(type) {
+	case *crypto.Secp256k1Signer:
+		return constants.CurveSecp256k1, crypto.GetAccount(pubk), nil
+	case *crypto.BNSigner:
+		return constants.CurveBN256Eth, crypto.GetAccount(pubk), nil
+	default:
+		return 0, nil, errors.New("unknown signer type")
+	}
+}
@@ -0,0 +1,311 @@
+package localrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/MadBase/MadNet/errorz"
+	bindata "github.com/MadBase/MadNet/localrpc/swagger-bindata"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// errNotInSync is returned while the node is syncing and may not yet serve
+// requests
+var errNotInSync = errors.New("not in sync - unsafe to serve requests at this time")
+
+// GatewayError is the body returned by the HTTP gateway when a request fails.
+// Kind names the errorz type the error was derived from so that clients may
+// react to errors without parsing the message.
+type GatewayError struct {
+	Error string `json:"error"`
+	Code  int32  `json:"code"`
+	Kind  string `json:"kind"`
+}
+
+// Error kinds reported in GatewayError
+const (
+	ErrKindInvalid     = "invalid"
+	ErrKindStale       = "stale"
+	ErrKindConsensus   = "consensus"
+	ErrKindNotFound    = "not_found"
+	ErrKindUnavailable = "unavailable"
+	ErrKindUnknown     = "unknown"
+)
+
+// classifyError maps an error returned by a handler onto a gRPC code and an
+// error kind based on the errorz type it wraps.
+func classifyError(err error) (codes.Code, string) {
+	var errInvalid *errorz.ErrInvalid
+	var errStale *errorz.ErrStale
+	var errConsensus *errorz.ErrConsensus
+	switch {
+	case errors.As(err, &errInvalid):
+		return codes.InvalidArgument, ErrKindInvalid
+	case errors.As(err, &errStale):
+		return codes.FailedPrecondition, ErrKindStale
+	case errors.As(err, &errConsensus):
+		return codes.Internal, ErrKindConsensus
+	case errors.Is(err, badger.ErrKeyNotFound):
+		return codes.NotFound, ErrKindNotFound
+	case errors.Is(err, errorz.ErrClosing), errors.Is(err, errNotInSync):
+		return codes.Unavailable, ErrKindUnavailable
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.InvalidArgument:
+			return s.Code(), ErrKindInvalid
+		case codes.FailedPrecondition:
+			return s.Code(), ErrKindStale
+		case codes.NotFound:
+			return s.Code(), ErrKindNotFound
+		case codes.Unavailable, codes.Canceled:
+			return s.Code(), ErrKindUnavailable
+		}
+		return s.Code(), ErrKindUnknown
+	}
+	return codes.Unknown, ErrKindUnknown
+}
+
+// errorStatus converts an error returned by a handler into a gRPC status
+func errorStatus(err error) *status.Status {
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+	code, _ := classifyError(err)
+	return status.New(code, err.Error())
+}
+
+// errorUnaryInterceptor converts the errors returned by the handlers into
+// gRPC status errors so that gRPC clients observe the same codes as the
+// HTTP gateway.
+func errorUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, errorStatus(err).Err()
+	}
+	return resp, nil
+}
+
+// gatewayErrorHandler writes a GatewayError body for a failed request
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	code, kind := classifyError(err)
+	body := &GatewayError{
+		Error: errorStatus(err).Message(),
+		Code:  int32(code),
+		Kind:  kind,
+	}
+	w.Header().Del("Trailer")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(code))
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// hexMarshaler is the JSON marshaler of the HTTP gateway. It marshals
+// messages as runtime.JSONPb does, except that bytes fields are hex encoded
+// like the string fields which carry bytes, rather than base64 encoded.
+// Requests may prefix hex values with 0x.
+type hexMarshaler struct {
+	*runtime.JSONPb
+}
+
+// Marshal marshals v into JSON with hex encoded bytes fields
+func (m *hexMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	pm, ok := v.(protoreflect.ProtoMessage)
+	if !ok {
+		return data, nil
+	}
+	return recodeBytesFields(pm.ProtoReflect().Descriptor(), data, base64ToHex)
+}
+
+// Unmarshal unmarshals JSON with hex encoded bytes fields into v
+func (m *hexMarshaler) Unmarshal(data []byte, v interface{}) error {
+	if pm, ok := v.(protoreflect.ProtoMessage); ok {
+		var err error
+		data, err = recodeBytesFields(pm.ProtoReflect().Descriptor(), data, hexToBase64)
+		if err != nil {
+			return err
+		}
+	}
+	return m.JSONPb.Unmarshal(data, v)
+}
+
+// NewDecoder returns a Decoder which reads a single JSON value from r
+func (m *hexMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v interface{}) error {
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(data)) == 0 {
+			return io.EOF
+		}
+		return m.Unmarshal(data, v)
+	})
+}
+
+// NewEncoder returns an Encoder which writes JSON values into w
+func (m *hexMarshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return runtime.EncoderFunc(func(v interface{}) error {
+		data, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		_, err = w.Write(m.Delimiter())
+		return err
+	})
+}
+
+// recodeBytesFields decodes the JSON encoding of a message described by md,
+// applies recode to the value of every bytes field and encodes it again
+func recodeBytesFields(md protoreflect.MessageDescriptor, data []byte, recode func(string) (string, error)) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var obj interface{}
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if err := recodeMessage(md, obj, recode); err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// recodeMessage applies recode to the bytes fields of the JSON object obj,
+// which holds a message described by md, and of the messages it contains
+func recodeMessage(md protoreflect.MessageDescriptor, obj interface{}, recode func(string) (string, error)) error {
+	fields, ok := obj.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		names := []string{string(fd.Name())}
+		if fd.JSONName() != names[0] {
+			names = append(names, fd.JSONName())
+		}
+		for _, name := range names {
+			v, ok := fields[name]
+			if !ok || v == nil {
+				continue
+			}
+			nv, err := recodeField(fd, v, recode)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", name, err)
+			}
+			fields[name] = nv
+		}
+	}
+	return nil
+}
+
+// recodeField applies recode to the JSON value v of the field fd
+func recodeField(fd protoreflect.FieldDescriptor, v interface{}, recode func(string) (string, error)) (interface{}, error) {
+	switch {
+	case fd.IsMap():
+		entries, ok := v.(map[string]interface{})
+		if !ok {
+			return v, nil
+		}
+		for k, ev := range entries {
+			nv, err := recodeValue(fd.MapValue(), ev, recode)
+			if err != nil {
+				return nil, err
+			}
+			entries[k] = nv
+		}
+		return entries, nil
+	case fd.IsList():
+		elems, ok := v.([]interface{})
+		if !ok {
+			return v, nil
+		}
+		for j, ev := range elems {
+			nv, err := recodeValue(fd, ev, recode)
+			if err != nil {
+				return nil, err
+			}
+			elems[j] = nv
+		}
+		return elems, nil
+	}
+	return recodeValue(fd, v, recode)
+}
+
+// recodeValue applies recode to a single JSON value of the kind of fd
+func recodeValue(fd protoreflect.FieldDescriptor, v interface{}, recode func(string) (string, error)) (interface{}, error) {
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		str, ok := v.(string)
+		if !ok {
+			return v, nil
+		}
+		return recode(str)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return v, recodeMessage(fd.Message(), v, recode)
+	}
+	return v, nil
+}
+
+// base64ToHex converts the JSONPb encoding of a bytes field into hex
+func base64ToHex(s string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return utils.EncodeHexString(b), nil
+}
+
+// hexToBase64 converts a hex encoded bytes field into the JSONPb encoding
+func hexToBase64(s string) (string, error) {
+	b, err := utils.DecodeHexString(s)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// newGatewayMux returns the mux translating HTTP/JSON requests into calls
+// against the LocalState handlers. Field names are those of the proto
+// definitions and fields holding default values are always emitted. All
+// fields carrying bytes are hex encoded, see hexMarshaler.
+func newGatewayMux() *runtime.ServeMux {
+	marshaler := &hexMarshaler{&runtime.JSONPb{OrigName: true, EmitDefaults: true}}
+	return runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, marshaler),
+		runtime.WithProtoErrorHandler(gatewayErrorHandler),
+	)
+}
+
+// serveOpenAPI serves the OpenAPI document describing the HTTP gateway. The
+// generated document describes bytes fields as base64 encoded; they are
+// described as hex encoded instead, see hexMarshaler.
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	doc, err := bindata.Asset("swagger.json")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	doc = bytes.ReplaceAll(doc, []byte(`"format": "byte"`), []byte(`"format": "hex"`))
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(doc)
+}
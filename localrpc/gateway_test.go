@@ -0,0 +1,266 @@
+package localrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/application"
+	"github.com/MadBase/MadNet/application/deposit"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/dynamics"
+	"github.com/MadBase/MadNet/logging"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// newTestHandlers returns Handlers backed by an empty in-memory database
+// which report the node as synchronized
+func newTestHandlers(t *testing.T) *Handlers {
+	t.Helper()
+	ctx, cf := context.WithCancel(context.Background())
+	t.Cleanup(cf)
+	rawDB, err := utils.OpenBadger(ctx.Done(), "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rawDB.Close() })
+	txPoolDB, err := utils.OpenBadger(ctx.Done(), "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { txPoolDB.Close() })
+	database := &db.Database{}
+	database.Init(rawDB)
+	storage := &dynamics.Storage{}
+	if err := storage.Init(database, logging.GetLogger(constants.LoggerDB)); err != nil {
+		t.Fatal(err)
+	}
+	storage.Start()
+	dph := &deposit.Handler{}
+	dph.Init()
+	app := &application.Application{}
+	if err := app.Init(database, txPoolDB, dph, storage); err != nil {
+		t.Fatal(err)
+	}
+	srpc := &Handlers{}
+	srpc.Init(database, app, nil, nil, func() bool { return true })
+	srpc.safecount = 1
+	return srpc
+}
+
+func setupGatewayTest(t *testing.T) (*Handler, *grpc.ClientConn, *Handlers) {
+	srpc := newTestHandlers(t)
+	dispatch := pb.NewLocalStateDispatch()
+	srpc.Register(dispatch)
+	handler, err := NewStateServerHandler(logrus.New(), "127.0.0.1:0", pb.NewGeneratedLocalStateServer(dispatch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = handler.grpcServer.Serve(lis) }()
+	dialer := func(ctx context.Context, s string) (net.Conn, error) { return lis.Dial() }
+	conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		handler.Close()
+	})
+	return handler, conn, srpc
+}
+
+func localStateMethods(t *testing.T) protoreflect.MethodDescriptors {
+	sd := pb.File_localstate_proto.Services().ByName("LocalState")
+	if sd == nil {
+		t.Fatal("LocalState service not found")
+	}
+	return sd.Methods()
+}
+
+func httpPath(t *testing.T, md protoreflect.MethodDescriptor) string {
+	opts := md.Options().(*descriptorpb.MethodOptions)
+	rule, ok := proto.GetExtension(opts, annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule.GetPost() == "" {
+		t.Fatalf("method %s has no HTTP POST route", md.Name())
+	}
+	return rule.GetPost()
+}
+
+func postJSON(handler *Handler, path string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.server.Handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// TestGatewayConformance checks that every LocalState method is routed by
+// the HTTP gateway to the same handler as the gRPC server, gives the same
+// response or reports the same status, and is described by the OpenAPI
+// document.
+func TestGatewayConformance(t *testing.T) {
+	handler, conn, _ := setupGatewayTest(t)
+	marshaler := &hexMarshaler{&runtime.JSONPb{OrigName: true, EmitDefaults: true}}
+
+	rec := httptest.NewRecorder()
+	handler.server.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("openapi.json: status %v", rec.Code)
+	}
+	doc := struct {
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+		} `json:"paths"`
+	}{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(rec.Body.Bytes(), []byte(`"format": "byte"`)) {
+		t.Fatal("openapi.json describes base64 encoded fields")
+	}
+
+	methods := localStateMethods(t)
+	for i := 0; i < methods.Len(); i++ {
+		md := methods.Get(i)
+		path := httpPath(t, md)
+
+		op, ok := doc.Paths[path]["post"]
+		if !ok {
+			t.Fatalf("%s: route %s missing from OpenAPI document", md.Name(), path)
+		}
+		if !strings.HasSuffix(op.OperationID, string(md.Name())) {
+			t.Fatalf("%s: route %s documents operation %s", md.Name(), path, op.OperationID)
+		}
+
+		inType, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+		if err != nil {
+			t.Fatal(err)
+		}
+		outType, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the dispatcher blocks calls to methods without a handler until
+		// the context is done
+		ctx, cf := context.WithTimeout(context.Background(), time.Second)
+		out := outType.New().Interface()
+		grpcErr := conn.Invoke(ctx, "/proto.LocalState/"+string(md.Name()), inType.New().Interface(), out)
+		cf()
+		grpcStatus := status.Convert(grpcErr)
+		if grpcStatus.Code() == codes.DeadlineExceeded {
+			t.Fatalf("%s: no handler registered", md.Name())
+		}
+
+		rec := postJSON(handler, path, "{}")
+		if grpcErr == nil {
+			if rec.Code != http.StatusOK {
+				t.Fatalf("%s: gRPC succeeded but HTTP returned %v: %s", md.Name(), rec.Code, rec.Body.String())
+			}
+			want, err := marshaler.Marshal(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(bytes.TrimSpace(rec.Body.Bytes()), want) {
+				t.Fatalf("%s: HTTP response %s does not match gRPC response %s", md.Name(), rec.Body.String(), want)
+			}
+			continue
+		}
+		body := &GatewayError{}
+		if err := json.Unmarshal(rec.Body.Bytes(), body); err != nil {
+			t.Fatalf("%s: bad error body %q: %v", md.Name(), rec.Body.String(), err)
+		}
+		if body.Code != int32(grpcStatus.Code()) {
+			t.Fatalf("%s: HTTP code %v does not match gRPC code %v", md.Name(), body.Code, grpcStatus.Code())
+		}
+		if body.Error != grpcStatus.Message() {
+			t.Fatalf("%s: HTTP error %q does not match gRPC error %q", md.Name(), body.Error, grpcStatus.Message())
+		}
+	}
+}
+
+func TestGatewayErrors(t *testing.T) {
+	handler, _, srpc := setupGatewayTest(t)
+
+	rec := postJSON(handler, "/v1/get-value-for-owner", `{"PaginationToken": "zz"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %v; got %v", http.StatusBadRequest, rec.Code)
+	}
+	body := &GatewayError{}
+	if err := json.Unmarshal(rec.Body.Bytes(), body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Kind != ErrKindInvalid {
+		t.Fatalf("expected kind %s; got %s", ErrKindInvalid, body.Kind)
+	}
+
+	rec = postJSON(handler, "/v1/get-data", `{"Index": "00"}`)
+	if err := json.Unmarshal(rec.Body.Bytes(), body); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Code == http.StatusOK || body.Kind == "" {
+		t.Fatalf("unexpected response %v: %s", rec.Code, rec.Body.String())
+	}
+
+	srpc.safecount = 0
+	rec = postJSON(handler, "/v1/get-block-number", `{}`)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected status %v; got %v", http.StatusServiceUnavailable, rec.Code)
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Kind != ErrKindUnavailable {
+		t.Fatalf("expected kind %s; got %s", ErrKindUnavailable, body.Kind)
+	}
+}
+
+func TestGatewayBytesEncoding(t *testing.T) {
+	marshaler := &hexMarshaler{&runtime.JSONPb{OrigName: true, EmitDefaults: true}}
+
+	data, err := marshaler.Marshal(&pb.GetValueResponse{PaginationToken: []byte{0x00, 0xff}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte(`"PaginationToken":"00ff"`)) {
+		t.Fatalf("bytes field not hex encoded: %s", data)
+	}
+
+	for _, in := range []string{`{"PaginationToken": "00ff"}`, `{"PaginationToken": "0x00ff"}`, `{"PaginationToken": "00FF"}`} {
+		req := &pb.GetValueRequest{}
+		if err := marshaler.NewDecoder(strings.NewReader(in)).Decode(req); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(req.PaginationToken, []byte{0x00, 0xff}) {
+			t.Fatalf("%s: decoded %x", in, req.PaginationToken)
+		}
+	}
+
+	req := &pb.GetValueRequest{}
+	if err := marshaler.Unmarshal([]byte(`{"PaginationToken": "AP8="}`), req); err == nil {
+		t.Fatal("Should have raised an error")
+	}
+	if err := marshaler.NewDecoder(strings.NewReader("")).Decode(req); err != io.EOF {
+		t.Fatalf("expected io.EOF; got %v", err)
+	}
+}
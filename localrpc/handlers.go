@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/MadBase/MadNet/application"
@@ -13,8 +14,11 @@ import (
 	"github.com/MadBase/MadNet/consensus/db"
 	"github.com/MadBase/MadNet/consensus/gossip"
 	"github.com/MadBase/MadNet/consensus/lstate"
+	cobjs "github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/consensus/stats"
 	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
 	"github.com/MadBase/MadNet/logging"
 	pb "github.com/MadBase/MadNet/proto"
 	"github.com/MadBase/MadNet/utils"
@@ -35,6 +39,19 @@ var _ pb.LocalStateGetMinedTransactionHandler = (*Handlers)(nil)
 var _ pb.LocalStateGetValueForOwnerHandler = (*Handlers)(nil)
 var _ pb.LocalStateIterateNameSpaceHandler = (*Handlers)(nil)
 var _ pb.LocalStateGetUTXOHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetObjectHandler = (*Handlers)(nil)
+var _ pb.LocalStateSendTransactionsHandler = (*Handlers)(nil)
+var _ pb.LocalStateValidateTransactionHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetWithdrawalProofHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetWithdrawalsForAccountHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetFeesHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetSpendingTransactionHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetBlockHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetBlockByHashHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetBlockRangeHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetValidatorStatsHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetSupplyHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetFeaturesHandler = (*Handlers)(nil)
 
 func (srpc *Handlers) notReady() error {
 	if srpc.safe() {
@@ -43,9 +60,9 @@ func (srpc *Handlers) notReady() error {
 
 	select {
 	case <-srpc.ctx.Done():
-		return errors.New("closing")
+		return errorz.ErrClosing
 	case <-time.After(1 * time.Second):
-		return errors.New("not in sync - unsafe to serve requests at this time")
+		return errNotInSync
 	}
 }
 
@@ -67,6 +84,10 @@ type Handlers struct {
 	ethAcct []byte
 	EthPubk []byte
 
+	// MerkleProofContract is the address of the Ethereum contract which
+	// verifies withdrawal proofs; it is returned with each proof
+	MerkleProofContract string
+
 	safeHandler func() bool
 	safecount   uint32
 }
@@ -90,6 +111,38 @@ func (srpc *Handlers) Init(database *db.Database, app *application.Application,
 	srpc.safeHandler = safe
 }
 
+// Register registers the handlers as the service handlers for every method
+// of the LocalState service
+func (srpc *Handlers) Register(dispatch *pb.LocalStateDispatch) {
+	dispatch.RegisterLocalStateGetBlockNumber(srpc)
+	dispatch.RegisterLocalStateGetEpochNumber(srpc)
+	dispatch.RegisterLocalStateGetBlockHeader(srpc)
+	dispatch.RegisterLocalStateGetChainID(srpc)
+	dispatch.RegisterLocalStateSendTransaction(srpc)
+	dispatch.RegisterLocalStateGetValueForOwner(srpc)
+	dispatch.RegisterLocalStateGetUTXO(srpc)
+	dispatch.RegisterLocalStateGetMinedTransaction(srpc)
+	dispatch.RegisterLocalStateGetPendingTransaction(srpc)
+	dispatch.RegisterLocalStateGetRoundStateForValidator(srpc)
+	dispatch.RegisterLocalStateGetValidatorSet(srpc)
+	dispatch.RegisterLocalStateIterateNameSpace(srpc)
+	dispatch.RegisterLocalStateGetData(srpc)
+	dispatch.RegisterLocalStateGetTxBlockNumber(srpc)
+	dispatch.RegisterLocalStateGetObject(srpc)
+	dispatch.RegisterLocalStateSendTransactions(srpc)
+	dispatch.RegisterLocalStateValidateTransaction(srpc)
+	dispatch.RegisterLocalStateGetWithdrawalProof(srpc)
+	dispatch.RegisterLocalStateGetWithdrawalsForAccount(srpc)
+	dispatch.RegisterLocalStateGetFees(srpc)
+	dispatch.RegisterLocalStateGetSpendingTransaction(srpc)
+	dispatch.RegisterLocalStateGetBlock(srpc)
+	dispatch.RegisterLocalStateGetBlockByHash(srpc)
+	dispatch.RegisterLocalStateGetBlockRange(srpc)
+	dispatch.RegisterLocalStateGetValidatorStats(srpc)
+	dispatch.RegisterLocalStateGetSupply(srpc)
+	dispatch.RegisterLocalStateGetFeatures(srpc)
+}
+
 func (srpc *Handlers) Start() {
 	srpc.SafeMonitor()
 }
@@ -205,7 +258,48 @@ func (srpc *Handlers) HandleLocalStateSendTransaction(ctx context.Context, req *
 	}
 
 	srpc.logger.Debugf("HandleLocalStateSendTransaction: %v", req)
-	ntx, err := ReverseTranslateTx(req.Tx)
+	txHash, err := srpc.sendTransaction(ctx, req.Tx)
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.TransactionDetails{TxHash: hex.EncodeToString(txHash)}
+	return result, nil
+}
+
+// HandleLocalStateSendTransactions ...
+func (srpc *Handlers) HandleLocalStateSendTransactions(ctx context.Context, req *pb.TransactionsData) (*pb.TransactionsDetails, error) {
+	if err := srpc.notReady(); err != nil {
+		return nil, err
+	}
+
+	srpc.logger.Debugf("HandleLocalStateSendTransactions: %v", len(req.Txs))
+	if len(req.Txs) > constants.LocalRPCMaxBatchSize {
+		return nil, fmt.Errorf("number of transactions is not allowed to be greater than %v; got %v", constants.LocalRPCMaxBatchSize, len(req.Txs))
+	}
+	results := []*pb.TransactionResult{}
+	for _, tx := range req.Txs {
+		result := &pb.TransactionResult{}
+		txHash, err := srpc.sendTransaction(ctx, tx)
+		if len(txHash) > 0 {
+			result.TxHash = hex.EncodeToString(txHash)
+		}
+		if err != nil {
+			_, kind := classifyError(err)
+			result.Error = errorStatus(err).Message()
+			result.Kind = kind
+		} else {
+			result.Accepted = true
+		}
+		results = append(results, result)
+	}
+	return &pb.TransactionsDetails{Results: results}, nil
+}
+
+// sendTransaction decodes tx and injects it into the pending tx pool. The
+// hash of the tx is returned whenever it could be computed, even if the tx
+// was rejected.
+func (srpc *Handlers) sendTransaction(ctx context.Context, tx *pb.Tx) ([]byte, error) {
+	ntx, err := ReverseTranslateTx(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -217,18 +311,82 @@ func (srpc *Handlers) HandleLocalStateSendTransaction(ctx context.Context, req *
 	if err != nil {
 		return nil, err
 	}
+	txHash, err := ntx.TxHash()
+	if err != nil {
+		return nil, err
+	}
 	_, err = srpc.GossipBus.HandleP2PGossipTransaction(ctx, &pb.GossipTransactionMessage{Transaction: txb})
 	if err != nil {
+		return txHash, err
+	}
+	return txHash, nil
+}
+
+// HandleLocalStateValidateTransaction ...
+func (srpc *Handlers) HandleLocalStateValidateTransaction(ctx context.Context, req *pb.TransactionData) (*pb.ValidateTransactionResponse, error) {
+	if err := srpc.notReady(); err != nil {
 		return nil, err
 	}
-	txHash, err := ntx.TxHash()
+
+	srpc.logger.Debugf("HandleLocalStateValidateTransaction: %v", req)
+	ntx, err := ReverseTranslateTx(req.Tx)
 	if err != nil {
 		return nil, err
 	}
-	result := &pb.TransactionDetails{TxHash: hex.EncodeToString(txHash)}
+	txb, err := ntx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	err = ntx.UnmarshalBinary(txb)
+	if err != nil {
+		return nil, err
+	}
+	var v *application.TxValidation
+	err = srpc.database.View(func(txn *badger.Txn) error {
+		os, err := srpc.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		chainID := os.SyncToBH.BClaims.ChainID
+		height := os.SyncToBH.BClaims.Height + 1
+		tmp, err := srpc.AppHandler.ValidateTx(txn, chainID, height, ntx)
+		if err != nil {
+			return err
+		}
+		v = tmp
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.ValidateTransactionResponse{
+		TxHash:  hex.EncodeToString(v.TxHash),
+		Valid:   v.IsValid(),
+		Reasons: []string{},
+		Vin:     translateValidationReasons(v.VinErrors),
+		Vout:    translateValidationReasons(v.VoutErrors),
+	}
+	for _, err := range v.TxErrors {
+		result.Reasons = append(result.Reasons, err.Error())
+	}
 	return result, nil
 }
 
+// translateValidationReasons converts errors keyed by position into a list of
+// reasons ordered by position
+func translateValidationReasons(errs map[int]error) []*pb.ValidationReason {
+	idxs := []int{}
+	for i := range errs {
+		idxs = append(idxs, i)
+	}
+	sort.Ints(idxs)
+	reasons := []*pb.ValidationReason{}
+	for _, i := range idxs {
+		reasons = append(reasons, &pb.ValidationReason{Index: uint32(i), Reason: errs[i].Error()})
+	}
+	return reasons
+}
+
 // HandleLocalStateGetValueForOwner ...
 func (srpc *Handlers) HandleLocalStateGetValueForOwner(ctx context.Context, req *pb.GetValueRequest) (*pb.GetValueResponse, error) {
 	if err := srpc.notReady(); err != nil {
@@ -258,19 +416,19 @@ func (srpc *Handlers) HandleLocalStateGetValueForOwner(ctx context.Context, req
 	var paginationToken *objs.PaginationToken
 	var height uint32
 	err = srpc.database.View(func(txn *badger.Txn) error {
-		tmp, v, pt, err := srpc.AppHandler.GetValueForOwner(txn, constants.CurveSpec(req.CurveSpec), account, minValue, req.PaginationToken)
+		os, err := srpc.database.GetOwnState(txn)
 		if err != nil {
 			return err
 		}
-		utxoIDs = tmp
-		value = v
-		paginationToken = pt
+		height = os.SyncToBH.BClaims.Height
 
-		os, err := srpc.database.GetOwnState(txn)
+		tmp, v, pt, err := srpc.AppHandler.GetValueForOwner(txn, constants.CurveSpec(req.CurveSpec), account, height, minValue, req.PaginationToken)
 		if err != nil {
 			return err
 		}
-		height = os.SyncToBH.BClaims.Height
+		utxoIDs = tmp
+		value = v
+		paginationToken = pt
 
 		return nil
 	})
@@ -352,6 +510,179 @@ func (srpc *Handlers) HandleLocalStateGetBlockHeader(ctx context.Context, req *p
 	return result, nil
 }
 
+// maxBlockRange is the most blocks returned by one GetBlockRange request
+const maxBlockRange = 64
+
+// HandleLocalStateGetBlock returns the block at a height
+func (srpc *Handlers) HandleLocalStateGetBlock(ctx context.Context, req *pb.BlockRequest) (*pb.BlockResponse, error) {
+	if err := srpc.notReady(); err != nil {
+		return nil, err
+	}
+
+	srpc.logger.Debugf("HandleLocalStateGetBlock: %v", req)
+	if req.Height == 0 {
+		return nil, errors.New("height cannot be zero")
+	}
+	var block *pb.Block
+	err := srpc.database.View(func(txn *badger.Txn) error {
+		bh, err := srpc.database.GetCommittedBlockHeader(txn, req.Height)
+		if err != nil {
+			return err
+		}
+		block, err = srpc.translateBlock(txn, bh, req.IncludeTxs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.BlockResponse{Block: block}
+	return result, nil
+}
+
+// HandleLocalStateGetBlockByHash returns the block with a block hash
+func (srpc *Handlers) HandleLocalStateGetBlockByHash(ctx context.Context, req *pb.BlockByHashRequest) (*pb.BlockResponse, error) {
+	if err := srpc.notReady(); err != nil {
+		return nil, err
+	}
+
+	srpc.logger.Debugf("HandleLocalStateGetBlockByHash: %v", req)
+	blockHash, err := ReverseTranslateByte(req.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(blockHash) != constants.HashLen {
+		return nil, fmt.Errorf("invalid length (%v) for BlockHash:%s", len(req.BlockHash), req.BlockHash)
+	}
+	var block *pb.Block
+	err = srpc.database.View(func(txn *badger.Txn) error {
+		bh, err := srpc.database.GetCommittedBlockHeaderByHash(txn, blockHash)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return fmt.Errorf("unknown block: %s", req.BlockHash)
+			}
+			return err
+		}
+		block, err = srpc.translateBlock(txn, bh, req.IncludeTxs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.BlockResponse{Block: block}
+	return result, nil
+}
+
+// HandleLocalStateGetBlockRange returns up to maxBlockRange consecutive
+// blocks and the height to continue from
+func (srpc *Handlers) HandleLocalStateGetBlockRange(ctx context.Context, req *pb.BlockRangeRequest) (*pb.BlockRangeResponse, error) {
+	if err := srpc.notReady(); err != nil {
+		return nil, err
+	}
+
+	srpc.logger.Debugf("HandleLocalStateGetBlockRange: %v", req)
+	if req.StartHeight == 0 {
+		return nil, errors.New("height cannot be zero")
+	}
+	count := req.Count
+	if count == 0 || count > maxBlockRange {
+		count = maxBlockRange
+	}
+	result := &pb.BlockRangeResponse{}
+	err := srpc.database.View(func(txn *badger.Txn) error {
+		os, err := srpc.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		last := os.SyncToBH.BClaims.Height
+		height := req.StartHeight
+		for ; height <= last && height-req.StartHeight < count; height++ {
+			bh, err := srpc.database.GetCommittedBlockHeader(txn, height)
+			if err != nil {
+				return err
+			}
+			block, err := srpc.translateBlock(txn, bh, req.IncludeTxs)
+			if err != nil {
+				return err
+			}
+			result.Blocks = append(result.Blocks, block)
+		}
+		if height <= last {
+			result.NextHeight = height
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// translateBlock returns bh with its hash, the validator set which signed it
+// and, if known, the round in which it was committed and its proposer. The
+// round is only recorded for blocks this node committed through consensus;
+// for downloaded blocks RoundKnown is false and Round and Proposer are left
+// unset. The txs of the block are included if includeTxs is set; they are
+// not available for blocks below the height a node fast synced to.
+func (srpc *Handlers) translateBlock(txn *badger.Txn, bh *cobjs.BlockHeader, includeTxs bool) (*pb.Block, error) {
+	height := bh.BClaims.Height
+	bhOut, err := ForwardTranslateBlockHeader(bh)
+	if err != nil {
+		return nil, err
+	}
+	blockHash, err := bh.BlockHash()
+	if err != nil {
+		return nil, err
+	}
+	block := &pb.Block{
+		BlockHeader: bhOut,
+		BlockHash:   ForwardTranslateByte(blockHash),
+	}
+	vs, err := srpc.database.GetValidatorSet(txn, height)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range vs.Validators {
+		block.Validators = append(block.Validators, ForwardTranslateByte(v.VAddr))
+	}
+	block.GroupKey = ForwardTranslateByte(vs.GroupKey)
+	round, err := srpc.database.GetCommittedBlockRound(txn, height)
+	switch {
+	case err == badger.ErrKeyNotFound:
+		// the block was downloaded
+	case err != nil:
+		return nil, err
+	case round == 0 || len(vs.Validators) == 0:
+		return nil, fmt.Errorf("invalid round %v recorded for block %v", round, height)
+	default:
+		block.RoundKnown = true
+		block.Round = round
+		idx := cobjs.GetProposerIdx(len(vs.Validators), height, round)
+		block.Proposer = ForwardTranslateByte(vs.Validators[idx].VAddr)
+	}
+	if !includeTxs || len(bh.TxHshLst) == 0 {
+		return block, nil
+	}
+	txi, missing, err := srpc.AppHandler.MinedTxGet(txn, bh.TxHshLst)
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("the transactions of block %v are not available", height)
+	}
+	for _, t := range txi {
+		tx, ok := t.(*objs.Tx)
+		if !ok {
+			return nil, errors.New("server fault - data invalid for requested value")
+		}
+		txOut, err := ForwardTranslateTx(tx)
+		if err != nil {
+			return nil, err
+		}
+		block.Txs = append(block.Txs, txOut)
+	}
+	return block, nil
+}
+
 func (srpc *Handlers) HandleLocalStateGetRoundStateForValidator(ctx context.Context, req *pb.RoundStateForValidatorRequest) (*pb.RoundStateForValidatorResponse, error) {
 	if err := srpc.notReady(); err != nil {
 		return nil, err
@@ -454,6 +785,58 @@ func (srpc *Handlers) HandleLocalStateGetData(ctx context.Context, req *pb.GetDa
 	return result, nil
 }
 
+// maxObjectSize is the largest object returned by one GetObject request;
+// larger objects must be read chunk by chunk with GetData
+const maxObjectSize = 1 << 20
+
+// HandleLocalStateGetObject ...
+func (srpc *Handlers) HandleLocalStateGetObject(ctx context.Context, req *pb.GetObjectRequest) (*pb.GetObjectResponse, error) {
+	if err := srpc.notReady(); err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	srpc.logger.Debugf("HandleLocalStateGetObject: %v", req)
+
+	err := srpc.database.View(func(txn *badger.Txn) error {
+		account, err := ReverseTranslateByte(req.Account)
+		if err != nil {
+			return err
+		}
+		if len(account) != 20 {
+			return fmt.Errorf("invalid length (%v) for Account:%s", len(req.Account), req.Account)
+		}
+		index, err := ReverseTranslateByte(req.Index)
+		if err != nil {
+			return err
+		}
+		if len(index) != 32 {
+			return fmt.Errorf("invalid length (%v) for Index:%s", len(req.Index), req.Index)
+		}
+		curveSpec := constants.CurveSpec(req.CurveSpec)
+		manifest, err := srpc.AppHandler.UTXOGetData(txn, curveSpec, account, index)
+		if err != nil {
+			return err
+		}
+		getData := func(chunkIndex []byte) ([]byte, error) {
+			return srpc.AppHandler.UTXOGetData(txn, curveSpec, account, chunkIndex)
+		}
+		tmp, err := objs.ReadObject(manifest, maxObjectSize, getData)
+		if err != nil {
+			return err
+		}
+		data = tmp
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	d := ForwardTranslateByte(data)
+
+	result := &pb.GetObjectResponse{Rawdata: d}
+	return result, nil
+}
+
 // HandleLocalStateIterateNameSpace ...
 func (srpc *Handlers) HandleLocalStateIterateNameSpace(ctx context.Context, req *pb.IterateNameSpaceRequest) (*pb.IterateNameSpaceResponse, error) {
 	if err := srpc.notReady(); err != nil {
@@ -638,3 +1021,315 @@ func (srpc *Handlers) HandleLocalStateGetTxBlockNumber(ctx context.Context, req
 	result := &pb.TxBlockNumberResponse{BlockHeight: height}
 	return result, nil
 }
+
+// HandleLocalStateGetWithdrawalProof returns a withdrawal with a proof of its
+// inclusion in the state root of the last snapshot
+func (srpc *Handlers) HandleLocalStateGetWithdrawalProof(ctx context.Context, req *pb.GetWithdrawalProofRequest) (*pb.GetWithdrawalProofResponse, error) {
+	if err := srpc.notReady(); err != nil {
+		return nil, err
+	}
+
+	srpc.logger.Debugf("HandleLocalStateGetWithdrawalProof: %v", req)
+	utxoID, err := ReverseTranslateByte(req.UTXOID)
+	if err != nil {
+		return nil, err
+	}
+	if len(utxoID) != constants.HashLen {
+		return nil, fmt.Errorf("invalid length (%v) for UTXOID:%s", len(req.UTXOID), req.UTXOID)
+	}
+	var utxo *objs.TXOut
+	var proof *db.MerkleProof
+	var bh *pb.BlockHeader
+	err = srpc.database.View(func(txn *badger.Txn) error {
+		snapshot, err := srpc.database.GetLastSnapshot(txn)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return errors.New("no snapshot has been taken yet")
+			}
+			return err
+		}
+		utxo, proof, err = srpc.AppHandler.GetWithdrawalProof(txn, utxoID, snapshot.BClaims.StateRoot)
+		if err != nil {
+			return err
+		}
+		bh, err = ForwardTranslateBlockHeader(snapshot)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	wd, err := utxo.Withdrawal()
+	if err != nil {
+		return nil, err
+	}
+	wdOut, err := ForwardTranslateWithdrawal(wd)
+	if err != nil {
+		return nil, err
+	}
+	abiBytes, err := wd.MarshalABI()
+	if err != nil {
+		return nil, err
+	}
+	auditPath, err := ForwardTranslateByteSlice(proof.Path)
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.GetWithdrawalProofResponse{
+		Withdrawal:  wdOut,
+		ABI:         ForwardTranslateByte(abiBytes),
+		BlockHeader: bh,
+		Bitmap:      ForwardTranslateByte(proof.Bitmap),
+		AuditPath:   auditPath,
+		ProofHeight: uint32(proof.KeyHeight),
+		ProofKey:    ForwardTranslateByte(proof.ProofKey),
+		ProofValue:  ForwardTranslateByte(proof.ProofValue),
+		Contract:    srpc.MerkleProofContract,
+	}
+	return result, nil
+}
+
+// HandleLocalStateGetWithdrawalsForAccount returns the utxoIDs of the
+// withdrawals to an Ethereum account
+func (srpc *Handlers) HandleLocalStateGetWithdrawalsForAccount(ctx context.Context, req *pb.GetWithdrawalsForAccountRequest) (*pb.GetWithdrawalsForAccountResponse, error) {
+	if err := srpc.notReady(); err != nil {
+		return nil, err
+	}
+
+	srpc.logger.Debugf("HandleLocalStateGetWithdrawalsForAccount: %v", req)
+	const maxCount = 256
+	account, err := ReverseTranslateByte(req.Account)
+	if err != nil {
+		return nil, err
+	}
+	if len(account) != constants.OwnerLen {
+		return nil, fmt.Errorf("invalid length (%v) for Account:%s", len(req.Account), req.Account)
+	}
+	var startKey []byte
+	if req.StartKey != "" {
+		startKey, err = ReverseTranslateByte(req.StartKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var utxoIDs [][]byte
+	var nextKey []byte
+	err = srpc.database.View(func(txn *badger.Txn) error {
+		utxoIDs, nextKey, err = srpc.AppHandler.GetWithdrawalsForAccount(txn, account, maxCount, startKey)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	out, err := ForwardTranslateByteSlice(utxoIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.GetWithdrawalsForAccountResponse{
+		UTXOIDs:  out,
+		StartKey: ForwardTranslateByte(nextKey),
+	}
+	return result, nil
+}
+
+// HandleLocalStateGetFees returns the fees currently set in dynamics
+func (srpc *Handlers) HandleLocalStateGetFees(ctx context.Context, req *pb.FeesRequest) (*pb.FeesResponse, error) {
+	if err := srpc.notReady(); err != nil {
+		return nil, err
+	}
+
+	srpc.logger.Debugf("HandleLocalStateGetFees: %v", req)
+	minTxFee, vsFee, dsEpochFee, asFee, err := srpc.AppHandler.GetFees()
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.FeesResponse{}
+	if result.MinTxFee, err = minTxFee.MarshalString(); err != nil {
+		return nil, err
+	}
+	if result.ValueStoreFee, err = vsFee.MarshalString(); err != nil {
+		return nil, err
+	}
+	if result.DataStoreEpochFee, err = dsEpochFee.MarshalString(); err != nil {
+		return nil, err
+	}
+	if result.AtomicSwapFee, err = asFee.MarshalString(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HandleLocalStateGetSpendingTransaction returns the hash and height of the
+// mined transaction which consumed a UTXO
+func (srpc *Handlers) HandleLocalStateGetSpendingTransaction(ctx context.Context, req *pb.GetSpendingTransactionRequest) (*pb.GetSpendingTransactionResponse, error) {
+	if err := srpc.notReady(); err != nil {
+		return nil, err
+	}
+
+	srpc.logger.Debugf("HandleLocalStateGetSpendingTransaction: %v", req)
+	utxoID, err := ReverseTranslateByte(req.UTXOID)
+	if err != nil {
+		return nil, err
+	}
+	if len(utxoID) != constants.HashLen {
+		return nil, fmt.Errorf("invalid length (%v) for UTXOID:%s", len(req.UTXOID), req.UTXOID)
+	}
+	var txHash []byte
+	var height uint32
+	err = srpc.database.View(func(txn *badger.Txn) error {
+		txHash, height, err = srpc.AppHandler.GetSpendingTx(txn, utxoID)
+		if err == badger.ErrKeyNotFound {
+			return fmt.Errorf("no mined transaction consumed UTXOID:%s", req.UTXOID)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.GetSpendingTransactionResponse{
+		TxHash: ForwardTranslateByte(txHash),
+		Height: height,
+	}
+	return result, nil
+}
+
+// maxStatsEpochs is the most epochs returned by one GetValidatorStats request
+const maxStatsEpochs = 64
+
+// HandleLocalStateGetValidatorStats returns the validator statistics of a
+// range of epochs
+func (srpc *Handlers) HandleLocalStateGetValidatorStats(ctx context.Context, req *pb.ValidatorStatsRequest) (*pb.ValidatorStatsResponse, error) {
+	if err := srpc.notReady(); err != nil {
+		return nil, err
+	}
+
+	srpc.logger.Debugf("HandleLocalStateGetValidatorStats: %v", req)
+	if req.StartEpoch == 0 {
+		return nil, errors.New("epoch cannot be zero")
+	}
+	if req.EndEpoch < req.StartEpoch {
+		return nil, errors.New("end epoch is before start epoch")
+	}
+	if req.EndEpoch-req.StartEpoch >= maxStatsEpochs {
+		return nil, fmt.Errorf("at most %v epochs can be requested", maxStatsEpochs)
+	}
+	result := &pb.ValidatorStatsResponse{}
+	err := srpc.database.View(func(txn *badger.Txn) error {
+		for epoch := req.StartEpoch; epoch <= req.EndEpoch; epoch++ {
+			es, err := stats.GetEpochStats(txn, epoch)
+			if err != nil {
+				if err == badger.ErrKeyNotFound {
+					continue
+				}
+				return err
+			}
+			esOut := &pb.EpochStats{
+				Epoch:   es.Epoch,
+				Heights: es.Heights,
+				Rounds:  es.Rounds,
+			}
+			for _, v := range es.Validators {
+				esOut.Validators = append(esOut.Validators, &pb.ValidatorStats{
+					VAddr:          ForwardTranslateByte(v.VAddr),
+					Heights:        v.Heights,
+					Rounds:         v.Rounds,
+					ProposerRounds: v.ProposerRounds,
+					Proposals:      v.Proposals,
+					PreVotes:       v.PreVotes,
+					PreCommits:     v.PreCommits,
+				})
+			}
+			result.Epochs = append(result.Epochs, esOut)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HandleLocalStateGetSupply returns the supply ledger entry of a height
+func (srpc *Handlers) HandleLocalStateGetSupply(ctx context.Context, req *pb.SupplyRequest) (*pb.SupplyResponse, error) {
+	if err := srpc.notReady(); err != nil {
+		return nil, err
+	}
+
+	srpc.logger.Debugf("HandleLocalStateGetSupply: %v", req)
+	result := &pb.SupplyResponse{}
+	err := srpc.database.View(func(txn *badger.Txn) error {
+		height := req.Height
+		if height == 0 {
+			os, err := srpc.database.GetOwnState(txn)
+			if err != nil {
+				return err
+			}
+			height = os.SyncToBH.BClaims.Height
+		}
+		s, err := srpc.AppHandler.GetSupply(txn, height)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return fmt.Errorf("the supply of height %v is not known", height)
+			}
+			return err
+		}
+		result.Height = s.Height
+		fields := []struct {
+			out *string
+			in  *uint256.Uint256
+		}{
+			{&result.Deposits, s.Deposits},
+			{&result.Fees, s.Fees},
+			{&result.DataStoreDeposits, s.DataStoreDeposits},
+			{&result.DataStoreRefunds, s.DataStoreRefunds},
+			{&result.DataStoreBurned, s.DataStoreBurned},
+			{&result.Withdrawals, s.Withdrawals},
+			{&result.ActiveValue, s.ActiveValue},
+		}
+		for _, f := range fields {
+			if *f.out, err = f.in.MarshalString(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HandleLocalStateGetFeatures returns the registered and the scheduled
+// features and whether each is active at an epoch
+func (srpc *Handlers) HandleLocalStateGetFeatures(ctx context.Context, req *pb.FeaturesRequest) (*pb.FeaturesResponse, error) {
+	if err := srpc.notReady(); err != nil {
+		return nil, err
+	}
+
+	srpc.logger.Debugf("HandleLocalStateGetFeatures: %v", req)
+	epoch := req.Epoch
+	if epoch == 0 {
+		err := srpc.database.View(func(txn *badger.Txn) error {
+			os, err := srpc.database.GetOwnState(txn)
+			if err != nil {
+				return err
+			}
+			epoch = utils.Epoch(os.SyncToBH.BClaims.Height + 1)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	result := &pb.FeaturesResponse{Epoch: epoch}
+	for _, f := range srpc.AppHandler.GetFeatures() {
+		result.Features = append(result.Features, &pb.Feature{
+			Name:            string(f.Name),
+			Description:     f.Description,
+			Registered:      f.Registered,
+			Scheduled:       f.Scheduled,
+			ActivationEpoch: f.ActivationEpoch,
+			Active:          f.Scheduled && epoch >= f.ActivationEpoch,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,81 @@
+package localrpc
+
+import (
+	"bytes"
+	"testing"
+
+	cobjs "github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/dgraph-io/badger/v2"
+)
+
+func TestTranslateBlockRound(t *testing.T) {
+	srpc := newTestHandlers(t)
+	vs := &cobjs.ValidatorSet{
+		GroupKey:  make([]byte, constants.CurveBN256EthPubkeyLen),
+		NotBefore: 1,
+	}
+	for _, name := range []string{"v1", "v2", "v3", "v4"} {
+		vs.Validators = append(vs.Validators, &cobjs.Validator{
+			VAddr:      crypto.Hasher([]byte(name))[:constants.OwnerLen],
+			GroupShare: make([]byte, constants.CurveBN256EthPubkeyLen),
+		})
+	}
+	newHeader := func(height uint32) *cobjs.BlockHeader {
+		return &cobjs.BlockHeader{
+			BClaims: &cobjs.BClaims{
+				ChainID:    1,
+				Height:     height,
+				PrevBlock:  crypto.Hasher([]byte("prev")),
+				TxRoot:     crypto.Hasher([]byte{}),
+				StateRoot:  crypto.Hasher([]byte("state")),
+				HeaderRoot: crypto.Hasher([]byte("header")),
+			},
+			SigGroup: make([]byte, constants.CurveBN256EthSigLen),
+		}
+	}
+	downloaded := newHeader(2)
+	committed := newHeader(3)
+	translate := func(bh *cobjs.BlockHeader) *pb.Block {
+		t.Helper()
+		var block *pb.Block
+		err := srpc.database.Update(func(txn *badger.Txn) error {
+			if err := srpc.database.SetValidatorSet(txn, vs); err != nil {
+				return err
+			}
+			if err := srpc.database.SetCommittedBlockRound(txn, committed.BClaims.Height, 2); err != nil {
+				return err
+			}
+			tmp, err := srpc.translateBlock(txn, bh, false)
+			block = tmp
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return block
+	}
+
+	block := translate(downloaded)
+	if block.RoundKnown || block.Round != 0 || block.Proposer != "" {
+		t.Fatalf("round of a downloaded block should be unknown: %v %v %q", block.RoundKnown, block.Round, block.Proposer)
+	}
+	if len(block.Validators) != len(vs.Validators) {
+		t.Fatalf("expected %d validators; got %d", len(vs.Validators), len(block.Validators))
+	}
+
+	block = translate(committed)
+	if !block.RoundKnown || block.Round != 2 {
+		t.Fatalf("round of a committed block should be known: %v %v", block.RoundKnown, block.Round)
+	}
+	idx := cobjs.GetProposerIdx(len(vs.Validators), committed.BClaims.Height, 2)
+	proposer, err := ReverseTranslateByte(block.Proposer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(proposer, vs.Validators[idx].VAddr) {
+		t.Fatalf("wrong proposer %q", block.Proposer)
+	}
+}
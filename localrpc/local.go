@@ -8,11 +8,11 @@ import (
 	"sync"
 
 	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/health"
 	"github.com/MadBase/MadNet/interfaces"
 	bindata "github.com/MadBase/MadNet/localrpc/swagger-bindata"
 	pb "github.com/MadBase/MadNet/proto"
 	assetfs "github.com/elazarl/go-bindata-assetfs"
-	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/http2"
@@ -28,6 +28,7 @@ type Handler struct {
 	listener   net.Listener
 	grpcServer *grpc.Server
 	server     *http.Server
+	mux        *http.ServeMux
 	log        *logrus.Logger
 	closeOnce  sync.Once
 }
@@ -51,11 +52,20 @@ func (rpch *Handler) Serve() {
 	}
 }
 
+// RegisterHealth serves the liveness and readiness reports of checker at
+// /healthz and /readyz and through the gRPC health service. It must be called
+// before Serve.
+func (rpch *Handler) RegisterHealth(checker *health.Checker) {
+	rpch.mux.Handle("/healthz", checker.HealthzHandler())
+	rpch.mux.Handle("/readyz", checker.ReadyzHandler())
+	health.NewGRPCServer(checker).Register(rpch.grpcServer)
+}
+
 // NewStateServerHandler returns a RPC ServerHandler for the BootNode
 // Service.
 func NewStateServerHandler(logger *logrus.Logger, addr string, service interfaces.StateServer) (*Handler, error) {
 	// create the grpc server
-	grpcServer := grpc.NewServer(grpc.MaxConcurrentStreams(constants.MaxConcurrentStreams), grpc.NumStreamWorkers(constants.LocalRPCMaxWorkers), grpc.ReadBufferSize(constants.ReadBufferSize))
+	grpcServer := grpc.NewServer(grpc.MaxConcurrentStreams(constants.MaxConcurrentStreams), grpc.NumStreamWorkers(constants.LocalRPCMaxWorkers), grpc.ReadBufferSize(constants.ReadBufferSize), grpc.UnaryInterceptor(errorUnaryInterceptor))
 	pb.RegisterLocalStateServer(grpcServer, service)
 
 	// make a server mux
@@ -72,9 +82,11 @@ func NewStateServerHandler(logger *logrus.Logger, addr string, service interface
 	mux.Handle(prefix, http.StripPrefix(prefix, fileServer))
 	// add redirect to file server
 	mux.HandleFunc("/swagger.json", serveSwagger)
+	// serve the generated OpenAPI document directly
+	mux.HandleFunc("/openapi.json", serveOpenAPI)
 
 	// make a new grpc runtime mux
-	gwmux := runtime.NewServeMux()
+	gwmux := newGatewayMux()
 
 	// make grpc handle cors request
 	cmux := cors.Default().Handler(gwmux)
@@ -110,6 +122,7 @@ func NewStateServerHandler(logger *logrus.Logger, addr string, service interface
 		cf:         cf,
 		listener:   lis,
 		server:     srv,
+		mux:        mux,
 		grpcServer: grpcServer,
 		log:        logger,
 	}
@@ -78,6 +78,7 @@ func ForwardTranslateVSPreImage(f *from.VSPreImage) (*to.VSPreImage, error) {
 		newOwner := ForwardTranslateByte(ownerBytes)
 
 		t.Owner = newOwner
+		t.LockEpoch = f.Owner.LockEpoch
 	}
 
 	t.TXOutIdx = f.TXOutIdx
@@ -201,6 +202,18 @@ func ForwardTranslateTXOut(f *from.TXOut) (*to.TXOut, error) {
 		tt := &to.TXOut_TxFee{TxFee: newObj}
 		t := &to.TXOut{Utxo: tt}
 		return t, nil
+	case f.HasWithdrawal():
+		obj, err := f.Withdrawal()
+		if err != nil {
+			return nil, err
+		}
+		newObj, err := ForwardTranslateWithdrawal(obj)
+		if err != nil {
+			return nil, err
+		}
+		tt := &to.TXOut_Withdrawal{Withdrawal: newObj}
+		t := &to.TXOut{Utxo: tt}
+		return t, nil
 	default:
 		return nil, errors.New("no txout in forward translate")
 	}
@@ -381,3 +394,48 @@ func ForwardTranslateTFPreImage(f *from.TFPreImage) (*to.TFPreImage, error) {
 	}
 	return t, nil
 }
+
+func ForwardTranslateWithdrawal(f *from.Withdrawal) (*to.Withdrawal, error) {
+	t := &to.Withdrawal{}
+	if f == nil {
+		return nil, errors.New("withdrawal object should not be nil")
+	}
+
+	newTxHash := ForwardTranslateByte(f.TxHash)
+
+	t.TxHash = newTxHash
+
+	if f.WDPreImage != nil {
+		newWDPreImage, err := ForwardTranslateWDPreImage(f.WDPreImage)
+		if err != nil {
+			return nil, err
+		}
+		t.WDPreImage = newWDPreImage
+	}
+	return t, nil
+}
+
+func ForwardTranslateWDPreImage(f *from.WDPreImage) (*to.WDPreImage, error) {
+	t := &to.WDPreImage{}
+	if f == nil {
+		return nil, errors.New("object of type WDPreImage should not be nil")
+	}
+
+	t.ChainID = f.ChainID
+	t.TXOutIdx = f.TXOutIdx
+
+	newAccount := ForwardTranslateByte(f.Account)
+
+	t.Account = newAccount
+
+	var err error
+	t.Value, err = f.Value.MarshalString()
+	if err != nil {
+		return nil, err
+	}
+	t.Fee, err = f.Fee.MarshalString()
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
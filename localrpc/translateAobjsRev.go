@@ -146,6 +146,9 @@ func ReverseTranslateTXInLinker(f *from.TXInLinker) (*to.TXInLinker, error) {
 }
 
 func ReverseTranslateTx(f *from.Tx) (*to.Tx, error) {
+	if f == nil {
+		return nil, errors.New("tx object should not be nil")
+	}
 	t := &to.Tx{}
 	for _, txIn := range f.Vin {
 		newVin, err := ReverseTranslateTXIn(txIn)
@@ -212,6 +215,17 @@ func ReverseTranslateTXOut(f *from.TXOut) (*to.TXOut, error) {
 		if err != nil {
 			return nil, err
 		}
+	case *from.TXOut_Withdrawal:
+		ff := f.GetWithdrawal()
+		obj, err := ReverseTranslateWithdrawal(ff)
+		if err != nil {
+			return nil, err
+		}
+
+		err = t.NewWithdrawal(obj)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		return nil, errors.New("invalid")
 	}
@@ -373,3 +387,51 @@ func ReverseTranslateTFPreImage(f *from.TFPreImage) (*to.TFPreImage, error) {
 	}
 	return t, nil
 }
+
+func ReverseTranslateWithdrawal(f *from.Withdrawal) (*to.Withdrawal, error) {
+	t := &to.Withdrawal{}
+	newTxHash, err := ReverseTranslateByte(f.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	t.TxHash = newTxHash
+
+	if f.WDPreImage != nil {
+		newWDPreImage, err := ReverseTranslateWDPreImage(f.WDPreImage)
+		if err != nil {
+			return nil, err
+		}
+		t.WDPreImage = newWDPreImage
+	}
+	return t, nil
+}
+
+func ReverseTranslateWDPreImage(f *from.WDPreImage) (*to.WDPreImage, error) {
+	t := &to.WDPreImage{}
+	t.ChainID = f.ChainID
+	t.TXOutIdx = f.TXOutIdx
+
+	newAccount, err := ReverseTranslateByte(f.Account)
+	if err != nil {
+		return nil, err
+	}
+	t.Account = newAccount
+
+	if len(f.Value) == 0 {
+		f.Value = "0"
+	}
+	t.Value = &uint256.Uint256{}
+	err = t.Value.UnmarshalString(f.Value)
+	if err != nil {
+		return nil, err
+	}
+	if len(f.Fee) == 0 {
+		f.Fee = "0"
+	}
+	t.Fee = &uint256.Uint256{}
+	err = t.Fee.UnmarshalString(f.Fee)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
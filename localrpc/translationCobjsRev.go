@@ -1,8 +1,6 @@
 package localrpc
 
 import (
-	"encoding/hex"
-
 	to "github.com/MadBase/MadNet/consensus/objs"
 	from "github.com/MadBase/MadNet/proto"
 	"github.com/MadBase/MadNet/utils"
@@ -297,7 +295,7 @@ func ReverseTranslateByte(in string) ([]byte, error) {
 func ReverseTranslateByteSlice(in []string) ([][]byte, error) {
 	out := [][]byte{}
 	for _, b := range in {
-		s, err := hex.DecodeString(b)
+		s, err := utils.DecodeHexString(b)
 		if err != nil {
 			return nil, err
 		}
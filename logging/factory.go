@@ -3,6 +3,7 @@ package logging
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -91,3 +92,15 @@ func GetKnownLoggers() []*logrus.Logger {
 
 	return ret
 }
+
+// GetKnownLoggerNames returns the sorted names of all loggers currently
+// configured
+func GetKnownLoggerNames() []string {
+	loggers.init()
+	ret := make([]string, 0, len(loggers.loggers))
+	for name := range loggers.loggers {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
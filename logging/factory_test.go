@@ -2,6 +2,7 @@ package logging_test
 
 import (
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/MadBase/MadNet/constants"
@@ -72,3 +73,12 @@ func TestGetKnownLoggers(t *testing.T) {
 	known := logging.GetKnownLoggers()
 	assert.Equal(t, len(constants.ValidLoggers), len(known))
 }
+
+func TestGetKnownLoggerNames(t *testing.T) {
+	names := logging.GetKnownLoggerNames()
+	assert.Equal(t, len(constants.ValidLoggers), len(names))
+	assert.True(t, sort.StringsAreSorted(names))
+	for _, name := range names {
+		assert.NotNil(t, logging.GetLogger(name))
+	}
+}
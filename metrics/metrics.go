@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace prefixes the name of every metric exported by the node
+const Namespace = "madnet"
+
+// DefaultRegistry is the registry to which packages add their collectors and
+// which is served by the node. It also holds the Go runtime and process
+// collectors.
+var DefaultRegistry = prometheus.NewRegistry()
+
+func init() {
+	DefaultRegistry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+}
+
+// MustRegister adds collectors to the DefaultRegistry and panics on failure.
+// Packages call this from init for the collectors they own.
+func MustRegister(cs ...prometheus.Collector) {
+	DefaultRegistry.MustRegister(cs...)
+}
+
+// Handler returns the handler serving the DefaultRegistry
+func Handler() http.Handler {
+	return promhttp.HandlerFor(DefaultRegistry, promhttp.HandlerOpts{})
+}
+
+// Server serves the metrics of a registry over HTTP at /metrics
+type Server struct {
+	address string
+	server  *http.Server
+}
+
+// NewServer returns a Server listening on address for the registry
+func NewServer(address string, registry *prometheus.Registry) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	return &Server{
+		address: address,
+		server: &http.Server{
+			Handler:      mux,
+			ReadTimeout:  5 * time.Second,
+			WriteTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// Start listens on the address of the server and serves requests until
+// Close is called
+func (s *Server) Start() error {
+	lis, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return err
+	}
+	if err := s.server.Serve(lis); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// Close stops the server
+func (s *Server) Close() error {
+	ctx, cf := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cf()
+	return s.server.Shutdown(ctx)
+}
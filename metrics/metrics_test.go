@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	c := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "test",
+		Name:      "events_total",
+		Help:      "A counter.",
+	}, []string{"step"})
+	MustRegister(c)
+	defer DefaultRegistry.Unregister(c)
+	c.WithLabelValues("pre\"vote").Add(3)
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected response %v", rec.Code)
+	}
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain") {
+		t.Fatalf("unexpected content type %q", rec.Header().Get("Content-Type"))
+	}
+	out := rec.Body.String()
+	expected := []string{
+		"# HELP madnet_test_events_total A counter.\n# TYPE madnet_test_events_total counter\n",
+		"madnet_test_events_total{step=\"pre\\\"vote\"} 3\n",
+		"go_goroutines ",
+	}
+	for _, e := range expected {
+		if !strings.Contains(out, e) {
+			t.Fatalf("missing %q in:\n%s", e, out)
+		}
+	}
+}
+
+func TestMetricsRegistry(t *testing.T) {
+	g := prometheus.NewGauge(prometheus.GaugeOpts{Namespace: Namespace, Name: "test_dup"})
+	MustRegister(g)
+	defer DefaultRegistry.Unregister(g)
+	err := DefaultRegistry.Register(prometheus.NewCounter(prometheus.CounterOpts{Namespace: Namespace, Name: "test_dup"}))
+	if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+		t.Fatalf("expected AlreadyRegisteredError; got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package peering
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/MadBase/MadNet/interfaces"
@@ -160,3 +161,29 @@ func (ps *activePeerStore) getPeers() ([]interfaces.P2PClient, bool) {
 	}
 	return result, true
 }
+
+// delIdentity removes the peer with identity from the store
+func (ps *activePeerStore) delIdentity(identity string) {
+	ps.Lock()
+	defer ps.Unlock()
+	obj, ok := ps.store[identity]
+	if ok {
+		if ps.canClose {
+			obj.Close()
+		}
+		delete(ps.store, identity)
+		delete(ps.pid, identity)
+	}
+}
+
+// addrs returns the sorted addresses of the active peers
+func (ps *activePeerStore) addrs() []string {
+	ps.RLock()
+	defer ps.RUnlock()
+	result := []string{}
+	for _, v := range ps.store {
+		result = append(result, v.NodeAddr().P2PAddr())
+	}
+	sort.Strings(result)
+	return result
+}
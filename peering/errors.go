@@ -0,0 +1,12 @@
+package peering
+
+import "errors"
+
+var (
+	// ErrInvalidIdentity is returned for a peer which is neither given by a
+	// hex public key nor by an address
+	ErrInvalidIdentity = errors.New("a peer is given by its hex public key or its address")
+
+	// ErrNotBanned is returned when unbanning a peer which is not banned
+	ErrNotBanned = errors.New("the peer is not banned")
+)
@@ -2,6 +2,7 @@ package peering
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -118,3 +119,23 @@ func (ps *inactivePeerStore) len() int {
 	defer ps.RUnlock()
 	return len(ps.store)
 }
+
+// delIdentity removes the peer with identity from the store without a
+// cooldown
+func (ps *inactivePeerStore) delIdentity(identity string) {
+	ps.Lock()
+	defer ps.Unlock()
+	delete(ps.store, identity)
+}
+
+// addrs returns the sorted addresses of the inactive peers
+func (ps *inactivePeerStore) addrs() []string {
+	ps.RLock()
+	defer ps.RUnlock()
+	result := []string{}
+	for _, v := range ps.store {
+		result = append(result, v.P2PAddr())
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,46 @@
+package peering
+
+import (
+	"context"
+
+	"github.com/MadBase/MadNet/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Worker kinds used to label the P2PBus metrics
+const (
+	workerRequest  = "request"
+	workerGossip   = "gossip"
+	workerGossipTx = "gossip_tx"
+)
+
+var (
+	p2pBusWorkers = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "peering",
+		Name:      "p2pbus_workers",
+		Help:      "Number of P2PBus workers running across all peers.",
+	}, []string{"kind"})
+	p2pBusErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "peering",
+		Name:      "p2pbus_errors_total",
+		Help:      "Number of failed requests reported to the P2PBus worker oversight.",
+	}, []string{"reason"})
+)
+
+func init() {
+	metrics.MustRegister(p2pBusWorkers, p2pBusErrors)
+}
+
+// countP2PBusError records an error reported to the worker oversight
+func countP2PBusError(err error) {
+	if err == nil {
+		return
+	}
+	reason := "error"
+	if err == context.DeadlineExceeded {
+		reason = "timeout"
+	}
+	p2pBusErrors.WithLabelValues(reason).Inc()
+}
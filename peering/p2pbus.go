@@ -294,6 +294,7 @@ func (p2p *P2PBus) workerOversight() {
 		case <-p2p.closeChan:
 			return
 		case err := <-p2p.metricChan:
+			countP2PBusError(err)
 			if err == nil {
 				p2p.errMetric++
 				if p2p.errMetric >= p2p.numWorkers*2 {
@@ -352,6 +353,8 @@ func (p2p *P2PBus) backoffCalc() int {
 
 func (p2p *P2PBus) reqWorker() {
 	p2p.logger.Debugf("Starting request worker for peer %v", p2p.client.NodeAddr())
+	p2pBusWorkers.WithLabelValues(workerRequest).Inc()
+	defer p2pBusWorkers.WithLabelValues(workerRequest).Dec()
 	for {
 		select {
 		case <-p2p.closeChan:
@@ -366,6 +369,8 @@ func (p2p *P2PBus) reqWorker() {
 
 func (p2p *P2PBus) gossipWorker() {
 	p2p.logger.Debugf("Starting gossip worker for peer %v", p2p.client.NodeAddr())
+	p2pBusWorkers.WithLabelValues(workerGossip).Inc()
+	defer p2pBusWorkers.WithLabelValues(workerGossip).Dec()
 	for {
 		select {
 		case <-p2p.closeChan:
@@ -378,6 +383,8 @@ func (p2p *P2PBus) gossipWorker() {
 
 func (p2p *P2PBus) gossipTxWorker() {
 	p2p.logger.Debugf("Starting gossipTx worker for peer %v", p2p.client.NodeAddr())
+	p2pBusWorkers.WithLabelValues(workerGossipTx).Inc()
+	defer p2pBusWorkers.WithLabelValues(workerGossipTx).Dec()
 	for {
 		select {
 		case <-p2p.closeChan:
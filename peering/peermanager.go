@@ -2,9 +2,12 @@ package peering
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"net"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -48,11 +51,14 @@ type PeerManager struct {
 	gossipTxChan             chan interface{}
 	reqChan                  chan interface{}
 	upnpMapper               *transport.UPnPMapper
+	// banned holds the identities of the peers banned until a restart
+	banned map[string]bool
 }
 
 // NewPeerManager creates a new peer manager based on the Configuration
-// values passed to the process.
-func NewPeerManager(p2pServer interfaces.P2PServer, chainID uint32, pLimMin int, pLimMax int, fwMode bool, fwHost, listenAddr, tprivk string, upnp bool) (*PeerManager, error) {
+// values passed to the process. Peers with another genesisHash are refused;
+// genesisHash is nil if the chain was not started from a genesis file.
+func NewPeerManager(p2pServer interfaces.P2PServer, chainID uint32, genesisHash []byte, pLimMin int, pLimMax int, fwMode bool, fwHost, listenAddr, tprivk string, upnp bool) (*PeerManager, error) {
 	logger := logging.GetLogger(constants.LoggerPeerMan)
 	ctx := context.Background()
 	subCtx, cf := context.WithCancel(ctx)
@@ -68,7 +74,7 @@ func NewPeerManager(p2pServer interfaces.P2PServer, chainID uint32, pLimMin int,
 		cf()
 		return nil, err
 	}
-	p2ptransport, err := transport.NewP2PTransport(logging.GetLogger(constants.LoggerTransport), types.ChainIdentifier(chainID), tprivk, port, host) // config.Configuration.Chain.ID, config.Configuration.Transport.PrivateKey
+	p2ptransport, err := transport.NewP2PTransport(logging.GetLogger(constants.LoggerTransport), types.ChainIdentifier(chainID), genesisHash, tprivk, port, host) // config.Configuration.Chain.ID, config.Configuration.Transport.PrivateKey
 	if err != nil {
 		utils.DebugTrace(logger, err)
 		cf()
@@ -84,13 +90,48 @@ func NewPeerManager(p2pServer interfaces.P2PServer, chainID uint32, pLimMin int,
 		}
 	}
 	// create the actual peer manager
+	pm := newPeerManager(subCtx, cf, logger, p2pServer, p2ptransport, pLimMin, pLimMax) // config.Configuration.Transport.PeerLimitMin, config.Configuration.Transport.PeerLimitMax
+	pm.upnpMapper = upnpMapper
+	if fwMode { // config.Configuration.Transport.FirewallMode
+		pm.logger.Info("RUNNING IN FIREWALL MODE")
+		pm.fireWallMode = true
+		naddr, err := transport.NewNodeAddr(fwHost) // config.Configuration.Transport.FirewallHost
+		if err != nil {
+			return nil, err
+		}
+		pm.fireWallHost = naddr
+	}
+	// make sure bootnodes parse
+	if _, err := pm.bootNodes.randomBootNode(); err != nil {
+		utils.DebugTrace(pm.logger, err)
+		return nil, err
+	}
+	return pm, nil
+}
+
+// NewMemoryPeerManager creates a peer manager that connects through an in
+// process MemoryNetwork instead of sockets. There are no boot nodes, the
+// addresses of the first peers are passed to AddPeer.
+func NewMemoryPeerManager(p2pServer interfaces.P2PServer, network *transport.MemoryNetwork, chainID uint32, pLimMin int, pLimMax int, tprivk string, port int) (*PeerManager, error) {
+	logger := logging.GetLogger(constants.LoggerPeerMan)
+	subCtx, cf := context.WithCancel(context.Background())
+	p2ptransport, err := network.NewTransport(logging.GetLogger(constants.LoggerTransport), types.ChainIdentifier(chainID), tprivk, port)
+	if err != nil {
+		utils.DebugTrace(logger, err)
+		cf()
+		return nil, err
+	}
+	return newPeerManager(subCtx, cf, logger, p2pServer, p2ptransport, pLimMin, pLimMax), nil
+}
+
+func newPeerManager(ctx context.Context, cf func(), logger *logrus.Logger, p2pServer interfaces.P2PServer, p2ptransport interfaces.P2PTransport, pLimMin int, pLimMax int) *PeerManager {
 	pm := &PeerManager{
-		ctx:                      subCtx,
+		ctx:                      ctx,
 		cf:                       cf,
 		logger:                   logger,
 		closeChan:                make(chan struct{}),
-		peeringCompleteThreshold: pLimMin, // config.Configuration.Transport.PeerLimitMin
-		peeringMaxThreshold:      pLimMax, // config.Configuration.Transport.PeerLimitMax
+		peeringCompleteThreshold: pLimMin,
+		peeringMaxThreshold:      pLimMax,
 		bootNodes:                &bootNodeList{},
 		clientHandler:            newClientHandler(),
 		active: &activePeerStore{
@@ -109,29 +150,15 @@ func NewPeerManager(p2pServer interfaces.P2PServer, chainID uint32, pLimMin int,
 		mux:              &transport.P2PMux{},
 		transport:        p2ptransport,
 		p2pServerHandler: NewMuxServerHandler(logger, p2ptransport.NodeAddr(), p2pServer),
-		upnpMapper:       upnpMapper,
 	}
 	pm.discServerHandler = NewP2PDiscoveryServerHandler(logger, p2ptransport.NodeAddr(), pm)
-	if fwMode { // config.Configuration.Transport.FirewallMode
-		pm.logger.Info("RUNNING IN FIREWALL MODE")
-		pm.fireWallMode = true
-		naddr, err := transport.NewNodeAddr(fwHost) // config.Configuration.Transport.FirewallHost
-		if err != nil {
-			return nil, err
-		}
-		pm.fireWallHost = naddr
-	}
-	// make sure bootnodes parse
-	if _, err := pm.bootNodes.randomBootNode(); err != nil {
-		utils.DebugTrace(pm.logger, err)
-		return nil, err
-	}
 	pm.reqChan = make(chan interface{}, pLimMax)
 	pm.gossipChan = make(chan interface{}, 8)
 	pm.gossipTxChan = make(chan interface{}, ((pLimMax - pLimMin) / 2))
 	pm.gossipMap = make(map[string]chan interface{})
 	pm.gossipTxMap = make(map[string]chan interface{})
-	return pm, nil
+	pm.banned = make(map[string]bool)
+	return pm
 }
 
 // Start launches the background loops of the peer manager
@@ -288,19 +315,21 @@ func (ps *PeerManager) handleDisc(conn interfaces.P2PConn) {
 	if err != nil {
 		return
 	}
-	func() {
-		ps.Lock()
-		defer ps.Unlock()
-		if !ps.active.contains(conn.NodeAddr()) {
-			ps.inactive.add(conn.NodeAddr())
-		}
-	}()
+	ps.addInactive(conn.NodeAddr())
 }
 
 // handle p2p dials from remote peers by tracking the connection
 // in local stores and notifying subscribers
 func (ps *PeerManager) handleP2P(conn interfaces.P2PConn) {
 	ps.logger.Debugf("New connection in peerManager from %s", conn.NodeAddr().P2PAddr())
+	if ps.isBanned(conn.NodeAddr()) {
+		ps.logger.Debugf("Refusing connection from banned peer %s", conn.NodeAddr().P2PAddr())
+		err := conn.Close()
+		if err != nil {
+			utils.DebugTrace(ps.logger, err)
+		}
+		return
+	}
 	ctx, cf := context.WithDeadline(ps.ctx, time.Now().Add(time.Second*5))
 	defer cf()
 	muxconn, err := ps.mux.HandleConnection(ctx, conn)
@@ -325,14 +354,26 @@ func (ps *PeerManager) handleP2P(conn interfaces.P2PConn) {
 	gossipChan := make(chan interface{}, 5)
 	gossipTxChan := make(chan interface{}, 16)
 	key := client.NodeAddr().String() + fmt.Sprintf("%v", time.Now())
-	func() {
+	banned := func() bool {
 		ps.Lock()
 		defer ps.Unlock()
+		// the peer may have been banned during the handshake
+		if ps.banned[client.NodeAddr().Identity()] {
+			return true
+		}
 		ps.active.add(client)
 		ps.inactive.del(client.NodeAddr())
 		ps.gossipMap[key] = gossipChan
 		ps.gossipTxMap[key] = gossipTxChan
+		return false
 	}()
+	if banned {
+		err := client.Close()
+		if err != nil {
+			utils.DebugTrace(ps.logger, err)
+		}
+		return
+	}
 	cleanup := func() {
 		ps.Lock()
 		defer ps.Unlock()
@@ -359,11 +400,120 @@ func (ps *PeerManager) dialP2P(addr interfaces.NodeAddr) {
 	go ps.handleP2P(conn)
 }
 
+// AddPeer adds the address of a peer to the inactive peers, it is dialed
+// by the discovery loops like the peers learned from boot nodes.
+func (ps *PeerManager) AddPeer(addr interfaces.NodeAddr) {
+	if ps.isMe(addr) {
+		return
+	}
+	ps.addInactive(addr)
+}
+
+// addInactive adds addr to the inactive peers unless it is active or banned
+func (ps *PeerManager) addInactive(addr interfaces.NodeAddr) {
+	ps.Lock()
+	defer ps.Unlock()
+	if !ps.active.contains(addr) && !ps.banned[addr.Identity()] {
+		ps.inactive.add(addr)
+	}
+}
+
+// NodeAddr returns the address of the local node
+func (ps *PeerManager) NodeAddr() interfaces.NodeAddr {
+	return ps.transport.NodeAddr()
+}
+
 // Counts returns the active and inactive peer counts
 func (ps *PeerManager) Counts() (int, int) {
 	return ps.active.len(), ps.inactive.len()
 }
 
+// PeerList lists the peers known to a peer manager
+type PeerList struct {
+	// Active holds the addresses of the connected peers
+	Active []string
+	// Inactive holds the addresses of the peers which may be dialed
+	Inactive []string
+	// Banned holds the identities of the banned peers
+	Banned []string
+}
+
+// Peers returns the peers known to the peer manager
+func (ps *PeerManager) Peers() *PeerList {
+	ps.Lock()
+	defer ps.Unlock()
+	banned := []string{}
+	for identity := range ps.banned {
+		banned = append(banned, identity)
+	}
+	sort.Strings(banned)
+	return &PeerList{
+		Active:   ps.active.addrs(),
+		Inactive: ps.inactive.addrs(),
+		Banned:   banned,
+	}
+}
+
+// Ban disconnects a peer and refuses its connections until it is unbanned
+// or the node restarts. The peer is given by its identity, the hex public
+// key, or by its full address.
+func (ps *PeerManager) Ban(peer string) error {
+	identity, err := peerIdentity(peer)
+	if err != nil {
+		return err
+	}
+	ps.Lock()
+	defer ps.Unlock()
+	ps.banned[identity] = true
+	ps.active.delIdentity(identity)
+	ps.inactive.delIdentity(identity)
+	ps.logger.Warnf("Banned peer %s", identity)
+	return nil
+}
+
+// Unban lifts the ban of a peer, given like to Ban
+func (ps *PeerManager) Unban(peer string) error {
+	identity, err := peerIdentity(peer)
+	if err != nil {
+		return err
+	}
+	ps.Lock()
+	defer ps.Unlock()
+	if !ps.banned[identity] {
+		return ErrNotBanned
+	}
+	delete(ps.banned, identity)
+	ps.logger.Warnf("Unbanned peer %s", identity)
+	return nil
+}
+
+func (ps *PeerManager) isBanned(addr interfaces.NodeAddr) bool {
+	ps.Lock()
+	defer ps.Unlock()
+	return ps.banned[addr.Identity()]
+}
+
+// compressedPubkeyLen is the length of the compressed public key a peer is
+// identified by
+const compressedPubkeyLen = 33
+
+// peerIdentity returns the identity of a peer given by its identity or by
+// its address
+func peerIdentity(peer string) (string, error) {
+	if strings.Contains(peer, "@") {
+		addr, err := transport.NewNodeAddr(peer)
+		if err != nil {
+			return "", err
+		}
+		return addr.Identity(), nil
+	}
+	pubk, err := hex.DecodeString(peer)
+	if err != nil || len(pubk) != compressedPubkeyLen {
+		return "", ErrInvalidIdentity
+	}
+	return hex.EncodeToString(pubk), nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 //P2P SERVER HANDLERS///////////////////////////////////////////////////////////
@@ -417,6 +567,15 @@ func (ps *PeerManager) PeeringComplete() bool {
 	return ps.peeringComplete
 }
 
+// Health reports whether enough peers are active for peering to be complete
+func (ps *PeerManager) Health() (bool, string) {
+	active, _ := ps.Counts()
+	if !ps.PeeringComplete() {
+		return false, fmt.Sprintf("%d of %d required peers active", active, ps.peeringCompleteThreshold)
+	}
+	return true, fmt.Sprintf("%d peers active", active)
+}
+
 func (ps *PeerManager) runDiscoveryLoops() {
 	defer ps.Close()
 	defer func() { ps.logger.Warning("Discovery loop exit") }()
@@ -475,13 +634,7 @@ func (ps *PeerManager) getPeersActive() {
 			if ps.isMe(p) {
 				continue
 			}
-			func() {
-				ps.Lock()
-				defer ps.Unlock()
-				if !ps.active.contains(p) {
-					ps.inactive.add(p)
-				}
-			}()
+			ps.addInactive(p)
 		}
 	}
 }
@@ -512,13 +665,7 @@ func (ps *PeerManager) discoDialBootnode() {
 			if ps.isMe(p) {
 				continue
 			}
-			func() {
-				ps.Lock()
-				defer ps.Unlock()
-				if !ps.active.contains(p) {
-					ps.inactive.add(p)
-				}
-			}()
+			ps.addInactive(p)
 		}
 	}
 }
@@ -0,0 +1,65 @@
+package peering
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func newBanTestPeerManager() *PeerManager {
+	return &PeerManager{
+		logger: logging.GetLogger("test"),
+		active: &activePeerStore{
+			store:     make(map[string]interfaces.P2PClient),
+			pid:       make(map[string]uint64),
+			closeChan: make(chan struct{}),
+			closeOnce: sync.Once{},
+		},
+		inactive: &inactivePeerStore{
+			store:     make(map[string]interfaces.NodeAddr),
+			cooldown:  make(map[string]uint64),
+			closeChan: make(chan struct{}),
+			closeOnce: sync.Once{},
+		},
+		banned: make(map[string]bool),
+	}
+}
+
+func TestPeerManagerBan(t *testing.T) {
+	pm := newBanTestPeerManager()
+	addr1, err := transport.RandomNodeAddr()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr2, err := transport.RandomNodeAddr()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pm.addInactive(addr1)
+	pm.addInactive(addr2)
+	assert.Equal(t, 2, len(pm.Peers().Inactive))
+
+	// a ban by address drops the inactive peer and keeps it out
+	assert.Nil(t, pm.Ban(addr1.P2PAddr()))
+	peers := pm.Peers()
+	assert.Equal(t, []string{addr2.P2PAddr()}, peers.Inactive)
+	assert.Equal(t, []string{addr1.Identity()}, peers.Banned)
+	assert.True(t, pm.isBanned(addr1))
+	pm.addInactive(addr1)
+	assert.Equal(t, 1, len(pm.Peers().Inactive))
+
+	// an unban by identity lets it back in
+	assert.Nil(t, pm.Unban(addr1.Identity()))
+	assert.Equal(t, ErrNotBanned, pm.Unban(addr1.Identity()))
+	pm.addInactive(addr1)
+	assert.Equal(t, 2, len(pm.Peers().Inactive))
+	assert.Empty(t, pm.Peers().Banned)
+
+	assert.Equal(t, ErrInvalidIdentity, pm.Ban("nothex"))
+	assert.Equal(t, ErrInvalidIdentity, pm.Ban("abcd"))
+	assert.NotNil(t, pm.Ban("bad@address"))
+}
@@ -87,6 +87,7 @@ type TXOut struct {
 	//	*TXOut_ValueStore
 	//	*TXOut_DataStore
 	//	*TXOut_TxFee
+	//	*TXOut_Withdrawal
 	Utxo isTXOut_Utxo `protobuf_oneof:"utxo"`
 }
 
@@ -157,6 +158,13 @@ func (x *TXOut) GetTxFee() *TxFee {
 	return nil
 }
 
+func (x *TXOut) GetWithdrawal() *Withdrawal {
+	if x, ok := x.GetUtxo().(*TXOut_Withdrawal); ok {
+		return x.Withdrawal
+	}
+	return nil
+}
+
 type isTXOut_Utxo interface {
 	isTXOut_Utxo()
 }
@@ -177,6 +185,10 @@ type TXOut_TxFee struct {
 	TxFee *TxFee `protobuf:"bytes,4,opt,name=TxFee,proto3,oneof"`
 }
 
+type TXOut_Withdrawal struct {
+	Withdrawal *Withdrawal `protobuf:"bytes,5,opt,name=Withdrawal,proto3,oneof"`
+}
+
 func (*TXOut_AtomicSwap) isTXOut_Utxo() {}
 
 func (*TXOut_ValueStore) isTXOut_Utxo() {}
@@ -185,6 +197,8 @@ func (*TXOut_DataStore) isTXOut_Utxo() {}
 
 func (*TXOut_TxFee) isTXOut_Utxo() {}
 
+func (*TXOut_Withdrawal) isTXOut_Utxo() {}
+
 // Protobuf message implementation for struct TXIn
 type TXIn struct {
 	state         protoimpl.MessageState
@@ -580,6 +594,11 @@ type VSPreImage struct {
 	TXOutIdx uint32 `protobuf:"varint,3,opt,name=TXOutIdx,proto3" json:"TXOutIdx,omitempty"`
 	Owner    string `protobuf:"bytes,4,opt,name=Owner,proto3" json:"Owner,omitempty"`
 	Fee      string `protobuf:"bytes,5,opt,name=Fee,proto3" json:"Fee,omitempty"`
+	// LockEpoch is derived from Owner and is zero unless the ValueStore is
+	// timelocked; it is ignored when a transaction is submitted. A tx which
+	// generates a timelocked ValueStore is invalid until the feature
+	// "timelock" is active
+	LockEpoch uint32 `protobuf:"varint,6,opt,name=LockEpoch,proto3" json:"LockEpoch,omitempty"`
 }
 
 func (x *VSPreImage) Reset() {
@@ -649,6 +668,13 @@ func (x *VSPreImage) GetFee() string {
 	return ""
 }
 
+func (x *VSPreImage) GetLockEpoch() uint32 {
+	if x != nil {
+		return x.LockEpoch
+	}
+	return 0
+}
+
 // Protobuf message implementation for struct DataStore
 type DataStore struct {
 	state         protoimpl.MessageState
@@ -985,6 +1011,144 @@ func (x *TFPreImage) GetFee() string {
 	return ""
 }
 
+// Protobuf message implementation for struct Withdrawal
+// A tx which generates a Withdrawal is invalid until the feature
+// "withdrawal" is active
+type Withdrawal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WDPreImage *WDPreImage `protobuf:"bytes,1,opt,name=WDPreImage,proto3" json:"WDPreImage,omitempty"`
+	TxHash     string      `protobuf:"bytes,2,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+}
+
+func (x *Withdrawal) Reset() {
+	*x = Withdrawal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aobjs_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Withdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Withdrawal) ProtoMessage() {}
+
+func (x *Withdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_aobjs_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Withdrawal.ProtoReflect.Descriptor instead.
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return file_aobjs_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Withdrawal) GetWDPreImage() *WDPreImage {
+	if x != nil {
+		return x.WDPreImage
+	}
+	return nil
+}
+
+func (x *Withdrawal) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+// Protobuf message implementation for struct WDPreImage
+type WDPreImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainID  uint32 `protobuf:"varint,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	Value    string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	TXOutIdx uint32 `protobuf:"varint,3,opt,name=TXOutIdx,proto3" json:"TXOutIdx,omitempty"`
+	Account  string `protobuf:"bytes,4,opt,name=Account,proto3" json:"Account,omitempty"`
+	Fee      string `protobuf:"bytes,5,opt,name=Fee,proto3" json:"Fee,omitempty"`
+}
+
+func (x *WDPreImage) Reset() {
+	*x = WDPreImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aobjs_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WDPreImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WDPreImage) ProtoMessage() {}
+
+func (x *WDPreImage) ProtoReflect() protoreflect.Message {
+	mi := &file_aobjs_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WDPreImage.ProtoReflect.Descriptor instead.
+func (*WDPreImage) Descriptor() ([]byte, []int) {
+	return file_aobjs_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WDPreImage) GetChainID() uint32 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
+func (x *WDPreImage) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *WDPreImage) GetTXOutIdx() uint32 {
+	if x != nil {
+		return x.TXOutIdx
+	}
+	return 0
+}
+
+func (x *WDPreImage) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *WDPreImage) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
 var File_aobjs_proto protoreflect.FileDescriptor
 
 var file_aobjs_proto_rawDesc = []byte{
@@ -993,7 +1157,7 @@ var file_aobjs_proto_rawDesc = []byte{
 	0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
 	0x54, 0x58, 0x49, 0x6e, 0x52, 0x03, 0x56, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x04, 0x56, 0x6f, 0x75,
 	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x54, 0x58, 0x4f, 0x75, 0x74, 0x52, 0x04, 0x56, 0x6f, 0x75, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x05,
+	0x54, 0x58, 0x4f, 0x75, 0x74, 0x52, 0x04, 0x56, 0x6f, 0x75, 0x74, 0x22, 0x86, 0x02, 0x0a, 0x05,
 	0x54, 0x58, 0x4f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x53,
 	0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0a,
@@ -1006,94 +1170,113 @@ var file_aobjs_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x72, 0x65, 0x48, 0x00, 0x52, 0x09, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x6f, 0x72,
 	0x65, 0x12, 0x24, 0x0a, 0x05, 0x54, 0x78, 0x46, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x46, 0x65, 0x65, 0x48, 0x00,
-	0x52, 0x05, 0x54, 0x78, 0x46, 0x65, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x75, 0x74, 0x78, 0x6f, 0x22,
-	0x57, 0x0a, 0x04, 0x54, 0x58, 0x49, 0x6e, 0x12, 0x31, 0x0a, 0x0a, 0x54, 0x58, 0x49, 0x6e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x58, 0x49, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x52, 0x0a,
-	0x54, 0x58, 0x49, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x5d, 0x0a, 0x0a, 0x54, 0x58, 0x49, 0x6e,
-	0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0c, 0x54, 0x58, 0x49, 0x6e, 0x50, 0x72,
-	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x58, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x0c, 0x54, 0x58, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x76, 0x0a, 0x0c, 0x54, 0x58, 0x49, 0x6e, 0x50,
-	0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x44, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x54, 0x78, 0x49,
-	0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x78, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75,
-	0x6d, 0x65, 0x64, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22,
-	0x57, 0x0a, 0x0a, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x53, 0x77, 0x61, 0x70, 0x12, 0x31, 0x0a,
-	0x0a, 0x41, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x53, 0x50, 0x72, 0x65, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x41, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0xae, 0x01, 0x0a, 0x0a, 0x41, 0x53, 0x50,
-	0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x44, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74,
-	0x49, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74,
-	0x49, 0x64, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x45, 0x78, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x45, 0x78,
-	0x70, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x46, 0x65, 0x65, 0x22, 0x57, 0x0a, 0x0a, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x56, 0x53, 0x50, 0x72, 0x65,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0a,
-	0x56, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78,
-	0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61,
-	0x73, 0x68, 0x22, 0x80, 0x01, 0x0a, 0x0a, 0x56, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x49, 0x64, 0x78, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x49, 0x64, 0x78, 0x12, 0x14, 0x0a,
-	0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x46, 0x65, 0x65, 0x22, 0x56, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x44, 0x53, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x4c,
-	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x52, 0x08, 0x44, 0x53, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x12,
+	0x52, 0x05, 0x54, 0x78, 0x46, 0x65, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x48, 0x00,
+	0x52, 0x0a, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x42, 0x06, 0x0a, 0x04,
+	0x75, 0x74, 0x78, 0x6f, 0x22, 0x57, 0x0a, 0x04, 0x54, 0x58, 0x49, 0x6e, 0x12, 0x31, 0x0a, 0x0a,
+	0x54, 0x58, 0x49, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x58, 0x49, 0x6e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x52, 0x0a, 0x54, 0x58, 0x49, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x12,
 	0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x55, 0x0a,
-	0x08, 0x44, 0x53, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0a, 0x44, 0x53, 0x50,
-	0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x0a, 0x44, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78,
-	0x48, 0x61, 0x73, 0x68, 0x22, 0xd0, 0x01, 0x0a, 0x0a, 0x44, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a,
-	0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x61, 0x77,
-	0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x61, 0x77, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x49, 0x64, 0x78, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x49, 0x64, 0x78, 0x12,
-	0x14, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x46, 0x65, 0x65, 0x22, 0x52, 0x0a, 0x05, 0x54, 0x78, 0x46, 0x65, 0x65,
-	0x12, 0x31, 0x0a, 0x0a, 0x54, 0x46, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x46, 0x50,
-	0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x54, 0x46, 0x50, 0x72, 0x65, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x54, 0x0a, 0x0a, 0x54,
-	0x46, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x49, 0x64, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x49, 0x64, 0x78, 0x12,
-	0x10, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x46, 0x65,
-	0x65, 0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x4d, 0x61, 0x64, 0x42, 0x61, 0x73, 0x65, 0x2f, 0x4d, 0x61, 0x64, 0x4e, 0x65, 0x74, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x09, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x5d, 0x0a,
+	0x0a, 0x54, 0x58, 0x49, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0c, 0x54,
+	0x58, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x58, 0x49, 0x6e, 0x50, 0x72,
+	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x54, 0x58, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x76, 0x0a, 0x0c,
+	0x54, 0x58, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x78, 0x12, 0x26, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x54, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0x57, 0x0a, 0x0a, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x53, 0x77,
+	0x61, 0x70, 0x12, 0x31, 0x0a, 0x0a, 0x41, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x41, 0x53, 0x50, 0x72, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0xae, 0x01,
+	0x0a, 0x0a, 0x41, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x54, 0x58, 0x4f, 0x75, 0x74, 0x49, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x54, 0x58, 0x4f, 0x75, 0x74, 0x49, 0x64, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x78, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x45, 0x78, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x46, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x46, 0x65, 0x65, 0x22, 0x57,
+	0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x0a,
+	0x56, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x0a, 0x56, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x9e, 0x01, 0x0a, 0x0a, 0x56, 0x53, 0x50, 0x72,
+	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x49,
+	0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x49,
+	0x64, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x46, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x6f,
+	0x63, 0x6b, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x4c,
+	0x6f, 0x63, 0x6b, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x56, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x44, 0x53, 0x4c, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x53, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x52, 0x08, 0x44, 0x53, 0x4c, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0x55, 0x0a, 0x08, 0x44, 0x53, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0a,
+	0x44, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x0a, 0x44, 0x53, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0xd0, 0x01, 0x0a, 0x0a, 0x44, 0x53, 0x50, 0x72,
+	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x52, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52,
+	0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x49,
+	0x64, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x49,
+	0x64, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x46, 0x65, 0x65, 0x22, 0x52, 0x0a, 0x05, 0x54, 0x78,
+	0x46, 0x65, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x54, 0x46, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x46, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x54, 0x46, 0x50, 0x72,
+	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x54,
+	0x0a, 0x0a, 0x54, 0x46, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x49,
+	0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x49,
+	0x64, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x46, 0x65, 0x65, 0x22, 0x57, 0x0a, 0x0a, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x12, 0x31, 0x0a, 0x0a, 0x57, 0x44, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57,
+	0x44, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x57, 0x44, 0x50, 0x72, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x84, 0x01,
+	0x0a, 0x0a, 0x57, 0x44, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x54, 0x58, 0x4f, 0x75, 0x74, 0x49, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x54, 0x58, 0x4f, 0x75, 0x74, 0x49, 0x64, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x46, 0x65, 0x65, 0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x64, 0x42, 0x61, 0x73, 0x65, 0x2f, 0x4d, 0x61, 0x64, 0x4e, 0x65,
+	0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1108,7 +1291,7 @@ func file_aobjs_proto_rawDescGZIP() []byte {
 	return file_aobjs_proto_rawDescData
 }
 
-var file_aobjs_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_aobjs_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_aobjs_proto_goTypes = []interface{}{
 	(*Tx)(nil),           // 0: proto.Tx
 	(*TXOut)(nil),        // 1: proto.TXOut
@@ -1124,6 +1307,8 @@ var file_aobjs_proto_goTypes = []interface{}{
 	(*DSPreImage)(nil),   // 11: proto.DSPreImage
 	(*TxFee)(nil),        // 12: proto.TxFee
 	(*TFPreImage)(nil),   // 13: proto.TFPreImage
+	(*Withdrawal)(nil),   // 14: proto.Withdrawal
+	(*WDPreImage)(nil),   // 15: proto.WDPreImage
 }
 var file_aobjs_proto_depIdxs = []int32{
 	2,  // 0: proto.Tx.Vin:type_name -> proto.TXIn
@@ -1132,18 +1317,20 @@ var file_aobjs_proto_depIdxs = []int32{
 	7,  // 3: proto.TXOut.ValueStore:type_name -> proto.ValueStore
 	9,  // 4: proto.TXOut.DataStore:type_name -> proto.DataStore
 	12, // 5: proto.TXOut.TxFee:type_name -> proto.TxFee
-	3,  // 6: proto.TXIn.TXInLinker:type_name -> proto.TXInLinker
-	4,  // 7: proto.TXInLinker.TXInPreImage:type_name -> proto.TXInPreImage
-	6,  // 8: proto.AtomicSwap.ASPreImage:type_name -> proto.ASPreImage
-	8,  // 9: proto.ValueStore.VSPreImage:type_name -> proto.VSPreImage
-	10, // 10: proto.DataStore.DSLinker:type_name -> proto.DSLinker
-	11, // 11: proto.DSLinker.DSPreImage:type_name -> proto.DSPreImage
-	13, // 12: proto.TxFee.TFPreImage:type_name -> proto.TFPreImage
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	14, // 6: proto.TXOut.Withdrawal:type_name -> proto.Withdrawal
+	3,  // 7: proto.TXIn.TXInLinker:type_name -> proto.TXInLinker
+	4,  // 8: proto.TXInLinker.TXInPreImage:type_name -> proto.TXInPreImage
+	6,  // 9: proto.AtomicSwap.ASPreImage:type_name -> proto.ASPreImage
+	8,  // 10: proto.ValueStore.VSPreImage:type_name -> proto.VSPreImage
+	10, // 11: proto.DataStore.DSLinker:type_name -> proto.DSLinker
+	11, // 12: proto.DSLinker.DSPreImage:type_name -> proto.DSPreImage
+	13, // 13: proto.TxFee.TFPreImage:type_name -> proto.TFPreImage
+	15, // 14: proto.Withdrawal.WDPreImage:type_name -> proto.WDPreImage
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_aobjs_proto_init() }
@@ -1320,12 +1507,37 @@ func file_aobjs_proto_init() {
 				return nil
 			}
 		}
+		file_aobjs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Withdrawal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aobjs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WDPreImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_aobjs_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*TXOut_AtomicSwap)(nil),
 		(*TXOut_ValueStore)(nil),
 		(*TXOut_DataStore)(nil),
 		(*TXOut_TxFee)(nil),
+		(*TXOut_Withdrawal)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1333,7 +1545,7 @@ func file_aobjs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_aobjs_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -32,8 +32,8 @@ var file_localstate_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x8b,
-	0x0c, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a,
+	0x61, 0x74, 0x65, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x85,
+	0x17, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a,
 	0x07, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
@@ -67,101 +67,212 @@ var file_localstate_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
 	0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2d,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x4b, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x55, 0x54, 0x58, 0x4f, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x54, 0x58,
-	0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x75,
-	0x74, 0x78, 0x6f, 0x3a, 0x01, 0x2a, 0x12, 0x84, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x4f, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65,
+	0x74, 0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x63, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2d, 0x62, 0x79, 0x2d, 0x68, 0x61, 0x73, 0x68, 0x3a, 0x01, 0x2a, 0x12,
+	0x64, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f,
+	0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2d, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x4b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f,
+	0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x54, 0x58,
+	0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x75, 0x74, 0x78, 0x6f, 0x3a,
+	0x01, 0x2a, 0x12, 0x84, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x65, 0x74, 0x2d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2d, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x2d, 0x66, 0x6f, 0x72, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x3a,
+	0x01, 0x2a, 0x12, 0x6c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2d, 0x73, 0x65, 0x74, 0x3a, 0x01, 0x2a,
+	0x12, 0x68, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x58, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22,
+	0x10, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2d, 0x69,
+	0x64, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x2d, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x10, 0x53,
+	0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x76, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2d,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x68,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22,
+	0x14, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x2d, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54,
+	0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22,
+	0x17, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x74, 0x78, 0x2d, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x59, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x20, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x65, 0x74, 0x2d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x2d, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x3a, 0x01, 0x2a, 0x12, 0x97, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x73, 0x2d, 0x66, 0x6f, 0x72, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x01, 0x2a,
+	0x12, 0x4b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x66, 0x65, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x8e, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69,
 	0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f,
-	0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46,
-	0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x6f,
-	0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26,
-	0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2d,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x2d, 0x66, 0x6f, 0x72, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x6c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f,
-	0x67, 0x65, 0x74, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2d, 0x73, 0x65,
-	0x74, 0x3a, 0x01, 0x2a, 0x12, 0x68, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x2d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x58,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x15, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2d, 0x69, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x1f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6e, 0x64,
-	0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12,
-	0x68, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
-	0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x2d,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x54, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x54, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
-	0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x74, 0x78, 0x2d, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x2d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f,
+	0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x53,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79,
+	0x3a, 0x01, 0x2a, 0x12, 0x74, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f,
+	0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x2d, 0x73, 0x74, 0x61, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x5b, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_localstate_proto_goTypes = []interface{}{
-	(*GetDataRequest)(nil),                 // 0: proto.GetDataRequest
-	(*GetValueRequest)(nil),                // 1: proto.GetValueRequest
-	(*IterateNameSpaceRequest)(nil),        // 2: proto.IterateNameSpaceRequest
-	(*MinedTransactionRequest)(nil),        // 3: proto.MinedTransactionRequest
-	(*BlockHeaderRequest)(nil),             // 4: proto.BlockHeaderRequest
-	(*UTXORequest)(nil),                    // 5: proto.UTXORequest
-	(*PendingTransactionRequest)(nil),      // 6: proto.PendingTransactionRequest
-	(*RoundStateForValidatorRequest)(nil),  // 7: proto.RoundStateForValidatorRequest
-	(*ValidatorSetRequest)(nil),            // 8: proto.ValidatorSetRequest
-	(*BlockNumberRequest)(nil),             // 9: proto.BlockNumberRequest
-	(*ChainIDRequest)(nil),                 // 10: proto.ChainIDRequest
-	(*TransactionData)(nil),                // 11: proto.TransactionData
-	(*EpochNumberRequest)(nil),             // 12: proto.EpochNumberRequest
-	(*TxBlockNumberRequest)(nil),           // 13: proto.TxBlockNumberRequest
-	(*GetDataResponse)(nil),                // 14: proto.GetDataResponse
-	(*GetValueResponse)(nil),               // 15: proto.GetValueResponse
-	(*IterateNameSpaceResponse)(nil),       // 16: proto.IterateNameSpaceResponse
-	(*MinedTransactionResponse)(nil),       // 17: proto.MinedTransactionResponse
-	(*BlockHeaderResponse)(nil),            // 18: proto.BlockHeaderResponse
-	(*UTXOResponse)(nil),                   // 19: proto.UTXOResponse
-	(*PendingTransactionResponse)(nil),     // 20: proto.PendingTransactionResponse
-	(*RoundStateForValidatorResponse)(nil), // 21: proto.RoundStateForValidatorResponse
-	(*ValidatorSetResponse)(nil),           // 22: proto.ValidatorSetResponse
-	(*BlockNumberResponse)(nil),            // 23: proto.BlockNumberResponse
-	(*ChainIDResponse)(nil),                // 24: proto.ChainIDResponse
-	(*TransactionDetails)(nil),             // 25: proto.TransactionDetails
-	(*EpochNumberResponse)(nil),            // 26: proto.EpochNumberResponse
-	(*TxBlockNumberResponse)(nil),          // 27: proto.TxBlockNumberResponse
+	(*GetDataRequest)(nil),                   // 0: proto.GetDataRequest
+	(*GetValueRequest)(nil),                  // 1: proto.GetValueRequest
+	(*IterateNameSpaceRequest)(nil),          // 2: proto.IterateNameSpaceRequest
+	(*MinedTransactionRequest)(nil),          // 3: proto.MinedTransactionRequest
+	(*BlockHeaderRequest)(nil),               // 4: proto.BlockHeaderRequest
+	(*BlockRequest)(nil),                     // 5: proto.BlockRequest
+	(*BlockByHashRequest)(nil),               // 6: proto.BlockByHashRequest
+	(*BlockRangeRequest)(nil),                // 7: proto.BlockRangeRequest
+	(*UTXORequest)(nil),                      // 8: proto.UTXORequest
+	(*PendingTransactionRequest)(nil),        // 9: proto.PendingTransactionRequest
+	(*RoundStateForValidatorRequest)(nil),    // 10: proto.RoundStateForValidatorRequest
+	(*ValidatorSetRequest)(nil),              // 11: proto.ValidatorSetRequest
+	(*BlockNumberRequest)(nil),               // 12: proto.BlockNumberRequest
+	(*ChainIDRequest)(nil),                   // 13: proto.ChainIDRequest
+	(*TransactionData)(nil),                  // 14: proto.TransactionData
+	(*TransactionsData)(nil),                 // 15: proto.TransactionsData
+	(*EpochNumberRequest)(nil),               // 16: proto.EpochNumberRequest
+	(*TxBlockNumberRequest)(nil),             // 17: proto.TxBlockNumberRequest
+	(*GetObjectRequest)(nil),                 // 18: proto.GetObjectRequest
+	(*GetWithdrawalProofRequest)(nil),        // 19: proto.GetWithdrawalProofRequest
+	(*GetWithdrawalsForAccountRequest)(nil),  // 20: proto.GetWithdrawalsForAccountRequest
+	(*FeesRequest)(nil),                      // 21: proto.FeesRequest
+	(*GetSpendingTransactionRequest)(nil),    // 22: proto.GetSpendingTransactionRequest
+	(*SupplyRequest)(nil),                    // 23: proto.SupplyRequest
+	(*ValidatorStatsRequest)(nil),            // 24: proto.ValidatorStatsRequest
+	(*FeaturesRequest)(nil),                  // 25: proto.FeaturesRequest
+	(*GetDataResponse)(nil),                  // 26: proto.GetDataResponse
+	(*GetValueResponse)(nil),                 // 27: proto.GetValueResponse
+	(*IterateNameSpaceResponse)(nil),         // 28: proto.IterateNameSpaceResponse
+	(*MinedTransactionResponse)(nil),         // 29: proto.MinedTransactionResponse
+	(*BlockHeaderResponse)(nil),              // 30: proto.BlockHeaderResponse
+	(*BlockResponse)(nil),                    // 31: proto.BlockResponse
+	(*BlockRangeResponse)(nil),               // 32: proto.BlockRangeResponse
+	(*UTXOResponse)(nil),                     // 33: proto.UTXOResponse
+	(*PendingTransactionResponse)(nil),       // 34: proto.PendingTransactionResponse
+	(*RoundStateForValidatorResponse)(nil),   // 35: proto.RoundStateForValidatorResponse
+	(*ValidatorSetResponse)(nil),             // 36: proto.ValidatorSetResponse
+	(*BlockNumberResponse)(nil),              // 37: proto.BlockNumberResponse
+	(*ChainIDResponse)(nil),                  // 38: proto.ChainIDResponse
+	(*TransactionDetails)(nil),               // 39: proto.TransactionDetails
+	(*TransactionsDetails)(nil),              // 40: proto.TransactionsDetails
+	(*ValidateTransactionResponse)(nil),      // 41: proto.ValidateTransactionResponse
+	(*EpochNumberResponse)(nil),              // 42: proto.EpochNumberResponse
+	(*TxBlockNumberResponse)(nil),            // 43: proto.TxBlockNumberResponse
+	(*GetObjectResponse)(nil),                // 44: proto.GetObjectResponse
+	(*GetWithdrawalProofResponse)(nil),       // 45: proto.GetWithdrawalProofResponse
+	(*GetWithdrawalsForAccountResponse)(nil), // 46: proto.GetWithdrawalsForAccountResponse
+	(*FeesResponse)(nil),                     // 47: proto.FeesResponse
+	(*GetSpendingTransactionResponse)(nil),   // 48: proto.GetSpendingTransactionResponse
+	(*SupplyResponse)(nil),                   // 49: proto.SupplyResponse
+	(*ValidatorStatsResponse)(nil),           // 50: proto.ValidatorStatsResponse
+	(*FeaturesResponse)(nil),                 // 51: proto.FeaturesResponse
 }
 var file_localstate_proto_depIdxs = []int32{
 	0,  // 0: proto.LocalState.GetData:input_type -> proto.GetDataRequest
@@ -169,31 +280,57 @@ var file_localstate_proto_depIdxs = []int32{
 	2,  // 2: proto.LocalState.IterateNameSpace:input_type -> proto.IterateNameSpaceRequest
 	3,  // 3: proto.LocalState.GetMinedTransaction:input_type -> proto.MinedTransactionRequest
 	4,  // 4: proto.LocalState.GetBlockHeader:input_type -> proto.BlockHeaderRequest
-	5,  // 5: proto.LocalState.GetUTXO:input_type -> proto.UTXORequest
-	6,  // 6: proto.LocalState.GetPendingTransaction:input_type -> proto.PendingTransactionRequest
-	7,  // 7: proto.LocalState.GetRoundStateForValidator:input_type -> proto.RoundStateForValidatorRequest
-	8,  // 8: proto.LocalState.GetValidatorSet:input_type -> proto.ValidatorSetRequest
-	9,  // 9: proto.LocalState.GetBlockNumber:input_type -> proto.BlockNumberRequest
-	10, // 10: proto.LocalState.GetChainID:input_type -> proto.ChainIDRequest
-	11, // 11: proto.LocalState.SendTransaction:input_type -> proto.TransactionData
-	12, // 12: proto.LocalState.GetEpochNumber:input_type -> proto.EpochNumberRequest
-	13, // 13: proto.LocalState.GetTxBlockNumber:input_type -> proto.TxBlockNumberRequest
-	14, // 14: proto.LocalState.GetData:output_type -> proto.GetDataResponse
-	15, // 15: proto.LocalState.GetValueForOwner:output_type -> proto.GetValueResponse
-	16, // 16: proto.LocalState.IterateNameSpace:output_type -> proto.IterateNameSpaceResponse
-	17, // 17: proto.LocalState.GetMinedTransaction:output_type -> proto.MinedTransactionResponse
-	18, // 18: proto.LocalState.GetBlockHeader:output_type -> proto.BlockHeaderResponse
-	19, // 19: proto.LocalState.GetUTXO:output_type -> proto.UTXOResponse
-	20, // 20: proto.LocalState.GetPendingTransaction:output_type -> proto.PendingTransactionResponse
-	21, // 21: proto.LocalState.GetRoundStateForValidator:output_type -> proto.RoundStateForValidatorResponse
-	22, // 22: proto.LocalState.GetValidatorSet:output_type -> proto.ValidatorSetResponse
-	23, // 23: proto.LocalState.GetBlockNumber:output_type -> proto.BlockNumberResponse
-	24, // 24: proto.LocalState.GetChainID:output_type -> proto.ChainIDResponse
-	25, // 25: proto.LocalState.SendTransaction:output_type -> proto.TransactionDetails
-	26, // 26: proto.LocalState.GetEpochNumber:output_type -> proto.EpochNumberResponse
-	27, // 27: proto.LocalState.GetTxBlockNumber:output_type -> proto.TxBlockNumberResponse
-	14, // [14:28] is the sub-list for method output_type
-	0,  // [0:14] is the sub-list for method input_type
+	5,  // 5: proto.LocalState.GetBlock:input_type -> proto.BlockRequest
+	6,  // 6: proto.LocalState.GetBlockByHash:input_type -> proto.BlockByHashRequest
+	7,  // 7: proto.LocalState.GetBlockRange:input_type -> proto.BlockRangeRequest
+	8,  // 8: proto.LocalState.GetUTXO:input_type -> proto.UTXORequest
+	9,  // 9: proto.LocalState.GetPendingTransaction:input_type -> proto.PendingTransactionRequest
+	10, // 10: proto.LocalState.GetRoundStateForValidator:input_type -> proto.RoundStateForValidatorRequest
+	11, // 11: proto.LocalState.GetValidatorSet:input_type -> proto.ValidatorSetRequest
+	12, // 12: proto.LocalState.GetBlockNumber:input_type -> proto.BlockNumberRequest
+	13, // 13: proto.LocalState.GetChainID:input_type -> proto.ChainIDRequest
+	14, // 14: proto.LocalState.SendTransaction:input_type -> proto.TransactionData
+	15, // 15: proto.LocalState.SendTransactions:input_type -> proto.TransactionsData
+	14, // 16: proto.LocalState.ValidateTransaction:input_type -> proto.TransactionData
+	16, // 17: proto.LocalState.GetEpochNumber:input_type -> proto.EpochNumberRequest
+	17, // 18: proto.LocalState.GetTxBlockNumber:input_type -> proto.TxBlockNumberRequest
+	18, // 19: proto.LocalState.GetObject:input_type -> proto.GetObjectRequest
+	19, // 20: proto.LocalState.GetWithdrawalProof:input_type -> proto.GetWithdrawalProofRequest
+	20, // 21: proto.LocalState.GetWithdrawalsForAccount:input_type -> proto.GetWithdrawalsForAccountRequest
+	21, // 22: proto.LocalState.GetFees:input_type -> proto.FeesRequest
+	22, // 23: proto.LocalState.GetSpendingTransaction:input_type -> proto.GetSpendingTransactionRequest
+	23, // 24: proto.LocalState.GetSupply:input_type -> proto.SupplyRequest
+	24, // 25: proto.LocalState.GetValidatorStats:input_type -> proto.ValidatorStatsRequest
+	25, // 26: proto.LocalState.GetFeatures:input_type -> proto.FeaturesRequest
+	26, // 27: proto.LocalState.GetData:output_type -> proto.GetDataResponse
+	27, // 28: proto.LocalState.GetValueForOwner:output_type -> proto.GetValueResponse
+	28, // 29: proto.LocalState.IterateNameSpace:output_type -> proto.IterateNameSpaceResponse
+	29, // 30: proto.LocalState.GetMinedTransaction:output_type -> proto.MinedTransactionResponse
+	30, // 31: proto.LocalState.GetBlockHeader:output_type -> proto.BlockHeaderResponse
+	31, // 32: proto.LocalState.GetBlock:output_type -> proto.BlockResponse
+	31, // 33: proto.LocalState.GetBlockByHash:output_type -> proto.BlockResponse
+	32, // 34: proto.LocalState.GetBlockRange:output_type -> proto.BlockRangeResponse
+	33, // 35: proto.LocalState.GetUTXO:output_type -> proto.UTXOResponse
+	34, // 36: proto.LocalState.GetPendingTransaction:output_type -> proto.PendingTransactionResponse
+	35, // 37: proto.LocalState.GetRoundStateForValidator:output_type -> proto.RoundStateForValidatorResponse
+	36, // 38: proto.LocalState.GetValidatorSet:output_type -> proto.ValidatorSetResponse
+	37, // 39: proto.LocalState.GetBlockNumber:output_type -> proto.BlockNumberResponse
+	38, // 40: proto.LocalState.GetChainID:output_type -> proto.ChainIDResponse
+	39, // 41: proto.LocalState.SendTransaction:output_type -> proto.TransactionDetails
+	40, // 42: proto.LocalState.SendTransactions:output_type -> proto.TransactionsDetails
+	41, // 43: proto.LocalState.ValidateTransaction:output_type -> proto.ValidateTransactionResponse
+	42, // 44: proto.LocalState.GetEpochNumber:output_type -> proto.EpochNumberResponse
+	43, // 45: proto.LocalState.GetTxBlockNumber:output_type -> proto.TxBlockNumberResponse
+	44, // 46: proto.LocalState.GetObject:output_type -> proto.GetObjectResponse
+	45, // 47: proto.LocalState.GetWithdrawalProof:output_type -> proto.GetWithdrawalProofResponse
+	46, // 48: proto.LocalState.GetWithdrawalsForAccount:output_type -> proto.GetWithdrawalsForAccountResponse
+	47, // 49: proto.LocalState.GetFees:output_type -> proto.FeesResponse
+	48, // 50: proto.LocalState.GetSpendingTransaction:output_type -> proto.GetSpendingTransactionResponse
+	49, // 51: proto.LocalState.GetSupply:output_type -> proto.SupplyResponse
+	50, // 52: proto.LocalState.GetValidatorStats:output_type -> proto.ValidatorStatsResponse
+	51, // 53: proto.LocalState.GetFeatures:output_type -> proto.FeaturesResponse
+	27, // [27:54] is the sub-list for method output_type
+	0,  // [0:27] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
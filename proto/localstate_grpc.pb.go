@@ -29,6 +29,12 @@ type LocalStateClient interface {
 	GetMinedTransaction(ctx context.Context, in *MinedTransactionRequest, opts ...grpc.CallOption) (*MinedTransactionResponse, error)
 	// Get blockheader by hash or blocknumber
 	GetBlockHeader(ctx context.Context, in *BlockHeaderRequest, opts ...grpc.CallOption) (*BlockHeaderResponse, error)
+	// Get a block with its proposer and validator set by height
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// Get a block with its proposer and validator set by hash
+	GetBlockByHash(ctx context.Context, in *BlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// Get consecutive blocks starting at a height
+	GetBlockRange(ctx context.Context, in *BlockRangeRequest, opts ...grpc.CallOption) (*BlockRangeResponse, error)
 	// Get a raw UTXO by TxHash and index or by UTXOID
 	GetUTXO(ctx context.Context, in *UTXORequest, opts ...grpc.CallOption) (*UTXOResponse, error)
 	// Get a pending transaction by hash
@@ -44,10 +50,33 @@ type LocalStateClient interface {
 	GetChainID(ctx context.Context, in *ChainIDRequest, opts ...grpc.CallOption) (*ChainIDResponse, error)
 	// Send a transaction to the node
 	SendTransaction(ctx context.Context, in *TransactionData, opts ...grpc.CallOption) (*TransactionDetails, error)
+	// Send a batch of transactions; each transaction is accepted or rejected
+	// independently of the others
+	SendTransactions(ctx context.Context, in *TransactionsData, opts ...grpc.CallOption) (*TransactionsDetails, error)
+	// Validate a transaction without adding it to the pending tx pool
+	ValidateTransaction(ctx context.Context, in *TransactionData, opts ...grpc.CallOption) (*ValidateTransactionResponse, error)
 	// Get the current block number
 	GetEpochNumber(ctx context.Context, in *EpochNumberRequest, opts ...grpc.CallOption) (*EpochNumberResponse, error)
 	// Get the current block number
 	GetTxBlockNumber(ctx context.Context, in *TxBlockNumberRequest, opts ...grpc.CallOption) (*TxBlockNumberResponse, error)
+	// Get a large object by the index of its manifest datastore; the chunk
+	// datastores listed in the manifest are verified and reassembled.
+	// Objects larger than 1 MiB are refused; read them chunk by chunk with
+	// GetData instead
+	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error)
+	// Get a withdrawal with a proof of its inclusion in the state of the
+	// last snapshot, for claiming the value on Ethereum
+	GetWithdrawalProof(ctx context.Context, in *GetWithdrawalProofRequest, opts ...grpc.CallOption) (*GetWithdrawalProofResponse, error)
+	GetWithdrawalsForAccount(ctx context.Context, in *GetWithdrawalsForAccountRequest, opts ...grpc.CallOption) (*GetWithdrawalsForAccountResponse, error)
+	GetFees(ctx context.Context, in *FeesRequest, opts ...grpc.CallOption) (*FeesResponse, error)
+	// Get the mined transaction which consumed a UTXO
+	GetSpendingTransaction(ctx context.Context, in *GetSpendingTransactionRequest, opts ...grpc.CallOption) (*GetSpendingTransactionResponse, error)
+	// Get the supply ledger entry of a height
+	GetSupply(ctx context.Context, in *SupplyRequest, opts ...grpc.CallOption) (*SupplyResponse, error)
+	// Get the per validator consensus statistics of a range of epochs
+	GetValidatorStats(ctx context.Context, in *ValidatorStatsRequest, opts ...grpc.CallOption) (*ValidatorStatsResponse, error)
+	// Get the registered and the scheduled protocol features
+	GetFeatures(ctx context.Context, in *FeaturesRequest, opts ...grpc.CallOption) (*FeaturesResponse, error)
 }
 
 type localStateClient struct {
@@ -103,6 +132,33 @@ func (c *localStateClient) GetBlockHeader(ctx context.Context, in *BlockHeaderRe
 	return out, nil
 }
 
+func (c *localStateClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetBlockByHash(ctx context.Context, in *BlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetBlockByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetBlockRange(ctx context.Context, in *BlockRangeRequest, opts ...grpc.CallOption) (*BlockRangeResponse, error) {
+	out := new(BlockRangeResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetBlockRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *localStateClient) GetUTXO(ctx context.Context, in *UTXORequest, opts ...grpc.CallOption) (*UTXOResponse, error) {
 	out := new(UTXOResponse)
 	err := c.cc.Invoke(ctx, "/proto.LocalState/GetUTXO", in, out, opts...)
@@ -166,6 +222,24 @@ func (c *localStateClient) SendTransaction(ctx context.Context, in *TransactionD
 	return out, nil
 }
 
+func (c *localStateClient) SendTransactions(ctx context.Context, in *TransactionsData, opts ...grpc.CallOption) (*TransactionsDetails, error) {
+	out := new(TransactionsDetails)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/SendTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) ValidateTransaction(ctx context.Context, in *TransactionData, opts ...grpc.CallOption) (*ValidateTransactionResponse, error) {
+	out := new(ValidateTransactionResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/ValidateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *localStateClient) GetEpochNumber(ctx context.Context, in *EpochNumberRequest, opts ...grpc.CallOption) (*EpochNumberResponse, error) {
 	out := new(EpochNumberResponse)
 	err := c.cc.Invoke(ctx, "/proto.LocalState/GetEpochNumber", in, out, opts...)
@@ -184,6 +258,78 @@ func (c *localStateClient) GetTxBlockNumber(ctx context.Context, in *TxBlockNumb
 	return out, nil
 }
 
+func (c *localStateClient) GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error) {
+	out := new(GetObjectResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetWithdrawalProof(ctx context.Context, in *GetWithdrawalProofRequest, opts ...grpc.CallOption) (*GetWithdrawalProofResponse, error) {
+	out := new(GetWithdrawalProofResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetWithdrawalProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetWithdrawalsForAccount(ctx context.Context, in *GetWithdrawalsForAccountRequest, opts ...grpc.CallOption) (*GetWithdrawalsForAccountResponse, error) {
+	out := new(GetWithdrawalsForAccountResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetWithdrawalsForAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetFees(ctx context.Context, in *FeesRequest, opts ...grpc.CallOption) (*FeesResponse, error) {
+	out := new(FeesResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetSpendingTransaction(ctx context.Context, in *GetSpendingTransactionRequest, opts ...grpc.CallOption) (*GetSpendingTransactionResponse, error) {
+	out := new(GetSpendingTransactionResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetSpendingTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetSupply(ctx context.Context, in *SupplyRequest, opts ...grpc.CallOption) (*SupplyResponse, error) {
+	out := new(SupplyResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetValidatorStats(ctx context.Context, in *ValidatorStatsRequest, opts ...grpc.CallOption) (*ValidatorStatsResponse, error) {
+	out := new(ValidatorStatsResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetValidatorStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetFeatures(ctx context.Context, in *FeaturesRequest, opts ...grpc.CallOption) (*FeaturesResponse, error) {
+	out := new(FeaturesResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetFeatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LocalStateServer is the server API for LocalState service.
 // All implementations should embed UnimplementedLocalStateServer
 // for forward compatibility
@@ -199,6 +345,12 @@ type LocalStateServer interface {
 	GetMinedTransaction(context.Context, *MinedTransactionRequest) (*MinedTransactionResponse, error)
 	// Get blockheader by hash or blocknumber
 	GetBlockHeader(context.Context, *BlockHeaderRequest) (*BlockHeaderResponse, error)
+	// Get a block with its proposer and validator set by height
+	GetBlock(context.Context, *BlockRequest) (*BlockResponse, error)
+	// Get a block with its proposer and validator set by hash
+	GetBlockByHash(context.Context, *BlockByHashRequest) (*BlockResponse, error)
+	// Get consecutive blocks starting at a height
+	GetBlockRange(context.Context, *BlockRangeRequest) (*BlockRangeResponse, error)
 	// Get a raw UTXO by TxHash and index or by UTXOID
 	GetUTXO(context.Context, *UTXORequest) (*UTXOResponse, error)
 	// Get a pending transaction by hash
@@ -214,10 +366,33 @@ type LocalStateServer interface {
 	GetChainID(context.Context, *ChainIDRequest) (*ChainIDResponse, error)
 	// Send a transaction to the node
 	SendTransaction(context.Context, *TransactionData) (*TransactionDetails, error)
+	// Send a batch of transactions; each transaction is accepted or rejected
+	// independently of the others
+	SendTransactions(context.Context, *TransactionsData) (*TransactionsDetails, error)
+	// Validate a transaction without adding it to the pending tx pool
+	ValidateTransaction(context.Context, *TransactionData) (*ValidateTransactionResponse, error)
 	// Get the current block number
 	GetEpochNumber(context.Context, *EpochNumberRequest) (*EpochNumberResponse, error)
 	// Get the current block number
 	GetTxBlockNumber(context.Context, *TxBlockNumberRequest) (*TxBlockNumberResponse, error)
+	// Get a large object by the index of its manifest datastore; the chunk
+	// datastores listed in the manifest are verified and reassembled.
+	// Objects larger than 1 MiB are refused; read them chunk by chunk with
+	// GetData instead
+	GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
+	// Get a withdrawal with a proof of its inclusion in the state of the
+	// last snapshot, for claiming the value on Ethereum
+	GetWithdrawalProof(context.Context, *GetWithdrawalProofRequest) (*GetWithdrawalProofResponse, error)
+	GetWithdrawalsForAccount(context.Context, *GetWithdrawalsForAccountRequest) (*GetWithdrawalsForAccountResponse, error)
+	GetFees(context.Context, *FeesRequest) (*FeesResponse, error)
+	// Get the mined transaction which consumed a UTXO
+	GetSpendingTransaction(context.Context, *GetSpendingTransactionRequest) (*GetSpendingTransactionResponse, error)
+	// Get the supply ledger entry of a height
+	GetSupply(context.Context, *SupplyRequest) (*SupplyResponse, error)
+	// Get the per validator consensus statistics of a range of epochs
+	GetValidatorStats(context.Context, *ValidatorStatsRequest) (*ValidatorStatsResponse, error)
+	// Get the registered and the scheduled protocol features
+	GetFeatures(context.Context, *FeaturesRequest) (*FeaturesResponse, error)
 }
 
 // UnimplementedLocalStateServer should be embedded to have forward compatible implementations.
@@ -239,6 +414,15 @@ func (UnimplementedLocalStateServer) GetMinedTransaction(context.Context, *Mined
 func (UnimplementedLocalStateServer) GetBlockHeader(context.Context, *BlockHeaderRequest) (*BlockHeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeader not implemented")
 }
+func (UnimplementedLocalStateServer) GetBlock(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (UnimplementedLocalStateServer) GetBlockByHash(context.Context, *BlockByHashRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHash not implemented")
+}
+func (UnimplementedLocalStateServer) GetBlockRange(context.Context, *BlockRangeRequest) (*BlockRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockRange not implemented")
+}
 func (UnimplementedLocalStateServer) GetUTXO(context.Context, *UTXORequest) (*UTXOResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUTXO not implemented")
 }
@@ -260,12 +444,42 @@ func (UnimplementedLocalStateServer) GetChainID(context.Context, *ChainIDRequest
 func (UnimplementedLocalStateServer) SendTransaction(context.Context, *TransactionData) (*TransactionDetails, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendTransaction not implemented")
 }
+func (UnimplementedLocalStateServer) SendTransactions(context.Context, *TransactionsData) (*TransactionsDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTransactions not implemented")
+}
+func (UnimplementedLocalStateServer) ValidateTransaction(context.Context, *TransactionData) (*ValidateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateTransaction not implemented")
+}
 func (UnimplementedLocalStateServer) GetEpochNumber(context.Context, *EpochNumberRequest) (*EpochNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEpochNumber not implemented")
 }
 func (UnimplementedLocalStateServer) GetTxBlockNumber(context.Context, *TxBlockNumberRequest) (*TxBlockNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTxBlockNumber not implemented")
 }
+func (UnimplementedLocalStateServer) GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObject not implemented")
+}
+func (UnimplementedLocalStateServer) GetWithdrawalProof(context.Context, *GetWithdrawalProofRequest) (*GetWithdrawalProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawalProof not implemented")
+}
+func (UnimplementedLocalStateServer) GetWithdrawalsForAccount(context.Context, *GetWithdrawalsForAccountRequest) (*GetWithdrawalsForAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawalsForAccount not implemented")
+}
+func (UnimplementedLocalStateServer) GetFees(context.Context, *FeesRequest) (*FeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFees not implemented")
+}
+func (UnimplementedLocalStateServer) GetSpendingTransaction(context.Context, *GetSpendingTransactionRequest) (*GetSpendingTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingTransaction not implemented")
+}
+func (UnimplementedLocalStateServer) GetSupply(context.Context, *SupplyRequest) (*SupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupply not implemented")
+}
+func (UnimplementedLocalStateServer) GetValidatorStats(context.Context, *ValidatorStatsRequest) (*ValidatorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorStats not implemented")
+}
+func (UnimplementedLocalStateServer) GetFeatures(context.Context, *FeaturesRequest) (*FeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeatures not implemented")
+}
 
 // UnsafeLocalStateServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LocalStateServer will
@@ -368,6 +582,60 @@ func _LocalState_GetBlockHeader_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LocalState_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetBlockByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetBlockByHash(ctx, req.(*BlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetBlockRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetBlockRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetBlockRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetBlockRange(ctx, req.(*BlockRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LocalState_GetUTXO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UTXORequest)
 	if err := dec(in); err != nil {
@@ -494,6 +762,42 @@ func _LocalState_SendTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LocalState_SendTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionsData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).SendTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/SendTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).SendTransactions(ctx, req.(*TransactionsData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_ValidateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).ValidateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/ValidateTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).ValidateTransaction(ctx, req.(*TransactionData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LocalState_GetEpochNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EpochNumberRequest)
 	if err := dec(in); err != nil {
@@ -530,6 +834,150 @@ func _LocalState_GetTxBlockNumber_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LocalState_GetObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetObject(ctx, req.(*GetObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetWithdrawalProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWithdrawalProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetWithdrawalProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetWithdrawalProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetWithdrawalProof(ctx, req.(*GetWithdrawalProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetWithdrawalsForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWithdrawalsForAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetWithdrawalsForAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetWithdrawalsForAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetWithdrawalsForAccount(ctx, req.(*GetWithdrawalsForAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetFees(ctx, req.(*FeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetSpendingTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetSpendingTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetSpendingTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetSpendingTransaction(ctx, req.(*GetSpendingTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetSupply(ctx, req.(*SupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetValidatorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetValidatorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetValidatorStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetValidatorStats(ctx, req.(*ValidatorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetFeatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetFeatures(ctx, req.(*FeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LocalState_ServiceDesc is the grpc.ServiceDesc for LocalState service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -557,6 +1005,18 @@ var LocalState_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockHeader",
 			Handler:    _LocalState_GetBlockHeader_Handler,
 		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _LocalState_GetBlock_Handler,
+		},
+		{
+			MethodName: "GetBlockByHash",
+			Handler:    _LocalState_GetBlockByHash_Handler,
+		},
+		{
+			MethodName: "GetBlockRange",
+			Handler:    _LocalState_GetBlockRange_Handler,
+		},
 		{
 			MethodName: "GetUTXO",
 			Handler:    _LocalState_GetUTXO_Handler,
@@ -585,6 +1045,14 @@ var LocalState_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendTransaction",
 			Handler:    _LocalState_SendTransaction_Handler,
 		},
+		{
+			MethodName: "SendTransactions",
+			Handler:    _LocalState_SendTransactions_Handler,
+		},
+		{
+			MethodName: "ValidateTransaction",
+			Handler:    _LocalState_ValidateTransaction_Handler,
+		},
 		{
 			MethodName: "GetEpochNumber",
 			Handler:    _LocalState_GetEpochNumber_Handler,
@@ -593,6 +1061,38 @@ var LocalState_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxBlockNumber",
 			Handler:    _LocalState_GetTxBlockNumber_Handler,
 		},
+		{
+			MethodName: "GetObject",
+			Handler:    _LocalState_GetObject_Handler,
+		},
+		{
+			MethodName: "GetWithdrawalProof",
+			Handler:    _LocalState_GetWithdrawalProof_Handler,
+		},
+		{
+			MethodName: "GetWithdrawalsForAccount",
+			Handler:    _LocalState_GetWithdrawalsForAccount_Handler,
+		},
+		{
+			MethodName: "GetFees",
+			Handler:    _LocalState_GetFees_Handler,
+		},
+		{
+			MethodName: "GetSpendingTransaction",
+			Handler:    _LocalState_GetSpendingTransaction_Handler,
+		},
+		{
+			MethodName: "GetSupply",
+			Handler:    _LocalState_GetSupply_Handler,
+		},
+		{
+			MethodName: "GetValidatorStats",
+			Handler:    _LocalState_GetValidatorStats_Handler,
+		},
+		{
+			MethodName: "GetFeatures",
+			Handler:    _LocalState_GetFeatures_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "localstate.proto",
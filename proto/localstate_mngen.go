@@ -48,6 +48,30 @@ type LocalStateGetBlockHeaderHandler interface {
 	HandleLocalStateGetBlockHeader(context.Context, *BlockHeaderRequest) (*BlockHeaderResponse, error)
 }
 
+// LocalStateGetBlockHandler is an interface class that only contains
+// the method HandleLocalStateGetBlock
+// The class that implements this method MUST handle the RPC call for
+// the method GetBlock of the RPC service LocalState
+type LocalStateGetBlockHandler interface {
+	HandleLocalStateGetBlock(context.Context, *BlockRequest) (*BlockResponse, error)
+}
+
+// LocalStateGetBlockByHashHandler is an interface class that only contains
+// the method HandleLocalStateGetBlockByHash
+// The class that implements this method MUST handle the RPC call for
+// the method GetBlockByHash of the RPC service LocalState
+type LocalStateGetBlockByHashHandler interface {
+	HandleLocalStateGetBlockByHash(context.Context, *BlockByHashRequest) (*BlockResponse, error)
+}
+
+// LocalStateGetBlockRangeHandler is an interface class that only contains
+// the method HandleLocalStateGetBlockRange
+// The class that implements this method MUST handle the RPC call for
+// the method GetBlockRange of the RPC service LocalState
+type LocalStateGetBlockRangeHandler interface {
+	HandleLocalStateGetBlockRange(context.Context, *BlockRangeRequest) (*BlockRangeResponse, error)
+}
+
 // LocalStateGetUTXOHandler is an interface class that only contains
 // the method HandleLocalStateGetUTXO
 // The class that implements this method MUST handle the RPC call for
@@ -104,6 +128,22 @@ type LocalStateSendTransactionHandler interface {
 	HandleLocalStateSendTransaction(context.Context, *TransactionData) (*TransactionDetails, error)
 }
 
+// LocalStateSendTransactionsHandler is an interface class that only contains
+// the method HandleLocalStateSendTransactions
+// The class that implements this method MUST handle the RPC call for
+// the method SendTransactions of the RPC service LocalState
+type LocalStateSendTransactionsHandler interface {
+	HandleLocalStateSendTransactions(context.Context, *TransactionsData) (*TransactionsDetails, error)
+}
+
+// LocalStateValidateTransactionHandler is an interface class that only contains
+// the method HandleLocalStateValidateTransaction
+// The class that implements this method MUST handle the RPC call for
+// the method ValidateTransaction of the RPC service LocalState
+type LocalStateValidateTransactionHandler interface {
+	HandleLocalStateValidateTransaction(context.Context, *TransactionData) (*ValidateTransactionResponse, error)
+}
+
 // LocalStateGetEpochNumberHandler is an interface class that only contains
 // the method HandleLocalStateGetEpochNumber
 // The class that implements this method MUST handle the RPC call for
@@ -120,6 +160,70 @@ type LocalStateGetTxBlockNumberHandler interface {
 	HandleLocalStateGetTxBlockNumber(context.Context, *TxBlockNumberRequest) (*TxBlockNumberResponse, error)
 }
 
+// LocalStateGetObjectHandler is an interface class that only contains
+// the method HandleLocalStateGetObject
+// The class that implements this method MUST handle the RPC call for
+// the method GetObject of the RPC service LocalState
+type LocalStateGetObjectHandler interface {
+	HandleLocalStateGetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
+}
+
+// LocalStateGetWithdrawalProofHandler is an interface class that only contains
+// the method HandleLocalStateGetWithdrawalProof
+// The class that implements this method MUST handle the RPC call for
+// the method GetWithdrawalProof of the RPC service LocalState
+type LocalStateGetWithdrawalProofHandler interface {
+	HandleLocalStateGetWithdrawalProof(context.Context, *GetWithdrawalProofRequest) (*GetWithdrawalProofResponse, error)
+}
+
+// LocalStateGetWithdrawalsForAccountHandler is an interface class that only contains
+// the method HandleLocalStateGetWithdrawalsForAccount
+// The class that implements this method MUST handle the RPC call for
+// the method GetWithdrawalsForAccount of the RPC service LocalState
+type LocalStateGetWithdrawalsForAccountHandler interface {
+	HandleLocalStateGetWithdrawalsForAccount(context.Context, *GetWithdrawalsForAccountRequest) (*GetWithdrawalsForAccountResponse, error)
+}
+
+// LocalStateGetFeesHandler is an interface class that only contains
+// the method HandleLocalStateGetFees
+// The class that implements this method MUST handle the RPC call for
+// the method GetFees of the RPC service LocalState
+type LocalStateGetFeesHandler interface {
+	HandleLocalStateGetFees(context.Context, *FeesRequest) (*FeesResponse, error)
+}
+
+// LocalStateGetSpendingTransactionHandler is an interface class that only contains
+// the method HandleLocalStateGetSpendingTransaction
+// The class that implements this method MUST handle the RPC call for
+// the method GetSpendingTransaction of the RPC service LocalState
+type LocalStateGetSpendingTransactionHandler interface {
+	HandleLocalStateGetSpendingTransaction(context.Context, *GetSpendingTransactionRequest) (*GetSpendingTransactionResponse, error)
+}
+
+// LocalStateGetSupplyHandler is an interface class that only contains
+// the method HandleLocalStateGetSupply
+// The class that implements this method MUST handle the RPC call for
+// the method GetSupply of the RPC service LocalState
+type LocalStateGetSupplyHandler interface {
+	HandleLocalStateGetSupply(context.Context, *SupplyRequest) (*SupplyResponse, error)
+}
+
+// LocalStateGetValidatorStatsHandler is an interface class that only contains
+// the method HandleLocalStateGetValidatorStats
+// The class that implements this method MUST handle the RPC call for
+// the method GetValidatorStats of the RPC service LocalState
+type LocalStateGetValidatorStatsHandler interface {
+	HandleLocalStateGetValidatorStats(context.Context, *ValidatorStatsRequest) (*ValidatorStatsResponse, error)
+}
+
+// LocalStateGetFeaturesHandler is an interface class that only contains
+// the method HandleLocalStateGetFeatures
+// The class that implements this method MUST handle the RPC call for
+// the method GetFeatures of the RPC service LocalState
+type LocalStateGetFeaturesHandler interface {
+	HandleLocalStateGetFeatures(context.Context, *FeaturesRequest) (*FeaturesResponse, error)
+}
+
 // LocalStateDispatch allows handlers to be registered for all RPC methods
 // using the Register<Service><Name> methods.
 // After registration, the LocalStateDispatch struct will dispatch calls
@@ -167,6 +271,30 @@ type LocalStateDispatch struct {
 	// method has been registered.
 	waitChanLocalStateGetBlockHeader chan struct{}
 
+	//	handlerLocalStateGetBlock is the registered handler for the
+	//  GetBlock RPC method of service LocalState
+	handlerLocalStateGetBlock LocalStateGetBlockHandler
+	// waitChanLocalStateGetBlock will cause a caller of the RPC
+	// method GetBlock on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetBlock chan struct{}
+
+	//	handlerLocalStateGetBlockByHash is the registered handler for the
+	//  GetBlockByHash RPC method of service LocalState
+	handlerLocalStateGetBlockByHash LocalStateGetBlockByHashHandler
+	// waitChanLocalStateGetBlockByHash will cause a caller of the RPC
+	// method GetBlockByHash on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetBlockByHash chan struct{}
+
+	//	handlerLocalStateGetBlockRange is the registered handler for the
+	//  GetBlockRange RPC method of service LocalState
+	handlerLocalStateGetBlockRange LocalStateGetBlockRangeHandler
+	// waitChanLocalStateGetBlockRange will cause a caller of the RPC
+	// method GetBlockRange on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetBlockRange chan struct{}
+
 	//	handlerLocalStateGetUTXO is the registered handler for the
 	//  GetUTXO RPC method of service LocalState
 	handlerLocalStateGetUTXO LocalStateGetUTXOHandler
@@ -223,6 +351,22 @@ type LocalStateDispatch struct {
 	// method has been registered.
 	waitChanLocalStateSendTransaction chan struct{}
 
+	//	handlerLocalStateSendTransactions is the registered handler for the
+	//  SendTransactions RPC method of service LocalState
+	handlerLocalStateSendTransactions LocalStateSendTransactionsHandler
+	// waitChanLocalStateSendTransactions will cause a caller of the RPC
+	// method SendTransactions on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateSendTransactions chan struct{}
+
+	//	handlerLocalStateValidateTransaction is the registered handler for the
+	//  ValidateTransaction RPC method of service LocalState
+	handlerLocalStateValidateTransaction LocalStateValidateTransactionHandler
+	// waitChanLocalStateValidateTransaction will cause a caller of the RPC
+	// method ValidateTransaction on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateValidateTransaction chan struct{}
+
 	//	handlerLocalStateGetEpochNumber is the registered handler for the
 	//  GetEpochNumber RPC method of service LocalState
 	handlerLocalStateGetEpochNumber LocalStateGetEpochNumberHandler
@@ -238,6 +382,70 @@ type LocalStateDispatch struct {
 	// method GetTxBlockNumber on service LocalState to block until the
 	// method has been registered.
 	waitChanLocalStateGetTxBlockNumber chan struct{}
+
+	//	handlerLocalStateGetObject is the registered handler for the
+	//  GetObject RPC method of service LocalState
+	handlerLocalStateGetObject LocalStateGetObjectHandler
+	// waitChanLocalStateGetObject will cause a caller of the RPC
+	// method GetObject on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetObject chan struct{}
+
+	//	handlerLocalStateGetWithdrawalProof is the registered handler for the
+	//  GetWithdrawalProof RPC method of service LocalState
+	handlerLocalStateGetWithdrawalProof LocalStateGetWithdrawalProofHandler
+	// waitChanLocalStateGetWithdrawalProof will cause a caller of the RPC
+	// method GetWithdrawalProof on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetWithdrawalProof chan struct{}
+
+	//	handlerLocalStateGetWithdrawalsForAccount is the registered handler for the
+	//  GetWithdrawalsForAccount RPC method of service LocalState
+	handlerLocalStateGetWithdrawalsForAccount LocalStateGetWithdrawalsForAccountHandler
+	// waitChanLocalStateGetWithdrawalsForAccount will cause a caller of the RPC
+	// method GetWithdrawalsForAccount on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetWithdrawalsForAccount chan struct{}
+
+	//	handlerLocalStateGetFees is the registered handler for the
+	//  GetFees RPC method of service LocalState
+	handlerLocalStateGetFees LocalStateGetFeesHandler
+	// waitChanLocalStateGetFees will cause a caller of the RPC
+	// method GetFees on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetFees chan struct{}
+
+	//	handlerLocalStateGetSpendingTransaction is the registered handler for the
+	//  GetSpendingTransaction RPC method of service LocalState
+	handlerLocalStateGetSpendingTransaction LocalStateGetSpendingTransactionHandler
+	// waitChanLocalStateGetSpendingTransaction will cause a caller of the RPC
+	// method GetSpendingTransaction on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetSpendingTransaction chan struct{}
+
+	//	handlerLocalStateGetSupply is the registered handler for the
+	//  GetSupply RPC method of service LocalState
+	handlerLocalStateGetSupply LocalStateGetSupplyHandler
+	// waitChanLocalStateGetSupply will cause a caller of the RPC
+	// method GetSupply on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetSupply chan struct{}
+
+	//	handlerLocalStateGetValidatorStats is the registered handler for the
+	//  GetValidatorStats RPC method of service LocalState
+	handlerLocalStateGetValidatorStats LocalStateGetValidatorStatsHandler
+	// waitChanLocalStateGetValidatorStats will cause a caller of the RPC
+	// method GetValidatorStats on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetValidatorStats chan struct{}
+
+	//	handlerLocalStateGetFeatures is the registered handler for the
+	//  GetFeatures RPC method of service LocalState
+	handlerLocalStateGetFeatures LocalStateGetFeaturesHandler
+	// waitChanLocalStateGetFeatures will cause a caller of the RPC
+	// method GetFeatures on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetFeatures chan struct{}
 }
 
 // RegisterLocalStateGetData will register the object 't' as the service
@@ -380,6 +588,90 @@ func (d *LocalStateDispatch) LocalStateGetBlockHeader(ctx context.Context, r *Bl
 	}
 }
 
+// RegisterLocalStateGetBlock will register the object 't' as the service
+// handler for the RPC method GetBlock from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetBlock(t LocalStateGetBlockHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetBlock != nil {
+		panic("double registration of LocalStateGetBlock")
+	}
+	// register the service handler
+	d.handlerLocalStateGetBlock = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetBlock)
+}
+
+// LocalStateGetBlock will invoke the handler for the RPC method
+// GetBlock from service LocalState
+func (d *LocalStateDispatch) LocalStateGetBlock(ctx context.Context, r *BlockRequest) (*BlockResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetBlock:
+		// return the invoked methods response
+		return d.handlerLocalStateGetBlock.HandleLocalStateGetBlock(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetBlockByHash will register the object 't' as the service
+// handler for the RPC method GetBlockByHash from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetBlockByHash(t LocalStateGetBlockByHashHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetBlockByHash != nil {
+		panic("double registration of LocalStateGetBlockByHash")
+	}
+	// register the service handler
+	d.handlerLocalStateGetBlockByHash = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetBlockByHash)
+}
+
+// LocalStateGetBlockByHash will invoke the handler for the RPC method
+// GetBlockByHash from service LocalState
+func (d *LocalStateDispatch) LocalStateGetBlockByHash(ctx context.Context, r *BlockByHashRequest) (*BlockResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetBlockByHash:
+		// return the invoked methods response
+		return d.handlerLocalStateGetBlockByHash.HandleLocalStateGetBlockByHash(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetBlockRange will register the object 't' as the service
+// handler for the RPC method GetBlockRange from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetBlockRange(t LocalStateGetBlockRangeHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetBlockRange != nil {
+		panic("double registration of LocalStateGetBlockRange")
+	}
+	// register the service handler
+	d.handlerLocalStateGetBlockRange = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetBlockRange)
+}
+
+// LocalStateGetBlockRange will invoke the handler for the RPC method
+// GetBlockRange from service LocalState
+func (d *LocalStateDispatch) LocalStateGetBlockRange(ctx context.Context, r *BlockRangeRequest) (*BlockRangeResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetBlockRange:
+		// return the invoked methods response
+		return d.handlerLocalStateGetBlockRange.HandleLocalStateGetBlockRange(ctx, r)
+	}
+}
+
 // RegisterLocalStateGetUTXO will register the object 't' as the service
 // handler for the RPC method GetUTXO from service LocalState
 func (d *LocalStateDispatch) RegisterLocalStateGetUTXO(t LocalStateGetUTXOHandler) {
@@ -576,6 +868,62 @@ func (d *LocalStateDispatch) LocalStateSendTransaction(ctx context.Context, r *T
 	}
 }
 
+// RegisterLocalStateSendTransactions will register the object 't' as the service
+// handler for the RPC method SendTransactions from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateSendTransactions(t LocalStateSendTransactionsHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateSendTransactions != nil {
+		panic("double registration of LocalStateSendTransactions")
+	}
+	// register the service handler
+	d.handlerLocalStateSendTransactions = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateSendTransactions)
+}
+
+// LocalStateSendTransactions will invoke the handler for the RPC method
+// SendTransactions from service LocalState
+func (d *LocalStateDispatch) LocalStateSendTransactions(ctx context.Context, r *TransactionsData) (*TransactionsDetails, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateSendTransactions:
+		// return the invoked methods response
+		return d.handlerLocalStateSendTransactions.HandleLocalStateSendTransactions(ctx, r)
+	}
+}
+
+// RegisterLocalStateValidateTransaction will register the object 't' as the service
+// handler for the RPC method ValidateTransaction from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateValidateTransaction(t LocalStateValidateTransactionHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateValidateTransaction != nil {
+		panic("double registration of LocalStateValidateTransaction")
+	}
+	// register the service handler
+	d.handlerLocalStateValidateTransaction = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateValidateTransaction)
+}
+
+// LocalStateValidateTransaction will invoke the handler for the RPC method
+// ValidateTransaction from service LocalState
+func (d *LocalStateDispatch) LocalStateValidateTransaction(ctx context.Context, r *TransactionData) (*ValidateTransactionResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateValidateTransaction:
+		// return the invoked methods response
+		return d.handlerLocalStateValidateTransaction.HandleLocalStateValidateTransaction(ctx, r)
+	}
+}
+
 // RegisterLocalStateGetEpochNumber will register the object 't' as the service
 // handler for the RPC method GetEpochNumber from service LocalState
 func (d *LocalStateDispatch) RegisterLocalStateGetEpochNumber(t LocalStateGetEpochNumberHandler) {
@@ -632,6 +980,230 @@ func (d *LocalStateDispatch) LocalStateGetTxBlockNumber(ctx context.Context, r *
 	}
 }
 
+// RegisterLocalStateGetObject will register the object 't' as the service
+// handler for the RPC method GetObject from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetObject(t LocalStateGetObjectHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetObject != nil {
+		panic("double registration of LocalStateGetObject")
+	}
+	// register the service handler
+	d.handlerLocalStateGetObject = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetObject)
+}
+
+// LocalStateGetObject will invoke the handler for the RPC method
+// GetObject from service LocalState
+func (d *LocalStateDispatch) LocalStateGetObject(ctx context.Context, r *GetObjectRequest) (*GetObjectResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetObject:
+		// return the invoked methods response
+		return d.handlerLocalStateGetObject.HandleLocalStateGetObject(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetWithdrawalProof will register the object 't' as the service
+// handler for the RPC method GetWithdrawalProof from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetWithdrawalProof(t LocalStateGetWithdrawalProofHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetWithdrawalProof != nil {
+		panic("double registration of LocalStateGetWithdrawalProof")
+	}
+	// register the service handler
+	d.handlerLocalStateGetWithdrawalProof = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetWithdrawalProof)
+}
+
+// LocalStateGetWithdrawalProof will invoke the handler for the RPC method
+// GetWithdrawalProof from service LocalState
+func (d *LocalStateDispatch) LocalStateGetWithdrawalProof(ctx context.Context, r *GetWithdrawalProofRequest) (*GetWithdrawalProofResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetWithdrawalProof:
+		// return the invoked methods response
+		return d.handlerLocalStateGetWithdrawalProof.HandleLocalStateGetWithdrawalProof(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetWithdrawalsForAccount will register the object 't' as the service
+// handler for the RPC method GetWithdrawalsForAccount from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetWithdrawalsForAccount(t LocalStateGetWithdrawalsForAccountHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetWithdrawalsForAccount != nil {
+		panic("double registration of LocalStateGetWithdrawalsForAccount")
+	}
+	// register the service handler
+	d.handlerLocalStateGetWithdrawalsForAccount = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetWithdrawalsForAccount)
+}
+
+// LocalStateGetWithdrawalsForAccount will invoke the handler for the RPC method
+// GetWithdrawalsForAccount from service LocalState
+func (d *LocalStateDispatch) LocalStateGetWithdrawalsForAccount(ctx context.Context, r *GetWithdrawalsForAccountRequest) (*GetWithdrawalsForAccountResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetWithdrawalsForAccount:
+		// return the invoked methods response
+		return d.handlerLocalStateGetWithdrawalsForAccount.HandleLocalStateGetWithdrawalsForAccount(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetFees will register the object 't' as the service
+// handler for the RPC method GetFees from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetFees(t LocalStateGetFeesHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetFees != nil {
+		panic("double registration of LocalStateGetFees")
+	}
+	// register the service handler
+	d.handlerLocalStateGetFees = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetFees)
+}
+
+// LocalStateGetFees will invoke the handler for the RPC method
+// GetFees from service LocalState
+func (d *LocalStateDispatch) LocalStateGetFees(ctx context.Context, r *FeesRequest) (*FeesResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetFees:
+		// return the invoked methods response
+		return d.handlerLocalStateGetFees.HandleLocalStateGetFees(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetSpendingTransaction will register the object 't' as the service
+// handler for the RPC method GetSpendingTransaction from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetSpendingTransaction(t LocalStateGetSpendingTransactionHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetSpendingTransaction != nil {
+		panic("double registration of LocalStateGetSpendingTransaction")
+	}
+	// register the service handler
+	d.handlerLocalStateGetSpendingTransaction = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetSpendingTransaction)
+}
+
+// LocalStateGetSpendingTransaction will invoke the handler for the RPC method
+// GetSpendingTransaction from service LocalState
+func (d *LocalStateDispatch) LocalStateGetSpendingTransaction(ctx context.Context, r *GetSpendingTransactionRequest) (*GetSpendingTransactionResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetSpendingTransaction:
+		// return the invoked methods response
+		return d.handlerLocalStateGetSpendingTransaction.HandleLocalStateGetSpendingTransaction(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetSupply will register the object 't' as the service
+// handler for the RPC method GetSupply from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetSupply(t LocalStateGetSupplyHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetSupply != nil {
+		panic("double registration of LocalStateGetSupply")
+	}
+	// register the service handler
+	d.handlerLocalStateGetSupply = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetSupply)
+}
+
+// LocalStateGetSupply will invoke the handler for the RPC method
+// GetSupply from service LocalState
+func (d *LocalStateDispatch) LocalStateGetSupply(ctx context.Context, r *SupplyRequest) (*SupplyResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetSupply:
+		// return the invoked methods response
+		return d.handlerLocalStateGetSupply.HandleLocalStateGetSupply(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetValidatorStats will register the object 't' as the service
+// handler for the RPC method GetValidatorStats from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetValidatorStats(t LocalStateGetValidatorStatsHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetValidatorStats != nil {
+		panic("double registration of LocalStateGetValidatorStats")
+	}
+	// register the service handler
+	d.handlerLocalStateGetValidatorStats = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetValidatorStats)
+}
+
+// LocalStateGetValidatorStats will invoke the handler for the RPC method
+// GetValidatorStats from service LocalState
+func (d *LocalStateDispatch) LocalStateGetValidatorStats(ctx context.Context, r *ValidatorStatsRequest) (*ValidatorStatsResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetValidatorStats:
+		// return the invoked methods response
+		return d.handlerLocalStateGetValidatorStats.HandleLocalStateGetValidatorStats(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetFeatures will register the object 't' as the service
+// handler for the RPC method GetFeatures from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetFeatures(t LocalStateGetFeaturesHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetFeatures != nil {
+		panic("double registration of LocalStateGetFeatures")
+	}
+	// register the service handler
+	d.handlerLocalStateGetFeatures = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetFeatures)
+}
+
+// LocalStateGetFeatures will invoke the handler for the RPC method
+// GetFeatures from service LocalState
+func (d *LocalStateDispatch) LocalStateGetFeatures(ctx context.Context, r *FeaturesRequest) (*FeaturesResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetFeatures:
+		// return the invoked methods response
+		return d.handlerLocalStateGetFeatures.HandleLocalStateGetFeatures(ctx, r)
+	}
+}
+
 // NewLocalStateDispatch will construct a new LocalStateDispatcher with all fields properly
 // initialized.
 func NewLocalStateDispatch() *LocalStateDispatch {
@@ -652,6 +1224,15 @@ func NewLocalStateDispatch() *LocalStateDispatch {
 		// initialize the wait channel for method GetBlockHeader on service LocalState
 		waitChanLocalStateGetBlockHeader: make(chan struct{}),
 
+		// initialize the wait channel for method GetBlock on service LocalState
+		waitChanLocalStateGetBlock: make(chan struct{}),
+
+		// initialize the wait channel for method GetBlockByHash on service LocalState
+		waitChanLocalStateGetBlockByHash: make(chan struct{}),
+
+		// initialize the wait channel for method GetBlockRange on service LocalState
+		waitChanLocalStateGetBlockRange: make(chan struct{}),
+
 		// initialize the wait channel for method GetUTXO on service LocalState
 		waitChanLocalStateGetUTXO: make(chan struct{}),
 
@@ -673,11 +1254,41 @@ func NewLocalStateDispatch() *LocalStateDispatch {
 		// initialize the wait channel for method SendTransaction on service LocalState
 		waitChanLocalStateSendTransaction: make(chan struct{}),
 
+		// initialize the wait channel for method SendTransactions on service LocalState
+		waitChanLocalStateSendTransactions: make(chan struct{}),
+
+		// initialize the wait channel for method ValidateTransaction on service LocalState
+		waitChanLocalStateValidateTransaction: make(chan struct{}),
+
 		// initialize the wait channel for method GetEpochNumber on service LocalState
 		waitChanLocalStateGetEpochNumber: make(chan struct{}),
 
 		// initialize the wait channel for method GetTxBlockNumber on service LocalState
 		waitChanLocalStateGetTxBlockNumber: make(chan struct{}),
+
+		// initialize the wait channel for method GetObject on service LocalState
+		waitChanLocalStateGetObject: make(chan struct{}),
+
+		// initialize the wait channel for method GetWithdrawalProof on service LocalState
+		waitChanLocalStateGetWithdrawalProof: make(chan struct{}),
+
+		// initialize the wait channel for method GetWithdrawalsForAccount on service LocalState
+		waitChanLocalStateGetWithdrawalsForAccount: make(chan struct{}),
+
+		// initialize the wait channel for method GetFees on service LocalState
+		waitChanLocalStateGetFees: make(chan struct{}),
+
+		// initialize the wait channel for method GetSpendingTransaction on service LocalState
+		waitChanLocalStateGetSpendingTransaction: make(chan struct{}),
+
+		// initialize the wait channel for method GetSupply on service LocalState
+		waitChanLocalStateGetSupply: make(chan struct{}),
+
+		// initialize the wait channel for method GetValidatorStats on service LocalState
+		waitChanLocalStateGetValidatorStats: make(chan struct{}),
+
+		// initialize the wait channel for method GetFeatures on service LocalState
+		waitChanLocalStateGetFeatures: make(chan struct{}),
 	}
 }
 
@@ -718,6 +1329,24 @@ func (s *GeneratedLocalStateServer) GetBlockHeader(ctx context.Context, r *Block
 	return s.dispatch.LocalStateGetBlockHeader(ctx, r)
 }
 
+// GetBlock will invoke the method GetBlock on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetBlock(ctx context.Context, r *BlockRequest) (*BlockResponse, error) {
+	return s.dispatch.LocalStateGetBlock(ctx, r)
+}
+
+// GetBlockByHash will invoke the method GetBlockByHash on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetBlockByHash(ctx context.Context, r *BlockByHashRequest) (*BlockResponse, error) {
+	return s.dispatch.LocalStateGetBlockByHash(ctx, r)
+}
+
+// GetBlockRange will invoke the method GetBlockRange on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetBlockRange(ctx context.Context, r *BlockRangeRequest) (*BlockRangeResponse, error) {
+	return s.dispatch.LocalStateGetBlockRange(ctx, r)
+}
+
 // GetUTXO will invoke the method GetUTXO on the RPC service LocalState
 // using the LocalStateDispatch handler.
 func (s *GeneratedLocalStateServer) GetUTXO(ctx context.Context, r *UTXORequest) (*UTXOResponse, error) {
@@ -760,6 +1389,18 @@ func (s *GeneratedLocalStateServer) SendTransaction(ctx context.Context, r *Tran
 	return s.dispatch.LocalStateSendTransaction(ctx, r)
 }
 
+// SendTransactions will invoke the method SendTransactions on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) SendTransactions(ctx context.Context, r *TransactionsData) (*TransactionsDetails, error) {
+	return s.dispatch.LocalStateSendTransactions(ctx, r)
+}
+
+// ValidateTransaction will invoke the method ValidateTransaction on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) ValidateTransaction(ctx context.Context, r *TransactionData) (*ValidateTransactionResponse, error) {
+	return s.dispatch.LocalStateValidateTransaction(ctx, r)
+}
+
 // GetEpochNumber will invoke the method GetEpochNumber on the RPC service LocalState
 // using the LocalStateDispatch handler.
 func (s *GeneratedLocalStateServer) GetEpochNumber(ctx context.Context, r *EpochNumberRequest) (*EpochNumberResponse, error) {
@@ -772,6 +1413,54 @@ func (s *GeneratedLocalStateServer) GetTxBlockNumber(ctx context.Context, r *TxB
 	return s.dispatch.LocalStateGetTxBlockNumber(ctx, r)
 }
 
+// GetObject will invoke the method GetObject on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetObject(ctx context.Context, r *GetObjectRequest) (*GetObjectResponse, error) {
+	return s.dispatch.LocalStateGetObject(ctx, r)
+}
+
+// GetWithdrawalProof will invoke the method GetWithdrawalProof on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetWithdrawalProof(ctx context.Context, r *GetWithdrawalProofRequest) (*GetWithdrawalProofResponse, error) {
+	return s.dispatch.LocalStateGetWithdrawalProof(ctx, r)
+}
+
+// GetWithdrawalsForAccount will invoke the method GetWithdrawalsForAccount on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetWithdrawalsForAccount(ctx context.Context, r *GetWithdrawalsForAccountRequest) (*GetWithdrawalsForAccountResponse, error) {
+	return s.dispatch.LocalStateGetWithdrawalsForAccount(ctx, r)
+}
+
+// GetFees will invoke the method GetFees on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetFees(ctx context.Context, r *FeesRequest) (*FeesResponse, error) {
+	return s.dispatch.LocalStateGetFees(ctx, r)
+}
+
+// GetSpendingTransaction will invoke the method GetSpendingTransaction on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetSpendingTransaction(ctx context.Context, r *GetSpendingTransactionRequest) (*GetSpendingTransactionResponse, error) {
+	return s.dispatch.LocalStateGetSpendingTransaction(ctx, r)
+}
+
+// GetSupply will invoke the method GetSupply on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetSupply(ctx context.Context, r *SupplyRequest) (*SupplyResponse, error) {
+	return s.dispatch.LocalStateGetSupply(ctx, r)
+}
+
+// GetValidatorStats will invoke the method GetValidatorStats on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetValidatorStats(ctx context.Context, r *ValidatorStatsRequest) (*ValidatorStatsResponse, error) {
+	return s.dispatch.LocalStateGetValidatorStats(ctx, r)
+}
+
+// GetFeatures will invoke the method GetFeatures on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetFeatures(ctx context.Context, r *FeaturesRequest) (*FeaturesResponse, error) {
+	return s.dispatch.LocalStateGetFeatures(ctx, r)
+}
+
 // NewGeneratedLocalStateServer constructs a new server for the service.
 func NewGeneratedLocalStateServer(dispatch *LocalStateDispatch) *GeneratedLocalStateServer {
 	return &GeneratedLocalStateServer{
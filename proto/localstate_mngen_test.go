@@ -347,6 +347,210 @@ func TestLocalStateGetBlockHeaderCancel(t *testing.T) {
 	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
 }
 
+type testLocalStateGetBlockHandler struct{}
+
+func (th *testLocalStateGetBlockHandler) HandleLocalStateGetBlock(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return &BlockResponse{}, nil
+}
+
+func TestLocalStateGetBlock(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetBlockHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetBlock(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetBlock(context.Background(), &BlockRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetBlock(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetBlockHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetBlock(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetBlock(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetBlockCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetBlock(cancelCtx, &BlockRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetBlockByHashHandler struct{}
+
+func (th *testLocalStateGetBlockByHashHandler) HandleLocalStateGetBlockByHash(context.Context, *BlockByHashRequest) (*BlockResponse, error) {
+	return &BlockResponse{}, nil
+}
+
+func TestLocalStateGetBlockByHash(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetBlockByHashHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetBlockByHash(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetBlockByHash(context.Background(), &BlockByHashRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetBlockByHash(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetBlockByHashHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetBlockByHash(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetBlockByHash(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetBlockByHashCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetBlockByHash(cancelCtx, &BlockByHashRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetBlockRangeHandler struct{}
+
+func (th *testLocalStateGetBlockRangeHandler) HandleLocalStateGetBlockRange(context.Context, *BlockRangeRequest) (*BlockRangeResponse, error) {
+	return &BlockRangeResponse{}, nil
+}
+
+func TestLocalStateGetBlockRange(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetBlockRangeHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetBlockRange(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetBlockRange(context.Background(), &BlockRangeRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetBlockRange(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetBlockRangeHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetBlockRange(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetBlockRange(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetBlockRangeCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetBlockRange(cancelCtx, &BlockRangeRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
 type testLocalStateGetUTXOHandler struct{}
 
 func (th *testLocalStateGetUTXOHandler) HandleLocalStateGetUTXO(context.Context, *UTXORequest) (*UTXOResponse, error) {
@@ -823,21 +1027,21 @@ func TestLocalStateSendTransactionCancel(t *testing.T) {
 	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
 }
 
-type testLocalStateGetEpochNumberHandler struct{}
+type testLocalStateSendTransactionsHandler struct{}
 
-func (th *testLocalStateGetEpochNumberHandler) HandleLocalStateGetEpochNumber(context.Context, *EpochNumberRequest) (*EpochNumberResponse, error) {
-	return &EpochNumberResponse{}, nil
+func (th *testLocalStateSendTransactionsHandler) HandleLocalStateSendTransactions(context.Context, *TransactionsData) (*TransactionsDetails, error) {
+	return &TransactionsDetails{}, nil
 }
 
-func TestLocalStateGetEpochNumber(t *testing.T) {
+func TestLocalStateSendTransactions(t *testing.T) {
 	// Setup the dispatch handler
 	d := NewLocalStateDispatch()
 
 	// Setup the handler for the TestService
-	h := &testLocalStateGetEpochNumberHandler{}
+	h := &testLocalStateSendTransactionsHandler{}
 
 	// Register the handler with the dispatch class
-	d.RegisterLocalStateGetEpochNumber(h)
+	d.RegisterLocalStateSendTransactions(h)
 
 	// Create the server and pass in the dispatch class
 	srvr := GeneratedLocalStateServer{
@@ -845,29 +1049,29 @@ func TestLocalStateGetEpochNumber(t *testing.T) {
 	}
 
 	// Test calling the method TestCall
-	_, err := srvr.GetEpochNumber(context.Background(), &EpochNumberRequest{})
+	_, err := srvr.SendTransactions(context.Background(), &TransactionsData{})
 	if err != nil {
 		t.Error(err)
 	}
 }
 
-func TestDoubleregistrationLocalStateGetEpochNumber(t *testing.T) {
+func TestDoubleregistrationLocalStateSendTransactions(t *testing.T) {
 	// Setup the dispatch handler
 	d := NewLocalStateDispatch()
 
 	// Setup the handler for the TestService
-	h := &testLocalStateGetEpochNumberHandler{}
+	h := &testLocalStateSendTransactionsHandler{}
 
 	// Register the handler with the dispatch class
-	d.RegisterLocalStateGetEpochNumber(h)
+	d.RegisterLocalStateSendTransactions(h)
 
 	fn := func() {
-		d.RegisterLocalStateGetEpochNumber(h)
+		d.RegisterLocalStateSendTransactions(h)
 	}
 	assert.Panics(t, fn, "double registration must panic")
 }
 
-func TestLocalStateGetEpochNumberCancel(t *testing.T) {
+func TestLocalStateSendTransactionsCancel(t *testing.T) {
 	// Setup the dispatch handler
 	d := NewLocalStateDispatch()
 
@@ -882,7 +1086,7 @@ func TestLocalStateGetEpochNumberCancel(t *testing.T) {
 	ctx := context.Background()
 	cancelCtx, cancelFunc := context.WithCancel(ctx)
 	fn := func() {
-		_, err := srvr.GetEpochNumber(cancelCtx, &EpochNumberRequest{})
+		_, err := srvr.SendTransactions(cancelCtx, &TransactionsData{})
 		errChan <- err
 	}
 	go fn()
@@ -891,21 +1095,21 @@ func TestLocalStateGetEpochNumberCancel(t *testing.T) {
 	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
 }
 
-type testLocalStateGetTxBlockNumberHandler struct{}
+type testLocalStateValidateTransactionHandler struct{}
 
-func (th *testLocalStateGetTxBlockNumberHandler) HandleLocalStateGetTxBlockNumber(context.Context, *TxBlockNumberRequest) (*TxBlockNumberResponse, error) {
-	return &TxBlockNumberResponse{}, nil
+func (th *testLocalStateValidateTransactionHandler) HandleLocalStateValidateTransaction(context.Context, *TransactionData) (*ValidateTransactionResponse, error) {
+	return &ValidateTransactionResponse{}, nil
 }
 
-func TestLocalStateGetTxBlockNumber(t *testing.T) {
+func TestLocalStateValidateTransaction(t *testing.T) {
 	// Setup the dispatch handler
 	d := NewLocalStateDispatch()
 
 	// Setup the handler for the TestService
-	h := &testLocalStateGetTxBlockNumberHandler{}
+	h := &testLocalStateValidateTransactionHandler{}
 
 	// Register the handler with the dispatch class
-	d.RegisterLocalStateGetTxBlockNumber(h)
+	d.RegisterLocalStateValidateTransaction(h)
 
 	// Create the server and pass in the dispatch class
 	srvr := GeneratedLocalStateServer{
@@ -913,29 +1117,29 @@ func TestLocalStateGetTxBlockNumber(t *testing.T) {
 	}
 
 	// Test calling the method TestCall
-	_, err := srvr.GetTxBlockNumber(context.Background(), &TxBlockNumberRequest{})
+	_, err := srvr.ValidateTransaction(context.Background(), &TransactionData{})
 	if err != nil {
 		t.Error(err)
 	}
 }
 
-func TestDoubleregistrationLocalStateGetTxBlockNumber(t *testing.T) {
+func TestDoubleregistrationLocalStateValidateTransaction(t *testing.T) {
 	// Setup the dispatch handler
 	d := NewLocalStateDispatch()
 
 	// Setup the handler for the TestService
-	h := &testLocalStateGetTxBlockNumberHandler{}
+	h := &testLocalStateValidateTransactionHandler{}
 
 	// Register the handler with the dispatch class
-	d.RegisterLocalStateGetTxBlockNumber(h)
+	d.RegisterLocalStateValidateTransaction(h)
 
 	fn := func() {
-		d.RegisterLocalStateGetTxBlockNumber(h)
+		d.RegisterLocalStateValidateTransaction(h)
 	}
 	assert.Panics(t, fn, "double registration must panic")
 }
 
-func TestLocalStateGetTxBlockNumberCancel(t *testing.T) {
+func TestLocalStateValidateTransactionCancel(t *testing.T) {
 	// Setup the dispatch handler
 	d := NewLocalStateDispatch()
 
@@ -950,7 +1154,687 @@ func TestLocalStateGetTxBlockNumberCancel(t *testing.T) {
 	ctx := context.Background()
 	cancelCtx, cancelFunc := context.WithCancel(ctx)
 	fn := func() {
-		_, err := srvr.GetTxBlockNumber(cancelCtx, &TxBlockNumberRequest{})
+		_, err := srvr.ValidateTransaction(cancelCtx, &TransactionData{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetEpochNumberHandler struct{}
+
+func (th *testLocalStateGetEpochNumberHandler) HandleLocalStateGetEpochNumber(context.Context, *EpochNumberRequest) (*EpochNumberResponse, error) {
+	return &EpochNumberResponse{}, nil
+}
+
+func TestLocalStateGetEpochNumber(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetEpochNumberHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetEpochNumber(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetEpochNumber(context.Background(), &EpochNumberRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetEpochNumber(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetEpochNumberHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetEpochNumber(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetEpochNumber(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetEpochNumberCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetEpochNumber(cancelCtx, &EpochNumberRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetTxBlockNumberHandler struct{}
+
+func (th *testLocalStateGetTxBlockNumberHandler) HandleLocalStateGetTxBlockNumber(context.Context, *TxBlockNumberRequest) (*TxBlockNumberResponse, error) {
+	return &TxBlockNumberResponse{}, nil
+}
+
+func TestLocalStateGetTxBlockNumber(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetTxBlockNumberHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetTxBlockNumber(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetTxBlockNumber(context.Background(), &TxBlockNumberRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetTxBlockNumber(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetTxBlockNumberHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetTxBlockNumber(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetTxBlockNumber(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetTxBlockNumberCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetTxBlockNumber(cancelCtx, &TxBlockNumberRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetObjectHandler struct{}
+
+func (th *testLocalStateGetObjectHandler) HandleLocalStateGetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error) {
+	return &GetObjectResponse{}, nil
+}
+
+func TestLocalStateGetObject(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetObjectHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetObject(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetObject(context.Background(), &GetObjectRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetObject(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetObjectHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetObject(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetObject(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetObjectCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetObject(cancelCtx, &GetObjectRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetWithdrawalProofHandler struct{}
+
+func (th *testLocalStateGetWithdrawalProofHandler) HandleLocalStateGetWithdrawalProof(context.Context, *GetWithdrawalProofRequest) (*GetWithdrawalProofResponse, error) {
+	return &GetWithdrawalProofResponse{}, nil
+}
+
+func TestLocalStateGetWithdrawalProof(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetWithdrawalProofHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetWithdrawalProof(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetWithdrawalProof(context.Background(), &GetWithdrawalProofRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetWithdrawalProof(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetWithdrawalProofHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetWithdrawalProof(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetWithdrawalProof(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetWithdrawalProofCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetWithdrawalProof(cancelCtx, &GetWithdrawalProofRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetWithdrawalsForAccountHandler struct{}
+
+func (th *testLocalStateGetWithdrawalsForAccountHandler) HandleLocalStateGetWithdrawalsForAccount(context.Context, *GetWithdrawalsForAccountRequest) (*GetWithdrawalsForAccountResponse, error) {
+	return &GetWithdrawalsForAccountResponse{}, nil
+}
+
+func TestLocalStateGetWithdrawalsForAccount(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetWithdrawalsForAccountHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetWithdrawalsForAccount(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetWithdrawalsForAccount(context.Background(), &GetWithdrawalsForAccountRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetWithdrawalsForAccount(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetWithdrawalsForAccountHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetWithdrawalsForAccount(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetWithdrawalsForAccount(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetWithdrawalsForAccountCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetWithdrawalsForAccount(cancelCtx, &GetWithdrawalsForAccountRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetFeesHandler struct{}
+
+func (th *testLocalStateGetFeesHandler) HandleLocalStateGetFees(context.Context, *FeesRequest) (*FeesResponse, error) {
+	return &FeesResponse{}, nil
+}
+
+func TestLocalStateGetFees(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetFeesHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetFees(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetFees(context.Background(), &FeesRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetFees(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetFeesHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetFees(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetFees(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetFeesCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetFees(cancelCtx, &FeesRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetSpendingTransactionHandler struct{}
+
+func (th *testLocalStateGetSpendingTransactionHandler) HandleLocalStateGetSpendingTransaction(context.Context, *GetSpendingTransactionRequest) (*GetSpendingTransactionResponse, error) {
+	return &GetSpendingTransactionResponse{}, nil
+}
+
+func TestLocalStateGetSpendingTransaction(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetSpendingTransactionHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetSpendingTransaction(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetSpendingTransaction(context.Background(), &GetSpendingTransactionRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetSpendingTransaction(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetSpendingTransactionHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetSpendingTransaction(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetSpendingTransaction(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetSpendingTransactionCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetSpendingTransaction(cancelCtx, &GetSpendingTransactionRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetSupplyHandler struct{}
+
+func (th *testLocalStateGetSupplyHandler) HandleLocalStateGetSupply(context.Context, *SupplyRequest) (*SupplyResponse, error) {
+	return &SupplyResponse{}, nil
+}
+
+func TestLocalStateGetSupply(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetSupplyHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetSupply(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetSupply(context.Background(), &SupplyRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetSupply(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetSupplyHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetSupply(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetSupply(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetSupplyCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetSupply(cancelCtx, &SupplyRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetValidatorStatsHandler struct{}
+
+func (th *testLocalStateGetValidatorStatsHandler) HandleLocalStateGetValidatorStats(context.Context, *ValidatorStatsRequest) (*ValidatorStatsResponse, error) {
+	return &ValidatorStatsResponse{}, nil
+}
+
+func TestLocalStateGetValidatorStats(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetValidatorStatsHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetValidatorStats(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetValidatorStats(context.Background(), &ValidatorStatsRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetValidatorStats(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetValidatorStatsHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetValidatorStats(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetValidatorStats(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetValidatorStatsCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetValidatorStats(cancelCtx, &ValidatorStatsRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetFeaturesHandler struct{}
+
+func (th *testLocalStateGetFeaturesHandler) HandleLocalStateGetFeatures(context.Context, *FeaturesRequest) (*FeaturesResponse, error) {
+	return &FeaturesResponse{}, nil
+}
+
+func TestLocalStateGetFeatures(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetFeaturesHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetFeatures(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetFeatures(context.Background(), &FeaturesRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetFeatures(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetFeaturesHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetFeatures(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetFeatures(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetFeaturesCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetFeatures(cancelCtx, &FeaturesRequest{})
 		errChan <- err
 	}
 	go fn()
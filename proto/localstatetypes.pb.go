@@ -135,21 +135,132 @@ func (x *GetDataResponse) GetRawdata() string {
 	return ""
 }
 
+type GetObjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurveSpec uint32 `protobuf:"varint,1,opt,name=CurveSpec,proto3" json:"CurveSpec,omitempty"`
+	Account   string `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"` // must be 20 bytes or 40 hex chars
+	Index     string `protobuf:"bytes,3,opt,name=Index,proto3" json:"Index,omitempty"`     // index of the manifest; must be 32 bytes or 64 hex chars
+}
+
+func (x *GetObjectRequest) Reset() {
+	*x = GetObjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectRequest) ProtoMessage() {}
+
+func (x *GetObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetObjectRequest) GetCurveSpec() uint32 {
+	if x != nil {
+		return x.CurveSpec
+	}
+	return 0
+}
+
+func (x *GetObjectRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *GetObjectRequest) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+type GetObjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rawdata string `protobuf:"bytes,1,opt,name=Rawdata,proto3" json:"Rawdata,omitempty"`
+}
+
+func (x *GetObjectResponse) Reset() {
+	*x = GetObjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectResponse) ProtoMessage() {}
+
+func (x *GetObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetObjectResponse) GetRawdata() string {
+	if x != nil {
+		return x.Rawdata
+	}
+	return ""
+}
+
 type GetValueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CurveSpec       uint32 `protobuf:"varint,1,opt,name=CurveSpec,proto3" json:"CurveSpec,omitempty"`
-	Account         string `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"` // 20 bytes
-	Minvalue        string `protobuf:"bytes,3,opt,name=Minvalue,proto3" json:"Minvalue,omitempty"`
+	CurveSpec uint32 `protobuf:"varint,1,opt,name=CurveSpec,proto3" json:"CurveSpec,omitempty"`
+	Account   string `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"` // 20 bytes
+	Minvalue  string `protobuf:"bytes,3,opt,name=Minvalue,proto3" json:"Minvalue,omitempty"`
+	// hex encoded by the HTTP gateway
 	PaginationToken []byte `protobuf:"bytes,4,opt,name=PaginationToken,proto3" json:"PaginationToken,omitempty"`
 }
 
 func (x *GetValueRequest) Reset() {
 	*x = GetValueRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[2]
+		mi := &file_localstatetypes_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -162,7 +273,7 @@ func (x *GetValueRequest) String() string {
 func (*GetValueRequest) ProtoMessage() {}
 
 func (x *GetValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[2]
+	mi := &file_localstatetypes_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,7 +286,7 @@ func (x *GetValueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetValueRequest.ProtoReflect.Descriptor instead.
 func (*GetValueRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{2}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetValueRequest) GetCurveSpec() uint32 {
@@ -211,16 +322,17 @@ type GetValueResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UTXOIDs         []string `protobuf:"bytes,1,rep,name=UTXOIDs,proto3" json:"UTXOIDs,omitempty"` // []string of hashes
-	TotalValue      string   `protobuf:"bytes,2,opt,name=TotalValue,proto3" json:"TotalValue,omitempty"`
-	PaginationToken []byte   `protobuf:"bytes,3,opt,name=PaginationToken,proto3" json:"PaginationToken,omitempty"`
-	BlockHeight     uint32   `protobuf:"varint,4,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	UTXOIDs    []string `protobuf:"bytes,1,rep,name=UTXOIDs,proto3" json:"UTXOIDs,omitempty"` // []string of hashes
+	TotalValue string   `protobuf:"bytes,2,opt,name=TotalValue,proto3" json:"TotalValue,omitempty"`
+	// hex encoded by the HTTP gateway
+	PaginationToken []byte `protobuf:"bytes,3,opt,name=PaginationToken,proto3" json:"PaginationToken,omitempty"`
+	BlockHeight     uint32 `protobuf:"varint,4,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
 }
 
 func (x *GetValueResponse) Reset() {
 	*x = GetValueResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[3]
+		mi := &file_localstatetypes_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -233,7 +345,7 @@ func (x *GetValueResponse) String() string {
 func (*GetValueResponse) ProtoMessage() {}
 
 func (x *GetValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[3]
+	mi := &file_localstatetypes_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +358,7 @@ func (x *GetValueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetValueResponse.ProtoReflect.Descriptor instead.
 func (*GetValueResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{3}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetValueResponse) GetUTXOIDs() []string {
@@ -288,7 +400,7 @@ type MinedTransactionRequest struct {
 func (x *MinedTransactionRequest) Reset() {
 	*x = MinedTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[4]
+		mi := &file_localstatetypes_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -301,7 +413,7 @@ func (x *MinedTransactionRequest) String() string {
 func (*MinedTransactionRequest) ProtoMessage() {}
 
 func (x *MinedTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[4]
+	mi := &file_localstatetypes_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -314,7 +426,7 @@ func (x *MinedTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MinedTransactionRequest.ProtoReflect.Descriptor instead.
 func (*MinedTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{4}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MinedTransactionRequest) GetTxHash() string {
@@ -335,7 +447,7 @@ type MinedTransactionResponse struct {
 func (x *MinedTransactionResponse) Reset() {
 	*x = MinedTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[5]
+		mi := &file_localstatetypes_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -348,7 +460,7 @@ func (x *MinedTransactionResponse) String() string {
 func (*MinedTransactionResponse) ProtoMessage() {}
 
 func (x *MinedTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[5]
+	mi := &file_localstatetypes_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,7 +473,7 @@ func (x *MinedTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MinedTransactionResponse.ProtoReflect.Descriptor instead.
 func (*MinedTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{5}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MinedTransactionResponse) GetTx() *Tx {
@@ -382,7 +494,7 @@ type BlockHeaderRequest struct {
 func (x *BlockHeaderRequest) Reset() {
 	*x = BlockHeaderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[6]
+		mi := &file_localstatetypes_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -395,7 +507,7 @@ func (x *BlockHeaderRequest) String() string {
 func (*BlockHeaderRequest) ProtoMessage() {}
 
 func (x *BlockHeaderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[6]
+	mi := &file_localstatetypes_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +520,7 @@ func (x *BlockHeaderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockHeaderRequest.ProtoReflect.Descriptor instead.
 func (*BlockHeaderRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{6}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BlockHeaderRequest) GetHeight() uint32 {
@@ -429,7 +541,7 @@ type BlockHeaderResponse struct {
 func (x *BlockHeaderResponse) Reset() {
 	*x = BlockHeaderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[7]
+		mi := &file_localstatetypes_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -442,7 +554,7 @@ func (x *BlockHeaderResponse) String() string {
 func (*BlockHeaderResponse) ProtoMessage() {}
 
 func (x *BlockHeaderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[7]
+	mi := &file_localstatetypes_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,7 +567,7 @@ func (x *BlockHeaderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockHeaderResponse.ProtoReflect.Descriptor instead.
 func (*BlockHeaderResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{7}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BlockHeaderResponse) GetBlockHeader() *BlockHeader {
@@ -465,31 +577,45 @@ func (x *BlockHeaderResponse) GetBlockHeader() *BlockHeader {
 	return nil
 }
 
-type UTXORequest struct {
+// The round and proposer of a block are only known to a node which committed
+// the block through consensus. For blocks the node downloaded while catching
+// up or fast syncing RoundKnown is false and Round and Proposer are unset.
+type Block struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UTXOIDs []string `protobuf:"bytes,1,rep,name=UTXOIDs,proto3" json:"UTXOIDs,omitempty"` // []string of hashes
-}
-
-func (x *UTXORequest) Reset() {
-	*x = UTXORequest{}
+	BlockHeader *BlockHeader `protobuf:"bytes,1,opt,name=BlockHeader,proto3" json:"BlockHeader,omitempty"` // TxHshLst holds the txs of the block in order
+	BlockHash   string       `protobuf:"bytes,2,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	Txs         []*Tx        `protobuf:"bytes,3,rep,name=Txs,proto3" json:"Txs,omitempty"` // in the order of TxHshLst; empty unless requested
+	// the round in which the block was committed; zero if RoundKnown is false
+	Round uint32 `protobuf:"varint,4,opt,name=Round,proto3" json:"Round,omitempty"`
+	// the VAddr of the proposer of Round; empty if RoundKnown is false
+	Proposer   string   `protobuf:"bytes,5,opt,name=Proposer,proto3" json:"Proposer,omitempty"`
+	Validators []string `protobuf:"bytes,6,rep,name=Validators,proto3" json:"Validators,omitempty"` // the VAddrs of the validator set which signed the block
+	GroupKey   string   `protobuf:"bytes,7,opt,name=GroupKey,proto3" json:"GroupKey,omitempty"`     // the group key of the validator set
+	// false if the block was downloaded rather than committed by this node,
+	// in which case its round and proposer are unknown
+	RoundKnown bool `protobuf:"varint,8,opt,name=RoundKnown,proto3" json:"RoundKnown,omitempty"`
+}
+
+func (x *Block) Reset() {
+	*x = Block{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[8]
+		mi := &file_localstatetypes_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UTXORequest) String() string {
+func (x *Block) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UTXORequest) ProtoMessage() {}
+func (*Block) ProtoMessage() {}
 
-func (x *UTXORequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[8]
+func (x *Block) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,43 +626,93 @@ func (x *UTXORequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UTXORequest.ProtoReflect.Descriptor instead.
-func (*UTXORequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Block.ProtoReflect.Descriptor instead.
+func (*Block) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UTXORequest) GetUTXOIDs() []string {
+func (x *Block) GetBlockHeader() *BlockHeader {
 	if x != nil {
-		return x.UTXOIDs
+		return x.BlockHeader
 	}
 	return nil
 }
 
-type UTXOResponse struct {
+func (x *Block) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *Block) GetTxs() []*Tx {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *Block) GetRound() uint32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Block) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *Block) GetValidators() []string {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *Block) GetGroupKey() string {
+	if x != nil {
+		return x.GroupKey
+	}
+	return ""
+}
+
+func (x *Block) GetRoundKnown() bool {
+	if x != nil {
+		return x.RoundKnown
+	}
+	return false
+}
+
+type BlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UTXOs []*TXOut `protobuf:"bytes,1,rep,name=UTXOs,proto3" json:"UTXOs,omitempty"`
+	Height     uint32 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"` // must not be zero
+	IncludeTxs bool   `protobuf:"varint,2,opt,name=IncludeTxs,proto3" json:"IncludeTxs,omitempty"`
 }
 
-func (x *UTXOResponse) Reset() {
-	*x = UTXOResponse{}
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[9]
+		mi := &file_localstatetypes_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UTXOResponse) String() string {
+func (x *BlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UTXOResponse) ProtoMessage() {}
+func (*BlockRequest) ProtoMessage() {}
 
-func (x *UTXOResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[9]
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,43 +723,51 @@ func (x *UTXOResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UTXOResponse.ProtoReflect.Descriptor instead.
-func (*UTXOResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UTXOResponse) GetUTXOs() []*TXOut {
+func (x *BlockRequest) GetHeight() uint32 {
 	if x != nil {
-		return x.UTXOs
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-type PendingTransactionRequest struct {
+func (x *BlockRequest) GetIncludeTxs() bool {
+	if x != nil {
+		return x.IncludeTxs
+	}
+	return false
+}
+
+type BlockByHashRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxHash string `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"` // 32 bytes
+	BlockHash  string `protobuf:"bytes,1,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"` // 32 bytes
+	IncludeTxs bool   `protobuf:"varint,2,opt,name=IncludeTxs,proto3" json:"IncludeTxs,omitempty"`
 }
 
-func (x *PendingTransactionRequest) Reset() {
-	*x = PendingTransactionRequest{}
+func (x *BlockByHashRequest) Reset() {
+	*x = BlockByHashRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[10]
+		mi := &file_localstatetypes_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PendingTransactionRequest) String() string {
+func (x *BlockByHashRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PendingTransactionRequest) ProtoMessage() {}
+func (*BlockByHashRequest) ProtoMessage() {}
 
-func (x *PendingTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[10]
+func (x *BlockByHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,43 +778,50 @@ func (x *PendingTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PendingTransactionRequest.ProtoReflect.Descriptor instead.
-func (*PendingTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BlockByHashRequest.ProtoReflect.Descriptor instead.
+func (*BlockByHashRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PendingTransactionRequest) GetTxHash() string {
+func (x *BlockByHashRequest) GetBlockHash() string {
 	if x != nil {
-		return x.TxHash
+		return x.BlockHash
 	}
 	return ""
 }
 
-type PendingTransactionResponse struct {
+func (x *BlockByHashRequest) GetIncludeTxs() bool {
+	if x != nil {
+		return x.IncludeTxs
+	}
+	return false
+}
+
+type BlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tx *Tx `protobuf:"bytes,1,opt,name=Tx,proto3" json:"Tx,omitempty"`
+	Block *Block `protobuf:"bytes,1,opt,name=Block,proto3" json:"Block,omitempty"`
 }
 
-func (x *PendingTransactionResponse) Reset() {
-	*x = PendingTransactionResponse{}
+func (x *BlockResponse) Reset() {
+	*x = BlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[11]
+		mi := &file_localstatetypes_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PendingTransactionResponse) String() string {
+func (x *BlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PendingTransactionResponse) ProtoMessage() {}
+func (*BlockResponse) ProtoMessage() {}
 
-func (x *PendingTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[11]
+func (x *BlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,41 +832,45 @@ func (x *PendingTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PendingTransactionResponse.ProtoReflect.Descriptor instead.
-func (*PendingTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PendingTransactionResponse) GetTx() *Tx {
+func (x *BlockResponse) GetBlock() *Block {
 	if x != nil {
-		return x.Tx
+		return x.Block
 	}
 	return nil
 }
 
-type BlockNumberRequest struct {
+type BlockRangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StartHeight uint32 `protobuf:"varint,1,opt,name=StartHeight,proto3" json:"StartHeight,omitempty"` // must not be zero
+	Count       uint32 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`             // at most 64 blocks are returned
+	IncludeTxs  bool   `protobuf:"varint,3,opt,name=IncludeTxs,proto3" json:"IncludeTxs,omitempty"`
 }
 
-func (x *BlockNumberRequest) Reset() {
-	*x = BlockNumberRequest{}
+func (x *BlockRangeRequest) Reset() {
+	*x = BlockRangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[12]
+		mi := &file_localstatetypes_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockNumberRequest) String() string {
+func (x *BlockRangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockNumberRequest) ProtoMessage() {}
+func (*BlockRangeRequest) ProtoMessage() {}
 
-func (x *BlockNumberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[12]
+func (x *BlockRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,12 +881,1466 @@ func (x *BlockNumberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockNumberRequest.ProtoReflect.Descriptor instead.
-func (*BlockNumberRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use BlockRangeRequest.ProtoReflect.Descriptor instead.
+func (*BlockRangeRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BlockRangeRequest) GetStartHeight() uint32 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *BlockRangeRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BlockRangeRequest) GetIncludeTxs() bool {
+	if x != nil {
+		return x.IncludeTxs
+	}
+	return false
+}
+
+type BlockRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks     []*Block `protobuf:"bytes,1,rep,name=Blocks,proto3" json:"Blocks,omitempty"`
+	NextHeight uint32   `protobuf:"varint,2,opt,name=NextHeight,proto3" json:"NextHeight,omitempty"` // the StartHeight of the next page; zero if there are no more blocks
+}
+
+func (x *BlockRangeResponse) Reset() {
+	*x = BlockRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRangeResponse) ProtoMessage() {}
+
+func (x *BlockRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRangeResponse.ProtoReflect.Descriptor instead.
+func (*BlockRangeResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BlockRangeResponse) GetBlocks() []*Block {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *BlockRangeResponse) GetNextHeight() uint32 {
+	if x != nil {
+		return x.NextHeight
+	}
+	return 0
+}
+
+type ValidatorStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VAddr          string `protobuf:"bytes,1,opt,name=VAddr,proto3" json:"VAddr,omitempty"`
+	Heights        uint32 `protobuf:"varint,2,opt,name=Heights,proto3" json:"Heights,omitempty"`               // indexed heights at which it was in the validator set
+	Rounds         uint32 `protobuf:"varint,3,opt,name=Rounds,proto3" json:"Rounds,omitempty"`                 // rounds it was expected to vote in
+	ProposerRounds uint32 `protobuf:"varint,4,opt,name=ProposerRounds,proto3" json:"ProposerRounds,omitempty"` // rounds in which it was the proposer
+	Proposals      uint32 `protobuf:"varint,5,opt,name=Proposals,proto3" json:"Proposals,omitempty"`           // proposer rounds in which its proposal was seen
+	PreVotes       uint32 `protobuf:"varint,6,opt,name=PreVotes,proto3" json:"PreVotes,omitempty"`             // rounds in which its PreVote or PreVoteNil was seen
+	PreCommits     uint32 `protobuf:"varint,7,opt,name=PreCommits,proto3" json:"PreCommits,omitempty"`         // rounds in which its PreCommit or PreCommitNil was seen
+}
+
+func (x *ValidatorStats) Reset() {
+	*x = ValidatorStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorStats) ProtoMessage() {}
+
+func (x *ValidatorStats) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorStats.ProtoReflect.Descriptor instead.
+func (*ValidatorStats) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ValidatorStats) GetVAddr() string {
+	if x != nil {
+		return x.VAddr
+	}
+	return ""
+}
+
+func (x *ValidatorStats) GetHeights() uint32 {
+	if x != nil {
+		return x.Heights
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetRounds() uint32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetProposerRounds() uint32 {
+	if x != nil {
+		return x.ProposerRounds
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetProposals() uint32 {
+	if x != nil {
+		return x.Proposals
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetPreVotes() uint32 {
+	if x != nil {
+		return x.PreVotes
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetPreCommits() uint32 {
+	if x != nil {
+		return x.PreCommits
+	}
+	return 0
+}
+
+type EpochStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      uint32            `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Heights    uint32            `protobuf:"varint,2,opt,name=Heights,proto3" json:"Heights,omitempty"` // heights of the epoch which were indexed
+	Rounds     uint32            `protobuf:"varint,3,opt,name=Rounds,proto3" json:"Rounds,omitempty"`   // rounds the indexed heights took
+	Validators []*ValidatorStats `protobuf:"bytes,4,rep,name=Validators,proto3" json:"Validators,omitempty"`
+}
+
+func (x *EpochStats) Reset() {
+	*x = EpochStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochStats) ProtoMessage() {}
+
+func (x *EpochStats) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochStats.ProtoReflect.Descriptor instead.
+func (*EpochStats) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EpochStats) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochStats) GetHeights() uint32 {
+	if x != nil {
+		return x.Heights
+	}
+	return 0
+}
+
+func (x *EpochStats) GetRounds() uint32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *EpochStats) GetValidators() []*ValidatorStats {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+type ValidatorStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartEpoch uint32 `protobuf:"varint,1,opt,name=StartEpoch,proto3" json:"StartEpoch,omitempty"` // must not be zero
+	EndEpoch   uint32 `protobuf:"varint,2,opt,name=EndEpoch,proto3" json:"EndEpoch,omitempty"`     // inclusive; at most 64 epochs are returned
+}
+
+func (x *ValidatorStatsRequest) Reset() {
+	*x = ValidatorStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorStatsRequest) ProtoMessage() {}
+
+func (x *ValidatorStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorStatsRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorStatsRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ValidatorStatsRequest) GetStartEpoch() uint32 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *ValidatorStatsRequest) GetEndEpoch() uint32 {
+	if x != nil {
+		return x.EndEpoch
+	}
+	return 0
+}
+
+type ValidatorStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epochs []*EpochStats `protobuf:"bytes,1,rep,name=Epochs,proto3" json:"Epochs,omitempty"` // epochs without indexed heights are left out
+}
+
+func (x *ValidatorStatsResponse) Reset() {
+	*x = ValidatorStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorStatsResponse) ProtoMessage() {}
+
+func (x *ValidatorStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorStatsResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorStatsResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ValidatorStatsResponse) GetEpochs() []*EpochStats {
+	if x != nil {
+		return x.Epochs
+	}
+	return nil
+}
+
+type UTXORequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UTXOIDs []string `protobuf:"bytes,1,rep,name=UTXOIDs,proto3" json:"UTXOIDs,omitempty"` // []string of hashes
+}
+
+func (x *UTXORequest) Reset() {
+	*x = UTXORequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UTXORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTXORequest) ProtoMessage() {}
+
+func (x *UTXORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTXORequest.ProtoReflect.Descriptor instead.
+func (*UTXORequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UTXORequest) GetUTXOIDs() []string {
+	if x != nil {
+		return x.UTXOIDs
+	}
+	return nil
+}
+
+type UTXOResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UTXOs []*TXOut `protobuf:"bytes,1,rep,name=UTXOs,proto3" json:"UTXOs,omitempty"`
+}
+
+func (x *UTXOResponse) Reset() {
+	*x = UTXOResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UTXOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTXOResponse) ProtoMessage() {}
+
+func (x *UTXOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTXOResponse.ProtoReflect.Descriptor instead.
+func (*UTXOResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UTXOResponse) GetUTXOs() []*TXOut {
+	if x != nil {
+		return x.UTXOs
+	}
+	return nil
+}
+
+type PendingTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"` // 32 bytes
+}
+
+func (x *PendingTransactionRequest) Reset() {
+	*x = PendingTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingTransactionRequest) ProtoMessage() {}
+
+func (x *PendingTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingTransactionRequest.ProtoReflect.Descriptor instead.
+func (*PendingTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PendingTransactionRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type PendingTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tx *Tx `protobuf:"bytes,1,opt,name=Tx,proto3" json:"Tx,omitempty"`
+}
+
+func (x *PendingTransactionResponse) Reset() {
+	*x = PendingTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingTransactionResponse) ProtoMessage() {}
+
+func (x *PendingTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingTransactionResponse.ProtoReflect.Descriptor instead.
+func (*PendingTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PendingTransactionResponse) GetTx() *Tx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type BlockNumberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BlockNumberRequest) Reset() {
+	*x = BlockNumberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockNumberRequest) ProtoMessage() {}
+
+func (x *BlockNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockNumberRequest.ProtoReflect.Descriptor instead.
+func (*BlockNumberRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{24}
+}
+
+type BlockNumberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight uint32 `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"` // must not be zero
+}
+
+func (x *BlockNumberResponse) Reset() {
+	*x = BlockNumberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockNumberResponse) ProtoMessage() {}
+
+func (x *BlockNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockNumberResponse.ProtoReflect.Descriptor instead.
+func (*BlockNumberResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BlockNumberResponse) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+type ChainIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChainIDRequest) Reset() {
+	*x = ChainIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainIDRequest) ProtoMessage() {}
+
+func (x *ChainIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainIDRequest.ProtoReflect.Descriptor instead.
+func (*ChainIDRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{26}
+}
+
+type ChainIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainID uint32 `protobuf:"varint,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"` // must not be zero
+}
+
+func (x *ChainIDResponse) Reset() {
+	*x = ChainIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainIDResponse) ProtoMessage() {}
+
+func (x *ChainIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainIDResponse.ProtoReflect.Descriptor instead.
+func (*ChainIDResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ChainIDResponse) GetChainID() uint32 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
+type TransactionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tx *Tx `protobuf:"bytes,1,opt,name=Tx,proto3" json:"Tx,omitempty"`
+}
+
+func (x *TransactionData) Reset() {
+	*x = TransactionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionData) ProtoMessage() {}
+
+func (x *TransactionData) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionData.ProtoReflect.Descriptor instead.
+func (*TransactionData) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TransactionData) GetTx() *Tx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type TransactionDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"` //32 bytes
+}
+
+func (x *TransactionDetails) Reset() {
+	*x = TransactionDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionDetails) ProtoMessage() {}
+
+func (x *TransactionDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionDetails.ProtoReflect.Descriptor instead.
+func (*TransactionDetails) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TransactionDetails) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type TransactionsData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txs []*Tx `protobuf:"bytes,1,rep,name=Txs,proto3" json:"Txs,omitempty"`
+}
+
+func (x *TransactionsData) Reset() {
+	*x = TransactionsData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionsData) ProtoMessage() {}
+
+func (x *TransactionsData) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionsData.ProtoReflect.Descriptor instead.
+func (*TransactionsData) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TransactionsData) GetTxs() []*Tx {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+type TransactionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash   string `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"` //32 bytes; empty if the tx could not be decoded
+	Accepted bool   `protobuf:"varint,2,opt,name=Accepted,proto3" json:"Accepted,omitempty"`
+	Error    string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	Kind     string `protobuf:"bytes,4,opt,name=Kind,proto3" json:"Kind,omitempty"` // kind of error as reported by the HTTP gateway
+}
+
+func (x *TransactionResult) Reset() {
+	*x = TransactionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResult) ProtoMessage() {}
+
+func (x *TransactionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResult.ProtoReflect.Descriptor instead.
+func (*TransactionResult) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TransactionResult) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *TransactionResult) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *TransactionResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TransactionResult) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type TransactionsDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*TransactionResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"` // one result per tx in request order
+}
+
+func (x *TransactionsDetails) Reset() {
+	*x = TransactionsDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionsDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionsDetails) ProtoMessage() {}
+
+func (x *TransactionsDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionsDetails.ProtoReflect.Descriptor instead.
+func (*TransactionsDetails) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TransactionsDetails) GetResults() []*TransactionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ValidationReason struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index  uint32 `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"` // position of the object in Vin or Vout
+	Reason string `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *ValidationReason) Reset() {
+	*x = ValidationReason{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidationReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationReason) ProtoMessage() {}
+
+func (x *ValidationReason) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationReason.ProtoReflect.Descriptor instead.
+func (*ValidationReason) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ValidationReason) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidationReason) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ValidateTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash  string              `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"` //32 bytes
+	Valid   bool                `protobuf:"varint,2,opt,name=Valid,proto3" json:"Valid,omitempty"`
+	Reasons []string            `protobuf:"bytes,3,rep,name=Reasons,proto3" json:"Reasons,omitempty"` // failures concerning the tx as a whole
+	Vin     []*ValidationReason `protobuf:"bytes,4,rep,name=Vin,proto3" json:"Vin,omitempty"`
+	Vout    []*ValidationReason `protobuf:"bytes,5,rep,name=Vout,proto3" json:"Vout,omitempty"`
+}
+
+func (x *ValidateTransactionResponse) Reset() {
+	*x = ValidateTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTransactionResponse) ProtoMessage() {}
+
+func (x *ValidateTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTransactionResponse.ProtoReflect.Descriptor instead.
+func (*ValidateTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ValidateTransactionResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *ValidateTransactionResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateTransactionResponse) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *ValidateTransactionResponse) GetVin() []*ValidationReason {
+	if x != nil {
+		return x.Vin
+	}
+	return nil
+}
+
+func (x *ValidateTransactionResponse) GetVout() []*ValidationReason {
+	if x != nil {
+		return x.Vout
+	}
+	return nil
+}
+
+type FeesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FeesRequest) Reset() {
+	*x = FeesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeesRequest) ProtoMessage() {}
+
+func (x *FeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeesRequest.ProtoReflect.Descriptor instead.
+func (*FeesRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{35}
+}
+
+type FeesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinTxFee          string `protobuf:"bytes,1,opt,name=MinTxFee,proto3" json:"MinTxFee,omitempty"`
+	ValueStoreFee     string `protobuf:"bytes,2,opt,name=ValueStoreFee,proto3" json:"ValueStoreFee,omitempty"`
+	DataStoreEpochFee string `protobuf:"bytes,3,opt,name=DataStoreEpochFee,proto3" json:"DataStoreEpochFee,omitempty"`
+	AtomicSwapFee     string `protobuf:"bytes,4,opt,name=AtomicSwapFee,proto3" json:"AtomicSwapFee,omitempty"`
+}
+
+func (x *FeesResponse) Reset() {
+	*x = FeesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeesResponse) ProtoMessage() {}
+
+func (x *FeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeesResponse.ProtoReflect.Descriptor instead.
+func (*FeesResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FeesResponse) GetMinTxFee() string {
+	if x != nil {
+		return x.MinTxFee
+	}
+	return ""
+}
+
+func (x *FeesResponse) GetValueStoreFee() string {
+	if x != nil {
+		return x.ValueStoreFee
+	}
+	return ""
+}
+
+func (x *FeesResponse) GetDataStoreEpochFee() string {
+	if x != nil {
+		return x.DataStoreEpochFee
+	}
+	return ""
+}
+
+func (x *FeesResponse) GetAtomicSwapFee() string {
+	if x != nil {
+		return x.AtomicSwapFee
+	}
+	return ""
+}
+
+type EpochNumberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EpochNumberRequest) Reset() {
+	*x = EpochNumberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochNumberRequest) ProtoMessage() {}
+
+func (x *EpochNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochNumberRequest.ProtoReflect.Descriptor instead.
+func (*EpochNumberRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{37}
+}
+
+type EpochNumberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint32 `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"` // must not be zero
+}
+
+func (x *EpochNumberResponse) Reset() {
+	*x = EpochNumberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type BlockNumberResponse struct {
+func (x *EpochNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochNumberResponse) ProtoMessage() {}
+
+func (x *EpochNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochNumberResponse.ProtoReflect.Descriptor instead.
+func (*EpochNumberResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EpochNumberResponse) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type IterateNameSpaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurveSpec  uint32 `protobuf:"varint,1,opt,name=CurveSpec,proto3" json:"CurveSpec,omitempty"`
+	Account    string `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`       // 20 bytes
+	Number     uint32 `protobuf:"varint,3,opt,name=Number,proto3" json:"Number,omitempty"`        // not more than 256
+	StartIndex string `protobuf:"bytes,4,opt,name=StartIndex,proto3" json:"StartIndex,omitempty"` // 32 bytes
+}
+
+func (x *IterateNameSpaceRequest) Reset() {
+	*x = IterateNameSpaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IterateNameSpaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IterateNameSpaceRequest) ProtoMessage() {}
+
+func (x *IterateNameSpaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IterateNameSpaceRequest.ProtoReflect.Descriptor instead.
+func (*IterateNameSpaceRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *IterateNameSpaceRequest) GetCurveSpec() uint32 {
+	if x != nil {
+		return x.CurveSpec
+	}
+	return 0
+}
+
+func (x *IterateNameSpaceRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *IterateNameSpaceRequest) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *IterateNameSpaceRequest) GetStartIndex() string {
+	if x != nil {
+		return x.StartIndex
+	}
+	return ""
+}
+
+type IterateNameSpaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*IterateNameSpaceResponse_Result `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+}
+
+func (x *IterateNameSpaceResponse) Reset() {
+	*x = IterateNameSpaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IterateNameSpaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IterateNameSpaceResponse) ProtoMessage() {}
+
+func (x *IterateNameSpaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IterateNameSpaceResponse.ProtoReflect.Descriptor instead.
+func (*IterateNameSpaceResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *IterateNameSpaceResponse) GetResults() []*IterateNameSpaceResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type TxBlockNumberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"` //32 bytes
+}
+
+func (x *TxBlockNumberRequest) Reset() {
+	*x = TxBlockNumberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxBlockNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxBlockNumberRequest) ProtoMessage() {}
+
+func (x *TxBlockNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxBlockNumberRequest.ProtoReflect.Descriptor instead.
+func (*TxBlockNumberRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TxBlockNumberRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type TxBlockNumberResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -699,23 +2348,23 @@ type BlockNumberResponse struct {
 	BlockHeight uint32 `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"` // must not be zero
 }
 
-func (x *BlockNumberResponse) Reset() {
-	*x = BlockNumberResponse{}
+func (x *TxBlockNumberResponse) Reset() {
+	*x = TxBlockNumberResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[13]
+		mi := &file_localstatetypes_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockNumberResponse) String() string {
+func (x *TxBlockNumberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockNumberResponse) ProtoMessage() {}
+func (*TxBlockNumberResponse) ProtoMessage() {}
 
-func (x *BlockNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[13]
+func (x *TxBlockNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,41 +2375,90 @@ func (x *BlockNumberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockNumberResponse.ProtoReflect.Descriptor instead.
-func (*BlockNumberResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use TxBlockNumberResponse.ProtoReflect.Descriptor instead.
+func (*TxBlockNumberResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *BlockNumberResponse) GetBlockHeight() uint32 {
+func (x *TxBlockNumberResponse) GetBlockHeight() uint32 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-type ChainIDRequest struct {
+type ValidatorSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint32 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"` // ignore for now
+}
+
+func (x *ValidatorSetRequest) Reset() {
+	*x = ValidatorSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSetRequest) ProtoMessage() {}
+
+func (x *ValidatorSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorSetRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorSetRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ValidatorSetRequest) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ValidatorSetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ValidatorSet string `protobuf:"bytes,1,opt,name=ValidatorSet,proto3" json:"ValidatorSet,omitempty"` // ignore for now
 }
 
-func (x *ChainIDRequest) Reset() {
-	*x = ChainIDRequest{}
+func (x *ValidatorSetResponse) Reset() {
+	*x = ValidatorSetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[14]
+		mi := &file_localstatetypes_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChainIDRequest) String() string {
+func (x *ValidatorSetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChainIDRequest) ProtoMessage() {}
+func (*ValidatorSetResponse) ProtoMessage() {}
 
-func (x *ChainIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[14]
+func (x *ValidatorSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,36 +2469,45 @@ func (x *ChainIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChainIDRequest.ProtoReflect.Descriptor instead.
-func (*ChainIDRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ValidatorSetResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{44}
 }
 
-type ChainIDResponse struct {
+func (x *ValidatorSetResponse) GetValidatorSet() string {
+	if x != nil {
+		return x.ValidatorSet
+	}
+	return ""
+}
+
+type RoundStateForValidatorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainID uint32 `protobuf:"varint,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"` // must not be zero
+	VAddr  string `protobuf:"bytes,1,opt,name=VAddr,proto3" json:"VAddr,omitempty"`    // ignore for now
+	Height uint32 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"` // ignore for now
+	Round  uint32 `protobuf:"varint,3,opt,name=Round,proto3" json:"Round,omitempty"`   // ignore for now
 }
 
-func (x *ChainIDResponse) Reset() {
-	*x = ChainIDResponse{}
+func (x *RoundStateForValidatorRequest) Reset() {
+	*x = RoundStateForValidatorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[15]
+		mi := &file_localstatetypes_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChainIDResponse) String() string {
+func (x *RoundStateForValidatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChainIDResponse) ProtoMessage() {}
+func (*RoundStateForValidatorRequest) ProtoMessage() {}
 
-func (x *ChainIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[15]
+func (x *RoundStateForValidatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -811,43 +2518,58 @@ func (x *ChainIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChainIDResponse.ProtoReflect.Descriptor instead.
-func (*ChainIDResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RoundStateForValidatorRequest.ProtoReflect.Descriptor instead.
+func (*RoundStateForValidatorRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ChainIDResponse) GetChainID() uint32 {
+func (x *RoundStateForValidatorRequest) GetVAddr() string {
 	if x != nil {
-		return x.ChainID
+		return x.VAddr
+	}
+	return ""
+}
+
+func (x *RoundStateForValidatorRequest) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
 	}
 	return 0
 }
 
-type TransactionData struct {
+func (x *RoundStateForValidatorRequest) GetRound() uint32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+type RoundStateForValidatorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tx *Tx `protobuf:"bytes,1,opt,name=Tx,proto3" json:"Tx,omitempty"`
+	// ignore for now; hex encoded by the HTTP gateway
+	RoundState []byte `protobuf:"bytes,1,opt,name=RoundState,proto3" json:"RoundState,omitempty"`
 }
 
-func (x *TransactionData) Reset() {
-	*x = TransactionData{}
+func (x *RoundStateForValidatorResponse) Reset() {
+	*x = RoundStateForValidatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[16]
+		mi := &file_localstatetypes_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactionData) String() string {
+func (x *RoundStateForValidatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionData) ProtoMessage() {}
+func (*RoundStateForValidatorResponse) ProtoMessage() {}
 
-func (x *TransactionData) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[16]
+func (x *RoundStateForValidatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,43 +2580,43 @@ func (x *TransactionData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionData.ProtoReflect.Descriptor instead.
-func (*TransactionData) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RoundStateForValidatorResponse.ProtoReflect.Descriptor instead.
+func (*RoundStateForValidatorResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *TransactionData) GetTx() *Tx {
+func (x *RoundStateForValidatorResponse) GetRoundState() []byte {
 	if x != nil {
-		return x.Tx
+		return x.RoundState
 	}
 	return nil
 }
 
-type TransactionDetails struct {
+type GetWithdrawalProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxHash string `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"` //32 bytes
+	UTXOID string `protobuf:"bytes,1,opt,name=UTXOID,proto3" json:"UTXOID,omitempty"` // 32 bytes
 }
 
-func (x *TransactionDetails) Reset() {
-	*x = TransactionDetails{}
+func (x *GetWithdrawalProofRequest) Reset() {
+	*x = GetWithdrawalProofRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[17]
+		mi := &file_localstatetypes_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactionDetails) String() string {
+func (x *GetWithdrawalProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionDetails) ProtoMessage() {}
+func (*GetWithdrawalProofRequest) ProtoMessage() {}
 
-func (x *TransactionDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[17]
+func (x *GetWithdrawalProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -905,41 +2627,51 @@ func (x *TransactionDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionDetails.ProtoReflect.Descriptor instead.
-func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetWithdrawalProofRequest.ProtoReflect.Descriptor instead.
+func (*GetWithdrawalProofRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *TransactionDetails) GetTxHash() string {
+func (x *GetWithdrawalProofRequest) GetUTXOID() string {
 	if x != nil {
-		return x.TxHash
+		return x.UTXOID
 	}
 	return ""
 }
 
-type EpochNumberRequest struct {
+type GetWithdrawalProofResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Withdrawal  *Withdrawal  `protobuf:"bytes,1,opt,name=Withdrawal,proto3" json:"Withdrawal,omitempty"`
+	ABI         string       `protobuf:"bytes,2,opt,name=ABI,proto3" json:"ABI,omitempty"`                 // the withdrawal as abi encoded for the contract
+	BlockHeader *BlockHeader `protobuf:"bytes,3,opt,name=BlockHeader,proto3" json:"BlockHeader,omitempty"` // the snapshot the proof is anchored to
+	Bitmap      string       `protobuf:"bytes,4,opt,name=Bitmap,proto3" json:"Bitmap,omitempty"`
+	AuditPath   []string     `protobuf:"bytes,5,rep,name=AuditPath,proto3" json:"AuditPath,omitempty"`
+	ProofHeight uint32       `protobuf:"varint,6,opt,name=ProofHeight,proto3" json:"ProofHeight,omitempty"`
+	ProofKey    string       `protobuf:"bytes,7,opt,name=ProofKey,proto3" json:"ProofKey,omitempty"`
+	ProofValue  string       `protobuf:"bytes,8,opt,name=ProofValue,proto3" json:"ProofValue,omitempty"`
+	Contract    string       `protobuf:"bytes,9,opt,name=Contract,proto3" json:"Contract,omitempty"` // the address of the merkle proof contract
 }
 
-func (x *EpochNumberRequest) Reset() {
-	*x = EpochNumberRequest{}
+func (x *GetWithdrawalProofResponse) Reset() {
+	*x = GetWithdrawalProofResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[18]
+		mi := &file_localstatetypes_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EpochNumberRequest) String() string {
+func (x *GetWithdrawalProofResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpochNumberRequest) ProtoMessage() {}
+func (*GetWithdrawalProofResponse) ProtoMessage() {}
 
-func (x *EpochNumberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[18]
+func (x *GetWithdrawalProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,36 +2682,100 @@ func (x *EpochNumberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpochNumberRequest.ProtoReflect.Descriptor instead.
-func (*EpochNumberRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetWithdrawalProofResponse.ProtoReflect.Descriptor instead.
+func (*GetWithdrawalProofResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{48}
 }
 
-type EpochNumberResponse struct {
+func (x *GetWithdrawalProofResponse) GetWithdrawal() *Withdrawal {
+	if x != nil {
+		return x.Withdrawal
+	}
+	return nil
+}
+
+func (x *GetWithdrawalProofResponse) GetABI() string {
+	if x != nil {
+		return x.ABI
+	}
+	return ""
+}
+
+func (x *GetWithdrawalProofResponse) GetBlockHeader() *BlockHeader {
+	if x != nil {
+		return x.BlockHeader
+	}
+	return nil
+}
+
+func (x *GetWithdrawalProofResponse) GetBitmap() string {
+	if x != nil {
+		return x.Bitmap
+	}
+	return ""
+}
+
+func (x *GetWithdrawalProofResponse) GetAuditPath() []string {
+	if x != nil {
+		return x.AuditPath
+	}
+	return nil
+}
+
+func (x *GetWithdrawalProofResponse) GetProofHeight() uint32 {
+	if x != nil {
+		return x.ProofHeight
+	}
+	return 0
+}
+
+func (x *GetWithdrawalProofResponse) GetProofKey() string {
+	if x != nil {
+		return x.ProofKey
+	}
+	return ""
+}
+
+func (x *GetWithdrawalProofResponse) GetProofValue() string {
+	if x != nil {
+		return x.ProofValue
+	}
+	return ""
+}
+
+func (x *GetWithdrawalProofResponse) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+type GetWithdrawalsForAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Epoch uint32 `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"` // must not be zero
+	Account  string `protobuf:"bytes,1,opt,name=Account,proto3" json:"Account,omitempty"`   // 20 bytes Ethereum address
+	StartKey string `protobuf:"bytes,2,opt,name=StartKey,proto3" json:"StartKey,omitempty"` // returned by a previous request to continue from
 }
 
-func (x *EpochNumberResponse) Reset() {
-	*x = EpochNumberResponse{}
+func (x *GetWithdrawalsForAccountRequest) Reset() {
+	*x = GetWithdrawalsForAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[19]
+		mi := &file_localstatetypes_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EpochNumberResponse) String() string {
+func (x *GetWithdrawalsForAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpochNumberResponse) ProtoMessage() {}
+func (*GetWithdrawalsForAccountRequest) ProtoMessage() {}
 
-func (x *EpochNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[19]
+func (x *GetWithdrawalsForAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -990,46 +2786,51 @@ func (x *EpochNumberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpochNumberResponse.ProtoReflect.Descriptor instead.
-func (*EpochNumberResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetWithdrawalsForAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetWithdrawalsForAccountRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *EpochNumberResponse) GetEpoch() uint32 {
+func (x *GetWithdrawalsForAccountRequest) GetAccount() string {
 	if x != nil {
-		return x.Epoch
+		return x.Account
 	}
-	return 0
+	return ""
 }
 
-type IterateNameSpaceRequest struct {
+func (x *GetWithdrawalsForAccountRequest) GetStartKey() string {
+	if x != nil {
+		return x.StartKey
+	}
+	return ""
+}
+
+type GetWithdrawalsForAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CurveSpec  uint32 `protobuf:"varint,1,opt,name=CurveSpec,proto3" json:"CurveSpec,omitempty"`
-	Account    string `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`       // 20 bytes
-	Number     uint32 `protobuf:"varint,3,opt,name=Number,proto3" json:"Number,omitempty"`        // not more than 256
-	StartIndex string `protobuf:"bytes,4,opt,name=StartIndex,proto3" json:"StartIndex,omitempty"` // 32 bytes
+	UTXOIDs  []string `protobuf:"bytes,1,rep,name=UTXOIDs,proto3" json:"UTXOIDs,omitempty"`   // []string of hashes
+	StartKey string   `protobuf:"bytes,2,opt,name=StartKey,proto3" json:"StartKey,omitempty"` // empty if there are no more results
 }
 
-func (x *IterateNameSpaceRequest) Reset() {
-	*x = IterateNameSpaceRequest{}
+func (x *GetWithdrawalsForAccountResponse) Reset() {
+	*x = GetWithdrawalsForAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[20]
+		mi := &file_localstatetypes_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IterateNameSpaceRequest) String() string {
+func (x *GetWithdrawalsForAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IterateNameSpaceRequest) ProtoMessage() {}
+func (*GetWithdrawalsForAccountResponse) ProtoMessage() {}
 
-func (x *IterateNameSpaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[20]
+func (x *GetWithdrawalsForAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,64 +2841,50 @@ func (x *IterateNameSpaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IterateNameSpaceRequest.ProtoReflect.Descriptor instead.
-func (*IterateNameSpaceRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *IterateNameSpaceRequest) GetCurveSpec() uint32 {
-	if x != nil {
-		return x.CurveSpec
-	}
-	return 0
-}
-
-func (x *IterateNameSpaceRequest) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
+// Deprecated: Use GetWithdrawalsForAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetWithdrawalsForAccountResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *IterateNameSpaceRequest) GetNumber() uint32 {
+func (x *GetWithdrawalsForAccountResponse) GetUTXOIDs() []string {
 	if x != nil {
-		return x.Number
+		return x.UTXOIDs
 	}
-	return 0
+	return nil
 }
 
-func (x *IterateNameSpaceRequest) GetStartIndex() string {
+func (x *GetWithdrawalsForAccountResponse) GetStartKey() string {
 	if x != nil {
-		return x.StartIndex
+		return x.StartKey
 	}
 	return ""
 }
 
-type IterateNameSpaceResponse struct {
+type GetSpendingTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Results []*IterateNameSpaceResponse_Result `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+	UTXOID string `protobuf:"bytes,1,opt,name=UTXOID,proto3" json:"UTXOID,omitempty"` // 32 bytes
 }
 
-func (x *IterateNameSpaceResponse) Reset() {
-	*x = IterateNameSpaceResponse{}
+func (x *GetSpendingTransactionRequest) Reset() {
+	*x = GetSpendingTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[21]
+		mi := &file_localstatetypes_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IterateNameSpaceResponse) String() string {
+func (x *GetSpendingTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IterateNameSpaceResponse) ProtoMessage() {}
+func (*GetSpendingTransactionRequest) ProtoMessage() {}
 
-func (x *IterateNameSpaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[21]
+func (x *GetSpendingTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1108,43 +2895,44 @@ func (x *IterateNameSpaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IterateNameSpaceResponse.ProtoReflect.Descriptor instead.
-func (*IterateNameSpaceResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetSpendingTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetSpendingTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *IterateNameSpaceResponse) GetResults() []*IterateNameSpaceResponse_Result {
+func (x *GetSpendingTransactionRequest) GetUTXOID() string {
 	if x != nil {
-		return x.Results
+		return x.UTXOID
 	}
-	return nil
+	return ""
 }
 
-type TxBlockNumberRequest struct {
+type GetSpendingTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxHash string `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"` //32 bytes
+	TxHash string `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`  // 32 bytes
+	Height uint32 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"` // the height at which the tx was mined
 }
 
-func (x *TxBlockNumberRequest) Reset() {
-	*x = TxBlockNumberRequest{}
+func (x *GetSpendingTransactionResponse) Reset() {
+	*x = GetSpendingTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[22]
+		mi := &file_localstatetypes_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TxBlockNumberRequest) String() string {
+func (x *GetSpendingTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxBlockNumberRequest) ProtoMessage() {}
+func (*GetSpendingTransactionResponse) ProtoMessage() {}
 
-func (x *TxBlockNumberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[22]
+func (x *GetSpendingTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,43 +2943,50 @@ func (x *TxBlockNumberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxBlockNumberRequest.ProtoReflect.Descriptor instead.
-func (*TxBlockNumberRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetSpendingTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetSpendingTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *TxBlockNumberRequest) GetTxHash() string {
+func (x *GetSpendingTransactionResponse) GetTxHash() string {
 	if x != nil {
 		return x.TxHash
 	}
-	return ""
+	return ""
+}
+
+func (x *GetSpendingTransactionResponse) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
 }
 
-type TxBlockNumberResponse struct {
+type SupplyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockHeight uint32 `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"` // must not be zero
+	Height uint32 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"` // zero for the latest height
 }
 
-func (x *TxBlockNumberResponse) Reset() {
-	*x = TxBlockNumberResponse{}
+func (x *SupplyRequest) Reset() {
+	*x = SupplyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[23]
+		mi := &file_localstatetypes_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TxBlockNumberResponse) String() string {
+func (x *SupplyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxBlockNumberResponse) ProtoMessage() {}
+func (*SupplyRequest) ProtoMessage() {}
 
-func (x *TxBlockNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[23]
+func (x *SupplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,43 +2997,50 @@ func (x *TxBlockNumberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxBlockNumberResponse.ProtoReflect.Descriptor instead.
-func (*TxBlockNumberResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SupplyRequest.ProtoReflect.Descriptor instead.
+func (*SupplyRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *TxBlockNumberResponse) GetBlockHeight() uint32 {
+func (x *SupplyRequest) GetHeight() uint32 {
 	if x != nil {
-		return x.BlockHeight
+		return x.Height
 	}
 	return 0
 }
 
-type ValidatorSetRequest struct {
+type SupplyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height uint32 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"` // ignore for now
+	Height            uint32 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Deposits          string `protobuf:"bytes,2,opt,name=Deposits,proto3" json:"Deposits,omitempty"`                   // value of the deposits consumed at the height
+	Fees              string `protobuf:"bytes,3,opt,name=Fees,proto3" json:"Fees,omitempty"`                           // value paid as fees at the height
+	DataStoreDeposits string `protobuf:"bytes,4,opt,name=DataStoreDeposits,proto3" json:"DataStoreDeposits,omitempty"` // value locked in the DataStores created at the height
+	DataStoreRefunds  string `protobuf:"bytes,5,opt,name=DataStoreRefunds,proto3" json:"DataStoreRefunds,omitempty"`   // value returned by the DataStores consumed at the height
+	DataStoreBurned   string `protobuf:"bytes,6,opt,name=DataStoreBurned,proto3" json:"DataStoreBurned,omitempty"`     // value of the DataStores consumed at the height which was not returned
+	Withdrawals       string `protobuf:"bytes,7,opt,name=Withdrawals,proto3" json:"Withdrawals,omitempty"`             // value withdrawn to Ethereum at the height
+	ActiveValue       string `protobuf:"bytes,8,opt,name=ActiveValue,proto3" json:"ActiveValue,omitempty"`             // value of the spendable UTXOs after the height
 }
 
-func (x *ValidatorSetRequest) Reset() {
-	*x = ValidatorSetRequest{}
+func (x *SupplyResponse) Reset() {
+	*x = SupplyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[24]
+		mi := &file_localstatetypes_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorSetRequest) String() string {
+func (x *SupplyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorSetRequest) ProtoMessage() {}
+func (*SupplyResponse) ProtoMessage() {}
 
-func (x *ValidatorSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[24]
+func (x *SupplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,43 +3051,92 @@ func (x *ValidatorSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorSetRequest.ProtoReflect.Descriptor instead.
-func (*ValidatorSetRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SupplyResponse.ProtoReflect.Descriptor instead.
+func (*SupplyResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ValidatorSetRequest) GetHeight() uint32 {
+func (x *SupplyResponse) GetHeight() uint32 {
 	if x != nil {
 		return x.Height
 	}
 	return 0
 }
 
-type ValidatorSetResponse struct {
+func (x *SupplyResponse) GetDeposits() string {
+	if x != nil {
+		return x.Deposits
+	}
+	return ""
+}
+
+func (x *SupplyResponse) GetFees() string {
+	if x != nil {
+		return x.Fees
+	}
+	return ""
+}
+
+func (x *SupplyResponse) GetDataStoreDeposits() string {
+	if x != nil {
+		return x.DataStoreDeposits
+	}
+	return ""
+}
+
+func (x *SupplyResponse) GetDataStoreRefunds() string {
+	if x != nil {
+		return x.DataStoreRefunds
+	}
+	return ""
+}
+
+func (x *SupplyResponse) GetDataStoreBurned() string {
+	if x != nil {
+		return x.DataStoreBurned
+	}
+	return ""
+}
+
+func (x *SupplyResponse) GetWithdrawals() string {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return ""
+}
+
+func (x *SupplyResponse) GetActiveValue() string {
+	if x != nil {
+		return x.ActiveValue
+	}
+	return ""
+}
+
+type FeaturesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValidatorSet string `protobuf:"bytes,1,opt,name=ValidatorSet,proto3" json:"ValidatorSet,omitempty"` // ignore for now
+	Epoch uint32 `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"` // epoch at which Active is computed; zero for the current epoch
 }
 
-func (x *ValidatorSetResponse) Reset() {
-	*x = ValidatorSetResponse{}
+func (x *FeaturesRequest) Reset() {
+	*x = FeaturesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[25]
+		mi := &file_localstatetypes_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorSetResponse) String() string {
+func (x *FeaturesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorSetResponse) ProtoMessage() {}
+func (*FeaturesRequest) ProtoMessage() {}
 
-func (x *ValidatorSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[25]
+func (x *FeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,45 +3147,48 @@ func (x *ValidatorSetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorSetResponse.ProtoReflect.Descriptor instead.
-func (*ValidatorSetResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use FeaturesRequest.ProtoReflect.Descriptor instead.
+func (*FeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ValidatorSetResponse) GetValidatorSet() string {
+func (x *FeaturesRequest) GetEpoch() uint32 {
 	if x != nil {
-		return x.ValidatorSet
+		return x.Epoch
 	}
-	return ""
+	return 0
 }
 
-type RoundStateForValidatorRequest struct {
+type Feature struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VAddr  string `protobuf:"bytes,1,opt,name=VAddr,proto3" json:"VAddr,omitempty"`    // ignore for now
-	Height uint32 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"` // ignore for now
-	Round  uint32 `protobuf:"varint,3,opt,name=Round,proto3" json:"Round,omitempty"`   // ignore for now
+	Name            string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description     string `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	Registered      bool   `protobuf:"varint,3,opt,name=Registered,proto3" json:"Registered,omitempty"`           // true if this node knows the feature
+	Scheduled       bool   `protobuf:"varint,4,opt,name=Scheduled,proto3" json:"Scheduled,omitempty"`             // true if governance has activated the feature
+	ActivationEpoch uint32 `protobuf:"varint,5,opt,name=ActivationEpoch,proto3" json:"ActivationEpoch,omitempty"` // first epoch at which the feature is active
+	Active          bool   `protobuf:"varint,6,opt,name=Active,proto3" json:"Active,omitempty"`                   // true if the feature is active at the requested epoch
 }
 
-func (x *RoundStateForValidatorRequest) Reset() {
-	*x = RoundStateForValidatorRequest{}
+func (x *Feature) Reset() {
+	*x = Feature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[26]
+		mi := &file_localstatetypes_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RoundStateForValidatorRequest) String() string {
+func (x *Feature) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoundStateForValidatorRequest) ProtoMessage() {}
+func (*Feature) ProtoMessage() {}
 
-func (x *RoundStateForValidatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[26]
+func (x *Feature) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,57 +3199,79 @@ func (x *RoundStateForValidatorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoundStateForValidatorRequest.ProtoReflect.Descriptor instead.
-func (*RoundStateForValidatorRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use Feature.ProtoReflect.Descriptor instead.
+func (*Feature) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *RoundStateForValidatorRequest) GetVAddr() string {
+func (x *Feature) GetName() string {
 	if x != nil {
-		return x.VAddr
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RoundStateForValidatorRequest) GetHeight() uint32 {
+func (x *Feature) GetDescription() string {
 	if x != nil {
-		return x.Height
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *RoundStateForValidatorRequest) GetRound() uint32 {
+func (x *Feature) GetRegistered() bool {
 	if x != nil {
-		return x.Round
+		return x.Registered
+	}
+	return false
+}
+
+func (x *Feature) GetScheduled() bool {
+	if x != nil {
+		return x.Scheduled
+	}
+	return false
+}
+
+func (x *Feature) GetActivationEpoch() uint32 {
+	if x != nil {
+		return x.ActivationEpoch
 	}
 	return 0
 }
 
-type RoundStateForValidatorResponse struct {
+func (x *Feature) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type FeaturesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoundState []byte `protobuf:"bytes,1,opt,name=RoundState,proto3" json:"RoundState,omitempty"` // ignore for now
+	Epoch    uint32     `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Features []*Feature `protobuf:"bytes,2,rep,name=Features,proto3" json:"Features,omitempty"`
 }
 
-func (x *RoundStateForValidatorResponse) Reset() {
-	*x = RoundStateForValidatorResponse{}
+func (x *FeaturesResponse) Reset() {
+	*x = FeaturesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[27]
+		mi := &file_localstatetypes_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RoundStateForValidatorResponse) String() string {
+func (x *FeaturesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoundStateForValidatorResponse) ProtoMessage() {}
+func (*FeaturesResponse) ProtoMessage() {}
 
-func (x *RoundStateForValidatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[27]
+func (x *FeaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1406,14 +3282,21 @@ func (x *RoundStateForValidatorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoundStateForValidatorResponse.ProtoReflect.Descriptor instead.
-func (*RoundStateForValidatorResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use FeaturesResponse.ProtoReflect.Descriptor instead.
+func (*FeaturesResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *RoundStateForValidatorResponse) GetRoundState() []byte {
+func (x *FeaturesResponse) GetEpoch() uint32 {
 	if x != nil {
-		return x.RoundState
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *FeaturesResponse) GetFeatures() []*Feature {
+	if x != nil {
+		return x.Features
 	}
 	return nil
 }
@@ -1430,7 +3313,7 @@ type IterateNameSpaceResponse_Result struct {
 func (x *IterateNameSpaceResponse_Result) Reset() {
 	*x = IterateNameSpaceResponse_Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[28]
+		mi := &file_localstatetypes_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1443,7 +3326,7 @@ func (x *IterateNameSpaceResponse_Result) String() string {
 func (*IterateNameSpaceResponse_Result) ProtoMessage() {}
 
 func (x *IterateNameSpaceResponse_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[28]
+	mi := &file_localstatetypes_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1456,7 +3339,7 @@ func (x *IterateNameSpaceResponse_Result) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IterateNameSpaceResponse_Result.ProtoReflect.Descriptor instead.
 func (*IterateNameSpaceResponse_Result) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{21, 0}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{40, 0}
 }
 
 func (x *IterateNameSpaceResponse_Result) GetUTXOID() string {
@@ -1488,67 +3371,193 @@ var file_localstatetypes_proto_rawDesc = []byte{
 	0x09, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44,
 	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x52,
 	0x61, 0x77, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x61,
-	0x77, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x75, 0x72,
+	0x77, 0x64, 0x61, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x75, 0x72,
 	0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x43, 0x75,
 	0x72, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75,
 	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x4d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a,
-	0x0f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x55, 0x54, 0x58, 0x4f, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x55,
-	0x54, 0x58, 0x4f, 0x49, 0x44, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x22, 0x31, 0x0a, 0x17, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x52, 0x61, 0x77, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52,
+	0x61, 0x77, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x75,
+	0x72, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x43,
+	0x75, 0x72, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x55, 0x54, 0x58, 0x4f, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x55, 0x54, 0x58, 0x4f, 0x49, 0x44, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x31, 0x0a, 0x17, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x35, 0x0a, 0x18, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x19, 0x0a, 0x02, 0x54, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x52, 0x02, 0x54, 0x78, 0x22, 0x2c, 0x0a,
+	0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x86, 0x02, 0x0a, 0x05, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x34, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x03, 0x54, 0x78, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x52, 0x03,
+	0x54, 0x78, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x22, 0x46, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x54, 0x78, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x49,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x78, 0x73, 0x22, 0x52, 0x0a, 0x12, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a,
+	0x0a, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x78, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x78, 0x73, 0x22, 0x33, 0x0a,
+	0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
+	0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x6b, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x78, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x78, 0x73, 0x22,
+	0x5a, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e,
+	0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x0e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x56, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x50, 0x72,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0a, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x35, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0a, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x53, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x45, 0x6e, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x43, 0x0a, 0x16, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x22, 0x27, 0x0a, 0x0b, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x55, 0x54, 0x58, 0x4f, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x55, 0x54, 0x58, 0x4f, 0x49, 0x44, 0x73, 0x22, 0x32, 0x0a, 0x0c, 0x55, 0x54, 0x58,
+	0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x55, 0x54, 0x58,
+	0x4f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x52, 0x05, 0x55, 0x54, 0x58, 0x4f, 0x73, 0x22, 0x33, 0x0a,
+	0x19, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0x37, 0x0a, 0x1a, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x19, 0x0a, 0x02, 0x54, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x52, 0x02, 0x54, 0x78, 0x22, 0x14, 0x0a, 0x12, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x37, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x0f,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x0f, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x02,
+	0x54, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x78, 0x52, 0x02, 0x54, 0x78, 0x22, 0x2c, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x16, 0x0a,
 	0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54,
-	0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x35, 0x0a, 0x18, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x19, 0x0a, 0x02, 0x54, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x52, 0x02, 0x54, 0x78, 0x22, 0x2c, 0x0a, 0x12,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x34, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x27, 0x0a, 0x0b, 0x55, 0x54, 0x58, 0x4f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x54, 0x58, 0x4f, 0x49, 0x44,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x55, 0x54, 0x58, 0x4f, 0x49, 0x44, 0x73,
-	0x22, 0x32, 0x0a, 0x0c, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x22, 0x0a, 0x05, 0x55, 0x54, 0x58, 0x4f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x52, 0x05, 0x55,
-	0x54, 0x58, 0x4f, 0x73, 0x22, 0x33, 0x0a, 0x19, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x37, 0x0a, 0x1a, 0x50, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x02, 0x54, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x52, 0x02,
-	0x54, 0x78, 0x22, 0x14, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0x10, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44,
-	0x22, 0x2c, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x02, 0x54, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x52, 0x02, 0x54, 0x78, 0x22, 0x2c,
-	0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x14, 0x0a, 0x12,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x2f, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x03, 0x54, 0x78, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
+	0x78, 0x52, 0x03, 0x54, 0x78, 0x73, 0x22, 0x71, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x49, 0x0a, 0x13, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x32, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16,
+	0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x29,
+	0x0a, 0x03, 0x56, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x03, 0x56, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x04, 0x56, 0x6f, 0x75,
+	0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x04, 0x56, 0x6f, 0x75, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x54, 0x78, 0x46,
+	0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4d, 0x69, 0x6e, 0x54, 0x78, 0x46,
+	0x65, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x46, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x65, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x46, 0x65, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x46, 0x65, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63,
+	0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x41,
+	0x74, 0x6f, 0x6d, 0x69, 0x63, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x22, 0x14, 0x0a, 0x12,
 	0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x22, 0x2b, 0x0a, 0x13, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65,
 	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x70, 0x6f,
@@ -1594,8 +3603,93 @@ var file_localstatetypes_proto_rawDesc = []byte{
 	0x1e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x61,
 	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x1e, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0x33, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x55, 0x54, 0x58, 0x4f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x54,
+	0x58, 0x4f, 0x49, 0x44, 0x22, 0xc7, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x0a, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x42, 0x49, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x42, 0x49, 0x12, 0x34, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4b,
+	0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4b,
+	0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x57,
+	0x0a, 0x1f, 0x47, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73,
+	0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x58, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x55,
+	0x54, 0x58, 0x4f, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x55, 0x54,
+	0x58, 0x4f, 0x49, 0x44, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4b, 0x65,
+	0x79, 0x22, 0x37, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x54, 0x58, 0x4f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x55, 0x54, 0x58, 0x4f, 0x49, 0x44, 0x22, 0x50, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x27, 0x0a, 0x0d,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa0, 0x02, 0x0a, 0x0e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x46, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x65, 0x65, 0x73,
+	0x12, 0x2c, 0x0a, 0x11, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x2a,
+	0x0a, 0x10, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x27, 0x0a, 0x0f, 0x46, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x22, 0xbf, 0x01, 0x0a, 0x07, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x22, 0x54, 0x0a, 0x10, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2a, 0x0a,
+	0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1610,53 +3704,97 @@ func file_localstatetypes_proto_rawDescGZIP() []byte {
 	return file_localstatetypes_proto_rawDescData
 }
 
-var file_localstatetypes_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_localstatetypes_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_localstatetypes_proto_goTypes = []interface{}{
-	(*GetDataRequest)(nil),                  // 0: proto.GetDataRequest
-	(*GetDataResponse)(nil),                 // 1: proto.GetDataResponse
-	(*GetValueRequest)(nil),                 // 2: proto.GetValueRequest
-	(*GetValueResponse)(nil),                // 3: proto.GetValueResponse
-	(*MinedTransactionRequest)(nil),         // 4: proto.MinedTransactionRequest
-	(*MinedTransactionResponse)(nil),        // 5: proto.MinedTransactionResponse
-	(*BlockHeaderRequest)(nil),              // 6: proto.BlockHeaderRequest
-	(*BlockHeaderResponse)(nil),             // 7: proto.BlockHeaderResponse
-	(*UTXORequest)(nil),                     // 8: proto.UTXORequest
-	(*UTXOResponse)(nil),                    // 9: proto.UTXOResponse
-	(*PendingTransactionRequest)(nil),       // 10: proto.PendingTransactionRequest
-	(*PendingTransactionResponse)(nil),      // 11: proto.PendingTransactionResponse
-	(*BlockNumberRequest)(nil),              // 12: proto.BlockNumberRequest
-	(*BlockNumberResponse)(nil),             // 13: proto.BlockNumberResponse
-	(*ChainIDRequest)(nil),                  // 14: proto.ChainIDRequest
-	(*ChainIDResponse)(nil),                 // 15: proto.ChainIDResponse
-	(*TransactionData)(nil),                 // 16: proto.TransactionData
-	(*TransactionDetails)(nil),              // 17: proto.TransactionDetails
-	(*EpochNumberRequest)(nil),              // 18: proto.EpochNumberRequest
-	(*EpochNumberResponse)(nil),             // 19: proto.EpochNumberResponse
-	(*IterateNameSpaceRequest)(nil),         // 20: proto.IterateNameSpaceRequest
-	(*IterateNameSpaceResponse)(nil),        // 21: proto.IterateNameSpaceResponse
-	(*TxBlockNumberRequest)(nil),            // 22: proto.TxBlockNumberRequest
-	(*TxBlockNumberResponse)(nil),           // 23: proto.TxBlockNumberResponse
-	(*ValidatorSetRequest)(nil),             // 24: proto.ValidatorSetRequest
-	(*ValidatorSetResponse)(nil),            // 25: proto.ValidatorSetResponse
-	(*RoundStateForValidatorRequest)(nil),   // 26: proto.RoundStateForValidatorRequest
-	(*RoundStateForValidatorResponse)(nil),  // 27: proto.RoundStateForValidatorResponse
-	(*IterateNameSpaceResponse_Result)(nil), // 28: proto.IterateNameSpaceResponse.Result
-	(*Tx)(nil),                              // 29: proto.Tx
-	(*BlockHeader)(nil),                     // 30: proto.BlockHeader
-	(*TXOut)(nil),                           // 31: proto.TXOut
+	(*GetDataRequest)(nil),                   // 0: proto.GetDataRequest
+	(*GetDataResponse)(nil),                  // 1: proto.GetDataResponse
+	(*GetObjectRequest)(nil),                 // 2: proto.GetObjectRequest
+	(*GetObjectResponse)(nil),                // 3: proto.GetObjectResponse
+	(*GetValueRequest)(nil),                  // 4: proto.GetValueRequest
+	(*GetValueResponse)(nil),                 // 5: proto.GetValueResponse
+	(*MinedTransactionRequest)(nil),          // 6: proto.MinedTransactionRequest
+	(*MinedTransactionResponse)(nil),         // 7: proto.MinedTransactionResponse
+	(*BlockHeaderRequest)(nil),               // 8: proto.BlockHeaderRequest
+	(*BlockHeaderResponse)(nil),              // 9: proto.BlockHeaderResponse
+	(*Block)(nil),                            // 10: proto.Block
+	(*BlockRequest)(nil),                     // 11: proto.BlockRequest
+	(*BlockByHashRequest)(nil),               // 12: proto.BlockByHashRequest
+	(*BlockResponse)(nil),                    // 13: proto.BlockResponse
+	(*BlockRangeRequest)(nil),                // 14: proto.BlockRangeRequest
+	(*BlockRangeResponse)(nil),               // 15: proto.BlockRangeResponse
+	(*ValidatorStats)(nil),                   // 16: proto.ValidatorStats
+	(*EpochStats)(nil),                       // 17: proto.EpochStats
+	(*ValidatorStatsRequest)(nil),            // 18: proto.ValidatorStatsRequest
+	(*ValidatorStatsResponse)(nil),           // 19: proto.ValidatorStatsResponse
+	(*UTXORequest)(nil),                      // 20: proto.UTXORequest
+	(*UTXOResponse)(nil),                     // 21: proto.UTXOResponse
+	(*PendingTransactionRequest)(nil),        // 22: proto.PendingTransactionRequest
+	(*PendingTransactionResponse)(nil),       // 23: proto.PendingTransactionResponse
+	(*BlockNumberRequest)(nil),               // 24: proto.BlockNumberRequest
+	(*BlockNumberResponse)(nil),              // 25: proto.BlockNumberResponse
+	(*ChainIDRequest)(nil),                   // 26: proto.ChainIDRequest
+	(*ChainIDResponse)(nil),                  // 27: proto.ChainIDResponse
+	(*TransactionData)(nil),                  // 28: proto.TransactionData
+	(*TransactionDetails)(nil),               // 29: proto.TransactionDetails
+	(*TransactionsData)(nil),                 // 30: proto.TransactionsData
+	(*TransactionResult)(nil),                // 31: proto.TransactionResult
+	(*TransactionsDetails)(nil),              // 32: proto.TransactionsDetails
+	(*ValidationReason)(nil),                 // 33: proto.ValidationReason
+	(*ValidateTransactionResponse)(nil),      // 34: proto.ValidateTransactionResponse
+	(*FeesRequest)(nil),                      // 35: proto.FeesRequest
+	(*FeesResponse)(nil),                     // 36: proto.FeesResponse
+	(*EpochNumberRequest)(nil),               // 37: proto.EpochNumberRequest
+	(*EpochNumberResponse)(nil),              // 38: proto.EpochNumberResponse
+	(*IterateNameSpaceRequest)(nil),          // 39: proto.IterateNameSpaceRequest
+	(*IterateNameSpaceResponse)(nil),         // 40: proto.IterateNameSpaceResponse
+	(*TxBlockNumberRequest)(nil),             // 41: proto.TxBlockNumberRequest
+	(*TxBlockNumberResponse)(nil),            // 42: proto.TxBlockNumberResponse
+	(*ValidatorSetRequest)(nil),              // 43: proto.ValidatorSetRequest
+	(*ValidatorSetResponse)(nil),             // 44: proto.ValidatorSetResponse
+	(*RoundStateForValidatorRequest)(nil),    // 45: proto.RoundStateForValidatorRequest
+	(*RoundStateForValidatorResponse)(nil),   // 46: proto.RoundStateForValidatorResponse
+	(*GetWithdrawalProofRequest)(nil),        // 47: proto.GetWithdrawalProofRequest
+	(*GetWithdrawalProofResponse)(nil),       // 48: proto.GetWithdrawalProofResponse
+	(*GetWithdrawalsForAccountRequest)(nil),  // 49: proto.GetWithdrawalsForAccountRequest
+	(*GetWithdrawalsForAccountResponse)(nil), // 50: proto.GetWithdrawalsForAccountResponse
+	(*GetSpendingTransactionRequest)(nil),    // 51: proto.GetSpendingTransactionRequest
+	(*GetSpendingTransactionResponse)(nil),   // 52: proto.GetSpendingTransactionResponse
+	(*SupplyRequest)(nil),                    // 53: proto.SupplyRequest
+	(*SupplyResponse)(nil),                   // 54: proto.SupplyResponse
+	(*FeaturesRequest)(nil),                  // 55: proto.FeaturesRequest
+	(*Feature)(nil),                          // 56: proto.Feature
+	(*FeaturesResponse)(nil),                 // 57: proto.FeaturesResponse
+	(*IterateNameSpaceResponse_Result)(nil),  // 58: proto.IterateNameSpaceResponse.Result
+	(*Tx)(nil),                               // 59: proto.Tx
+	(*BlockHeader)(nil),                      // 60: proto.BlockHeader
+	(*TXOut)(nil),                            // 61: proto.TXOut
+	(*Withdrawal)(nil),                       // 62: proto.Withdrawal
 }
 var file_localstatetypes_proto_depIdxs = []int32{
-	29, // 0: proto.MinedTransactionResponse.Tx:type_name -> proto.Tx
-	30, // 1: proto.BlockHeaderResponse.BlockHeader:type_name -> proto.BlockHeader
-	31, // 2: proto.UTXOResponse.UTXOs:type_name -> proto.TXOut
-	29, // 3: proto.PendingTransactionResponse.Tx:type_name -> proto.Tx
-	29, // 4: proto.TransactionData.Tx:type_name -> proto.Tx
-	28, // 5: proto.IterateNameSpaceResponse.Results:type_name -> proto.IterateNameSpaceResponse.Result
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	59, // 0: proto.MinedTransactionResponse.Tx:type_name -> proto.Tx
+	60, // 1: proto.BlockHeaderResponse.BlockHeader:type_name -> proto.BlockHeader
+	60, // 2: proto.Block.BlockHeader:type_name -> proto.BlockHeader
+	59, // 3: proto.Block.Txs:type_name -> proto.Tx
+	10, // 4: proto.BlockResponse.Block:type_name -> proto.Block
+	10, // 5: proto.BlockRangeResponse.Blocks:type_name -> proto.Block
+	16, // 6: proto.EpochStats.Validators:type_name -> proto.ValidatorStats
+	17, // 7: proto.ValidatorStatsResponse.Epochs:type_name -> proto.EpochStats
+	61, // 8: proto.UTXOResponse.UTXOs:type_name -> proto.TXOut
+	59, // 9: proto.PendingTransactionResponse.Tx:type_name -> proto.Tx
+	59, // 10: proto.TransactionData.Tx:type_name -> proto.Tx
+	59, // 11: proto.TransactionsData.Txs:type_name -> proto.Tx
+	31, // 12: proto.TransactionsDetails.Results:type_name -> proto.TransactionResult
+	33, // 13: proto.ValidateTransactionResponse.Vin:type_name -> proto.ValidationReason
+	33, // 14: proto.ValidateTransactionResponse.Vout:type_name -> proto.ValidationReason
+	58, // 15: proto.IterateNameSpaceResponse.Results:type_name -> proto.IterateNameSpaceResponse.Result
+	62, // 16: proto.GetWithdrawalProofResponse.Withdrawal:type_name -> proto.Withdrawal
+	60, // 17: proto.GetWithdrawalProofResponse.BlockHeader:type_name -> proto.BlockHeader
+	56, // 18: proto.FeaturesResponse.Features:type_name -> proto.Feature
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_localstatetypes_proto_init() }
@@ -1679,8 +3817,128 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataResponse); i {
+		file_localstatetypes_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetObjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetObjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinedTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinedTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeaderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeaderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Block); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1691,8 +3949,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValueRequest); i {
+		file_localstatetypes_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockByHashRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1703,8 +3961,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValueResponse); i {
+		file_localstatetypes_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1715,8 +3973,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MinedTransactionRequest); i {
+		file_localstatetypes_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockRangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1727,8 +3985,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MinedTransactionResponse); i {
+		file_localstatetypes_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockRangeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1739,8 +3997,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeaderRequest); i {
+		file_localstatetypes_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1751,8 +4009,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeaderResponse); i {
+		file_localstatetypes_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1763,7 +4021,31 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UTXORequest); i {
 			case 0:
 				return &v.state
@@ -1775,7 +4057,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UTXOResponse); i {
 			case 0:
 				return &v.state
@@ -1787,7 +4069,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PendingTransactionRequest); i {
 			case 0:
 				return &v.state
@@ -1799,7 +4081,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PendingTransactionResponse); i {
 			case 0:
 				return &v.state
@@ -1811,7 +4093,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockNumberRequest); i {
 			case 0:
 				return &v.state
@@ -1823,7 +4105,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockNumberResponse); i {
 			case 0:
 				return &v.state
@@ -1835,7 +4117,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChainIDRequest); i {
 			case 0:
 				return &v.state
@@ -1847,7 +4129,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChainIDResponse); i {
 			case 0:
 				return &v.state
@@ -1859,7 +4141,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransactionData); i {
 			case 0:
 				return &v.state
@@ -1871,7 +4153,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransactionDetails); i {
 			case 0:
 				return &v.state
@@ -1883,7 +4165,91 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionsData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionsDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidationReason); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EpochNumberRequest); i {
 			case 0:
 				return &v.state
@@ -1895,7 +4261,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EpochNumberResponse); i {
 			case 0:
 				return &v.state
@@ -1907,7 +4273,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IterateNameSpaceRequest); i {
 			case 0:
 				return &v.state
@@ -1919,7 +4285,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IterateNameSpaceResponse); i {
 			case 0:
 				return &v.state
@@ -1931,7 +4297,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxBlockNumberRequest); i {
 			case 0:
 				return &v.state
@@ -1943,7 +4309,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxBlockNumberResponse); i {
 			case 0:
 				return &v.state
@@ -1955,7 +4321,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ValidatorSetRequest); i {
 			case 0:
 				return &v.state
@@ -1967,7 +4333,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ValidatorSetResponse); i {
 			case 0:
 				return &v.state
@@ -1979,7 +4345,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RoundStateForValidatorRequest); i {
 			case 0:
 				return &v.state
@@ -1991,7 +4357,7 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RoundStateForValidatorResponse); i {
 			case 0:
 				return &v.state
@@ -2003,7 +4369,139 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWithdrawalProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWithdrawalProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWithdrawalsForAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWithdrawalsForAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpendingTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpendingTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupplyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeaturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Feature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeaturesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IterateNameSpaceResponse_Result); i {
 			case 0:
 				return &v.state
@@ -2022,7 +4520,7 @@ func file_localstatetypes_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_localstatetypes_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   29,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
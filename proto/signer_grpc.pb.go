@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SignerClient is the client API for Signer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SignerClient interface {
+	// List the public keys held by the signer
+	PublicKeys(ctx context.Context, in *SignerPublicKeysRequest, opts ...grpc.CallOption) (*SignerPublicKeysResponse, error)
+	// Sign a consensus message with the secp256k1 key
+	Sign(ctx context.Context, in *SignerSignRequest, opts ...grpc.CallOption) (*SignerSignResponse, error)
+	// Sign a consensus message with a group key share
+	SignGroup(ctx context.Context, in *SignerSignGroupRequest, opts ...grpc.CallOption) (*SignerSignResponse, error)
+	// Hand a private key to the signer
+	ImportKey(ctx context.Context, in *SignerImportKeyRequest, opts ...grpc.CallOption) (*SignerImportKeyResponse, error)
+}
+
+type signerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSignerClient(cc grpc.ClientConnInterface) SignerClient {
+	return &signerClient{cc}
+}
+
+func (c *signerClient) PublicKeys(ctx context.Context, in *SignerPublicKeysRequest, opts ...grpc.CallOption) (*SignerPublicKeysResponse, error) {
+	out := new(SignerPublicKeysResponse)
+	err := c.cc.Invoke(ctx, "/proto.Signer/PublicKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) Sign(ctx context.Context, in *SignerSignRequest, opts ...grpc.CallOption) (*SignerSignResponse, error) {
+	out := new(SignerSignResponse)
+	err := c.cc.Invoke(ctx, "/proto.Signer/Sign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignGroup(ctx context.Context, in *SignerSignGroupRequest, opts ...grpc.CallOption) (*SignerSignResponse, error) {
+	out := new(SignerSignResponse)
+	err := c.cc.Invoke(ctx, "/proto.Signer/SignGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) ImportKey(ctx context.Context, in *SignerImportKeyRequest, opts ...grpc.CallOption) (*SignerImportKeyResponse, error) {
+	out := new(SignerImportKeyResponse)
+	err := c.cc.Invoke(ctx, "/proto.Signer/ImportKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SignerServer is the server API for Signer service.
+// All implementations should embed UnimplementedSignerServer
+// for forward compatibility
+type SignerServer interface {
+	// List the public keys held by the signer
+	PublicKeys(context.Context, *SignerPublicKeysRequest) (*SignerPublicKeysResponse, error)
+	// Sign a consensus message with the secp256k1 key
+	Sign(context.Context, *SignerSignRequest) (*SignerSignResponse, error)
+	// Sign a consensus message with a group key share
+	SignGroup(context.Context, *SignerSignGroupRequest) (*SignerSignResponse, error)
+	// Hand a private key to the signer
+	ImportKey(context.Context, *SignerImportKeyRequest) (*SignerImportKeyResponse, error)
+}
+
+// UnimplementedSignerServer should be embedded to have forward compatible implementations.
+type UnimplementedSignerServer struct {
+}
+
+func (UnimplementedSignerServer) PublicKeys(context.Context, *SignerPublicKeysRequest) (*SignerPublicKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublicKeys not implemented")
+}
+func (UnimplementedSignerServer) Sign(context.Context, *SignerSignRequest) (*SignerSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedSignerServer) SignGroup(context.Context, *SignerSignGroupRequest) (*SignerSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignGroup not implemented")
+}
+func (UnimplementedSignerServer) ImportKey(context.Context, *SignerImportKeyRequest) (*SignerImportKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKey not implemented")
+}
+
+// UnsafeSignerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SignerServer will
+// result in compilation errors.
+type UnsafeSignerServer interface {
+	mustEmbedUnimplementedSignerServer()
+}
+
+func RegisterSignerServer(s grpc.ServiceRegistrar, srv SignerServer) {
+	s.RegisterService(&Signer_ServiceDesc, srv)
+}
+
+func _Signer_PublicKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerPublicKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).PublicKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Signer/PublicKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).PublicKeys(ctx, req.(*SignerPublicKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Signer/Sign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).Sign(ctx, req.(*SignerSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSignGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Signer/SignGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignGroup(ctx, req.(*SignerSignGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_ImportKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerImportKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).ImportKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Signer/ImportKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).ImportKey(ctx, req.(*SignerImportKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Signer_ServiceDesc is the grpc.ServiceDesc for Signer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Signer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Signer",
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublicKeys",
+			Handler:    _Signer_PublicKeys_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _Signer_Sign_Handler,
+		},
+		{
+			MethodName: "SignGroup",
+			Handler:    _Signer_SignGroup_Handler,
+		},
+		{
+			MethodName: "ImportKey",
+			Handler:    _Signer_ImportKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "signer.proto",
+}
@@ -64,7 +64,7 @@ func (c *Conn) CloseChan() <-chan struct{} {
 // remote peer located at address which has remotePub as its long-term static
 // public key. In the case of a handshake failure, the connection is closed and
 // a non-nil error is returned.
-func Dial(localPriv *secp256k1.PrivateKey, protocol types.Protocol, protoVersion types.ProtoVersion, chainID types.ChainIdentifier, port int, netAddr *NetAddress, dialer func(string, string) (net.Conn, error)) (*Conn, error) {
+func Dial(localPriv *secp256k1.PrivateKey, protocol types.Protocol, protoVersion types.ProtoVersion, chainID types.ChainIdentifier, genesisHash []byte, allowUnknownGenesis bool, port int, netAddr *NetAddress, dialer func(string, string) (net.Conn, error)) (*Conn, error) {
 	ipAddr := netAddr.Address.String()
 	var conn net.Conn
 	var err error
@@ -156,6 +156,21 @@ func Dial(localPriv *secp256k1.PrivateKey, protocol types.Protocol, protoVersion
 		return nil, err
 	}
 
+	if err := checkGenesisVersion(protoVersion, remoteVersion, genesisHash, allowUnknownGenesis); err != nil {
+		b.conn.Close()
+		return nil, err
+	}
+	if useGenesisHandshake(protoVersion, remoteVersion) {
+		if err := conn.SetReadDeadline(time.Now().Add(handshakeReadTimeout)); err != nil {
+			b.conn.Close()
+			return nil, err
+		}
+		if err := selfInitiatedGenesisHandshake(b, genesisHash, allowUnknownGenesis); err != nil {
+			b.conn.Close()
+			return nil, err
+		}
+	}
+
 	if err := writeUint32(b, uint32(protocol)); err != nil {
 		b.conn.Close()
 		return nil, err
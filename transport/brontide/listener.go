@@ -56,14 +56,16 @@ type Listener struct {
 	pubkeyLimit            int
 
 	// handshaking
-	chainID      types.ChainIdentifier
-	port         int
-	protoVersion types.ProtoVersion
+	chainID             types.ChainIdentifier
+	genesisHash         []byte
+	allowUnknownGenesis bool
+	port                int
+	protoVersion        types.ProtoVersion
 }
 
 // NewListener returns a new net.Listener which enforces the Brontide scheme
 // during both initial connection establishment and data transfer.
-func NewListener(localStatic *secp256k1.PrivateKey, host string, port int, protoVersion types.ProtoVersion, chainID types.ChainIdentifier, totalLimit int, pubkeyLimit int, originLimit int) (*Listener, error) {
+func NewListener(localStatic *secp256k1.PrivateKey, host string, port int, protoVersion types.ProtoVersion, chainID types.ChainIdentifier, genesisHash []byte, allowUnknownGenesis bool, totalLimit int, pubkeyLimit int, originLimit int) (*Listener, error) {
 	listenAddr := net.JoinHostPort(host, strconv.Itoa(port))
 
 	addr, err := net.ResolveTCPAddr("tcp", listenAddr)
@@ -90,6 +92,8 @@ func NewListener(localStatic *secp256k1.PrivateKey, host string, port int, proto
 		port:                   port,
 		protoVersion:           protoVersion,
 		chainID:                chainID,
+		genesisHash:            genesisHash,
+		allowUnknownGenesis:    allowUnknownGenesis,
 	}
 
 	go brontideListener.listen()
@@ -421,6 +425,36 @@ func (l *Listener) doHandshake(conn net.Conn) {
 		return
 	}
 
+	if err := checkGenesisVersion(l.protoVersion, remoteVersion, l.genesisHash, l.allowUnknownGenesis); err != nil {
+		utils.DebugTrace(l.logger, err)
+		err2 := brontideConn.Close()
+		if err2 != nil {
+			utils.DebugTrace(l.logger, err2)
+		}
+		l.rejectConn(rejectedConnErr(err, remoteAddr))
+		return
+	}
+	if useGenesisHandshake(l.protoVersion, remoteVersion) {
+		if err := conn.SetReadDeadline(time.Now().Add(handshakeReadTimeout)); err != nil {
+			utils.DebugTrace(l.logger, err)
+			err2 := brontideConn.Close()
+			if err2 != nil {
+				utils.DebugTrace(l.logger, err2)
+			}
+			l.rejectConn(rejectedConnErr(err, remoteAddr))
+			return
+		}
+		if err := peerInitiatedGenesisHandshake(brontideConn, l.genesisHash, l.allowUnknownGenesis); err != nil {
+			utils.DebugTrace(l.logger, err)
+			err2 := brontideConn.Close()
+			if err2 != nil {
+				utils.DebugTrace(l.logger, err2)
+			}
+			l.rejectConn(rejectedConnErr(err, remoteAddr))
+			return
+		}
+	}
+
 	select {
 	case <-l.quit:
 		return
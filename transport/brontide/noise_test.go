@@ -37,7 +37,7 @@ func makeListener() (*Listener, *NetAddress, error) {
 	addr := "localhost"
 
 	// Our listener will be local, and the connection remote.
-	listener, err := NewListener(localPriv, addr, testPortListener, testProtoVer, testChainID, 50, 1, 50)
+	listener, err := NewListener(localPriv, addr, testPortListener, testProtoVer, testChainID, nil, false, 50, 1, 50)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -68,7 +68,7 @@ func establishTestConnection(t *testing.T) (net.Conn, net.Conn, func(), error) {
 		if err != nil {
 			t.Error(err)
 		}
-		remoteConn, err := Dial(remotePriv, testProtocol, testProtoVer, testChainID, 9001, netAddr, net.Dial)
+		remoteConn, err := Dial(remotePriv, testProtocol, testProtoVer, testChainID, nil, false, 9001, netAddr, net.Dial)
 		remoteConnChan <- maybeNetConn{remoteConn, err}
 	}()
 
@@ -202,7 +202,7 @@ func TestConcurrentHandshakes(t *testing.T) {
 		if err != nil {
 			t.Fatalf("unable to generate private key: %v", err)
 		}
-		remoteConn, err := Dial(remotePriv, testProtocol, testProtoVer, testChainID, 9001, netAddr, net.Dial)
+		remoteConn, err := Dial(remotePriv, testProtocol, testProtoVer, testChainID, nil, false, 9001, netAddr, net.Dial)
 		if err != nil {
 			t.Errorf("Error in concurrent dial: %v", err)
 		}
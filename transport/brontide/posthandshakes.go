@@ -1,11 +1,13 @@
 package brontide
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 
+	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/types"
 )
 
@@ -13,6 +15,14 @@ import (
 // when remoteID and localID fail to agree.
 var ErrWrongChainID = errors.New("remote peer sent wrong chain identifier")
 
+// ErrWrongGenesis occurs in (peer|self)GenesisHandshake when the local
+// genesis hash is known and the remote peer sent another one.
+var ErrWrongGenesis = errors.New("remote peer sent wrong genesis hash")
+
+// GenesisHandshakeVersion is the first protocol version which exchanges
+// genesis hashes during the handshake.
+const GenesisHandshakeVersion types.ProtoVersion = 2
+
 // Verify that both peers are working on the same chain by
 // having them cross compare their chain identifiers.
 // This step MUST be done after an authenticated encrypted channel
@@ -92,6 +102,77 @@ func peerInitiatedVersionHandshake(conn net.Conn, version types.ProtoVersion) (u
 	return remoteversion, nil
 }
 
+// Verify that both peers started from the same genesis file. A node whose
+// chain was not initialized from a genesis file sends zeros; it is only
+// accepted by peers which do not know their genesis hash either, or which
+// set allowUnknownGenesis. The exchange only happens if both peers speak
+// GenesisHandshakeVersion; see useGenesisHandshake and checkGenesisVersion.
+func selfInitiatedGenesisHandshake(conn net.Conn, genesisHash []byte, allowUnknownGenesis bool) error {
+	local := genesisHashOrZero(genesisHash)
+	if _, err := conn.Write(local); err != nil {
+		return err
+	}
+	remote := make([]byte, constants.HashLen)
+	if _, err := io.ReadFull(conn, remote); err != nil {
+		return err
+	}
+	return checkGenesisHash(local, remote, allowUnknownGenesis)
+}
+
+func peerInitiatedGenesisHandshake(conn net.Conn, genesisHash []byte, allowUnknownGenesis bool) error {
+	local := genesisHashOrZero(genesisHash)
+	remote := make([]byte, constants.HashLen)
+	if _, err := io.ReadFull(conn, remote); err != nil {
+		return err
+	}
+	if _, err := conn.Write(local); err != nil {
+		return err
+	}
+	return checkGenesisHash(local, remote, allowUnknownGenesis)
+}
+
+// useGenesisHandshake returns true if both versions exchange genesis hashes.
+// Peers speaking an older version do not send a genesis hash; they are
+// checked by checkGenesisVersion instead.
+func useGenesisHandshake(local types.ProtoVersion, remote uint32) bool {
+	return local >= GenesisHandshakeVersion && types.ProtoVersion(remote) >= GenesisHandshakeVersion
+}
+
+// checkGenesisVersion rejects peers which do not exchange genesis hashes
+// once the local genesis hash is known, since any peer could otherwise skip
+// the genesis check by claiming an older version. They are only accepted if
+// allowUnknownGenesis is set.
+func checkGenesisVersion(local types.ProtoVersion, remote uint32, genesisHash []byte, allowUnknownGenesis bool) error {
+	if local < GenesisHandshakeVersion || useGenesisHandshake(local, remote) {
+		return nil
+	}
+	if allowUnknownGenesis || len(genesisHash) != constants.HashLen {
+		return nil
+	}
+	return fmt.Errorf("%w: protocol version %v does not exchange genesis hashes", ErrWrongGenesis, remote)
+}
+
+func genesisHashOrZero(genesisHash []byte) []byte {
+	if len(genesisHash) != constants.HashLen {
+		return make([]byte, constants.HashLen)
+	}
+	return genesisHash
+}
+
+// checkGenesisHash accepts remote if it matches local or if local is not
+// known. A remote which does not know its genesis hash is only accepted if
+// allowUnknownGenesis is set.
+func checkGenesisHash(local, remote []byte, allowUnknownGenesis bool) error {
+	zero := make([]byte, constants.HashLen)
+	if bytes.Equal(local, zero) || bytes.Equal(local, remote) {
+		return nil
+	}
+	if bytes.Equal(remote, zero) && allowUnknownGenesis {
+		return nil
+	}
+	return fmt.Errorf("%w: wanted %x, got %x", ErrWrongGenesis, local, remote)
+}
+
 func writeUint32(conn net.Conn, local uint32) error {
 	localBytes := marshalUint32(local)
 	_, err := conn.Write(localBytes[:])
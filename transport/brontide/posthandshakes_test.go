@@ -0,0 +1,128 @@
+package brontide
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto/secp256k1"
+)
+
+func TestGenesisHandshake(t *testing.T) {
+	hashA := bytes.Repeat([]byte{1}, constants.HashLen)
+	hashB := bytes.Repeat([]byte{2}, constants.HashLen)
+	tests := []struct {
+		self, peer     []byte
+		allowUnknown   bool
+		selfOK, peerOK bool
+	}{
+		{nil, nil, false, true, true},
+		{hashA, nil, false, false, true},
+		{nil, hashB, false, true, false},
+		{hashA, nil, true, true, true},
+		{nil, hashB, true, true, true},
+		{hashA, hashA, false, true, true},
+		{hashA, hashB, false, false, false},
+		{hashA, hashB, true, false, false},
+	}
+	for i, tt := range tests {
+		c1, c2 := net.Pipe()
+		peerErr := make(chan error, 1)
+		go func() {
+			peerErr <- peerInitiatedGenesisHandshake(c2, tt.peer, tt.allowUnknown)
+		}()
+		selfErr := selfInitiatedGenesisHandshake(c1, tt.self, tt.allowUnknown)
+		pErr := <-peerErr
+		c1.Close()
+		c2.Close()
+		if tt.selfOK && selfErr != nil {
+			t.Fatalf("Should have succeeded (%v): %v", i, selfErr)
+		}
+		if !tt.selfOK && !errors.Is(selfErr, ErrWrongGenesis) {
+			t.Fatalf("Should have raised ErrWrongGenesis (%v): %v", i, selfErr)
+		}
+		if tt.peerOK && pErr != nil {
+			t.Fatalf("Should have succeeded (%v): %v", i, pErr)
+		}
+		if !tt.peerOK && !errors.Is(pErr, ErrWrongGenesis) {
+			t.Fatalf("Should have raised ErrWrongGenesis (%v): %v", i, pErr)
+		}
+	}
+}
+
+func TestUseGenesisHandshake(t *testing.T) {
+	if useGenesisHandshake(GenesisHandshakeVersion, uint32(GenesisHandshakeVersion-1)) {
+		t.Fatal("Should not exchange genesis hashes with an older peer")
+	}
+	if useGenesisHandshake(GenesisHandshakeVersion-1, uint32(GenesisHandshakeVersion)) {
+		t.Fatal("Should not exchange genesis hashes as an older peer")
+	}
+	if !useGenesisHandshake(GenesisHandshakeVersion, uint32(GenesisHandshakeVersion)) {
+		t.Fatal("Should exchange genesis hashes")
+	}
+}
+
+func TestGenesisHandshakeOlderPeer(t *testing.T) {
+	hashA := bytes.Repeat([]byte{1}, constants.HashLen)
+	hashB := bytes.Repeat([]byte{2}, constants.HashLen)
+	oldVersion := GenesisHandshakeVersion - 1
+	for _, allowUnknown := range []bool{false, true} {
+		// a node which knows its genesis dials an older node of another chain
+		oldPriv, err := secp256k1.NewPrivateKey(secp256k1.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		oldListener, err := NewListener(oldPriv, "localhost", 0, oldVersion, testChainID, hashB, false, 50, 1, 50)
+		if err != nil {
+			t.Fatal(err)
+		}
+		netAddr := &NetAddress{
+			IdentityKey: oldPriv.PubKey(),
+			Address:     oldListener.Addr().(*net.TCPAddr),
+		}
+		priv, err := secp256k1.NewPrivateKey(secp256k1.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn, err := Dial(priv, testProtocol, GenesisHandshakeVersion, testChainID, hashA, allowUnknown, 9001, netAddr, net.Dial)
+		if allowUnknown && err != nil {
+			t.Fatalf("Should have accepted the older peer: %v", err)
+		}
+		if !allowUnknown && !errors.Is(err, ErrWrongGenesis) {
+			t.Fatalf("Should have raised ErrWrongGenesis: %v", err)
+		}
+		if conn != nil {
+			conn.Close()
+		}
+		oldListener.Close()
+
+		// an older node of another chain dials a node which knows its genesis
+		listener, err := NewListener(priv, "localhost", 0, GenesisHandshakeVersion, testChainID, hashA, allowUnknown, 50, 1, 50)
+		if err != nil {
+			t.Fatal(err)
+		}
+		netAddr = &NetAddress{
+			IdentityKey: priv.PubKey(),
+			Address:     listener.Addr().(*net.TCPAddr),
+		}
+		go func() {
+			conn, err := Dial(oldPriv, testProtocol, oldVersion, testChainID, hashB, false, 9001, netAddr, net.Dial)
+			if err == nil {
+				conn.Close()
+			}
+		}()
+		accepted, err := listener.Accept()
+		if allowUnknown && err != nil {
+			t.Fatalf("Should have accepted the older peer: %v", err)
+		}
+		if !allowUnknown && !errors.Is(err, ErrReject) {
+			t.Fatalf("Should have rejected the older peer: %v", err)
+		}
+		if accepted != nil {
+			accepted.Close()
+		}
+		listener.Close()
+	}
+}
@@ -28,4 +28,16 @@ var (
 	// ErrInvalidPrivKey occurs when private key bytes is strictly less than
 	// 16 bytes in length; this is an invalid private key.
 	ErrInvalidPrivKey = errors.New("invalid private key hex string")
+
+	// ErrPeerNotFound occurs when a MemoryTransport dials an identity
+	// that has no transport in the network.
+	ErrPeerNotFound = errors.New("no transport with this identity in the memory network")
+
+	// ErrIdentityInUse occurs when a second transport with the same
+	// private key joins a MemoryNetwork.
+	ErrIdentityInUse = errors.New("identity already in use in the memory network")
+
+	// ErrChainIDMismatch occurs when a MemoryTransport dials an address
+	// of another chain.
+	ErrChainIDMismatch = errors.New("chain id of the address does not match")
 )
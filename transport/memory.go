@@ -0,0 +1,180 @@
+package transport
+
+import (
+	"net"
+	"sync"
+
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/types"
+	"github.com/sirupsen/logrus"
+)
+
+var _ interfaces.P2PTransport = (*MemoryTransport)(nil)
+
+// memoryHost is the host of every address in a MemoryNetwork. Transports
+// are found by their identity, the host and port are only kept so that the
+// addresses may be gossiped and parsed like any other.
+const memoryHost string = "127.0.0.1"
+
+// MemoryNetwork connects the MemoryTransports of the nodes running in a
+// single process. Connections are synchronous in memory pipes, there is
+// no encryption handshake and no socket is opened.
+type MemoryNetwork struct {
+	sync.Mutex
+	transports map[string]*MemoryTransport
+}
+
+// NewMemoryNetwork returns an empty network.
+func NewMemoryNetwork() *MemoryNetwork {
+	return &MemoryNetwork{transports: make(map[string]*MemoryTransport)}
+}
+
+// NewTransport returns a transport joined to the network. The private key
+// only sets the identity of the node, the port is only used in the address.
+func (mn *MemoryNetwork) NewTransport(logger *logrus.Logger, cid types.ChainIdentifier, privateKeyHex string, port int) (interfaces.P2PTransport, error) {
+	localPrivateKey, err := deserializeTransportPrivateKey(privateKeyHex)
+	if err != nil {
+		return nil, err
+	}
+	localNodeAddr := &NodeAddr{
+		host:     memoryHost,
+		port:     port,
+		identity: publicKeyFromPrivateKey(localPrivateKey),
+		chainID:  cid,
+	}
+	mt := &MemoryTransport{
+		logger:        logger,
+		network:       mn,
+		localNodeAddr: localNodeAddr,
+		closeChan:     make(chan struct{}),
+		acceptChan:    make(chan *P2PConn),
+	}
+	mn.Lock()
+	defer mn.Unlock()
+	if _, ok := mn.transports[localNodeAddr.Identity()]; ok {
+		return nil, ErrIdentityInUse
+	}
+	mn.transports[localNodeAddr.Identity()] = mt
+	return mt, nil
+}
+
+func (mn *MemoryNetwork) lookup(identity string) (*MemoryTransport, bool) {
+	mn.Lock()
+	defer mn.Unlock()
+	mt, ok := mn.transports[identity]
+	return mt, ok
+}
+
+func (mn *MemoryNetwork) remove(identity string) {
+	mn.Lock()
+	defer mn.Unlock()
+	delete(mn.transports, identity)
+}
+
+// MemoryTransport is a P2PTransport whose peers are the other transports
+// of the same MemoryNetwork.
+type MemoryTransport struct {
+	logger        *logrus.Logger
+	network       *MemoryNetwork
+	localNodeAddr *NodeAddr
+	closeChan     chan struct{}
+	closeOnce     sync.Once
+	acceptChan    chan *P2PConn
+}
+
+// NodeAddr returns the address of the transport.
+func (mt *MemoryTransport) NodeAddr() interfaces.NodeAddr {
+	return mt.localNodeAddr
+}
+
+// Close removes the transport from the network and causes Accept to
+// return an error. This method is safe to be called multiple times.
+func (mt *MemoryTransport) Close() error {
+	fn := func() {
+		mt.network.remove(mt.localNodeAddr.Identity())
+		close(mt.closeChan)
+		mt.logger.Info("MemoryTransport closed.")
+	}
+	mt.closeOnce.Do(fn)
+	return nil
+}
+
+// Accept returns the connections other transports of the network open
+// to this one.
+func (mt *MemoryTransport) Accept() (interfaces.P2PConn, error) {
+	select {
+	case <-mt.closeChan:
+		return nil, ErrListenerClosed
+	case conn := <-mt.acceptChan:
+		return conn, nil
+	}
+}
+
+// Dial opens a connection to the transport of the network with the
+// identity of addr. The call blocks until the remote side accepts.
+func (mt *MemoryTransport) Dial(addr interfaces.NodeAddr, protocol types.Protocol) (interfaces.P2PConn, error) {
+	select {
+	case <-mt.closeChan:
+		return nil, ErrListenerClosed
+	default:
+	}
+	if addr.ChainID() != mt.localNodeAddr.ChainID() {
+		return nil, ErrChainIDMismatch
+	}
+	remote, ok := mt.network.lookup(addr.Identity())
+	if !ok {
+		return nil, ErrPeerNotFound
+	}
+	localConn, remoteConn := net.Pipe()
+	link := &memoryLink{
+		conns:     []net.Conn{localConn, remoteConn},
+		closeChan: make(chan struct{}),
+	}
+	local := &P2PConn{
+		Conn:         localConn,
+		logger:       mt.logger,
+		nodeAddr:     remote.localNodeAddr,
+		initiator:    types.SelfInitiatedConnection,
+		protocol:     protocol,
+		protoVersion: protoVersion,
+		cleanupfn:    link.close,
+		closeChan:    link.closeChan,
+	}
+	peer := &P2PConn{
+		Conn:         remoteConn,
+		logger:       remote.logger,
+		nodeAddr:     mt.localNodeAddr,
+		initiator:    types.PeerInitiatedConnection,
+		protocol:     protocol,
+		protoVersion: protoVersion,
+		cleanupfn:    link.close,
+		closeChan:    link.closeChan,
+	}
+	select {
+	case remote.acceptChan <- peer:
+		return local, nil
+	case <-remote.closeChan:
+		link.close()
+		return nil, ErrListenerClosed
+	case <-mt.closeChan:
+		link.close()
+		return nil, ErrListenerClosed
+	}
+}
+
+// memoryLink closes both ends of a pipe when either end is closed, as
+// the remote side of a socket would notice.
+type memoryLink struct {
+	conns     []net.Conn
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+func (ml *memoryLink) close() {
+	ml.closeOnce.Do(func() {
+		close(ml.closeChan)
+		for _, conn := range ml.conns {
+			conn.Close()
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package transport
+
+import (
+	"io"
+	"testing"
+
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMemoryTransport(t *testing.T, mn *MemoryNetwork, port int) interfaces.P2PTransport {
+	privk, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport, err := mn.NewTransport(logrus.New(), testCID, serializeTransportPrivateKey(privk), port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return transport
+}
+
+func TestMemoryTransport(t *testing.T) {
+	mn := NewMemoryNetwork()
+	transport1 := newMemoryTransport(t, mn, t1Port)
+	defer transport1.Close()
+	transport2 := newMemoryTransport(t, mn, t2Port)
+	defer transport2.Close()
+
+	accepted := make(chan interfaces.P2PConn)
+	go func() {
+		conn, err := transport1.Accept()
+		if err != nil {
+			t.Error(err)
+		}
+		accepted <- conn
+	}()
+	conn2, err := transport2.Dial(transport1.NodeAddr(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn1 := <-accepted
+	if conn1 == nil {
+		t.Fatal("no connection accepted")
+	}
+	assert.Equal(t, transport2.NodeAddr().Identity(), conn1.NodeAddr().Identity())
+	assert.Equal(t, transport1.NodeAddr().Identity(), conn2.NodeAddr().Identity())
+
+	go func() {
+		if _, err := conn2.Write([]byte("test")); err != nil {
+			t.Error(err)
+		}
+	}()
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(conn1, buf); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "test", string(buf))
+
+	// closing one end closes the other
+	if err := conn2.Close(); err != nil {
+		t.Fatal(err)
+	}
+	<-conn1.CloseChan()
+	if _, err := conn1.Read(buf); err == nil {
+		t.Fatal("read from a closed connection")
+	}
+}
+
+func TestMemoryTransportFail(t *testing.T) {
+	mn := NewMemoryNetwork()
+	transport1 := newMemoryTransport(t, mn, t1Port)
+	transport2 := newMemoryTransport(t, mn, t2Port)
+	defer transport2.Close()
+
+	privk, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	privkHex := serializeTransportPrivateKey(privk)
+	transport3, err := mn.NewTransport(logrus.New(), testCIDFail, privkHex, t1Port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport3.Close()
+	_, err = mn.NewTransport(logrus.New(), testCID, privkHex, t2Port)
+	assert.Equal(t, ErrIdentityInUse, err)
+
+	_, err = transport2.Dial(transport3.NodeAddr(), 1)
+	assert.Equal(t, ErrChainIDMismatch, err)
+
+	addr1 := transport1.NodeAddr()
+	if err := transport1.Close(); err != nil {
+		t.Fatal(err)
+	}
+	_, err = transport2.Dial(addr1, 1)
+	assert.Equal(t, ErrPeerNotFound, err)
+	_, err = transport1.Accept()
+	assert.Equal(t, ErrListenerClosed, err)
+}
@@ -32,13 +32,13 @@ func TestMux(t *testing.T) {
 	}
 	nodePrivKey2Hex := serializeTransportPrivateKey(nodePrivKey2)
 
-	transport1, err := NewP2PTransport(logger, testCID, nodePrivKey1Hex, t1Port, t1Host)
+	transport1, err := NewP2PTransport(logger, testCID, nil, nodePrivKey1Hex, t1Port, t1Host)
 	if err != nil {
 		t.Fatal(err)
 	}
 	defer transport1.Close()
 
-	transport2, err := NewP2PTransport(logger, testCID, nodePrivKey2Hex, t2Port, t2Host)
+	transport2, err := NewP2PTransport(logger, testCID, nil, nodePrivKey2Hex, t2Port, t2Host)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -206,13 +206,13 @@ func TestMux2(t *testing.T) {
 	}
 	nodePrivKey2Hex := serializeTransportPrivateKey(nodePrivKey2)
 
-	transport1, err := NewP2PTransport(logger, testCID, nodePrivKey1Hex, t1Port, t1Host)
+	transport1, err := NewP2PTransport(logger, testCID, nil, nodePrivKey1Hex, t1Port, t1Host)
 	if err != nil {
 		t.Fatal(err)
 	}
 	defer transport1.Close()
 
-	transport2, err := NewP2PTransport(logger, testCID, nodePrivKey2Hex, t2Port, t2Host)
+	transport2, err := NewP2PTransport(logger, testCID, nil, nodePrivKey2Hex, t2Port, t2Host)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -314,13 +314,13 @@ func TestMux3(t *testing.T) {
 	}
 	nodePrivKey2Hex := serializeTransportPrivateKey(nodePrivKey2)
 
-	transport1, err := NewP2PTransport(logger, testCID, nodePrivKey1Hex, t1Port, t1Host)
+	transport1, err := NewP2PTransport(logger, testCID, nil, nodePrivKey1Hex, t1Port, t1Host)
 	if err != nil {
 		t.Fatal(err)
 	}
 	defer transport1.Close()
 
-	transport2, err := NewP2PTransport(logger, testCID, nodePrivKey2Hex, t2Port, t2Host)
+	transport2, err := NewP2PTransport(logger, testCID, nil, nodePrivKey2Hex, t2Port, t2Host)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -19,7 +19,7 @@ var _ interfaces.P2PTransport = (*P2PTransport)(nil)
 // the design of brontide.
 const (
 	tcpNetwork   string             = "tcp"
-	protoVersion types.ProtoVersion = 1
+	protoVersion types.ProtoVersion = brontide.GenesisHandshakeVersion
 )
 
 // P2PTransport wraps the brontide library in native types.
@@ -33,6 +33,10 @@ type P2PTransport struct {
 	localNodeAddr interfaces.NodeAddr
 	// This is the private key used during encryption and authentication.
 	localPrivateKey *secp256k1.PrivateKey
+	// This is the hash of the genesis file of the chain, if it is known.
+	genesisHash []byte
+	// If true, peers which do not know their genesis hash are accepted.
+	allowUnknownGenesis bool
 	// This is the brontide listener.
 	listener *brontide.Listener
 	// This is the quit notification channel for Accept loops.
@@ -79,6 +83,8 @@ func (pt *P2PTransport) Dial(addr interfaces.NodeAddr, protocol types.Protocol)
 		protocol,
 		protoVersion,
 		pt.localNodeAddr.ChainID(),
+		pt.genesisHash,
+		pt.allowUnknownGenesis,
 		pt.localNodeAddr.Port(),
 		btcAddr,
 		func(network string, address string) (net.Conn, error) {
@@ -167,8 +173,12 @@ func (pt *P2PTransport) Accept() (interfaces.P2PConn, error) {
 }
 
 // NewP2PTransport returns a transport object. This object is both a server
-// and a client.
-func NewP2PTransport(logger *logrus.Logger, cid types.ChainIdentifier, privateKeyHex string, port int, host string) (interfaces.P2PTransport, error) {
+// and a client. Peers whose genesis hash differs from genesisHash are
+// rejected; genesisHash is nil if the chain was not started from a genesis
+// file. Unless Transport.AllowUnknownGenesis is set, peers which do not know
+// or do not exchange their genesis hash are rejected as well once
+// genesisHash is set.
+func NewP2PTransport(logger *logrus.Logger, cid types.ChainIdentifier, genesisHash []byte, privateKeyHex string, port int, host string) (interfaces.P2PTransport, error) {
 	localPrivateKey, err := deserializeTransportPrivateKey(privateKeyHex)
 	if err != nil {
 		return nil, err
@@ -194,17 +204,21 @@ func NewP2PTransport(logger *logrus.Logger, cid types.ChainIdentifier, privateKe
 		mp = config.Configuration.Transport.PeerLimitMax
 	}
 
-	listener, err := brontide.NewListener(localPrivateKey, host, port, protoVersion, cid, mp, 1, mc)
+	allowUnknownGenesis := config.Configuration.Transport.AllowUnknownGenesis
+
+	listener, err := brontide.NewListener(localPrivateKey, host, port, protoVersion, cid, genesisHash, allowUnknownGenesis, mp, 1, mc)
 	if err != nil {
 		return nil, err
 	}
 
 	transport := &P2PTransport{
-		logger:          logger,
-		localNodeAddr:   localNodeAddr,
-		localPrivateKey: localPrivateKey,
-		listener:        listener,
-		closeChan:       make(chan struct{}),
+		logger:              logger,
+		localNodeAddr:       localNodeAddr,
+		localPrivateKey:     localPrivateKey,
+		genesisHash:         utils.CopySlice(genesisHash),
+		allowUnknownGenesis: allowUnknownGenesis,
+		listener:            listener,
+		closeChan:           make(chan struct{}),
 	}
 	return transport, nil
 }
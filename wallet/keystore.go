@@ -0,0 +1,218 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	aobjs "github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+)
+
+// ErrUnknownAccount is returned when an account is not in the keystore
+var ErrUnknownAccount = errors.New("account not in keystore")
+
+const keystorePerm = 0600
+
+// Key describes an account in the keystore
+type Key struct {
+	Account   []byte
+	CurveSpec constants.CurveSpec
+}
+
+type keystoreEntry struct {
+	Account   string              `json:"account"`
+	CurveSpec constants.CurveSpec `json:"curveSpec"`
+	Crypto    keystore.CryptoJSON `json:"crypto"`
+}
+
+type keystoreFile struct {
+	Keys []*keystoreEntry `json:"keys"`
+}
+
+// Keystore holds the private keys of MadNet accounts in a single file. Each
+// key is encrypted with its own passphrase using scrypt and AES as in the
+// Ethereum keystore.
+type Keystore struct {
+	path string
+	keys []*keystoreEntry
+
+	// ScryptN and ScryptP are the scrypt parameters used for new keys
+	ScryptN int
+	ScryptP int
+}
+
+// OpenKeystore loads the keystore file at path. A keystore which does not
+// exist yet is empty and is created by Save.
+func OpenKeystore(path string) (*Keystore, error) {
+	ks := &Keystore{
+		path:    path,
+		keys:    []*keystoreEntry{},
+		ScryptN: keystore.StandardScryptN,
+		ScryptP: keystore.StandardScryptP,
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ks, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	f := &keystoreFile{}
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, err
+	}
+	ks.keys = f.Keys
+	return ks, nil
+}
+
+// Save writes the keystore to its file
+func (ks *Keystore) Save() error {
+	data, err := json.MarshalIndent(&keystoreFile{Keys: ks.keys}, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(ks.path), filepath.Base(ks.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(keystorePerm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), ks.path)
+}
+
+// Keys returns the accounts in the keystore
+func (ks *Keystore) Keys() ([]*Key, error) {
+	keys := []*Key{}
+	for _, e := range ks.keys {
+		account, err := hex.DecodeString(e.Account)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, &Key{Account: account, CurveSpec: e.CurveSpec})
+	}
+	return keys, nil
+}
+
+// Generate creates a random key on curveSpec, adds it to the keystore and
+// returns its account
+func (ks *Keystore) Generate(curveSpec constants.CurveSpec, passphrase string) ([]byte, error) {
+	for {
+		privk, err := utils.RandomBytes(constants.HashLen)
+		if err != nil {
+			return nil, err
+		}
+		account, err := ks.Import(curveSpec, privk, passphrase)
+		if errors.Is(err, errInvalidPrivk) {
+			continue
+		}
+		return account, err
+	}
+}
+
+var errInvalidPrivk = errors.New("invalid private key")
+
+// Import adds the private key privk on curveSpec to the keystore and returns
+// its account
+func (ks *Keystore) Import(curveSpec constants.CurveSpec, privk []byte, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, errors.New("empty passphrase")
+	}
+	signer, err := NewSigner(curveSpec, privk)
+	if err != nil {
+		return nil, err
+	}
+	pubk, err := signer.Pubkey()
+	if err != nil {
+		return nil, err
+	}
+	account := crypto.GetAccount(pubk)
+	if _, err := ks.entry(account); err == nil {
+		return nil, errors.New("account already in keystore")
+	}
+	cj, err := keystore.EncryptDataV3(privk, []byte(passphrase), ks.ScryptN, ks.ScryptP)
+	if err != nil {
+		return nil, err
+	}
+	ks.keys = append(ks.keys, &keystoreEntry{
+		Account:   hex.EncodeToString(account),
+		CurveSpec: curveSpec,
+		Crypto:    cj,
+	})
+	return account, nil
+}
+
+// Signer decrypts the key of account and returns a signer for it
+func (ks *Keystore) Signer(account []byte, passphrase string) (aobjs.Signer, error) {
+	e, err := ks.entry(account)
+	if err != nil {
+		return nil, err
+	}
+	privk, err := keystore.DecryptDataV3(e.Crypto, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return NewSigner(e.CurveSpec, privk)
+}
+
+// CurveSpec returns the curve of the key of account
+func (ks *Keystore) CurveSpec(account []byte) (constants.CurveSpec, error) {
+	e, err := ks.entry(account)
+	if err != nil {
+		return 0, err
+	}
+	return e.CurveSpec, nil
+}
+
+func (ks *Keystore) entry(account []byte) (*keystoreEntry, error) {
+	for _, e := range ks.keys {
+		a, err := hex.DecodeString(e.Account)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(a, account) {
+			return e, nil
+		}
+	}
+	return nil, ErrUnknownAccount
+}
+
+// NewSigner returns a signer for the private key privk on curveSpec
+func NewSigner(curveSpec constants.CurveSpec, privk []byte) (aobjs.Signer, error) {
+	if len(privk) != constants.HashLen {
+		return nil, errInvalidPrivk
+	}
+	switch curveSpec {
+	case constants.CurveSecp256k1:
+		signer := &crypto.Secp256k1Signer{}
+		if err := signer.SetPrivk(privk); err != nil {
+			return nil, errInvalidPrivk
+		}
+		return signer, nil
+	case constants.CurveBN256Eth:
+		signer := &crypto.BNSigner{}
+		if err := signer.SetPrivk(privk); err != nil {
+			return nil, errInvalidPrivk
+		}
+		return signer, nil
+	default:
+		return nil, errors.New("invalid curveSpec")
+	}
+}
@@ -0,0 +1,92 @@
+package wallet
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+)
+
+func TestKeystore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keystore.json")
+
+	ks, err := OpenKeystore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks.ScryptN = keystore.LightScryptN
+	ks.ScryptP = keystore.LightScryptP
+	secp, err := ks.Generate(constants.CurveSecp256k1, "secp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	privk := crypto.Hasher([]byte("bn"))
+	bn, err := ks.Import(constants.CurveBN256Eth, privk, "bn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.Import(constants.CurveBN256Eth, privk, "bn"); err == nil {
+		t.Fatal("Should have raised error")
+	}
+	if _, err := ks.Import(constants.CurveBN256Eth, privk[:31], "bn"); err == nil {
+		t.Fatal("Should have raised error")
+	}
+	if err := ks.Save(); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != keystorePerm {
+		t.Fatalf("Wrong permissions: %v", info.Mode().Perm())
+	}
+
+	ks, err = OpenKeystore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := ks.Keys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || !bytes.Equal(keys[0].Account, secp) || !bytes.Equal(keys[1].Account, bn) {
+		t.Fatal("Wrong keys")
+	}
+	if keys[0].CurveSpec != constants.CurveSecp256k1 || keys[1].CurveSpec != constants.CurveBN256Eth {
+		t.Fatal("Wrong curves")
+	}
+	if _, err := ks.Signer(secp, "bn"); err == nil {
+		t.Fatal("Should have raised error")
+	}
+	if _, err := ks.Signer(crypto.Hasher([]byte("other"))[:20], "bn"); err != ErrUnknownAccount {
+		t.Fatalf("Should have raised ErrUnknownAccount: %v", err)
+	}
+	for _, key := range keys {
+		passphrase := "secp"
+		if key.CurveSpec == constants.CurveBN256Eth {
+			passphrase = "bn"
+		}
+		signer, err := ks.Signer(key.Account, passphrase)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w, err := New(nil, signer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(w.Account(), key.Account) || w.CurveSpec() != key.CurveSpec {
+			t.Fatal("Signer does not match the key")
+		}
+	}
+}
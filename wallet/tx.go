@@ -0,0 +1,464 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	aobjs "github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/localrpc"
+	"github.com/MadBase/MadNet/utils"
+)
+
+// Payment is an amount of value to be sent to an account
+type Payment struct {
+	Account   []byte
+	CurveSpec constants.CurveSpec
+	Value     *uint256.Uint256
+}
+
+// draft holds the parts of a tx being built
+type draft struct {
+	chainID uint32
+	height  uint32
+	fees    *localrpc.Fees
+	// renewals is true if FeatureRenewal is active at height
+	renewals bool
+	// consumed are the inputs which must be spent by the tx in addition to
+	// the coins selected to pay for it
+	consumed aobjs.Vout
+	outputs  aobjs.Vout
+}
+
+// Pay sends each payment to its account and blocks until the tx is mined
+func (w *Wallet) Pay(ctx context.Context, payments ...*Payment) ([]byte, error) {
+	tx, err := w.BuildPayment(ctx, payments...)
+	if err != nil {
+		return nil, err
+	}
+	return w.Send(ctx, tx)
+}
+
+// BuildPayment returns a signed tx which sends each payment to its account.
+// The signer pays the value store fee of each payment.
+func (w *Wallet) BuildPayment(ctx context.Context, payments ...*Payment) (*aobjs.Tx, error) {
+	if len(payments) == 0 {
+		return nil, errors.New("no payments")
+	}
+	d, err := w.newDraft(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range payments {
+		if p.Value == nil || p.Value.IsZero() {
+			return nil, errors.New("payment value must be positive")
+		}
+		vs := &aobjs.ValueStore{}
+		if err := vs.New(d.chainID, p.Value.Clone(), d.fees.ValueStoreFee.Clone(), p.Account, p.CurveSpec, make([]byte, constants.HashLen)); err != nil {
+			return nil, err
+		}
+		if err := d.addValueStore(vs); err != nil {
+			return nil, err
+		}
+	}
+	return w.build(ctx, d)
+}
+
+// WriteDataStore stores rawData at index for numEpochs and blocks until the
+// tx is mined
+func (w *Wallet) WriteDataStore(ctx context.Context, index []byte, rawData []byte, numEpochs uint32) ([]byte, error) {
+	tx, err := w.BuildWriteDataStore(ctx, index, rawData, numEpochs)
+	if err != nil {
+		return nil, err
+	}
+	return w.Send(ctx, tx)
+}
+
+// BuildWriteDataStore returns a signed tx which stores rawData at index for
+// numEpochs. If the signer already owns a DataStore at index, it is consumed
+// and its remaining value is returned toward the new one; if the new
+// DataStore renews it, only the epochs added to the lease are charged the
+// DataStore epoch fee.
+func (w *Wallet) BuildWriteDataStore(ctx context.Context, index []byte, rawData []byte, numEpochs uint32) (*aobjs.Tx, error) {
+	d, err := w.newDraft(ctx)
+	if err != nil {
+		return nil, err
+	}
+	old, err := w.findDataStore(ctx, index)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.addDataStore(w, index, rawData, numEpochs, old); err != nil {
+		return nil, err
+	}
+	return w.build(ctx, d)
+}
+
+// RenewDataStore extends the lease of the DataStore at index and blocks
+// until the tx is mined
+func (w *Wallet) RenewDataStore(ctx context.Context, index []byte, numEpochs uint32) ([]byte, error) {
+	tx, err := w.BuildRenewDataStore(ctx, index, numEpochs)
+	if err != nil {
+		return nil, err
+	}
+	return w.Send(ctx, tx)
+}
+
+// BuildRenewDataStore returns a signed tx which extends the lease of the
+// DataStore owned by the signer at index so that it lasts numEpochs from the
+// current epoch
+func (w *Wallet) BuildRenewDataStore(ctx context.Context, index []byte, numEpochs uint32) (*aobjs.Tx, error) {
+	d, err := w.newDraft(ctx)
+	if err != nil {
+		return nil, err
+	}
+	old, err := w.findDataStore(ctx, index)
+	if err != nil {
+		return nil, err
+	}
+	if old == nil {
+		return nil, errors.New("no datastore at index")
+	}
+	oldDS, err := old.DataStore()
+	if err != nil {
+		return nil, err
+	}
+	rawData, err := oldDS.RawData()
+	if err != nil {
+		return nil, err
+	}
+	renewal, err := d.addDataStore(w, index, rawData, numEpochs, old)
+	if err != nil {
+		return nil, err
+	}
+	if !renewal {
+		return nil, errors.New("renewal does not extend the lease")
+	}
+	return w.build(ctx, d)
+}
+
+// DeleteDataStore removes the DataStore at index and blocks until the tx is
+// mined
+func (w *Wallet) DeleteDataStore(ctx context.Context, index []byte) ([]byte, error) {
+	tx, err := w.BuildDeleteDataStore(ctx, index)
+	if err != nil {
+		return nil, err
+	}
+	return w.Send(ctx, tx)
+}
+
+// BuildDeleteDataStore returns a signed tx which consumes the DataStore owned
+// by the signer at index. Its remaining value, less fees, is returned to the
+// signer.
+func (w *Wallet) BuildDeleteDataStore(ctx context.Context, index []byte) (*aobjs.Tx, error) {
+	d, err := w.newDraft(ctx)
+	if err != nil {
+		return nil, err
+	}
+	old, err := w.findDataStore(ctx, index)
+	if err != nil {
+		return nil, err
+	}
+	if old == nil {
+		return nil, errors.New("no datastore at index")
+	}
+	d.consumed = append(d.consumed, old)
+	return w.build(ctx, d)
+}
+
+// findDataStore returns the DataStore owned by the signer at index or nil if
+// there is none
+func (w *Wallet) findDataStore(ctx context.Context, index []byte) (*aobjs.TXOut, error) {
+	if len(index) != constants.HashLen {
+		return nil, errors.New("invalid index length")
+	}
+	resp, err := w.client.PaginateDataStoreUTXOByOwner(ctx, w.curveSpec, w.account, 1, utils.CopySlice(index))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) != 1 || !bytes.Equal(resp[0].Index, index) {
+		return nil, nil
+	}
+	utxos, err := w.client.GetUTXO(ctx, [][]byte{resp[0].UTXOID})
+	if err != nil {
+		return nil, err
+	}
+	if len(utxos) != 1 || !utxos[0].HasDataStore() {
+		return nil, errors.New("datastore not found")
+	}
+	return utxos[0], nil
+}
+
+// newDraft returns a draft with the chain id, fees and height at which the
+// tx is expected to be mined. Remaining values are computed at this height,
+// so a tx consuming a DataStore must be mined in the same epoch.
+func (w *Wallet) newDraft(ctx context.Context) (*draft, error) {
+	chainID, err := w.client.GetChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	height, err := w.client.GetBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fees, err := w.client.GetFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	features, _, err := w.client.GetFeatures(ctx, utils.Epoch(height+1))
+	if err != nil {
+		return nil, err
+	}
+	renewals := false
+	for _, f := range features {
+		if f.Name == string(aobjs.FeatureRenewal) {
+			renewals = f.Active
+		}
+	}
+	d := &draft{
+		chainID:  chainID,
+		height:   height + 1,
+		fees:     fees,
+		renewals: renewals,
+		consumed: aobjs.Vout{},
+		outputs:  aobjs.Vout{},
+	}
+	return d, nil
+}
+
+func (d *draft) addValueStore(vs *aobjs.ValueStore) error {
+	utxo := &aobjs.TXOut{}
+	if err := utxo.NewValueStore(vs); err != nil {
+		return err
+	}
+	d.outputs = append(d.outputs, utxo)
+	return nil
+}
+
+// addDataStore adds a DataStore holding rawData at index for numEpochs. If
+// old is not nil it is consumed by the tx; true is returned if the new
+// DataStore renews it, in which case only the added epochs are charged once
+// FeatureRenewal is active.
+func (d *draft) addDataStore(w *Wallet, index []byte, rawData []byte, numEpochs uint32, old *aobjs.TXOut) (bool, error) {
+	deposit, err := aobjs.BaseDepositEquation(uint32(len(rawData)), numEpochs)
+	if err != nil {
+		return false, err
+	}
+	owner := &aobjs.DataStoreOwner{}
+	owner.New(w.account, w.curveSpec)
+	ds := &aobjs.DataStore{
+		DSLinker: &aobjs.DSLinker{
+			DSPreImage: &aobjs.DSPreImage{
+				ChainID:  d.chainID,
+				Index:    utils.CopySlice(index),
+				IssuedAt: utils.Epoch(d.height),
+				Deposit:  deposit,
+				RawData:  utils.CopySlice(rawData),
+				Owner:    owner,
+			},
+			TxHash: make([]byte, constants.HashLen),
+		},
+	}
+	renewal := false
+	// the fee is charged for the lease plus the two epochs of the initial
+	// burn, or only for the epochs added when renewing once renewals are
+	// active
+	chargedEpochs := uint64(numEpochs) + 2
+	if old != nil {
+		d.consumed = append(d.consumed, old)
+		oldDS, err := old.DataStore()
+		if err != nil {
+			return false, err
+		}
+		renewal, err = ds.IsRenewalOf(d.height, oldDS)
+		if err != nil {
+			return false, err
+		}
+		if renewal && d.renewals {
+			eoe, err := ds.EpochOfExpiration()
+			if err != nil {
+				return false, err
+			}
+			oldEoe, err := oldDS.EpochOfExpiration()
+			if err != nil {
+				return false, err
+			}
+			chargedEpochs = uint64(eoe - oldEoe)
+		}
+	}
+	epochs, err := new(uint256.Uint256).FromUint64(chargedEpochs)
+	if err != nil {
+		return false, err
+	}
+	fee, err := new(uint256.Uint256).Mul(d.fees.DataStoreEpochFee, epochs)
+	if err != nil {
+		return false, err
+	}
+	ds.DSLinker.DSPreImage.Fee = fee
+	utxo := &aobjs.TXOut{}
+	if err := utxo.NewDataStore(ds); err != nil {
+		return false, err
+	}
+	d.outputs = append(d.outputs, utxo)
+	return renewal, nil
+}
+
+// build selects the coins to pay for the draft, adds the change and tx fee
+// and signs the tx. The inputs of the tx are reserved until it is sent or
+// released.
+func (w *Wallet) build(ctx context.Context, d *draft) (*aobjs.Tx, error) {
+	valueOut := uint256.Zero()
+	if len(d.outputs) > 0 {
+		v, err := d.outputs.ValuePlusFee()
+		if err != nil {
+			return nil, err
+		}
+		valueOut = v
+	}
+	valueOut, err := new(uint256.Uint256).Add(valueOut, d.fees.MinTxFee)
+	if err != nil {
+		return nil, err
+	}
+	valueIn := uint256.Zero()
+	if len(d.consumed) > 0 {
+		valueIn, err = d.consumed.RemainingValue(d.height)
+		if err != nil {
+			return nil, err
+		}
+	}
+	needed := uint256.Zero()
+	if valueIn.Lt(valueOut) {
+		needed, err = new(uint256.Uint256).Sub(valueOut, valueIn)
+		if err != nil {
+			return nil, err
+		}
+	}
+	coins, err := w.reserve(ctx, d.height, d.consumed, needed)
+	if err != nil {
+		return nil, err
+	}
+	consumed := append(d.consumed, coins...)
+	tx, err := w.assemble(d, consumed, valueOut)
+	if err != nil {
+		w.Release(&aobjs.Tx{Vin: txIns(consumed)})
+		return nil, err
+	}
+	return tx, nil
+}
+
+// assemble builds and signs a tx spending consumed for the outputs of the
+// draft. valueOut includes the minimum tx fee; any change too small to pay
+// for its own value store is added to the tx fee.
+func (w *Wallet) assemble(d *draft, consumed aobjs.Vout, valueOut *uint256.Uint256) (*aobjs.Tx, error) {
+	tx := &aobjs.Tx{Vin: aobjs.Vin{}, Vout: aobjs.Vout{}}
+	for _, utxo := range consumed {
+		txIn, err := utxo.MakeTxIn()
+		if err != nil {
+			return nil, err
+		}
+		tx.Vin = append(tx.Vin, txIn)
+	}
+	tx.Vout = append(tx.Vout, d.outputs...)
+
+	valueIn, err := consumed.RemainingValue(d.height)
+	if err != nil {
+		return nil, err
+	}
+	change, err := new(uint256.Uint256).Sub(valueIn, valueOut)
+	if err != nil {
+		return nil, err
+	}
+	txFee := d.fees.MinTxFee.Clone()
+	if change.Gt(d.fees.ValueStoreFee) {
+		changeValue, err := new(uint256.Uint256).Sub(change, d.fees.ValueStoreFee)
+		if err != nil {
+			return nil, err
+		}
+		vs := &aobjs.ValueStore{}
+		if err := vs.New(d.chainID, changeValue, d.fees.ValueStoreFee.Clone(), w.account, w.curveSpec, make([]byte, constants.HashLen)); err != nil {
+			return nil, err
+		}
+		utxo := &aobjs.TXOut{}
+		if err := utxo.NewValueStore(vs); err != nil {
+			return nil, err
+		}
+		tx.Vout = append(tx.Vout, utxo)
+	} else {
+		txFee, err = new(uint256.Uint256).Add(txFee, change)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !txFee.IsZero() {
+		tf := &aobjs.TxFee{}
+		if err := tf.New(d.chainID, txFee); err != nil {
+			return nil, err
+		}
+		utxo := &aobjs.TXOut{}
+		if err := utxo.NewTxFee(tf); err != nil {
+			return nil, err
+		}
+		tx.Vout = append(tx.Vout, utxo)
+	}
+	if len(tx.Vout) == 0 {
+		return nil, errors.New("tx has no outputs")
+	}
+
+	if err := tx.Vout.SetTxOutIdx(); err != nil {
+		return nil, err
+	}
+	if err := tx.SetTxHash(); err != nil {
+		return nil, err
+	}
+	for _, utxo := range tx.Vout {
+		if !utxo.HasDataStore() {
+			continue
+		}
+		ds, err := utxo.DataStore()
+		if err != nil {
+			return nil, err
+		}
+		if err := ds.PreSign(w.signer); err != nil {
+			return nil, err
+		}
+	}
+	for i, utxo := range consumed {
+		switch {
+		case utxo.HasValueStore():
+			vs, err := utxo.ValueStore()
+			if err != nil {
+				return nil, err
+			}
+			if err := vs.Sign(tx.Vin[i], w.signer); err != nil {
+				return nil, err
+			}
+		case utxo.HasDataStore():
+			ds, err := utxo.DataStore()
+			if err != nil {
+				return nil, err
+			}
+			if err := ds.Sign(tx.Vin[i], w.signer); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.New("cannot spend utxo")
+		}
+	}
+	return tx, nil
+}
+
+// txIns returns the inputs which consume utxos; inputs which cannot be made
+// are skipped
+func txIns(utxos aobjs.Vout) aobjs.Vin {
+	vin := aobjs.Vin{}
+	for _, utxo := range utxos {
+		txIn, err := utxo.MakeTxIn()
+		if err != nil {
+			continue
+		}
+		vin = append(vin, txIn)
+	}
+	return vin
+}
@@ -0,0 +1,228 @@
+// Package wallet builds, signs and sends transactions for a single signer.
+//
+// The wallet selects coins with GetValueForOwner, charges the fees currently
+// set in dynamics and returns change to the signer. The inputs of every
+// transaction built are reserved until the transaction is mined, fails to
+// send or is released, so concurrent builds never spend the same UTXO twice.
+package wallet
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	aobjs "github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/localrpc"
+	pb "github.com/MadBase/MadNet/proto"
+)
+
+// ErrInsufficientFunds is returned when the unreserved value of the signer
+// cannot pay for a transaction
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// Client is the subset of localrpc.Client used by the wallet
+type Client interface {
+	GetChainID(ctx context.Context) (uint32, error)
+	GetBlockNumber(ctx context.Context) (uint32, error)
+	GetFees(ctx context.Context) (*localrpc.Fees, error)
+	GetFeatures(ctx context.Context, epoch uint32) ([]*pb.Feature, uint32, error)
+	GetValueForOwner(ctx context.Context, curveSpec constants.CurveSpec, account []byte, minValue *uint256.Uint256) ([][]byte, *uint256.Uint256, error)
+	GetUTXO(ctx context.Context, utxoIDs [][]byte) (aobjs.Vout, error)
+	PaginateDataStoreUTXOByOwner(ctx context.Context, curveSpec constants.CurveSpec, account []byte, num uint8, startIndex []byte) ([]*aobjs.PaginationResponse, error)
+	SendTransaction(ctx context.Context, tx *aobjs.Tx) ([]byte, error)
+	GetMinedTransaction(ctx context.Context, txHash []byte) (*aobjs.Tx, error)
+}
+
+var _ Client = (*localrpc.Client)(nil)
+
+// Wallet builds and sends transactions spending the value of one signer
+type Wallet struct {
+	sync.Mutex
+	client    Client
+	signer    aobjs.Signer
+	curveSpec constants.CurveSpec
+	account   []byte
+	// reserved maps the utxoIDs consumed by transactions which have been
+	// built but not yet mined to the value they hold
+	reserved map[string]*uint256.Uint256
+
+	// PollInterval is the time between checks for a mined transaction
+	PollInterval time.Duration
+}
+
+// New returns a wallet which spends the value of signer. The signer must be
+// a *crypto.Secp256k1Signer or a *crypto.BNSigner.
+func New(client Client, signer aobjs.Signer) (*Wallet, error) {
+	pubk, err := signer.Pubkey()
+	if err != nil {
+		return nil, err
+	}
+	var curveSpec constants.CurveSpec
+	switch signer.(type) {
+	case *crypto.Secp256k1Signer:
+		curveSpec = constants.CurveSecp256k1
+	case *crypto.BNSigner:
+		curveSpec = constants.CurveBN256Eth
+	default:
+		return nil, errors.New("unknown signer type")
+	}
+	w := &Wallet{
+		client:       client,
+		signer:       signer,
+		curveSpec:    curveSpec,
+		account:      crypto.GetAccount(pubk),
+		reserved:     make(map[string]*uint256.Uint256),
+		PollInterval: time.Second,
+	}
+	return w, nil
+}
+
+// Account returns the account of the signer
+func (w *Wallet) Account() []byte {
+	return append([]byte{}, w.account...)
+}
+
+// CurveSpec returns the curve of the signer
+func (w *Wallet) CurveSpec() constants.CurveSpec {
+	return w.curveSpec
+}
+
+// Send sends tx and blocks until it has been mined or ctx is done. The
+// inputs reserved for tx are released in either case. The hash of tx is
+// returned.
+func (w *Wallet) Send(ctx context.Context, tx *aobjs.Tx) ([]byte, error) {
+	defer w.Release(tx)
+	txHash, err := w.client.SendTransaction(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.waitForMined(ctx, txHash); err != nil {
+		return nil, err
+	}
+	return txHash, nil
+}
+
+// Release releases the inputs reserved for tx so they may be spent by
+// another transaction. It must be called for a built tx which is not sent.
+func (w *Wallet) Release(tx *aobjs.Tx) {
+	w.Lock()
+	defer w.Unlock()
+	for _, txIn := range tx.Vin {
+		utxoID, err := txIn.UTXOID()
+		if err != nil {
+			continue
+		}
+		delete(w.reserved, string(utxoID))
+	}
+}
+
+// Reserved returns the total value of the inputs which are reserved
+func (w *Wallet) Reserved() *uint256.Uint256 {
+	w.Lock()
+	defer w.Unlock()
+	return w.reservedValue()
+}
+
+func (w *Wallet) reservedValue() *uint256.Uint256 {
+	total := uint256.Zero()
+	for _, v := range w.reserved {
+		total.Add(total, v)
+	}
+	return total
+}
+
+// reserve reserves the consumed inputs of a tx being built along with
+// enough coins of the signer to pay needed. The coins and their values are
+// returned. Selection holds the lock so that concurrent builds do not select
+// the same coins.
+func (w *Wallet) reserve(ctx context.Context, height uint32, consumed aobjs.Vout, needed *uint256.Uint256) (aobjs.Vout, error) {
+	w.Lock()
+	defer w.Unlock()
+	values := make(map[string]*uint256.Uint256)
+	for _, utxo := range consumed {
+		utxoID, err := utxo.UTXOID()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := w.reserved[string(utxoID)]; ok {
+			return nil, errors.New("utxo is already reserved")
+		}
+		v, err := utxo.RemainingValue(height)
+		if err != nil {
+			return nil, err
+		}
+		values[string(utxoID)] = v
+	}
+	coins := aobjs.Vout{}
+	if !needed.IsZero() {
+		// the reserved coins may be among those returned, so ask for enough
+		// to cover them as well
+		minValue, err := new(uint256.Uint256).Add(needed, w.reservedValue())
+		if err != nil {
+			return nil, err
+		}
+		utxoIDs, _, err := w.client.GetValueForOwner(ctx, w.curveSpec, w.account, minValue)
+		if err != nil {
+			return nil, err
+		}
+		free := [][]byte{}
+		for _, utxoID := range utxoIDs {
+			if _, ok := w.reserved[string(utxoID)]; ok {
+				continue
+			}
+			if _, ok := values[string(utxoID)]; ok {
+				continue
+			}
+			free = append(free, utxoID)
+		}
+		if len(free) == 0 {
+			return nil, ErrInsufficientFunds
+		}
+		utxos, err := w.client.GetUTXO(ctx, free)
+		if err != nil {
+			return nil, err
+		}
+		total := uint256.Zero()
+		for _, utxo := range utxos {
+			if total.Gte(needed) {
+				break
+			}
+			utxoID, err := utxo.UTXOID()
+			if err != nil {
+				return nil, err
+			}
+			v, err := utxo.RemainingValue(height)
+			if err != nil {
+				return nil, err
+			}
+			values[string(utxoID)] = v
+			total.Add(total, v)
+			coins = append(coins, utxo)
+		}
+		if total.Lt(needed) {
+			return nil, ErrInsufficientFunds
+		}
+	}
+	for id, v := range values {
+		w.reserved[id] = v
+	}
+	return coins, nil
+}
+
+// waitForMined blocks until the tx with txHash has been mined or ctx is done
+func (w *Wallet) waitForMined(ctx context.Context, txHash []byte) error {
+	for {
+		if _, err := w.client.GetMinedTransaction(ctx, txHash); err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(w.PollInterval):
+		}
+	}
+}
@@ -0,0 +1,480 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	aobjs "github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/localrpc"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/utils"
+)
+
+// testClient is an in memory chain which mines every tx it is sent
+type testClient struct {
+	sync.Mutex
+	chainID uint32
+	height  uint32
+	fees    *localrpc.Fees
+	// renewals is true if FeatureRenewal is active
+	renewals bool
+	utxos    map[string]*aobjs.TXOut
+	mined    map[string]*aobjs.Tx
+	sent     []*aobjs.Tx
+}
+
+func newTestClient() *testClient {
+	return &testClient{
+		chainID: 42,
+		height:  1,
+		fees: &localrpc.Fees{
+			MinTxFee:          uint256.Two(),
+			ValueStoreFee:     uint256.One(),
+			DataStoreEpochFee: uint256.One(),
+			AtomicSwapFee:     uint256.One(),
+		},
+		renewals: true,
+		utxos:    make(map[string]*aobjs.TXOut),
+		mined:    make(map[string]*aobjs.Tx),
+	}
+}
+
+func (c *testClient) GetChainID(ctx context.Context) (uint32, error) {
+	return c.chainID, nil
+}
+
+func (c *testClient) GetBlockNumber(ctx context.Context) (uint32, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.height, nil
+}
+
+func (c *testClient) GetFees(ctx context.Context) (*localrpc.Fees, error) {
+	return c.fees, nil
+}
+
+func (c *testClient) GetFeatures(ctx context.Context, epoch uint32) ([]*pb.Feature, uint32, error) {
+	features := []*pb.Feature{{Name: string(aobjs.FeatureRenewal), Active: c.renewals}}
+	return features, epoch, nil
+}
+
+func (c *testClient) ids() []string {
+	ids := []string{}
+	for id := range c.utxos {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (c *testClient) GetValueForOwner(ctx context.Context, curveSpec constants.CurveSpec, account []byte, minValue *uint256.Uint256) ([][]byte, *uint256.Uint256, error) {
+	c.Lock()
+	defer c.Unlock()
+	utxoIDs := [][]byte{}
+	total := uint256.Zero()
+	for _, id := range c.ids() {
+		utxo := c.utxos[id]
+		if !utxo.HasValueStore() {
+			continue
+		}
+		vs, _ := utxo.ValueStore()
+		owner, err := vs.Owner()
+		if err != nil {
+			return nil, nil, err
+		}
+		if owner.CurveSpec != curveSpec || !bytes.Equal(owner.Account, account) {
+			continue
+		}
+		v, err := vs.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+		utxoIDs = append(utxoIDs, []byte(id))
+		total.Add(total, v)
+		if total.Gte(minValue) {
+			break
+		}
+	}
+	return utxoIDs, total, nil
+}
+
+func (c *testClient) GetUTXO(ctx context.Context, utxoIDs [][]byte) (aobjs.Vout, error) {
+	c.Lock()
+	defer c.Unlock()
+	result := aobjs.Vout{}
+	for _, utxoID := range utxoIDs {
+		if utxo, ok := c.utxos[string(utxoID)]; ok {
+			result = append(result, utxo)
+		}
+	}
+	return result, nil
+}
+
+func (c *testClient) PaginateDataStoreUTXOByOwner(ctx context.Context, curveSpec constants.CurveSpec, account []byte, num uint8, startIndex []byte) ([]*aobjs.PaginationResponse, error) {
+	c.Lock()
+	defer c.Unlock()
+	for _, id := range c.ids() {
+		utxo := c.utxos[id]
+		if !utxo.HasDataStore() {
+			continue
+		}
+		ds, _ := utxo.DataStore()
+		index, err := ds.Index()
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(index, startIndex) {
+			return []*aobjs.PaginationResponse{{UTXOID: []byte(id), Index: index}}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *testClient) SendTransaction(ctx context.Context, tx *aobjs.Tx) ([]byte, error) {
+	c.Lock()
+	defer c.Unlock()
+	txHash, err := tx.TxHash()
+	if err != nil {
+		return nil, err
+	}
+	for _, txIn := range tx.Vin {
+		utxoID, err := txIn.UTXOID()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := c.utxos[string(utxoID)]; !ok {
+			return nil, errors.New("missing utxo")
+		}
+		delete(c.utxos, string(utxoID))
+	}
+	if err := c.add(tx.Vout); err != nil {
+		return nil, err
+	}
+	c.mined[string(txHash)] = tx
+	c.sent = append(c.sent, tx)
+	c.height++
+	return txHash, nil
+}
+
+func (c *testClient) GetMinedTransaction(ctx context.Context, txHash []byte) (*aobjs.Tx, error) {
+	c.Lock()
+	defer c.Unlock()
+	tx, ok := c.mined[string(txHash)]
+	if !ok {
+		return nil, errors.New("not mined")
+	}
+	return tx, nil
+}
+
+func (c *testClient) add(vout aobjs.Vout) error {
+	for _, utxo := range vout {
+		if utxo.HasTxFee() {
+			continue
+		}
+		utxoID, err := utxo.UTXOID()
+		if err != nil {
+			return err
+		}
+		c.utxos[string(utxoID)] = utxo
+	}
+	return nil
+}
+
+// fund gives account a value store of each value
+func (c *testClient) fund(t *testing.T, account []byte, curveSpec constants.CurveSpec, values ...uint64) {
+	c.Lock()
+	defer c.Unlock()
+	for i, value := range values {
+		v := u256(t, value)
+		txHash := crypto.Hasher(account, []byte(strconv.Itoa(len(c.utxos)+i)))
+		vs := &aobjs.ValueStore{}
+		if err := vs.New(c.chainID, v, uint256.Zero(), account, curveSpec, txHash); err != nil {
+			t.Fatal(err)
+		}
+		utxo := &aobjs.TXOut{}
+		if err := utxo.NewValueStore(vs); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.add(aobjs.Vout{utxo}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func u256(t *testing.T, v uint64) *uint256.Uint256 {
+	u, err := new(uint256.Uint256).FromUint64(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+func makeWallet(t *testing.T, c Client, i int) *Wallet {
+	privk := crypto.Hasher([]byte(strconv.Itoa(i)))
+	var signer aobjs.Signer
+	if i%2 == 0 {
+		s := &crypto.Secp256k1Signer{}
+		if err := s.SetPrivk(privk); err != nil {
+			t.Fatal(err)
+		}
+		signer = s
+	} else {
+		s := &crypto.BNSigner{}
+		if err := s.SetPrivk(privk); err != nil {
+			t.Fatal(err)
+		}
+		signer = s
+	}
+	w, err := New(c, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+// validateTx checks that tx is balanced and correctly signed
+func validateTx(t *testing.T, c *testClient, tx *aobjs.Tx) {
+	t.Helper()
+	utxoIDs := [][]byte{}
+	for _, txIn := range tx.Vin {
+		utxoID, err := txIn.UTXOID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		utxoIDs = append(utxoIDs, utxoID)
+	}
+	consumed, err := c.GetUTXO(context.Background(), utxoIDs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(consumed) != len(tx.Vin) {
+		t.Fatal("tx consumes missing utxos")
+	}
+	height := c.height + 1
+	if err := tx.ValidateEqualVinVout(height, consumed); err != nil {
+		t.Fatal(err)
+	}
+	for i, utxo := range consumed {
+		if err := utxo.ValidateSignature(height, tx.Vin[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tx.ValidatePreSignature(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWalletPayment(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient()
+	for i := 0; i < 2; i++ {
+		w := makeWallet(t, c, i)
+		other := makeWallet(t, c, i+2)
+		c.fund(t, w.Account(), w.CurveSpec(), 10)
+
+		payment := &Payment{Account: other.Account(), CurveSpec: other.CurveSpec(), Value: uint256.Two()}
+		tx, err := w.BuildPayment(ctx, payment)
+		if err != nil {
+			t.Fatal(err)
+		}
+		validateTx(t, c, tx)
+		// payment, change and tx fee
+		if len(tx.Vout) != 3 {
+			t.Fatalf("Should have 3 outputs: %v", len(tx.Vout))
+		}
+		change, err := tx.Vout[1].ValueStore()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 10 - (2 + 1) - 2 - 1
+		if v, _ := change.Value(); !v.Eq(u256(t, 4)) {
+			t.Fatalf("Wrong change: %v", v)
+		}
+		if _, err := w.Send(ctx, tx); err != nil {
+			t.Fatal(err)
+		}
+		if !w.Reserved().IsZero() {
+			t.Fatal("Should have released the reservation")
+		}
+		utxoIDs, total, err := c.GetValueForOwner(ctx, other.CurveSpec(), other.Account(), uint256.One())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(utxoIDs) != 1 || !total.Eq(uint256.Two()) {
+			t.Fatalf("Payment not received: %v", total)
+		}
+	}
+}
+
+func TestWalletReservations(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient()
+	w := makeWallet(t, c, 0)
+	other := makeWallet(t, c, 1)
+	c.fund(t, w.Account(), w.CurveSpec(), 10, 10)
+	payment := &Payment{Account: other.Account(), CurveSpec: other.CurveSpec(), Value: uint256.Two()}
+
+	tx1, err := w.BuildPayment(ctx, payment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx2, err := w.BuildPayment(ctx, payment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id1, _ := tx1.Vin[0].UTXOID()
+	id2, _ := tx2.Vin[0].UTXOID()
+	if len(tx1.Vin) != 1 || len(tx2.Vin) != 1 || bytes.Equal(id1, id2) {
+		t.Fatal("Concurrent builds should spend different coins")
+	}
+	if _, err := w.BuildPayment(ctx, payment); !errors.Is(err, ErrInsufficientFunds) {
+		t.Fatalf("Should have raised ErrInsufficientFunds: %v", err)
+	}
+	w.Release(tx2)
+	tx3, err := w.BuildPayment(ctx, payment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id3, _ := tx3.Vin[0].UTXOID()
+	if !bytes.Equal(id2, id3) {
+		t.Fatal("Should have reused the released coin")
+	}
+	if _, err := w.Send(ctx, tx1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Send(ctx, tx3); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWalletDataStore(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient()
+	w := makeWallet(t, c, 1)
+	c.fund(t, w.Account(), w.CurveSpec(), 100000)
+	index := crypto.Hasher([]byte("index"))
+	rawData := []byte("data")
+
+	tx, err := w.BuildWriteDataStore(ctx, index, rawData, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validateTx(t, c, tx)
+	ds, err := tx.Vout[0].DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee, _ := ds.Fee(); !fee.Eq(u256(t, 4)) {
+		t.Fatalf("Wrong datastore fee: %v", fee)
+	}
+	if _, err := w.Send(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err = w.BuildRenewDataStore(ctx, index, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validateTx(t, c, tx)
+	ds, err = tx.Vout[0].DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// expires 3 epochs later than the datastore it renews
+	if fee, _ := ds.Fee(); !fee.Eq(u256(t, 3)) {
+		t.Fatalf("Wrong renewal fee: %v", fee)
+	}
+	if _, err := w.Send(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.BuildRenewDataStore(ctx, index, 1); err == nil {
+		t.Fatal("Should have raised error")
+	}
+
+	// without renewals the full lease is charged
+	c.renewals = false
+	tx, err = w.BuildRenewDataStore(ctx, index, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err = tx.Vout[0].DataStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee, _ := ds.Fee(); !fee.Eq(u256(t, 9)) {
+		t.Fatalf("Wrong datastore fee: %v", fee)
+	}
+	w.Release(tx)
+	c.renewals = true
+
+	tx, err = w.BuildDeleteDataStore(ctx, index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validateTx(t, c, tx)
+	if len(tx.Vin) != 1 {
+		t.Fatal("Should not need funding to delete a datastore")
+	}
+	if _, err := w.Send(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.BuildDeleteDataStore(ctx, index); err == nil {
+		t.Fatal("Should have raised error")
+	}
+	if _, err := w.BuildWriteDataStore(ctx, utils.CopySlice(index[:31]), rawData, 2); err == nil {
+		t.Fatal("Should have raised error")
+	}
+}
+
+func TestWalletConcurrentBuilds(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient()
+	w := makeWallet(t, c, 0)
+	other := makeWallet(t, c, 1)
+	const n = 8
+	values := make([]uint64, n)
+	for i := range values {
+		values[i] = 10
+	}
+	c.fund(t, w.Account(), w.CurveSpec(), values...)
+	payment := &Payment{Account: other.Account(), CurveSpec: other.CurveSpec(), Value: uint256.Two()}
+
+	txs := make([]*aobjs.Tx, n)
+	errs := make([]error, n)
+	wg := sync.WaitGroup{}
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			txs[i], errs[i] = w.BuildPayment(ctx, payment)
+		}(i)
+	}
+	wg.Wait()
+	seen := make(map[string]bool)
+	for i := 0; i < n; i++ {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		utxoID, err := txs[i].Vin[0].UTXOID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[string(utxoID)] {
+			t.Fatal("Coin selected twice")
+		}
+		seen[string(utxoID)] = true
+	}
+	for i := 0; i < n; i++ {
+		if _, err := w.Send(ctx, txs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+}